@@ -1,44 +1,128 @@
 package zog
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+)
 
+// Assemble assembles a program held in a string
 func Assemble(s string) (*Assembly, error) {
+	return assembleSource("", s)
+}
 
-	s += "\n"
-
-	assembler := &PegAssembler{Buffer: s}
-	assembler.Init()
-	assembler.Current.Init()
-	err := assembler.Parse()
+// AssembleFile assembles a source file
+func AssembleFile(fname string) (*Assembly, error) {
+	buf, err := ioutil.ReadFile(fname)
 	if err != nil {
-		//assembler.Print()
-		assembler.PrintSyntaxTree()
-		return nil, fmt.Errorf("Can't parse: %s", err)
+		return nil, fmt.Errorf("Can't read source: %s", err)
 	}
-	//assembler.Print()
-	//assembler.PrintSyntaxTree()
-	assembler.Execute()
-	assembly := assembler.GetAssembly()
+	return assembleSource(fname, string(buf))
+}
 
-	err = assembly.ResolveAddresses()
+func assembleSource(fname string, s string) (*Assembly, error) {
+	a := &Assembly{}
+	a.Init()
+	err := a.assemble(fname, s)
 	if err != nil {
-		return nil, fmt.Errorf("Can't resolve addresses: %s", err)
+		return nil, err
 	}
-
-	return assembly, nil
+	return a, nil
 }
 
 type LabelledInstruction struct {
 	Label string
 	Inst  Instruction
 	Addr  uint16
+	File  string
+	Line  int
+	// The section the instruction is in, and where its bytes need
+	// patching once the sections are placed
+	Section string
+	Relocs  []Reloc
+}
+
+// Location is the file:line the instruction came from
+func (l *LabelledInstruction) Location() string {
+	return location(l.File, l.Line)
+}
+
+func location(file string, line int) string {
+	if file == "" {
+		return fmt.Sprintf("Line %d", line)
+	}
+	return fmt.Sprintf("%s:%d", file, line)
+}
+
+// Symbol is a label or EQU value
+type Symbol struct {
+	Name  string
+	Value int
+	File  string
+	Line  int
+	// False if the value depends on symbols not yet defined
+	known bool
+	// Set with DEFL or :=
+	redefinable bool
+	// The value first given this pass
+	first int
+	// The last pass to define the symbol
+	pass int
+	// The section the value is relative to, or the imported symbol it is
+	// an offset from
+	Section string
+	Extern  string
+}
+
+func (s *Symbol) Location() string {
+	return location(s.File, s.Line)
 }
 
 type Assembly struct {
 	BaseAddr uint16
 	Linsts   []LabelledInstruction
 	Labels   map[string]int
-	resolved bool
+	Symbols  map[string]*Symbol
+	// Every source line, as assembled, for listings
+	Lines []SourceLine
+	// Set by END with an operand
+	Entry        uint16
+	EntrySection string
+	HasEntry     bool
+	resolved     bool
+	// Warnings from the last assembly, which didn't stop it
+	Warnings []*Error
+
+	// State for the pass in progress
+	pass     int
+	addr     uint16
+	emitted  bool
+	changed  *Symbol
+	errs     []*Error
+	warnings []*Error
+	// The value of $
+	dollar uint16
+	// The last non-local label, which .local labels belong to
+	scope string
+	// The current section, the location counters of the others, and the
+	// symbols named by PUBLIC
+	section  string
+	counters map[string]uint16
+	exports  []string
+	// Operands of the instruction being resolved, for relocation
+	operands []operand
+
+	// The macro layer
+	parser   *PegAssembler
+	macros   map[string]*macro
+	conds    []cond
+	depth    int
+	uniq     int
+	ended    bool
+	sources  map[string][]srcLine
+	binaries map[string][]byte
 }
 
 func (a *Assembly) Encode() ([]byte, error) {
@@ -55,51 +139,116 @@ func (a *Assembly) Encode() ([]byte, error) {
 	return buf, nil
 }
 
+// Block is a run of assembled bytes at their address
+type Block struct {
+	Addr uint16
+	Data []byte
+}
+
+func (b Block) End() int {
+	return int(b.Addr) + len(b.Data)
+}
+
+// Blocks places the code at the addresses given by ORG, in address order.
+// It is an error for two parts of the code to overlap.
+func (a *Assembly) Blocks() ([]Block, error) {
+	err := a.ResolveAddresses()
+	if err != nil {
+		return nil, err
+	}
+	if a.Relocatable() {
+		return nil, errors.New("Relocatable code has to be linked")
+	}
+
+	var blocks []Block
+	for _, linst := range a.Linsts {
+		buf := linst.Inst.Encode()
+		if len(buf) == 0 {
+			continue
+		}
+		if int(linst.Addr)+len(buf) > 0x10000 {
+			return nil, a.errorAt(linst.File, linst.Line, "code runs past the end of memory")
+		}
+		n := len(blocks)
+		if n > 0 && blocks[n-1].End() == int(linst.Addr) {
+			blocks[n-1].Data = append(blocks[n-1].Data, buf...)
+			continue
+		}
+		blocks = append(blocks, Block{Addr: linst.Addr, Data: append([]byte{}, buf...)})
+	}
+	return placeBlocks(blocks)
+}
+
+// placeBlocks sorts blocks by address, joining any which touch
+func placeBlocks(blocks []Block) ([]Block, error) {
+	sort.SliceStable(blocks, func(i, j int) bool { return blocks[i].Addr < blocks[j].Addr })
+	var placed []Block
+	for _, b := range blocks {
+		n := len(placed)
+		if n > 0 && placed[n-1].End() > int(b.Addr) {
+			return nil, fmt.Errorf("Code at %04X overlaps code at %04X", b.Addr, placed[n-1].Addr)
+		}
+		if n > 0 && placed[n-1].End() == int(b.Addr) {
+			placed[n-1].Data = append(placed[n-1].Data, b.Data...)
+			continue
+		}
+		placed = append(placed, b)
+	}
+	return placed, nil
+}
+
+// ResolveAddresses fills in the operands of all instructions, once the
+// passes have settled the address of every label.
 func (a *Assembly) ResolveAddresses() error {
 	if a.resolved {
 		return nil
 	}
 
-	// One pass to work out addresses for instructions
-	addr := a.BaseAddr
-	for i := range a.Linsts {
-		a.Linsts[i].Addr = addr
-		buf := a.Linsts[i].Inst.Encode()
-		addr += uint16(len(buf))
-	}
-	// One pass to find and resolve labels
 	for i := range a.Linsts {
+		a.dollar = a.Linsts[i].Addr
+		a.section = a.Linsts[i].Section
+		a.operands = nil
 		err := a.Linsts[i].Inst.Resolve(a)
 		if err != nil {
-			return fmt.Errorf("Failed to resolve label for [%s]: %s", a.Linsts[i].Inst, err)
+			return a.errorAt(a.Linsts[i].File, a.Linsts[i].Line, "can't resolve [%s]: %s", a.Linsts[i].Inst, err)
 		}
-		label := a.Linsts[i].Label
-		if label != "" {
-			label += ":"
-		}
-		fmt.Printf("%-16s %04X: %s\n", label, a.Linsts[i].Addr, a.Linsts[i].Inst)
 	}
 	a.resolved = true
 	return nil
 }
 
 func (a *Assembly) ResolveLoc8(l Loc8) error {
-	contents, ok := l.(Contents)
-	if !ok {
-		return nil
+	switch l := l.(type) {
+	case *Expr8:
+		n, err := evalByte(a, l.expr)
+		if err != nil {
+			return err
+		}
+		l.Imm8 = Imm8(n)
+		a.operand(l.expr, -1, operandByte)
+	case IndexedContents:
+		if l.expr == nil {
+			return nil
+		}
+		d, err := l.expr.expr.Eval(a)
+		if err != nil {
+			return err
+		}
+		if d < -128 || d > 127 {
+			return fmt.Errorf("Index displacement out of range: %s = %d", l.expr.expr, d)
+		}
+		l.expr.Disp = Disp(d)
+		a.operand(l.expr.expr, 0, operandAbsolute)
+	case Contents:
+		return a.resolveContents(l)
 	}
-	return a.resolveContents(contents)
+	return nil
 }
 
 func (a *Assembly) ResolveLoc16(l Loc16) error {
 	label, ok := l.(*Label)
 	if ok {
-		addr, err := a.FindLabelAddr(label.name)
-		if err != nil {
-			return err
-		}
-		label.Imm16 = Imm16(addr)
-		return nil
+		return a.resolveLabel(label)
 	}
 
 	contents, ok := l.(Contents)
@@ -114,15 +263,63 @@ func (a *Assembly) resolveContents(c Contents) error {
 	if !ok {
 		return nil
 	}
+	return a.resolveLabel(label)
+}
 
-	addr, err := a.FindLabelAddr(label.name)
+func (a *Assembly) resolveLabel(label *Label) error {
+	var e Expr = exprSymbol(label.name)
+	if label.expr != nil {
+		e = label.expr
+	}
+	nn, err := evalWord(a, e)
 	if err != nil {
 		return err
 	}
-	label.Imm16 = Imm16(addr)
+	label.Imm16 = Imm16(nn)
+	a.operand(e, -2, operandWord)
 	return nil
 }
 
+// ResolveRelative finds the displacement from the end of the current
+// (two byte) instruction to a relative jump target.
+func (a *Assembly) ResolveRelative(t *RelTarget) (Disp, error) {
+	addr, err := t.expr.Eval(a)
+	if err != nil {
+		return 0, err
+	}
+	a.operand(t.expr, 0, operandRelative)
+	d := addr - (int(a.dollar) + 2)
+	if d < -128 || d > 127 {
+		return 0, fmt.Errorf("Relative jump to %s out of range (%d), use JP", t, d)
+	}
+	return Disp(d), nil
+}
+
+// qualify gives a .local label its full name. sjasm's .@name in a macro
+// is the same as .name outside it.
+func (a *Assembly) qualify(name string) string {
+	if strings.HasPrefix(name, ".") {
+		return a.scope + "." + strings.TrimPrefix(name[1:], "@")
+	}
+	return name
+}
+
+// SymbolValue finds the value of a label or EQU symbol, or $ for the
+// address of the current instruction
+func (a *Assembly) SymbolValue(name string) (int, error) {
+	if name == "$" {
+		return int(a.dollar), nil
+	}
+	sym, ok := a.Symbols[name]
+	if !ok {
+		return 0, &symbolError{name, fmt.Sprintf("Undefined symbol: %s", name)}
+	}
+	if !sym.known {
+		return 0, &symbolError{name, fmt.Sprintf("Symbol %s has no value", name)}
+	}
+	return sym.Value, nil
+}
+
 func (a *Assembly) FindLabelAddr(name string) (uint16, error) {
 	index, ok := a.Labels[name]
 	if !ok {
@@ -137,6 +334,10 @@ func (a *Assembly) FindLabelAddr(name string) (uint16, error) {
 
 func (a *Assembly) Init() {
 	a.Labels = make(map[string]int)
+	a.Symbols = make(map[string]*Symbol)
+	a.sources = make(map[string][]srcLine)
+	a.binaries = make(map[string][]byte)
+	a.counters = make(map[string]uint16)
 }
 
 func (a *Assembly) Instructions() []Instruction {
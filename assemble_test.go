@@ -1,7 +1,11 @@
 package zog
 
 import (
+	"bytes"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 )
@@ -19,6 +23,8 @@ func TestAssembleRich(t *testing.T) {
 	}{
 		{`org 0100h : start: jp start`, "c3 00 01"},
 		{`ld a, (foo) : foo: defb abh`, "3a 03 00 ab"},
+		{`ach: nop : ld a, ach : ld b, ACh`, "00 3e 00 06 ac"},
+		{`ld a, feh : jp feh : feh: nop`, "3e 05 c3 05 00 00"},
 		{`defw 1234h`, "3412"},
 		{`defb 10h`, "10"},
 		{`defs 03h`, "00 00 00"},
@@ -33,6 +39,49 @@ foo: JP foo
 foo:
 	JP foo
 `, "C3 00 00"},
+		{"loop: NOP : JR loop", "00 18 fd"},
+		{"JR NZ, fwd : NOP : fwd: NOP", "20 01 00 00"},
+		{"JR $", "18 fe"},
+		{"NOP : JR $+3 : NOP", "00 18 01 00"},
+		{"JR C, $-2", "38 fc"},
+		{"ld b, 10h : again: NOP : DJNZ again", "06 10 00 10 fd"},
+		{"JR -16", "18 f0"},
+		{"ld a, 1+2*3", "3e 07"},
+		{"ld a, (1+2)*3", "3e 09"},
+		{"ld a, ~0 & 0fh", "3e 0f"},
+		{"ld a, 1 << 4 >> 2", "3e 04"},
+		{"ld a, 17 % 5 ^ 3", "3e 01"},
+		{"ld a, 1010b | %0101", "3e 0f"},
+		{"ld a, 17o + 0b1", "3e 10"},
+		{"ld a, 'A'", "3e 41"},
+		{"ld a, LOW 1234h : ld b, HIGH(1234h)", "3e 34 06 12"},
+		{"ld hl, foo+2 : foo: nop", "21 05 00 00"},
+		{"ld hl, (ptr) : ptr: dw 1234h", "2a 03 00 34 12"},
+		{"ld bc, fin-start : start: nop : nop : fin:", "01 02 00 00 00"},
+		{"count equ 3 : ld b, count", "06 03"},
+		{"count = 2*size : size: equ 4 : ld b, count", "06 08"},
+		{"ld a, (ix+off+1) : off equ 2", "dd 7e 03"},
+		{"ld (iy-off), 5 : off equ 1", "fd 36 ff 05"},
+		{"out (port), a : in a, (port) : port equ 0feh", "d3 fe db fe"},
+		{"nop : db $", "00 01"},
+		{"org 8000h : dw $", "00 80"},
+		{"ds size : size equ 2 : db 1", "00 00 01"},
+		{"jr $+2", "18 00"},
+		{"jr fin : ds size : fin: nop : size equ 3", "18 03 00 00 00 00"},
+		{"db 1, 2, 3", "01 02 03"},
+		{`db "Hi", 0dh, 'there', '!'+1`, "48 69 0d 74 68 65 72 65 22"},
+		{`defm "ok"`, "6f 6b"},
+		{"dw 1234h, fin, 'A' : fin:", "34 12 06 00 41 00"},
+		{`defz "ab", "c"`, "61 62 63 00"},
+		{`dz 'x'`, "78 00"},
+		{`dc "ab", 1, "cd"`, "61 e2 01 63 e4"},
+		{"ds 3, 0ffh", "ff ff ff"},
+		{"ds 300h", strings.Repeat("00", 0x300)},
+		{"ds size, val : size equ 2 : val equ 0e5h", "e5 e5"},
+		{"db 1 : align 4 : db 2", "01 00 00 00 02"},
+		{"db 1 : align 4, 0ffh : db 2", "01 ff ff ff 02"},
+		{"align 8 : db 1", "01"},
+		{"org 7 : db 1 : align 4 : dw $", "01 08 00"},
 	}
 	for _, tc := range testCases {
 		fmt.Printf("Assemble: %s\n", tc.prog)
@@ -54,6 +103,345 @@ foo:
 	}
 }
 
+func TestAssembleJrRange(t *testing.T) {
+	prog := "start: NOP\n defs 80h\n JR start\n"
+	_, err := Assemble(prog)
+	if err == nil {
+		t.Fatalf("Expected out of range error")
+	}
+	if !strings.Contains(err.Error(), "Line 3") || !strings.Contains(err.Error(), "out of range") {
+		t.Fatalf("Error doesn't name the line: %s", err)
+	}
+}
+
+func TestAssembleExprErrors(t *testing.T) {
+	testCases := []struct {
+		prog   string
+		errStr string
+	}{
+		{"ld a, 256", "out of range"},
+		{"ld a, (ix+128)", "out of range"},
+		{"ld hl, nowhere", "Line 1, column 8: can't resolve [LD HL, nowhere (0x0000)]: Undefined symbol: nowhere"},
+		{"ld a, 1/zero : zero equ 0", "Division by zero"},
+		{"loop1 equ loop2 : loop2 equ loop1 : dw loop1", "Symbol loop2 has no value"},
+		{"nop\nfoo: nop\nfoo: nop", "Line 3: duplicate symbol foo, previously defined at Line 2"},
+		{"ds (lbl & 1) ^ 1\nlbl: nop", "Line 2: phase error, value of lbl still changing"},
+		{"ds later", "Line 1, column 4: Undefined symbol: later"},
+		{"ds 1, 256", "out of range"},
+		{"align 0", "Line 1: invalid alignment 0"},
+		{"db 1, 2,", "can't parse"},
+		{"org 100h : jr 0105h", "Line 1: displacement 0105h out of range; use a label or $-relative target"},
+		{"djnz 0110h", "displacement 0110h out of range"},
+		{"jr nz, 0x80", "displacement 0x80 out of range"},
+		{"djnz 500", "displacement 500 out of range"},
+	}
+	for _, tc := range testCases {
+		_, err := Assemble(tc.prog)
+		if err == nil {
+			t.Fatalf("Expected error assembling [%s]", tc.prog)
+		}
+		if !strings.Contains(err.Error(), tc.errStr) {
+			t.Fatalf("Error for [%s] doesn't contain [%s]: %s", tc.prog, tc.errStr, err)
+		}
+	}
+}
+
+func TestAssembleDiagnostics(t *testing.T) {
+	prog := "start:\tnop\n\tjp nowhere\n\tld a, 256\n\tfoo bar\n\tjr start\n"
+	_, err := Assemble(prog)
+	el, ok := err.(ErrorList)
+	if !ok {
+		t.Fatalf("Expected an ErrorList, got %T: %v", err, err)
+	}
+	if len(el) != 3 || el.Errors() != 3 {
+		t.Fatalf("Expected 3 errors, got %d: %s", len(el), el)
+	}
+	expected := []struct {
+		line, col int
+	}{{2, 5}, {3, 0}, {4, 6}}
+	for i, e := range el {
+		if e.Line != expected[i].line || e.Col != expected[i].col {
+			t.Fatalf("Error %d at %d:%d, expected %d:%d: %s", i, e.Line, e.Col, expected[i].line, expected[i].col, e)
+		}
+	}
+	detail := el[0].Detail()
+	if detail != "Line 2, column 5: can't resolve [JP nowhere (0x0000)]: Undefined symbol: nowhere\n\tjp nowhere\n\t   ^" {
+		t.Fatalf("Unexpected detail:\n%s", detail)
+	}
+
+	assembly, err := Assemble(" org 70000\n nop\n")
+	if err != nil {
+		t.Fatalf("Failed to assemble: %s", err)
+	}
+	if len(assembly.Warnings) != 1 || !strings.Contains(assembly.Warnings[0].Error(), "Line 1: warning: ORG 70000 truncated") {
+		t.Fatalf("Expected a warning about ORG, got %v", assembly.Warnings)
+	}
+}
+
+// Operands which the grammar accepts but which aren't real instructions
+func TestAssembleInvalid(t *testing.T) {
+	testCases := []string{
+		"rst foo",
+		"rst 9",
+		"ld i, b",
+		"ld b, r",
+		"ld (hl), (hl)",
+		"ld (bc), b",
+		"ld ixh, iyl",
+		"ld ixh, h",
+		"ld l, iyh",
+		"ld ixl, (ix+1)",
+		"ex de, bc",
+		"ex (sp), de",
+		"push sp",
+		"pop af'",
+		"ld bc, de",
+		"ld af, 5",
+		"call de",
+		"jr po, 0",
+		"djnz 500",
+		"in b, (5)",
+		"out (5), b",
+		"sla ixh",
+		"bit 0, iyl",
+		"rlc b, c",
+	}
+	for _, prog := range testCases {
+		_, err := Assemble(prog)
+		if err == nil {
+			t.Fatalf("Expected error assembling [%s]", prog)
+		}
+		if _, ok := err.(ErrorList); !ok {
+			t.Fatalf("Expected an ErrorList assembling [%s], got %T: %s", prog, err, err)
+		}
+	}
+}
+
+func TestAssembleMacros(t *testing.T) {
+	testCases := []struct {
+		prog        string
+		byteFormStr string
+	}{
+		{"twice macro op\n op\n op\n endm\n twice nop", "00 00"},
+		{"ldr macro r, v\n ld r, v\n endm\n ldr a, 5\n ldr b, 6", "3e 05 06 06"},
+		{"MACRO ldr r, v:7\n ld r, v\n ENDM\n ldr a\n ldr b, 1", "3e 07 06 01"},
+		{"chr macro c\n db 'c'\n endm\n chr 'h'", "68"},
+		{"wait macro ?lab\n?lab: djnz ?lab\n endm\n wait\n wait", "10 fe 10 fe"},
+		{"wait macro\n local lp\nlp: djnz lp\n endm\n wait\n wait", "10 fe 10 fe"},
+		{"twice macro\n nop\n nop\n endm\nstart: twice\n jp start", "00 00 c3 00 00"},
+		{"rept 3\n nop\n endr", "00 00 00"},
+		{"REPEAT 3\n db @#\n ENDR", "00 01 02"},
+		{"num equ 2\n rept num*2\n inc a\n endm", "3c 3c 3c 3c"},
+		{"irp r, <a, b, c>\n inc r\n endr", "3c 04 0c"},
+		{"irp r, d, e\n dec r\n endr", "15 1d"},
+		{"if 1\n nop\n else\n halt\n endif", "00"},
+		{"if 0\n nop\n else\n halt\n endif", "76"},
+		{"if 0\n nop\n elseif 2 > 1\n halt\n else\n di\n endif", "76"},
+		{"if 0\n if 1\n nop\n endif\n else\n halt\n endif", "76"},
+		{"if size == 2\n nop\n endif\nsize equ 2", "00"},
+		{"ifdef foo\n nop\n endif\n ifndef foo\n halt\n endif", "76"},
+		{"foo equ 1\n ifdef foo\n nop\n endif", "00"},
+		{" ifndef FOO\nFOO equ 1\n endif\n ld a, FOO\n ifndef FOO\nFOO equ 2\n endif", "3e 01"},
+		{"lbl: if 1\n nop\n endif\n jp lbl", "00 c3 00 00"},
+		{" if 0\n nop\nlbl: else\n halt\ndone: endif\n jp lbl\n jp done", "76 c3 00 00 c3 01 00"},
+		{"m macro r\n ifidn r, a\n xor a\n else\n ld r, 0\n endif\n endm\n m a\n m b", "af 06 00"},
+		{"count := 0\n db count\ncount := count + 1\n db count", "00 01"},
+		{"count defl 3\n db count\ncount defl count * 2\n db count", "03 06"},
+		{"inc_ macro\n ex af, af'\n endm\n inc_", "08"},
+	}
+	for _, tc := range testCases {
+		assembly, err := Assemble(tc.prog)
+		if err != nil {
+			t.Fatalf("Failed to assemble [%s]: %s", tc.prog, err)
+		}
+		buf, err := assembly.Encode()
+		if err != nil {
+			t.Fatalf("Failed to encode [%s]: %s", tc.prog, err)
+		}
+		byteFormStr := strings.Replace(strings.ToLower(tc.byteFormStr), " ", "", -1)
+		hexBufStr := strings.ToLower(bufToHex(buf))
+		if hexBufStr != byteFormStr {
+			t.Fatalf("Assembling [%s] got [%s] expected [%s]", tc.prog, hexBufStr, byteFormStr)
+		}
+	}
+}
+
+func TestAssembleMacroErrors(t *testing.T) {
+	testCases := []struct {
+		prog   string
+		errStr string
+	}{
+		{"if 1\n nop", "Line 1: IF without ENDIF"},
+		{"nop\n endif", "Line 2: ENDIF without IF"},
+		{"m macro\n nop", "Line 1: MACRO without ENDM"},
+		{" endm", "Line 1: ENDM without MACRO or REPT"},
+		{"m macro a\n nop\n endm\n m 1, 2", "Line 4: too many arguments for macro m"},
+		{"m macro\n m\n endm\n m", "nested too deeply"},
+		{" error 'Not today'", "Line 1: Not today"},
+		{" include 'nosuchfile.asm'", "Line 1: can't include"},
+	}
+	for _, tc := range testCases {
+		_, err := Assemble(tc.prog)
+		if err == nil {
+			t.Fatalf("Expected error assembling [%s]", tc.prog)
+		}
+		if !strings.Contains(err.Error(), tc.errStr) {
+			t.Fatalf("Error for [%s] doesn't contain [%s]: %s", tc.prog, tc.errStr, err)
+		}
+	}
+}
+
+func TestAssembleInclude(t *testing.T) {
+	dir, err := ioutil.TempDir("", "zogas")
+	if err != nil {
+		t.Fatalf("Can't make temp dir: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	files := map[string]string{
+		"main.asm":     "start: include 'inc/defs.asm'\n ld a, val\n incbin \"data.bin\", 1, 2\n jp start\n",
+		"inc/defs.asm": "val equ 42\n include more.asm\n",
+		"inc/more.asm": " nop\n",
+		"data.bin":     "\x01\x02\x03\x04",
+	}
+	for name, contents := range files {
+		fname := filepath.Join(dir, name)
+		os.MkdirAll(filepath.Dir(fname), 0755)
+		err = ioutil.WriteFile(fname, []byte(contents), 0644)
+		if err != nil {
+			t.Fatalf("Can't write %s: %s", fname, err)
+		}
+	}
+
+	assembly, err := AssembleFile(filepath.Join(dir, "main.asm"))
+	if err != nil {
+		t.Fatalf("Failed to assemble: %s", err)
+	}
+	buf, err := assembly.Encode()
+	if err != nil {
+		t.Fatalf("Failed to encode: %s", err)
+	}
+	got := strings.ToLower(bufToHex(buf))
+	expected := "003e2a0203c30000"
+	if got != expected {
+		t.Fatalf("Got [%s] expected [%s]", got, expected)
+	}
+}
+
+func TestAssembleSyntax(t *testing.T) {
+	testCases := []struct {
+		prog        string
+		byteFormStr string
+	}{
+		{"ld a,b", "78"},
+		{"Ld hL, 1234H", "21 34 12"},
+		{"ORG 100H : DB 1", "01"},
+		{" ASEG\n .TITLE 'x'", ""},
+		{"ab: jp ab", "c3 00 00"},
+		{"x: jp x", "c3 00 00"},
+		{"loop djnz loop", "10 fe"},
+		{"Loop\n djnz Loop", "10 fe"},
+		{"nop\ndisplay halt", "00 76"},
+		{"LBL: JP LBL", "c3 00 00"},
+		{"one: jr .skip\n.skip: nop\ntwo: jr .skip\n.skip: nop", "18 00 00 18 00 00"},
+		{"one: nop\n.x nop\n jp .x", "00 00 c3 01 00"},
+		{"one:\n.lp djnz .lp\ntwo:\n.lp djnz .lp", "10 fe 10 fe"},
+		{"one: nop\n.val equ 5\n ld a, .val", "00 3e 05"},
+		{"cp a, 1 : sub a, b : xor a, a : or a, c", "fe 01 90 af b1"},
+		{"add (hl) : adc 1 : sbc a, b", "86 ce 01 98"},
+		{"JP (HL) : jp (ix) : jp (iy)", "e9 dd e9 fd e9"},
+		{"ex af, af", "08"},
+		{"ld a, 0d7h xor 1", "3e d6"},
+		{"ld a, 6 and 3 or 8", "3e 0a"},
+		{"ld a, 1 shl 4 shr 1", "3e 08"},
+		{"ld a, 17 mod 5", "3e 02"},
+		{"ld a, not 0f0h and 0ffh", "3e 0f"},
+		{"cnt set 1 : db cnt : cnt set cnt + 1 : db cnt", "01 02"},
+		{"set 1, a", "cb cf"},
+		{"nop\n end\n halt", "00"},
+		{"m macro lbl\nlb&lbl: db 1\n jp lb&lbl\n endm\n m 1", "01 c3 00 00"},
+	}
+	for _, tc := range testCases {
+		assembly, err := Assemble(tc.prog)
+		if err != nil {
+			t.Fatalf("Failed to assemble [%s]: %s", tc.prog, err)
+		}
+		buf, err := assembly.Encode()
+		if err != nil {
+			t.Fatalf("Failed to encode [%s]: %s", tc.prog, err)
+		}
+		byteFormStr := strings.Replace(strings.ToLower(tc.byteFormStr), " ", "", -1)
+		hexBufStr := strings.ToLower(bufToHex(buf))
+		if hexBufStr != byteFormStr {
+			t.Fatalf("Assembling [%s] got [%s] expected [%s]", tc.prog, hexBufStr, byteFormStr)
+		}
+	}
+}
+
+func TestAssembleZexall(t *testing.T) {
+	for _, name := range []string{"zexall", "zexdoc"} {
+		assembly, err := AssembleFile("zexall/cpm/" + name + ".src")
+		if err != nil {
+			t.Fatalf("Failed to assemble %s: %s", name, err)
+		}
+		buf, err := assembly.Encode()
+		if err != nil {
+			t.Fatalf("Failed to encode %s: %s", name, err)
+		}
+		expected, err := ioutil.ReadFile("zexall/cpm/" + name + ".com")
+		if err != nil {
+			t.Fatalf("Can't read %s.com: %s", name, err)
+		}
+		if !bytes.Equal(buf, expected) {
+			t.Fatalf("Assembled %s doesn't match %s.com", name, name)
+		}
+	}
+}
+
+func TestAssembleBlocks(t *testing.T) {
+	assembly, err := Assemble(`
+	org 8000h
+	ld a, 1
+	ret
+	org 4000h
+	db 1, 2
+	org 8003h
+	nop
+	end 8000h
+`)
+	if err != nil {
+		t.Fatalf("Failed to assemble: %s", err)
+	}
+	blocks, err := assembly.Blocks()
+	if err != nil {
+		t.Fatalf("Failed to place code: %s", err)
+	}
+	expected := []Block{
+		{0x4000, []byte{0x01, 0x02}},
+		{0x8000, []byte{0x3e, 0x01, 0xc9, 0x00}},
+	}
+	if len(blocks) != len(expected) {
+		t.Fatalf("Got %d blocks, expected %d", len(blocks), len(expected))
+	}
+	for i := range blocks {
+		if blocks[i].Addr != expected[i].Addr || !bytes.Equal(blocks[i].Data, expected[i].Data) {
+			t.Fatalf("Got block %04X [% X] expected %04X [% X]", blocks[i].Addr, blocks[i].Data, expected[i].Addr, expected[i].Data)
+		}
+	}
+	if !assembly.HasEntry || assembly.Entry != 0x8000 {
+		t.Fatalf("Got entry %04X (%v) expected 8000", assembly.Entry, assembly.HasEntry)
+	}
+
+	assembly, err = Assemble("\torg 100h\n\tld a, 1\n\torg 101h\n\tnop\n")
+	if err != nil {
+		t.Fatalf("Failed to assemble: %s", err)
+	}
+	_, err = assembly.Blocks()
+	if err == nil {
+		t.Fatalf("No error for overlapping code")
+	}
+}
+
 func TestAssembleBasic(t *testing.T) {
 	testCases := []string{
 		"RLC (IX+1), B",
@@ -104,13 +492,13 @@ func TestAssembleBasic(t *testing.T) {
 		//		"ADD DE, HL",
 		"EX AF,AF'",
 		"RET C",
-		"CALL DE",
+		"CALL 0x1234",
 
 		"RET C",
 		"RST 8",
 		"RST 16",
 		"DJNZ -10",
-		"CALL Z, DE",
+		"CALL Z, 0x1234",
 
 		"RL A",
 		"SET 4, A",
@@ -121,7 +509,7 @@ func TestAssembleBasic(t *testing.T) {
 		"LD DE, (0x1234)",
 		"LD (0x1234), HL",
 
-		"LD (0x1234), H",
+		"LD A, (0x1234)",
 
 		"LD A, (HL)",
 		"LD (HL), A",
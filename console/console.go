@@ -0,0 +1,168 @@
+package console
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+
+	"github.com/jbert/zog"
+)
+
+// Status port bits
+const (
+	StatusKeyReady = 0x01
+	StatusTxReady  = 0x02
+)
+
+// Console is a simple terminal device. Keys typed on the host are queued
+// in the background so the guest can poll the status port without
+// blocking, and guest output is passed through the terminal emulation.
+type Console struct {
+	sync.Mutex
+	w    io.Writer
+	t    *translator
+	keys chan byte
+	// Passed hotkeys, from Attach
+	hotkey func(zog.Hotkey)
+}
+
+// HotkeyEscape starts a hotkey, as in telnet: ^] then s saves the machine
+// state and ^] then l loads it. ^] twice sends one ^] to the guest.
+const HotkeyEscape = 0x1d
+
+var hotkeys = map[byte]zog.Hotkey{
+	's': zog.HotkeySaveState,
+	'l': zog.HotkeyLoadState,
+}
+
+func New(r io.Reader, w io.Writer, emu Emulation) *Console {
+	c := &Console{
+		w:    w,
+		t:    newTranslator(emu),
+		keys: make(chan byte, 1024),
+	}
+	go c.readKeys(r)
+	return c
+}
+
+func (c *Console) readKeys(r io.Reader) {
+	buf := make([]byte, 1)
+	escaped := false
+	for {
+		n, err := r.Read(buf)
+		if err != nil {
+			return
+		}
+		if n != 1 {
+			continue
+		}
+		k := buf[0]
+		if !escaped && k == HotkeyEscape {
+			escaped = true
+			continue
+		}
+		if escaped {
+			escaped = false
+			if hk, ok := hotkeys[k]; ok {
+				c.Lock()
+				hotkey := c.hotkey
+				c.Unlock()
+				if hotkey != nil {
+					hotkey(hk)
+				}
+				continue
+			}
+			if k != HotkeyEscape {
+				c.keys <- HotkeyEscape
+			}
+		}
+		c.keys <- k
+	}
+}
+
+func (c *Console) Status() byte {
+	status := byte(StatusTxReady)
+	if len(c.keys) > 0 {
+		status |= StatusKeyReady
+	}
+	return status
+}
+
+// ReadData returns the next key, or 0 if none is ready. It never blocks.
+func (c *Console) ReadData() byte {
+	select {
+	case n := <-c.keys:
+		return n
+	default:
+		return 0
+	}
+}
+
+func (c *Console) WriteData(n byte) {
+	c.Lock()
+	defer c.Unlock()
+	buf := c.t.translate(n)
+	if len(buf) == 0 {
+		return
+	}
+	_, err := c.w.Write(buf)
+	if err != nil {
+		panic(fmt.Sprintf("Error writing to console: %s", err))
+	}
+}
+
+// Attach puts the console on the given (8-bit) ports. The high byte of
+// the port address is ignored, as on most Z80 systems.
+func (c *Console) Attach(z *zog.Zog, dataPort, statusPort byte) error {
+	c.Lock()
+	c.hotkey = z.Hotkey
+	c.Unlock()
+	data := zog.IOFuncs{
+		InFunc:  func(port uint16) byte { return c.ReadData() },
+		OutFunc: func(port uint16, n byte) { c.WriteData(n) },
+	}
+	err := z.IO.Attach(0x00ff, uint16(dataPort), data)
+	if err != nil {
+		return err
+	}
+	status := zog.IOFuncs{
+		InFunc: func(port uint16) byte { return c.Status() },
+	}
+	return z.IO.Attach(0x00ff, uint16(statusPort), status)
+}
+
+func isTerminal(f *os.File) bool {
+	fi, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return fi.Mode()&os.ModeCharDevice != 0
+}
+
+func stty(f *os.File, args ...string) (string, error) {
+	cmd := exec.Command("stty", args...)
+	cmd.Stdin = f
+	out, err := cmd.Output()
+	return strings.TrimSpace(string(out)), err
+}
+
+// RawMode puts the host terminal into character-at-a-time mode with no
+// echo and no CR->NL mapping. Signals are left enabled so ^C still works.
+// The returned func restores the previous settings.
+func RawMode(f *os.File) (func(), error) {
+	if !isTerminal(f) {
+		return func() {}, nil
+	}
+	saved, err := stty(f, "-g")
+	if err != nil {
+		return nil, fmt.Errorf("Can't save terminal settings: %s", err)
+	}
+	_, err = stty(f, "-icanon", "-echo", "-icrnl", "min", "1")
+	if err != nil {
+		return nil, fmt.Errorf("Can't set raw mode: %s", err)
+	}
+	return func() { stty(f, saved) }, nil
+}
@@ -0,0 +1,63 @@
+package console
+
+import (
+	"os"
+
+	"github.com/jbert/zog"
+)
+
+const (
+	DataPort   = 0x00
+	StatusPort = 0x01
+)
+
+// Machine is a bare Z80 with a console on DataPort/StatusPort, suitable
+// for monitor programs which poll for keys.
+type Machine struct {
+	z       *zog.Zog
+	emu     Emulation
+	restore func()
+}
+
+func NewMachine(z *zog.Zog, emu Emulation) *Machine {
+	return &Machine{z: z, emu: emu}
+}
+
+func (m Machine) LoadAddr() uint16 {
+	return 0x0000
+}
+
+func (m Machine) RunAddr() uint16 {
+	return 0x0000
+}
+
+func (m Machine) Name() string {
+	return "console"
+}
+
+func (m *Machine) Start() error {
+	restore, err := RawMode(os.Stdin)
+	if err != nil {
+		return err
+	}
+	m.restore = restore
+
+	c := New(os.Stdin, os.Stdout, m.emu)
+	return c.Attach(m.z, DataPort, StatusPort)
+}
+
+func (m *Machine) Stop() {
+	if m.restore != nil {
+		m.restore()
+		m.restore = nil
+	}
+}
+
+// Keys queued on the console aren't saved
+func (m *Machine) Save() (*zog.MachineState, error) {
+	return m.z.SaveState(m.Name()), nil
+}
+
+func (m *Machine) Restore(s *zog.MachineState) error {
+	return m.z.RestoreState(s, m.Name())
+}
@@ -0,0 +1,172 @@
+package console
+
+import "fmt"
+
+// Emulation selects how bytes written by the guest are interpreted before
+// being passed to the (ANSI) host terminal.
+type Emulation int
+
+const (
+	ANSI Emulation = iota
+	VT52
+	ADM3A
+)
+
+func (e Emulation) String() string {
+	switch e {
+	case ANSI:
+		return "ansi"
+	case VT52:
+		return "vt52"
+	case ADM3A:
+		return "adm3a"
+	default:
+		return fmt.Sprintf("unknown emulation %d", int(e))
+	}
+}
+
+func ParseEmulation(s string) (Emulation, error) {
+	switch s {
+	case "", "ansi", "none":
+		return ANSI, nil
+	case "vt52":
+		return VT52, nil
+	case "adm3a", "adm-3a":
+		return ADM3A, nil
+	default:
+		return ANSI, fmt.Errorf("Unknown terminal emulation [%s]", s)
+	}
+}
+
+const esc = 0x1b
+
+const (
+	ansiUp          = "\x1b[A"
+	ansiDown        = "\x1b[B"
+	ansiRight       = "\x1b[C"
+	ansiLeft        = "\x1b[D"
+	ansiHome        = "\x1b[H"
+	ansiClearScreen = "\x1b[H\x1b[2J"
+	ansiClearEOS    = "\x1b[J"
+	ansiClearEOL    = "\x1b[K"
+	ansiReverseLF   = "\x1bM"
+	ansiReverse     = "\x1b[7m"
+	ansiNormal      = "\x1b[0m"
+)
+
+// translator turns a stream of guest bytes into host ANSI sequences. It
+// holds the state of any partially received escape sequence.
+type translator struct {
+	emu Emulation
+	seq []byte
+}
+
+func newTranslator(emu Emulation) *translator {
+	return &translator{emu: emu}
+}
+
+func ansiGoto(row, col byte) []byte {
+	// Both VT52 and ADM-3A offset row/col by 0x20, ANSI is 1-based
+	return []byte(fmt.Sprintf("\x1b[%d;%dH", int(row)-0x1f, int(col)-0x1f))
+}
+
+// translate returns the bytes to send to the host for guest byte n. The
+// result may be empty while an escape sequence is being collected.
+func (t *translator) translate(n byte) []byte {
+	switch t.emu {
+	case VT52:
+		return t.vt52(n)
+	case ADM3A:
+		return t.adm3a(n)
+	default:
+		return []byte{n}
+	}
+}
+
+func (t *translator) vt52(n byte) []byte {
+	if len(t.seq) == 0 {
+		if n == esc {
+			t.seq = append(t.seq, n)
+			return nil
+		}
+		return []byte{n}
+	}
+
+	t.seq = append(t.seq, n)
+	if t.seq[1] == 'Y' {
+		// ESC Y row col
+		if len(t.seq) < 4 {
+			return nil
+		}
+		row, col := t.seq[2], t.seq[3]
+		t.seq = t.seq[:0]
+		return ansiGoto(row, col)
+	}
+	t.seq = t.seq[:0]
+
+	switch n {
+	case 'A':
+		return []byte(ansiUp)
+	case 'B':
+		return []byte(ansiDown)
+	case 'C':
+		return []byte(ansiRight)
+	case 'D':
+		return []byte(ansiLeft)
+	case 'E':
+		// Heathkit/Atari extension
+		return []byte(ansiClearScreen)
+	case 'H':
+		return []byte(ansiHome)
+	case 'I':
+		return []byte(ansiReverseLF)
+	case 'J':
+		return []byte(ansiClearEOS)
+	case 'K':
+		return []byte(ansiClearEOL)
+	case 'p':
+		return []byte(ansiReverse)
+	case 'q':
+		return []byte(ansiNormal)
+	case esc:
+		// Restart the sequence
+		t.seq = append(t.seq, esc)
+		return nil
+	default:
+		// Graphics mode, keypad modes, identify etc. are dropped
+		return nil
+	}
+}
+
+func (t *translator) adm3a(n byte) []byte {
+	if len(t.seq) > 0 {
+		t.seq = append(t.seq, n)
+		if t.seq[1] != '=' {
+			t.seq = t.seq[:0]
+			return nil
+		}
+		// ESC = row col
+		if len(t.seq) < 4 {
+			return nil
+		}
+		row, col := t.seq[2], t.seq[3]
+		t.seq = t.seq[:0]
+		return ansiGoto(row, col)
+	}
+
+	switch n {
+	case esc:
+		t.seq = append(t.seq, n)
+		return nil
+	case 0x0b: // ^K
+		return []byte(ansiUp)
+	case 0x0c: // ^L
+		return []byte(ansiRight)
+	case 0x1a: // ^Z
+		return []byte(ansiClearScreen)
+	case 0x1e: // ^^
+		return []byte(ansiHome)
+	default:
+		return []byte{n}
+	}
+}
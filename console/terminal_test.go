@@ -0,0 +1,108 @@
+package console
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/jbert/zog"
+)
+
+func TestTranslate(t *testing.T) {
+	testCases := []struct {
+		emu      Emulation
+		in       string
+		expected string
+	}{
+		{ANSI, "hello\x1b[2J", "hello\x1b[2J"},
+
+		{VT52, "abc", "abc"},
+		{VT52, "\x1bH\x1bJ", "\x1b[H\x1b[J"},
+		{VT52, "\x1bA\x1bB\x1bC\x1bD", "\x1b[A\x1b[B\x1b[C\x1b[D"},
+		{VT52, "\x1bY  x", "\x1b[1;1Hx"},
+		{VT52, "\x1bY%*", "\x1b[6;11H"},
+		{VT52, "\x1bKz", "\x1b[Kz"},
+		{VT52, "\x1bFa\x1bG", "a"},
+
+		{ADM3A, "abc\r\n", "abc\r\n"},
+		{ADM3A, "\x1a", "\x1b[H\x1b[2J"},
+		{ADM3A, "\x1e\x0b\x0c\x08", "\x1b[H\x1b[A\x1b[C\x08"},
+		{ADM3A, "\x1b=!#y", "\x1b[2;4Hy"},
+	}
+
+	for _, tc := range testCases {
+		tr := newTranslator(tc.emu)
+		var got []byte
+		for _, n := range []byte(tc.in) {
+			got = append(got, tr.translate(n)...)
+		}
+		if string(got) != tc.expected {
+			t.Errorf("%s: %q -> %q, expected %q", tc.emu, tc.in, got, tc.expected)
+		}
+	}
+}
+
+func TestConsoleStatus(t *testing.T) {
+	out := &bytes.Buffer{}
+	c := New(bytes.NewReader([]byte("k")), out, VT52)
+
+	for i := 0; c.Status()&StatusKeyReady == 0; i++ {
+		if i > 100 {
+			t.Fatalf("Key never became ready")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if n := c.ReadData(); n != 'k' {
+		t.Errorf("Read %02X, expected 'k'", n)
+	}
+	if c.Status() != StatusTxReady {
+		t.Errorf("Status %02X after read, expected %02X", c.Status(), StatusTxReady)
+	}
+	if n := c.ReadData(); n != 0 {
+		t.Errorf("Read %02X with no key ready", n)
+	}
+
+	for _, n := range []byte("\x1bHhi") {
+		c.WriteData(n)
+	}
+	if out.String() != "\x1b[Hhi" {
+		t.Errorf("Wrote %q", out.String())
+	}
+}
+
+func TestConsoleHotkeys(t *testing.T) {
+	r, w := io.Pipe()
+	c := New(r, &bytes.Buffer{}, VT52)
+	z := zog.New(0)
+	hotkeys := make(chan zog.Hotkey, 10)
+	z.SetHotkeyFunc(func(hk zog.Hotkey) { hotkeys <- hk })
+	err := c.Attach(z, DataPort, StatusPort)
+	if err != nil {
+		t.Fatalf("Can't attach: %s", err)
+	}
+	go w.Write([]byte("a\x1ds\x1d\x1db\x1dx\x1dl"))
+
+	var keys []byte
+	for len(keys) < 5 {
+		select {
+		case k := <-c.keys:
+			keys = append(keys, k)
+		case <-time.After(time.Second):
+			t.Fatalf("Only got keys %q", keys)
+		}
+	}
+	if string(keys) != "a\x1db\x1dx" {
+		t.Errorf("Got keys %q", keys)
+	}
+	for _, expected := range []zog.Hotkey{zog.HotkeySaveState, zog.HotkeyLoadState} {
+		select {
+		case hk := <-hotkeys:
+			if hk != expected {
+				t.Errorf("Got hotkey %d, expected %d", hk, expected)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("No hotkey %d", expected)
+		}
+	}
+}
@@ -72,6 +72,15 @@ printstr_end:
 func (m *Machine) Stop() {
 }
 
+// The machine has no device state to save
+func (m *Machine) Save() (*zog.MachineState, error) {
+	return m.z.SaveState(m.Name()), nil
+}
+
+func (m *Machine) Restore(s *zog.MachineState) error {
+	return m.z.RestoreState(s, m.Name())
+}
+
 func (m *Machine) Start() error {
 	m.z.RegisterOutputHandler(0xffff, printByte)
 	zeroPageAssembly, err := zog.Assemble(`
@@ -1,78 +1,154 @@
 package zog
 
 import (
-	"bytes"
 	"fmt"
 	"io"
-	"log"
 )
 
-func Decode(r io.Reader) (chan Instruction, chan error) {
-	errCh := make(chan error)
-	iCh := make(chan Instruction)
-	go decode(r, iCh, errCh)
-	return iCh, errCh
+// Decoded is an instruction with where it came from
+type Decoded struct {
+	Inst Instruction
+	Addr uint16
+	// The bytes the instruction was decoded from. These are only valid
+	// until the next call to Decode.
+	Bytes []byte
+	Len   int
 }
 
-func DecodeOne(r io.Reader) (Instruction, error) {
-	t := NewDecodeTable(r)
-	return decodeOne(r, t)
+// Decoder decodes instructions one at a time, from a stream of bytes or a
+// buffer, keeping track of the address and bytes of each
+type Decoder struct {
+	r    io.ByteReader
+	buf  []byte
+	off  int
+	base uint16
+	addr uint16
+	raw  []byte
+	t    DecodeTable
+	// DDCB and FDCB instructions, by displacement, built as they are seen
+	indexedCBOps *[2][256]*[256]Instruction
+	// The first error reading the current instruction, which the
+	// decode functions don't always pass back
+	err error
 }
 
-func DecodeBytes(buf []byte) ([]Instruction, error) {
+// NewDecoder decodes from a stream of bytes, the first of which is at addr
+func NewDecoder(r io.ByteReader, addr uint16) *Decoder {
+	d := &Decoder{r: r, addr: addr, raw: make([]byte, 0, 8)}
+	d.t.r = d
+	return d
+}
 
-	r := bytes.NewReader(buf)
+// NewBytesDecoder decodes from a buffer loaded at base
+func NewBytesDecoder(buf []byte, base uint16) *Decoder {
+	d := NewDecoder(nil, base)
+	d.buf = buf
+	d.base = base
+	return d
+}
 
-	var insts []Instruction
-	var err error
-	var ok bool
+// Seek moves a buffer decoder to an offset into its buffer
+func (d *Decoder) Seek(off int) {
+	d.off = off
+	d.addr = d.base + uint16(off)
+}
 
-	instCh, errCh := Decode(r)
+// Offset is how far a buffer decoder has got through its buffer
+func (d *Decoder) Offset() int {
+	return d.off
+}
 
-	looping := true
-	for looping {
-		select {
-		case inst, ok := <-instCh:
-			if !ok {
-				looping = false
-				break
-			}
-			insts = append(insts, inst)
-		case err, ok = <-errCh:
-			if !ok {
-				looping = false
-				break
-			}
-			break
+// SetAddr sets the address of the next byte from a stream
+func (d *Decoder) SetAddr(addr uint16) {
+	d.addr = addr
+}
+
+// ReadByte reads the next byte of the current instruction
+func (d *Decoder) ReadByte() (byte, error) {
+	var n byte
+	if d.r == nil {
+		if d.off >= len(d.buf) {
+			return 0, d.fail(io.EOF)
+		}
+		n = d.buf[d.off]
+		d.off++
+	} else {
+		var err error
+		n, err = d.r.ReadByte()
+		if err != nil {
+			return 0, d.fail(err)
 		}
 	}
+	d.addr++
+	d.raw = append(d.raw, n)
+	return n, nil
+}
 
-	return insts, err
+func (d *Decoder) fail(err error) error {
+	if d.err == nil {
+		d.err = err
+	}
+	return err
 }
 
-func getByte(r io.Reader) (byte, error) {
-	buf := make([]byte, 1)
-	n, err := r.Read(buf)
+// Decode decodes the next instruction. It returns io.EOF if there are
+// no more bytes, or io.ErrUnexpectedEOF if they run out part way through
+// an instruction.
+func (d *Decoder) Decode() (Decoded, error) {
+	addr := d.addr
+	d.raw = d.raw[:0]
+	d.err = nil
+	inst, err := d.decodeOne()
+	if d.err != nil {
+		inst, err = nil, d.err
+		if err == io.EOF && len(d.raw) > 0 {
+			err = io.ErrUnexpectedEOF
+		}
+	}
+	return Decoded{Inst: inst, Addr: addr, Bytes: d.raw, Len: len(d.raw)}, err
+}
+
+// DecodeOne decodes a single instruction from a stream
+func DecodeOne(r io.ByteReader) (Instruction, error) {
+	decoded, err := NewDecoder(r, 0).Decode()
+	return decoded.Inst, err
+}
+
+// DecodeBytes decodes every instruction in a buffer
+func DecodeBytes(buf []byte) ([]Instruction, error) {
+	var insts []Instruction
+	d := NewBytesDecoder(buf, 0)
+	for {
+		decoded, err := d.Decode()
+		if err == io.EOF {
+			return insts, nil
+		}
+		if err != nil {
+			return insts, fmt.Errorf("Can't decode at %04X: %s", decoded.Addr, err)
+		}
+		insts = append(insts, decoded.Inst)
+	}
+}
+
+func getByte(r io.ByteReader) (byte, error) {
+	n, err := r.ReadByte()
 	if err == io.EOF {
 		return 0, err
 	}
 	if err != nil {
 		return 0, fmt.Errorf("getImmd: Can't get byte: %s", err)
 	}
-	if n != 1 {
-		return 0, fmt.Errorf("getImmd: Can't get byte - read %d", n)
-	}
-	return buf[0], nil
+	return n, nil
 }
 
-func getImmd(r io.Reader) (Disp, error) {
+func getImmd(r io.ByteReader) (Disp, error) {
 	n, err := getByte(r)
 	if err != nil {
 		return 0, err
 	}
 	return Disp(n), nil
 }
-func getImmN(r io.Reader) (Imm8, error) {
+func getImmN(r io.ByteReader) (Imm8, error) {
 	n, err := getByte(r)
 	if err != nil {
 		return 0, err
@@ -80,7 +156,7 @@ func getImmN(r io.Reader) (Imm8, error) {
 	return Imm8(n), nil
 }
 
-func getImmNN(r io.Reader) (Imm16, error) {
+func getImmNN(r io.ByteReader) (Imm16, error) {
 	l, err := getByte(r)
 	if err != nil {
 		return 0, err
@@ -92,31 +168,14 @@ func getImmNN(r io.Reader) (Imm16, error) {
 	return Imm16(uint16(h)<<8 | uint16(l)), nil
 }
 
-func decode(r io.Reader, iCh chan Instruction, errCh chan error) {
-	t := NewDecodeTable(r)
-	for {
-		inst, err := decodeOne(r, t)
-		if err == io.EOF {
-			break
-		}
-		if err != nil {
-			errCh <- err
-			continue
-		}
-		iCh <- inst
-	}
-	close(iCh)
-	close(errCh)
-}
-
-func decodeOne(r io.Reader, t *DecodeTable) (Instruction, error) {
+func (d *Decoder) decodeOne() (Instruction, error) {
 
 	// Set to 0 if no prefix in effect
 	var opPrefix byte
 	var indexPrefix byte
 
 	for {
-		n, err := getByte(r)
+		n, err := getByte(d)
 		if err != nil {
 			return nil, err
 		}
@@ -137,44 +196,78 @@ func decodeOne(r io.Reader, t *DecodeTable) (Instruction, error) {
 			}
 		}
 
-		t.ResetPrefix(indexPrefix)
-
-		var inst Instruction
-
-		switch opPrefix {
-		case 0:
-			inst, err = baseDecode(t, r, indexPrefix, n)
-		case 0xcb:
-			var disp byte
-			if indexPrefix != 0x00 {
-				// DDCB - displacement byte comes before instruction...
-				disp = n
-				n, err = getByte(r)
-				if err != nil {
-					err = fmt.Errorf("DDCB: Can't get instruction after displacement: %s", err)
-				}
-			}
-			if err == nil {
-				inst, err = cbDecode(t, r, indexPrefix, n, disp)
+		if opPrefix == 0xcb && indexPrefix != 0x00 {
+			// DDCB - displacement byte comes before instruction...
+			disp := n
+			n, err = getByte(d)
+			if err != nil {
+				return nil, fmt.Errorf("DDCB: Can't get instruction after displacement: %s", err)
 			}
-		case 0xed:
-			inst, err = edDecode(t, r, indexPrefix, n)
+			return d.indexedCB(indexPrefix, disp, n)
 		}
 
-		//		fmt.Printf("D: inst [%v] err [%v]\n", inst, err)
+		op := &opTables[tableIndex(opPrefix, indexPrefix)][n]
+		if op.inst != nil {
+			return op.inst, nil
+		}
+		d.t.ResetPrefix(indexPrefix)
+		return decodeOpcode(&d.t, d, opPrefix, indexPrefix, n)
+	}
+}
 
-		if inst == nil {
-			if err == nil {
-				err = fmt.Errorf("TODO - impl %02X [%02X] (%02X)", n, opPrefix, indexPrefix)
-			}
+// indexedCB decodes DDCB and FDCB instructions, which have no operands
+// after the displacement, so each is only built once
+func (d *Decoder) indexedCB(indexPrefix, disp, n byte) (Instruction, error) {
+	i := 0
+	if indexPrefix == 0xfd {
+		i = 1
+	}
+	if d.indexedCBOps == nil {
+		d.indexedCBOps = &[2][256]*[256]Instruction{}
+	}
+	ops := d.indexedCBOps[i][disp]
+	if ops == nil {
+		ops = &[256]Instruction{}
+		d.indexedCBOps[i][disp] = ops
+	}
+	if ops[n] == nil {
+		d.t.ResetPrefix(indexPrefix)
+		inst, err := cbDecode(&d.t, d, indexPrefix, n, disp)
+		if err != nil {
 			return nil, err
 		}
+		ops[n] = inst
+	}
+	return ops[n], nil
+}
+
+// decodeOpcode decodes the byte after any prefixes, and its operands
+func decodeOpcode(t *DecodeTable, r io.ByteReader, opPrefix, indexPrefix, n byte) (Instruction, error) {
+	var inst Instruction
+	var err error
+
+	switch opPrefix {
+	case 0:
+		inst, err = baseDecode(t, r, indexPrefix, n)
+	case 0xcb:
+		inst, err = cbDecode(t, r, indexPrefix, n, 0)
+	case 0xed:
+		inst, err = edDecode(t, r, indexPrefix, n)
+	}
+
+	//		fmt.Printf("D: inst [%v] err [%v]\n", inst, err)
 
-		return inst, nil
+	if inst == nil {
+		if err == nil {
+			err = fmt.Errorf("TODO - impl %02X [%02X] (%02X)", n, opPrefix, indexPrefix)
+		}
+		return nil, err
 	}
+
+	return inst, nil
 }
 
-func cbDecode(t *DecodeTable, r io.Reader, indexPrefix, n byte, disp byte) (Instruction, error) {
+func cbDecode(t *DecodeTable, r io.ByteReader, indexPrefix, n byte, disp byte) (Instruction, error) {
 	var err error
 	var inst Instruction
 
@@ -202,7 +295,7 @@ func cbDecode(t *DecodeTable, r io.Reader, indexPrefix, n byte, disp byte) (Inst
 	} else if indexPrefix == 0xFD {
 		hl = IY
 	}
-	l8 := IndexedContents{hl, Disp(disp)}
+	l8 := IndexedContents{addr: hl, d: Disp(disp)}
 
 	// We have handled the index byte here, we don't want the table
 	// lookup to read another and think we are in indexed mode
@@ -234,7 +327,7 @@ func cbDecode(t *DecodeTable, r io.Reader, indexPrefix, n byte, disp byte) (Inst
 	return inst, err
 }
 
-func edDecode(t *DecodeTable, r io.Reader, indexPrefix, n byte) (Instruction, error) {
+func edDecode(t *DecodeTable, r io.ByteReader, indexPrefix, n byte) (Instruction, error) {
 	var err error
 	var inst Instruction
 
@@ -251,7 +344,6 @@ func edDecode(t *DecodeTable, r io.Reader, indexPrefix, n byte) (Instruction, er
 	switch x {
 	case 0, 3:
 		// Invalid instruction, equivalent to NONI followed by NOP
-		log.Printf("Invalid instruction: [ED%02X]\n", n)
 		inst = NOP
 	case 1:
 		switch z {
@@ -323,7 +415,7 @@ func edDecode(t *DecodeTable, r io.Reader, indexPrefix, n byte) (Instruction, er
 		if z <= 3 && y >= 4 {
 			inst = t.LookupBLI(y-4, z)
 		} else {
-			log.Printf("Invalid instruction: [ED%02X]\n", n)
+			// Invalid instruction, as above
 			inst = NOP
 		}
 	}
@@ -332,7 +424,7 @@ func edDecode(t *DecodeTable, r io.Reader, indexPrefix, n byte) (Instruction, er
 	return inst, err
 }
 
-func baseDecode(t *DecodeTable, r io.Reader, indexPrefix, n byte) (Instruction, error) {
+func baseDecode(t *DecodeTable, r io.ByteReader, indexPrefix, n byte) (Instruction, error) {
 	var err error
 	var inst Instruction
 
@@ -360,17 +452,17 @@ func baseDecode(t *DecodeTable, r io.Reader, indexPrefix, n byte) (Instruction,
 			case 2:
 				d, err := getImmd(r)
 				if err == nil {
-					inst = &DJNZ{d}
+					inst = &DJNZ{d: d}
 				}
 			case 3:
 				d, err := getImmd(r)
 				if err == nil {
-					inst = &JR{True, d}
+					inst = &JR{c: True, d: d}
 				}
 			case 4, 5, 6, 7:
 				d, err := getImmd(r)
 				if err == nil {
-					inst = &JR{tableCC[y-4], d}
+					inst = &JR{c: tableCC[y-4], d: d}
 				}
 			}
 		case 1:
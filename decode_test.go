@@ -1,7 +1,10 @@
 package zog
 
 import (
+	"bufio"
+	"bytes"
 	"fmt"
+	"io"
 	"testing"
 )
 
@@ -34,6 +37,83 @@ func TestDecodeOddities(t *testing.T) {
 	}
 }
 
+func TestDecoder(t *testing.T) {
+	buf := []byte{
+		0x00,                   // NOP
+		0xdd, 0x36, 0x05, 0x42, // LD (IX+5), 0x42
+		0xfd, 0xdd, 0x23, // INC IX
+		0xcd, 0x34, 0x12, // CALL 0x1234
+		0xdd, 0xcb, 0x0a, 0x06, // RLC (IX+10)
+		0x3e, // LD A, ... missing its operand
+	}
+	expected := []struct {
+		addr uint16
+		len  int
+		text string
+	}{
+		{0x8000, 1, "NOP"},
+		{0x8001, 4, "LD (IX+5), 0x42"},
+		{0x8005, 3, "INC IX"},
+		{0x8008, 3, "CALL 0x1234"},
+		{0x800b, 4, "RLC (IX+10)"},
+	}
+
+	decoders := map[string]*Decoder{
+		"bytes":  NewBytesDecoder(buf, 0x8000),
+		"stream": NewDecoder(bufio.NewReader(bytes.NewReader(buf)), 0x8000),
+	}
+	for name, d := range decoders {
+		off := 0
+		for _, e := range expected {
+			decoded, err := d.Decode()
+			if err != nil {
+				t.Fatalf("%s: error decoding at %04X: %s", name, e.addr, err)
+			}
+			if decoded.Addr != e.addr || decoded.Len != e.len || !compareAssembly(decoded.Inst.String(), e.text) {
+				t.Fatalf("%s: got %04X %d [%s] expected %04X %d [%s]", name, decoded.Addr, decoded.Len, decoded.Inst, e.addr, e.len, e.text)
+			}
+			if !bytes.Equal(decoded.Bytes, buf[off:off+e.len]) {
+				t.Fatalf("%s: got bytes [%s] for [%s]", name, bufToHex(decoded.Bytes), e.text)
+			}
+			off += e.len
+		}
+		_, err := d.Decode()
+		if err != io.ErrUnexpectedEOF {
+			t.Fatalf("%s: expected unexpected EOF for truncated instruction, got %v", name, err)
+		}
+		_, err = d.Decode()
+		if err != io.EOF {
+			t.Fatalf("%s: expected EOF at end, got %v", name, err)
+		}
+	}
+
+	// Decoders can go back over code, as the disassembler does
+	d := decoders["bytes"]
+	d.Seek(8)
+	decoded, err := d.Decode()
+	if err != nil || decoded.Addr != 0x8008 || d.Offset() != 11 {
+		t.Fatalf("After seek got %04X [%v] offset %d: %v", decoded.Addr, decoded.Inst, d.Offset(), err)
+	}
+
+	// Instructions without operands are only built once
+	d = NewBytesDecoder([]byte{0x41, 0xdd, 0xcb, 0x05, 0x46}, 0)
+	allocs := testing.AllocsPerRun(100, func() {
+		d.Seek(0)
+		d.Decode()
+		d.Decode()
+	})
+	if allocs > 0 {
+		t.Fatalf("Decoding LD B, C : BIT 0, (IX+5) made %v allocations", allocs)
+	}
+
+	for _, truncated := range [][]byte{buf, {0xdd, 0x7e}, {0xfd, 0xcb}} {
+		_, err = DecodeBytes(truncated)
+		if err == nil {
+			t.Fatalf("Expected an error from DecodeBytes for truncated [%s]", bufToHex(truncated))
+		}
+	}
+}
+
 func TestDecodeAll(t *testing.T) {
 	testUtilRunAll(t, func(t *testing.T, byteForm []byte, stringForm string) {
 		testDecodeOne(t, byteForm, stringForm)
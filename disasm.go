@@ -0,0 +1,322 @@
+package zog
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// Disassembly is a binary split into code and data, by following the flow
+// of the code from its entry points
+type Disassembly struct {
+	Base uint16
+	Data []byte
+	// Names for addresses. Branch targets are named like L1234 unless they
+	// already have a name here.
+	Labels map[uint16]string
+
+	// The instructions reached as code, by offset into Data, and whether
+	// each byte is part of one
+	insts map[int]*disInst
+	code  []bool
+	// What the text of each instruction assembles to
+	assembled map[string][]byte
+	// For comments on the addresses instructions use
+	symbols *SymbolTable
+}
+
+type disInst struct {
+	inst Instruction
+	len  int
+	// Where it jumps or calls to, if anywhere
+	target    uint16
+	hasTarget bool
+	// False if assembling the instruction wouldn't give the same bytes,
+	// for example because of a redundant prefix
+	exact bool
+}
+
+// Disassemble decodes a binary loaded at base, following jumps and calls
+// from each entry point. Bytes which are never reached are data.
+func Disassemble(buf []byte, base uint16, entries []uint16) (*Disassembly, error) {
+	if int(base)+len(buf) > 0x10000 {
+		return nil, fmt.Errorf("%d bytes at %04X runs past the end of memory", len(buf), base)
+	}
+	d := &Disassembly{
+		Base:      base,
+		Data:      buf,
+		Labels:    make(map[uint16]string),
+		insts:     make(map[int]*disInst),
+		code:      make([]bool, len(buf)),
+		assembled: make(map[string][]byte),
+	}
+	for _, addr := range entries {
+		if !d.contains(addr) {
+			return nil, fmt.Errorf("Entry point %04X is outside the binary", addr)
+		}
+	}
+	d.trace(entries)
+	return d, nil
+}
+
+func (d *Disassembly) contains(addr uint16) bool {
+	return addr >= d.Base && int(addr-d.Base) < len(d.Data)
+}
+
+// trace follows each path through the code until it runs into code
+// already seen, something which isn't an instruction, or an instruction
+// which doesn't carry on to the next
+func (d *Disassembly) trace(entries []uint16) {
+	todo := append([]uint16{}, entries...)
+	dec := NewBytesDecoder(d.Data, d.Base)
+	for len(todo) > 0 {
+		addr := todo[len(todo)-1]
+		todo = todo[:len(todo)-1]
+		for d.contains(addr) {
+			off := int(addr - d.Base)
+			if d.code[off] {
+				break
+			}
+			dec.Seek(off)
+			decoded, err := dec.Decode()
+			if err != nil {
+				break
+			}
+			inst, n := decoded.Inst, decoded.Len
+			if d.overlaps(off, n) {
+				break
+			}
+
+			di := &disInst{inst: inst, len: n}
+			di.exact = d.roundTrips(inst.String(), decoded.Bytes)
+			targets, next := branches(inst, addr, n)
+			if len(targets) > 0 {
+				di.target, di.hasTarget = targets[0], true
+			}
+			for i := off; i < off+n; i++ {
+				d.code[i] = true
+			}
+			d.insts[off] = di
+			todo = append(todo, targets...)
+
+			if !next {
+				break
+			}
+			addr += uint16(n)
+		}
+	}
+
+	for _, addr := range entries {
+		d.label(addr)
+	}
+	for _, di := range d.insts {
+		if di.hasTarget {
+			d.label(di.target)
+		}
+	}
+}
+
+func (d *Disassembly) overlaps(off, n int) bool {
+	if off+n > len(d.Data) {
+		return true
+	}
+	for i := off; i < off+n; i++ {
+		if d.code[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// roundTrips checks that the text of an instruction assembles to the
+// bytes it was decoded from
+func (d *Disassembly) roundTrips(text string, buf []byte) bool {
+	got, ok := d.assembled[text]
+	if !ok {
+		a, err := Assemble(text)
+		if err == nil {
+			got, _ = a.Encode()
+		}
+		d.assembled[text] = got
+	}
+	return got != nil && bytes.Equal(got, buf)
+}
+
+func unconditional(c Conditional) bool {
+	return c == nil || c == True
+}
+
+// branches gives the addresses an instruction can transfer control to,
+// and whether it can also carry on to the next instruction
+func branches(inst Instruction, addr uint16, n int) ([]uint16, bool) {
+	next := addr + uint16(n)
+	switch i := inst.(type) {
+	case *JP:
+		nn, ok := i.l.(Imm16)
+		if !ok {
+			// JP (HL) and friends go who knows where
+			return nil, false
+		}
+		return []uint16{uint16(nn)}, !unconditional(i.c)
+	case *CALL:
+		if nn, ok := i.l.(Imm16); ok {
+			return []uint16{uint16(nn)}, true
+		}
+	case *JR:
+		return []uint16{next + uint16(int8(i.d))}, !unconditional(i.c)
+	case *DJNZ:
+		return []uint16{next + uint16(int8(i.d))}, true
+	case *RST:
+		return []uint16{uint16(i.addr)}, true
+	case *RET:
+		return nil, !unconditional(i.c)
+	case EDSimple:
+		return nil, i != RETI && i != RETN
+	}
+	return nil, true
+}
+
+// label names an address, unless it already has a name
+func (d *Disassembly) label(addr uint16) {
+	if _, ok := d.Labels[addr]; !ok {
+		d.Labels[addr] = fmt.Sprintf("L%04X", addr)
+	}
+}
+
+// Annotate names addresses from a symbol table, in place of made up
+// labels, and notes the names of other addresses the code uses
+func (d *Disassembly) Annotate(st *SymbolTable) {
+	d.symbols = st
+	used := make(map[string]uint16)
+	for addr, name := range d.Labels {
+		used[name] = addr
+	}
+	for addr, name := range st.names {
+		// Two addresses can't have the same label
+		if other, ok := used[name]; ok && other != addr {
+			continue
+		}
+		delete(used, d.Labels[addr])
+		d.Labels[addr] = name
+		used[name] = addr
+	}
+}
+
+// placeable is true if a label can go on the line at an address in the
+// binary, which is anywhere other than the middle of an instruction
+func (d *Disassembly) placeable(addr uint16) bool {
+	if !d.contains(addr) {
+		return false
+	}
+	off := int(addr - d.Base)
+	_, start := d.insts[off]
+	return start || !d.code[off]
+}
+
+// text gives the instruction with its target replaced by a label, if
+// there is one
+func (d *Disassembly) text(di *disInst) string {
+	name, ok := d.Labels[di.target]
+	if !di.hasTarget || !ok {
+		return di.inst.String()
+	}
+	var op string
+	var c Conditional
+	switch i := di.inst.(type) {
+	case *JP:
+		op, c = "JP", i.c
+	case *CALL:
+		op, c = "CALL", i.c
+	case *JR:
+		op, c = "JR", i.c
+	case *DJNZ:
+		op = "DJNZ"
+	default:
+		return di.inst.String()
+	}
+	if unconditional(c) {
+		return fmt.Sprintf("%s %s", op, name)
+	}
+	return fmt.Sprintf("%s %s, %s", op, c, name)
+}
+
+// Write writes the disassembly as source which assembles back to the same
+// binary. Labels which are used but can't be placed on a line are
+// defined with EQU.
+func (d *Disassembly) Write(w io.Writer) error {
+	var buf bytes.Buffer
+
+	var equs []uint16
+	for _, di := range d.insts {
+		if di.hasTarget && !d.placeable(di.target) {
+			if _, ok := d.Labels[di.target]; ok {
+				equs = append(equs, di.target)
+			}
+		}
+	}
+	sort.Slice(equs, func(i, j int) bool { return equs[i] < equs[j] })
+	for i, addr := range equs {
+		if i > 0 && equs[i-1] == addr {
+			continue
+		}
+		fmt.Fprintf(&buf, "%s\tEQU 0x%04X\n", d.Labels[addr], addr)
+	}
+	if len(equs) > 0 {
+		buf.WriteString("\n")
+	}
+
+	fmt.Fprintf(&buf, "\tORG 0x%04X\n\n", d.Base)
+	for off := 0; off < len(d.Data); {
+		addr := d.Base + uint16(off)
+		if name, ok := d.Labels[addr]; ok {
+			buf.WriteString(name + ":")
+		}
+
+		di, ok := d.insts[off]
+		switch {
+		case ok && di.exact:
+			text := d.text(di)
+			buf.WriteString("\t" + text)
+			if d.symbols != nil {
+				if comments := d.symbols.Comments(text); len(comments) > 0 {
+					buf.WriteString("\t; " + strings.Join(comments, ", "))
+				}
+			}
+			buf.WriteString("\n")
+			off += di.len
+		case ok:
+			buf.WriteString("\t" + dataBytes(d.Data[off:off+di.len]) + "\t; " + di.inst.String() + "\n")
+			off += di.len
+		default:
+			end := d.dataEnd(off)
+			buf.WriteString("\t" + dataBytes(d.Data[off:end]) + "\n")
+			off = end
+		}
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// Data is written a few bytes to a line, stopping at code or a label
+const dataPerLine = 8
+
+func (d *Disassembly) dataEnd(off int) int {
+	end := off + 1
+	for end < len(d.Data) && end-off < dataPerLine && !d.code[end] {
+		if _, ok := d.Labels[d.Base+uint16(end)]; ok {
+			break
+		}
+		end++
+	}
+	return end
+}
+
+func dataBytes(buf []byte) string {
+	var s []string
+	for _, b := range buf {
+		s = append(s, fmt.Sprintf("0x%02X", b))
+	}
+	return "DB " + strings.Join(s, ", ")
+}
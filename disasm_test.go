@@ -0,0 +1,98 @@
+package zog
+
+import (
+	"bytes"
+	"io/ioutil"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+const disasmProg = `	org 8000h
+	ld b, 10
+loop:	call sub
+	djnz loop
+	jp nz, 0
+	jp (hl)
+	db "data"
+sub:	db 0ddh, 0
+	ret z
+	jr sub
+	db 0edh, 4ch
+`
+
+// disassembleAgain checks that the disassembly assembles back to the
+// binary, and gives its source
+func disassembleAgain(t *testing.T, buf []byte, base uint16, entries []uint16) string {
+	d, err := Disassemble(buf, base, entries)
+	if err != nil {
+		t.Fatalf("Failed to disassemble: %s", err)
+	}
+	src := &bytes.Buffer{}
+	err = d.Write(src)
+	if err != nil {
+		t.Fatalf("Failed to write: %s", err)
+	}
+	assembly, err := Assemble(src.String())
+	if err != nil {
+		t.Fatalf("Failed to assemble disassembly: %s\n%s", err, src)
+	}
+	blocks, err := assembly.Blocks()
+	if err != nil {
+		t.Fatalf("Failed to place disassembly: %s", err)
+	}
+	if len(blocks) != 1 || blocks[0].Addr != base || !bytes.Equal(blocks[0].Data, buf) {
+		t.Fatalf("Disassembly doesn't assemble to the same bytes:\n%s", src)
+	}
+	return src.String()
+}
+
+func TestDisassemble(t *testing.T) {
+	assembly, err := Assemble(disasmProg)
+	if err != nil {
+		t.Fatalf("Failed to assemble: %s", err)
+	}
+	buf, err := assembly.Encode()
+	if err != nil {
+		t.Fatalf("Failed to encode: %s", err)
+	}
+	src := disassembleAgain(t, buf, 0x8000, []uint16{0x8000})
+
+	expected := []string{
+		"L0000\tEQU 0x0000",
+		"\tORG 0x8000",
+		"L8000:\tLD B, 0x0A",
+		"L8002:\tCALL L800F",
+		"\tDJNZ L8002",
+		"\tJP NZ, L0000",
+		"\tJP HL",
+		"\tDB 0x64, 0x61, 0x74, 0x61",
+		"L800F:\tDB 0xDD, 0x00\t; NOP",
+		"\tRET Z",
+		"\tJR L800F",
+		"\tDB 0xED, 0x4C",
+	}
+	for _, line := range expected {
+		if !strings.Contains(src, line+"\n") {
+			t.Fatalf("Disassembly doesn't contain [%s]:\n%s", line, src)
+		}
+	}
+}
+
+func TestDisassembleRoundTrip(t *testing.T) {
+	prelim, err := ioutil.ReadFile("zexall/prelim.com")
+	if err != nil {
+		t.Fatalf("Can't read prelim.com: %s", err)
+	}
+	disassembleAgain(t, prelim, 0x100, []uint16{0x100})
+
+	// Anything at all should come back the same
+	r := rand.New(rand.NewSource(1))
+	buf := make([]byte, 4096)
+	r.Read(buf)
+	var entries []uint16
+	for addr := 0xc000; addr < 0xc000+len(buf); addr += 97 {
+		entries = append(entries, uint16(addr))
+	}
+	disassembleAgain(t, buf, 0xc000, entries)
+}
@@ -11,6 +11,11 @@ func Encode(insts []Instruction) []byte {
 	return buf
 }
 
+// operandError is what Encode panics with when an instruction's operands
+// can't be encoded together. The assembler reports it as an error, while
+// any other panic is a bug.
+type operandError string
+
 type loc8Info struct {
 	ltype    locType
 	idxTable byte
@@ -91,7 +96,7 @@ func inspectLoc8(l Loc8, info *loc8Info, idx *idxInfo) {
 	if ok {
 		r16, ok := iContents.addr.(R16)
 		if !ok {
-			panic("Non-r16 addr in indexed content")
+			panic(operandError("Non-r16 addr in indexed content"))
 		}
 
 		info.ltype = tableR
@@ -101,7 +106,7 @@ func inspectLoc8(l Loc8, info *loc8Info, idx *idxInfo) {
 		idx.isIY = r16 == IY // Else IX
 
 		idx.hasDisp = true
-		idx.idxDisp = byte(iContents.d)
+		idx.idxDisp = byte(iContents.disp())
 		return
 	}
 
@@ -128,7 +133,7 @@ func inspectLoc8(l Loc8, info *loc8Info, idx *idxInfo) {
 			info.imm16 = []byte{lo, hi}
 			return
 		}
-		panic("Unrecognised contents of loc8")
+		panic(operandError("Unrecognised contents of loc8"))
 	}
 
 	r8, ok := l.(R8)
@@ -139,13 +144,20 @@ func inspectLoc8(l Loc8, info *loc8Info, idx *idxInfo) {
 	}
 
 	imm8, ok := l.(Imm8)
+	if !ok {
+		var e8 *Expr8
+		e8, ok = l.(*Expr8)
+		if ok {
+			imm8 = e8.Imm8
+		}
+	}
 	if ok {
 		info.ltype = Immediate
 		info.imm8 = byte(imm8)
 		return
 	}
 
-	panic(fmt.Sprintf("WTF? %T", l))
+	panic(operandError(fmt.Sprintf("WTF? %T", l)))
 }
 
 func (u *InstU8) inspect() {
@@ -252,6 +264,9 @@ func inspectLoc16(l Loc16, info *loc16Info, idx *idxInfo, wantRP2 bool) {
 	contents, ok := l.(Contents)
 	if ok {
 		imm16, isImm := contents.addr.(Imm16)
+		if label, isLabel := contents.addr.(*Label); isLabel {
+			imm16, isImm = label.Imm16, true
+		}
 		if isImm {
 			info.ltype = ImmediateContents
 			hi := byte(imm16 >> 8)
@@ -259,7 +274,7 @@ func inspectLoc16(l Loc16, info *loc16Info, idx *idxInfo, wantRP2 bool) {
 			info.imm16 = []byte{lo, hi}
 			return
 		}
-		panic("Non-immediate Loc16 contents")
+		panic(operandError("Non-immediate Loc16 contents"))
 	}
 
 	imm16, ok := l.(Imm16)
@@ -346,3 +361,15 @@ func encodeXPQZ(x, p, q, z byte) byte {
 	y := p<<1 | q
 	return encodeXYZ(x, y, z)
 }
+
+// immByte is the value of an immediate byte operand
+func immByte(l Loc8) byte {
+	switch n := l.(type) {
+	case Imm8:
+		return byte(n)
+	case *Expr8:
+		return byte(n.Imm8)
+	default:
+		panic(operandError(fmt.Sprintf("Non-immediate byte operand: %T", l)))
+	}
+}
@@ -0,0 +1,179 @@
+package zog
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Error is a problem with the source, found while assembling it
+type Error struct {
+	File string
+	Line int
+	// Counted from 1, or 0 if not known
+	Col int
+	Msg string
+	// The line of source the problem is on
+	Excerpt string
+	Warning bool
+}
+
+func (e *Error) Error() string {
+	kind := ""
+	if e.Warning {
+		kind = "warning: "
+	}
+	return fmt.Sprintf("%s: %s%s", e.Location(), kind, e.Msg)
+}
+
+func (e *Error) Location() string {
+	if e.Col == 0 {
+		return location(e.File, e.Line)
+	}
+	if e.File == "" {
+		return fmt.Sprintf("Line %d, column %d", e.Line, e.Col)
+	}
+	return fmt.Sprintf("%s:%d:%d", e.File, e.Line, e.Col)
+}
+
+// Detail gives the error followed by the line of source, with a marker
+// under the column if we have one
+func (e *Error) Detail() string {
+	s := e.Error()
+	if e.Excerpt == "" {
+		return s
+	}
+	s += "\n" + e.Excerpt
+	if e.Col > 0 && e.Col <= len(e.Excerpt)+1 {
+		// Copy any tabs so that the marker lines up
+		marker := []byte(e.Excerpt[:e.Col-1])
+		for i, c := range marker {
+			if c != '\t' {
+				marker[i] = ' '
+			}
+		}
+		s += "\n" + string(marker) + "^"
+	}
+	return s
+}
+
+// point sets the column to the first place the identifier appears in
+// the excerpt
+func (e *Error) point(name string) *Error {
+	text := e.Excerpt
+	for start := 0; start < len(text); {
+		i := strings.Index(strings.ToLower(text[start:]), strings.ToLower(name))
+		if i < 0 {
+			break
+		}
+		i += start
+		end := i + len(name)
+		if (i == 0 || !isIdentChar(text[i-1])) && (end == len(text) || !isIdentChar(text[end])) {
+			e.Col = i + 1
+			break
+		}
+		start = i + 1
+	}
+	return e
+}
+
+// ErrorList is every error and warning from an assembly, in the order of
+// the source
+type ErrorList []*Error
+
+func (el ErrorList) Error() string {
+	var msgs []string
+	for _, e := range el {
+		msgs = append(msgs, e.Error())
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// Errors doesn't count the warnings
+func (el ErrorList) Errors() int {
+	n := 0
+	for _, e := range el {
+		if !e.Warning {
+			n++
+		}
+	}
+	return n
+}
+
+func (el ErrorList) sort() {
+	sort.SliceStable(el, func(i, j int) bool {
+		a, b := el[i], el[j]
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		return a.Col < b.Col
+	})
+}
+
+// symbolError is about a particular symbol, which can then be pointed at
+// in the source
+type symbolError struct {
+	name string
+	msg  string
+}
+
+func (e *symbolError) Error() string {
+	return e.msg
+}
+
+func (l srcLine) errorf(format string, args ...interface{}) *Error {
+	return &Error{File: l.file, Line: l.line, Msg: fmt.Sprintf(format, args...), Excerpt: l.text}
+}
+
+// wrap gives an error from evaluating something on the line a position
+func (l srcLine) wrap(err error) *Error {
+	e := l.errorf("%s", err)
+	if se, ok := err.(*symbolError); ok {
+		e.point(se.name)
+	}
+	return e
+}
+
+// errorAt makes an error for a line which has already been assembled
+func (a *Assembly) errorAt(file string, line int, format string, args ...interface{}) *Error {
+	e := &Error{File: file, Line: line, Msg: fmt.Sprintf(format, args...)}
+	// Most likely the line we are on
+	for i := len(a.Lines) - 1; i >= 0; i-- {
+		if a.Lines[i].File == file && a.Lines[i].Line == line {
+			e.Excerpt = a.Lines[i].Text
+			break
+		}
+	}
+	return e
+}
+
+func (a *Assembly) instError(linst *LabelledInstruction, err error) *Error {
+	e := a.errorAt(linst.File, linst.Line, "%s", err)
+	if se, ok := err.(*symbolError); ok {
+		e.point(se.name)
+	}
+	return e
+}
+
+// addError notes an error, to be reported if it is still there at the
+// end of the last pass
+func (a *Assembly) addError(err error) {
+	e, ok := err.(*Error)
+	if !ok {
+		e = &Error{Msg: err.Error()}
+	}
+	a.errs = append(a.errs, e)
+}
+
+// warn notes a problem which doesn't stop the assembly
+func (a *Assembly) warn(e *Error) {
+	e.Warning = true
+	a.warnings = append(a.warnings, e)
+}
+
+func (a *Assembly) warnAt(file string, line int, format string, args ...interface{}) {
+	a.warn(a.errorAt(file, line, format, args...))
+}
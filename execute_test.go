@@ -2,6 +2,7 @@ package zog
 
 import (
 	"fmt"
+	"io/ioutil"
 	"testing"
 )
 
@@ -763,3 +764,67 @@ func bufToDecimal(buf []byte) string {
 	}
 	return s
 }
+
+// BenchmarkZexdoc runs the start of the zexdoc instruction exerciser flat
+// out, with BDOS calls (just its console output) going nowhere
+// heldSource keeps /INT low until the program acknowledges it
+type heldSource struct{}
+
+func (heldSource) InterruptPending() (bool, byte) {
+	return true, 0
+}
+
+func TestExecuteEIDelay(t *testing.T) {
+	z := traceTestZog(t, "\tORG 100h\n\tLD SP, 0F000h\n\tIM 1\n\tEI\nloop:\tINC C\n\tJR loop\n")
+	// The handler counts interrupts, and returns with the source still
+	// asserting
+	err := z.LoadBytes(0x38, []byte{0x04, 0xfb, 0xed, 0x4d})
+	if err != nil {
+		t.Fatalf("Can't load handler: %s", err)
+	}
+	z.AddInterruptSource(heldSource{})
+	for i := 0; i < 200; i++ {
+		_, err := z.step()
+		if err != nil {
+			t.Fatalf("Failed to step: %s", err)
+		}
+		if z.reg.SP < 0xf000-2 {
+			t.Fatalf("Interrupt taken between EI and RETI, SP is %04X after %d", z.reg.SP, i)
+		}
+	}
+	if z.reg.B == 0 || z.reg.C == 0 {
+		t.Fatalf("Expected interrupts and the loop to run, got B %d C %d", z.reg.B, z.reg.C)
+	}
+}
+
+func BenchmarkZexdoc(b *testing.B) {
+	buf, err := ioutil.ReadFile("zexall/cpm/zexdoc.com")
+	if err != nil {
+		b.Fatalf("Can't read zexdoc: %s", err)
+	}
+	z := New(0)
+	z.SetClockHz(0)
+	// HALT on warm boot, and BDOS at 0005 jumps to a RET
+	err = z.LoadBytes(0, []byte{0x76, 0, 0, 0, 0, 0xc3, 0x00, 0xf0})
+	if err == nil {
+		err = z.LoadBytes(0xf000, []byte{0xc9})
+	}
+	if err == nil {
+		err = z.LoadBytes(0x100, buf)
+	}
+	if err != nil {
+		b.Fatalf("Can't load zexdoc: %s", err)
+	}
+	z.reg.PC = 0x100
+
+	tStates := 0
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n, err := z.step()
+		if err != nil {
+			b.Fatalf("Failed at %04X after %d instructions: %s", z.reg.PC, i, err)
+		}
+		tStates += n
+	}
+	b.ReportMetric(float64(tStates)/b.Elapsed().Seconds()/1e6, "MHz")
+}
@@ -0,0 +1,189 @@
+package zog
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Expr is an assembler expression, evaluated once symbol values are known
+type Expr interface {
+	Eval(a *Assembly) (int, error)
+	String() string
+}
+
+type exprNum int
+
+func (e exprNum) Eval(a *Assembly) (int, error) {
+	return int(e), nil
+}
+func (e exprNum) String() string {
+	if e < 0 || e > 9 {
+		return fmt.Sprintf("0x%X", int(e))
+	}
+	return fmt.Sprintf("%d", int(e))
+}
+
+type exprSymbol string
+
+func (e exprSymbol) Eval(a *Assembly) (int, error) {
+	return a.SymbolValue(string(e))
+}
+func (e exprSymbol) String() string {
+	return string(e)
+}
+
+type exprDollar struct{}
+
+func (e exprDollar) Eval(a *Assembly) (int, error) {
+	return a.SymbolValue("$")
+}
+func (e exprDollar) String() string {
+	return "$"
+}
+
+type exprUnary struct {
+	op string
+	e  Expr
+}
+
+func (e *exprUnary) Eval(a *Assembly) (int, error) {
+	v, err := e.e.Eval(a)
+	if err != nil {
+		return 0, err
+	}
+	switch e.op {
+	case "-":
+		return -v, nil
+	case "~":
+		return ^v, nil
+	case "!":
+		return truth(v == 0), nil
+	case "LOW":
+		return v & 0xff, nil
+	case "HIGH":
+		return (v >> 8) & 0xff, nil
+	default:
+		return 0, fmt.Errorf("Unknown unary operator: %s", e.op)
+	}
+}
+func (e *exprUnary) String() string {
+	if len(e.op) > 1 {
+		return fmt.Sprintf("%s(%s)", e.op, e.e)
+	}
+	return fmt.Sprintf("%s%s", e.op, e.e)
+}
+
+type exprBinary struct {
+	op   string
+	l, r Expr
+}
+
+func (e *exprBinary) Eval(a *Assembly) (int, error) {
+	l, err := e.l.Eval(a)
+	if err != nil {
+		return 0, err
+	}
+	r, err := e.r.Eval(a)
+	if err != nil {
+		return 0, err
+	}
+	switch e.op {
+	case "+":
+		return l + r, nil
+	case "-":
+		return l - r, nil
+	case "*":
+		return l * r, nil
+	case "/", "%":
+		if r == 0 {
+			return 0, errors.New("Division by zero")
+		}
+		if e.op == "/" {
+			return l / r, nil
+		}
+		return l % r, nil
+	case "<<":
+		return l << uint(r&31), nil
+	case ">>":
+		return l >> uint(r&31), nil
+	case "&":
+		return l & r, nil
+	case "|":
+		return l | r, nil
+	case "^":
+		return l ^ r, nil
+	case "==":
+		return truth(l == r), nil
+	case "!=":
+		return truth(l != r), nil
+	case "<":
+		return truth(l < r), nil
+	case ">":
+		return truth(l > r), nil
+	case "<=":
+		return truth(l <= r), nil
+	case ">=":
+		return truth(l >= r), nil
+	default:
+		return 0, fmt.Errorf("Unknown binary operator: %s", e.op)
+	}
+}
+func (e *exprBinary) String() string {
+	return fmt.Sprintf("(%s%s%s)", e.l, e.op, e.r)
+}
+
+func truth(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// constValue evaluates an expression at parse time, if it doesn't depend
+// on any symbols (or the location counter)
+func constValue(e Expr) (int, bool) {
+	if !isConstant(e) {
+		return 0, false
+	}
+	v, err := e.Eval(nil)
+	if err != nil {
+		// Leave it for the resolve pass to report
+		return 0, false
+	}
+	return v, true
+}
+
+func isConstant(e Expr) bool {
+	switch e := e.(type) {
+	case exprNum:
+		return true
+	case *exprUnary:
+		return isConstant(e.e)
+	case *exprBinary:
+		return isConstant(e.l) && isConstant(e.r)
+	default:
+		return false
+	}
+}
+
+func evalByte(a *Assembly, e Expr) (byte, error) {
+	v, err := e.Eval(a)
+	if err != nil {
+		return 0, err
+	}
+	if v < -128 || v > 255 {
+		return 0, fmt.Errorf("Value out of range for byte: %s = %d", e, v)
+	}
+	return byte(v), nil
+}
+
+func evalWord(a *Assembly, e Expr) (uint16, error) {
+	v, err := e.Eval(a)
+	if err != nil {
+		return 0, err
+	}
+	if v < -32768 || v > 65535 {
+		return 0, fmt.Errorf("Value out of range for word: %s = %d", e, v)
+	}
+	return uint16(v), nil
+}
@@ -0,0 +1,204 @@
+package file
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/jbert/zog"
+)
+
+// Program is assembled code ready to be written out
+type Program struct {
+	Blocks []zog.Block
+	Entry  uint16
+	// For formats which record a name, such as .tap
+	Name string
+	// For gaps between blocks
+	Fill byte
+}
+
+// Low and high are the range of addresses covered by the program
+func (p *Program) Low() int {
+	if len(p.Blocks) == 0 {
+		return 0
+	}
+	return int(p.Blocks[0].Addr)
+}
+
+func (p *Program) High() int {
+	if len(p.Blocks) == 0 {
+		return 0
+	}
+	return p.Blocks[len(p.Blocks)-1].End()
+}
+
+// Image gives memory from lo to hi, with the program's blocks in place
+// and the fill byte elsewhere
+func (p *Program) Image(lo, hi int) []byte {
+	buf := make([]byte, hi-lo)
+	for i := range buf {
+		buf[i] = p.Fill
+	}
+	for _, b := range p.Blocks {
+		if b.End() <= lo || int(b.Addr) >= hi {
+			continue
+		}
+		for i, v := range b.Data {
+			addr := int(b.Addr) + i
+			if addr >= lo && addr < hi {
+				buf[addr-lo] = v
+			}
+		}
+	}
+	return buf
+}
+
+// Writers for each output format, by name
+var Formats = map[string]func(w io.Writer, p *Program) error{
+	"bin": WriteBinary,
+	"hex": WriteIntelHex,
+	"com": WriteCOM,
+	"tap": WriteTAP,
+	"sna": WriteSNA,
+	"z80": WriteZ80,
+}
+
+// FormatForFile picks an output format from a file name's extension
+func FormatForFile(fname string) string {
+	ext := strings.ToLower(strings.TrimPrefix(filepath.Ext(fname), "."))
+	switch ext {
+	case "ihx", "ihex":
+		return "hex"
+	}
+	if _, ok := Formats[ext]; ok {
+		return ext
+	}
+	return "bin"
+}
+
+func WriteProgram(w io.Writer, format string, p *Program) error {
+	write, ok := Formats[format]
+	if !ok {
+		var names []string
+		for name := range Formats {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return fmt.Errorf("Unknown output format [%s], expected one of %s", format, strings.Join(names, ", "))
+	}
+	return write(w, p)
+}
+
+// WriteBinary writes everything from the lowest address to the highest,
+// with gaps filled
+func WriteBinary(w io.Writer, p *Program) error {
+	_, err := w.Write(p.Image(p.Low(), p.High()))
+	return err
+}
+
+// WriteCOM writes a CP/M program, which always starts at 0x100
+func WriteCOM(w io.Writer, p *Program) error {
+	if len(p.Blocks) == 0 {
+		return nil
+	}
+	if p.Low() < 0x100 {
+		return fmt.Errorf("Code at %04X is below the CP/M TPA at 0100", p.Low())
+	}
+	_, err := w.Write(p.Image(0x100, p.High()))
+	return err
+}
+
+// Bytes in each Intel HEX data record
+const hexRecordLen = 16
+
+// WriteIntelHex writes data records for each block then an end of file
+// record
+func WriteIntelHex(w io.Writer, p *Program) error {
+	for _, b := range p.Blocks {
+		for i := 0; i < len(b.Data); i += hexRecordLen {
+			end := i + hexRecordLen
+			if end > len(b.Data) {
+				end = len(b.Data)
+			}
+			err := writeHexRecord(w, b.Addr+uint16(i), 0x00, b.Data[i:end])
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return writeHexRecord(w, 0, 0x01, nil)
+}
+
+func writeHexRecord(w io.Writer, addr uint16, recType byte, data []byte) error {
+	rec := []byte{byte(len(data)), byte(addr >> 8), byte(addr), recType}
+	rec = append(rec, data...)
+	sum := byte(0)
+	for _, b := range rec {
+		sum += b
+	}
+	rec = append(rec, -sum)
+	_, err := fmt.Fprintf(w, ":%X\r\n", rec)
+	return err
+}
+
+// Spectrum snapshots hold the 48K of RAM above the ROM
+const ramStart = 0x4000
+const attrStart = 0x5800
+const attrEnd = 0x5b00
+
+// The ROM's interrupt handler expects IY to point at the system variables
+const sysvarsIY = 0x5c3a
+
+// Start with a white screen rather than black on black
+const defaultAttr = 0x38
+
+func (p *Program) spectrumRAM() ([]byte, error) {
+	if len(p.Blocks) > 0 && p.Low() < ramStart {
+		return nil, fmt.Errorf("Code at %04X is in the Spectrum ROM", p.Low())
+	}
+	ram := make([]byte, 0x10000-ramStart)
+	for i := attrStart; i < attrEnd; i++ {
+		ram[i-ramStart] = defaultAttr
+	}
+	for _, b := range p.Blocks {
+		copy(ram[int(b.Addr)-ramStart:], b.Data)
+	}
+	return ram, nil
+}
+
+// WriteSNA writes a 48K .sna snapshot. The format has no PC, so the entry
+// point is left on the stack at the top of memory for the RETN which
+// starts a snapshot.
+func WriteSNA(w io.Writer, p *Program) error {
+	ram, err := p.spectrumRAM()
+	if err != nil {
+		return err
+	}
+	if p.High() > 0xfffe {
+		return errors.New("Code uses FFFE-FFFF, which .sna needs for the entry point")
+	}
+	sp := uint16(0xfffe)
+	ram[sp-ramStart] = byte(p.Entry)
+	ram[sp+1-ramStart] = byte(p.Entry >> 8)
+
+	header := make([]byte, 27)
+	// IY
+	header[15], header[16] = byte(sysvarsIY&0xff), byte(sysvarsIY>>8)
+	// Interrupts enabled (IFF2 is bit 2), IM 1
+	header[19] = 0x04
+	header[23], header[24] = byte(sp), byte(sp>>8)
+	header[25] = 1
+	// Border
+	header[26] = 7
+
+	_, err = w.Write(header)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(ram)
+	return err
+}
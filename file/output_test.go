@@ -0,0 +1,196 @@
+package file
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/jbert/zog"
+)
+
+func testProgram() *Program {
+	return &Program{
+		Blocks: []zog.Block{
+			{Addr: 0x8000, Data: []byte{0x3e, 0x01, 0xc9}},
+			{Addr: 0x8005, Data: []byte{0xaa, 0xbb}},
+		},
+		Entry: 0x8000,
+		Name:  "test",
+		Fill:  0xff,
+	}
+}
+
+func TestWriteBinary(t *testing.T) {
+	buf := &bytes.Buffer{}
+	err := WriteBinary(buf, testProgram())
+	if err != nil {
+		t.Fatalf("Failed to write: %s", err)
+	}
+	expected := []byte{0x3e, 0x01, 0xc9, 0xff, 0xff, 0xaa, 0xbb}
+	if !bytes.Equal(buf.Bytes(), expected) {
+		t.Fatalf("Got [% X] expected [% X]", buf.Bytes(), expected)
+	}
+}
+
+func TestWriteCOM(t *testing.T) {
+	p := &Program{Blocks: []zog.Block{{Addr: 0x102, Data: []byte{0xc9}}}}
+	buf := &bytes.Buffer{}
+	err := WriteCOM(buf, p)
+	if err != nil {
+		t.Fatalf("Failed to write: %s", err)
+	}
+	expected := []byte{0x00, 0x00, 0xc9}
+	if !bytes.Equal(buf.Bytes(), expected) {
+		t.Fatalf("Got [% X] expected [% X]", buf.Bytes(), expected)
+	}
+
+	p.Blocks[0].Addr = 0xff
+	err = WriteCOM(buf, p)
+	if err == nil {
+		t.Fatalf("No error for code below 0100")
+	}
+}
+
+func TestWriteIntelHex(t *testing.T) {
+	p := testProgram()
+	p.Blocks = append(p.Blocks, zog.Block{Addr: 0x9000, Data: bytes.Repeat([]byte{0x11}, 17)})
+	buf := &bytes.Buffer{}
+	err := WriteIntelHex(buf, p)
+	if err != nil {
+		t.Fatalf("Failed to write: %s", err)
+	}
+	expected := ":038000003E01C975\r\n" +
+		":02800500AABB14\r\n" +
+		":109000001111111111111111111111111111111150\r\n" +
+		":01901000114E\r\n" +
+		":00000001FF\r\n"
+	if buf.String() != expected {
+		t.Fatalf("Got:\n%s\nexpected:\n%s", buf.String(), expected)
+	}
+}
+
+func TestWriteTAP(t *testing.T) {
+	buf := &bytes.Buffer{}
+	err := WriteTAP(buf, testProgram())
+	if err != nil {
+		t.Fatalf("Failed to write: %s", err)
+	}
+
+	// Header, BASIC, header, code
+	var blocks [][]byte
+	tap := buf.Bytes()
+	for len(tap) > 0 {
+		n := int(tap[0]) | int(tap[1])<<8
+		block := tap[2 : 2+n]
+		sum := byte(0)
+		for _, b := range block {
+			sum ^= b
+		}
+		if sum != 0 {
+			t.Fatalf("Bad checksum on block %d", len(blocks))
+		}
+		blocks = append(blocks, block[:n-1])
+		tap = tap[2+n:]
+	}
+	if len(blocks) != 4 {
+		t.Fatalf("Got %d blocks, expected 4", len(blocks))
+	}
+
+	if blocks[0][0] != 0x00 || blocks[0][1] != 0 || string(blocks[0][2:12]) != "test      " {
+		t.Fatalf("Bad program header [% X]", blocks[0])
+	}
+	basic := blocks[1][1:]
+	loader := fmt.Sprintf("\x00\x0a%c\x00\xfd\xb0\"32767\":\xef\"\"\xaf:\xf9\xc0\xb0\"32768\"\r", len(basic)-4)
+	if string(basic) != loader {
+		t.Fatalf("Got loader [% X] expected [% X]", basic, loader)
+	}
+
+	code := []byte{0x00, 0x03}
+	code = append(code, "test      "...)
+	code = append(code, 0x07, 0x00, 0x00, 0x80, 0x00, 0x80)
+	if !bytes.Equal(blocks[2], code) {
+		t.Fatalf("Got code header [% X] expected [% X]", blocks[2], code)
+	}
+	expected := []byte{0xff, 0x3e, 0x01, 0xc9, 0xff, 0xff, 0xaa, 0xbb}
+	if !bytes.Equal(blocks[3], expected) {
+		t.Fatalf("Got code [% X] expected [% X]", blocks[3], expected)
+	}
+}
+
+func TestWriteSNA(t *testing.T) {
+	buf := &bytes.Buffer{}
+	err := WriteSNA(buf, testProgram())
+	if err != nil {
+		t.Fatalf("Failed to write: %s", err)
+	}
+	sna := buf.Bytes()
+	if len(sna) != 27+0xc000 {
+		t.Fatalf("Got length %d expected %d", len(sna), 27+0xc000)
+	}
+	mem := func(addr int) byte { return sna[27+addr-0x4000] }
+	if sna[23] != 0xfe || sna[24] != 0xff {
+		t.Fatalf("SP is %02X%02X, expected FFFE", sna[24], sna[23])
+	}
+	if mem(0xfffe) != 0x00 || mem(0xffff) != 0x80 {
+		t.Fatalf("Entry point on the stack is %02X%02X, expected 8000", mem(0xffff), mem(0xfffe))
+	}
+	if mem(0x8000) != 0x3e || mem(0x8006) != 0xbb || mem(0x8003) != 0x00 {
+		t.Fatalf("Code not placed at 8000")
+	}
+	if mem(0x5800) != defaultAttr {
+		t.Fatalf("Attributes not set")
+	}
+
+	p := &Program{Blocks: []zog.Block{{Addr: 0x3fff, Data: []byte{0x00}}}}
+	err = WriteSNA(buf, p)
+	if err == nil {
+		t.Fatalf("No error for code in ROM")
+	}
+}
+
+func TestWriteZ80(t *testing.T) {
+	buf := &bytes.Buffer{}
+	err := WriteZ80(buf, testProgram())
+	if err != nil {
+		t.Fatalf("Failed to write: %s", err)
+	}
+	h := &Z80header{}
+	err = Z80readHeader(buf, h)
+	if err != nil {
+		t.Fatalf("Failed to read header: %s", err)
+	}
+	if !h.IsVersion1() || !h.IsCompresed() || h.PC != 0x8000 {
+		t.Fatalf("Bad header %+v", h)
+	}
+	mem, err := h.Z80readMem(buf)
+	if err != nil {
+		t.Fatalf("Failed to read memory: %s", err)
+	}
+	if len(mem) != 0xc000 {
+		t.Fatalf("Got %d bytes of memory, expected %d", len(mem), 0xc000)
+	}
+	if !bytes.Equal(mem[0x4000:0x4007], []byte{0x3e, 0x01, 0xc9, 0x00, 0x00, 0xaa, 0xbb}) {
+		t.Fatalf("Code not placed at 8000")
+	}
+}
+
+func TestCompressMem(t *testing.T) {
+	testCases := [][]byte{
+		{},
+		{0x01, 0x02, 0x03},
+		{0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+		{0xed, 0xed},
+		{0xed, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+		{0xed, 0x01, 0xed},
+		bytes.Repeat([]byte{0x07}, 300),
+	}
+	for _, tc := range testCases {
+		got, err := DecompressMem(CompressMem(tc))
+		if err != nil {
+			t.Fatalf("Failed to decompress [% X]: %s", tc, err)
+		}
+		if !bytes.Equal(got, tc) {
+			t.Fatalf("Round trip of [% X] gave [% X]", tc, got)
+		}
+	}
+}
@@ -0,0 +1,314 @@
+package file
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/jbert/zog"
+)
+
+// From http://www.ramsoft.bbk.org.omegahg.com/rzxform.html
+const (
+	rzxMagic        = "RZX!"
+	rzxMajor        = 0
+	rzxMinor        = 13
+	rzxCreatorBlock = 0x10
+	rzxSnapBlock    = 0x30
+	rzxInputBlock   = 0x80
+
+	// Flags on snapshot and input blocks
+	rzxExternal   = 0x01
+	rzxProtected  = 0x01
+	rzxCompressed = 0x02
+
+	// An IN count saying the frame's INs are the previous frame's
+	rzxRepeat = 0xffff
+)
+
+// RZX is an input recording as Spectrum emulators write them: a snapshot
+// to start from, then what was read with IN in each frame
+type RZX struct {
+	Creator                    string
+	CreatorMajor, CreatorMinor uint16
+
+	// The snapshot's format, such as "z80", or "zog" for a MachineState,
+	// and its contents
+	SnapshotExt string
+	Snapshot    []byte
+
+	// T-states into the first frame the recording starts. zog doesn't
+	// keep frames in step with T-states, so writes 0.
+	TStates uint32
+	Frames  []zog.InputFrame
+}
+
+// NewRZX starts a recording of a machine from where it is now. A
+// Spectrum is saved as a .z80, which other emulators can load, and other
+// machines (or a Spectrum at PC 0, which a version 1 .z80 can't hold) as
+// zog's own state.
+func NewRZX(m zog.Machine, z *zog.Zog) (*RZX, error) {
+	x := &RZX{Creator: "zog", CreatorMajor: 0, CreatorMinor: 1}
+	buf := &bytes.Buffer{}
+	if m.Name() == "speccy" && z.GetRegisters().PC != 0 {
+		x.SnapshotExt = "z80"
+		err := WriteZ80State(buf, z)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		x.SnapshotExt = "zog"
+		s, err := m.Save()
+		if err != nil {
+			return nil, err
+		}
+		err = s.Write(buf)
+		if err != nil {
+			return nil, err
+		}
+	}
+	x.Snapshot = buf.Bytes()
+	return x, nil
+}
+
+// Restore puts a started machine into the state the recording starts in
+func (x *RZX) Restore(m zog.Machine, z *zog.Zog) error {
+	r := bytes.NewReader(x.Snapshot)
+	switch strings.ToLower(x.SnapshotExt) {
+	case "z80":
+		h := Z80header{}
+		err := Z80readHeader(r, &h)
+		if err != nil {
+			return err
+		}
+		return h.Load(r, z)
+	case "zog":
+		s, err := zog.ReadMachineState(r)
+		if err != nil {
+			return err
+		}
+		return m.Restore(s)
+	default:
+		return fmt.Errorf("Can't load a .%s snapshot", x.SnapshotExt)
+	}
+}
+
+// ReadRZX reads the first snapshot in a recording, and the input after it
+func ReadRZX(r io.Reader) (*RZX, error) {
+	var header struct {
+		Magic        [4]byte
+		Major, Minor byte
+		Flags        uint32
+	}
+	err := binary.Read(r, binary.LittleEndian, &header)
+	if err != nil {
+		return nil, fmt.Errorf("Can't read RZX header: %s", err)
+	}
+	if string(header.Magic[:]) != rzxMagic {
+		return nil, errors.New("Not an RZX file")
+	}
+
+	x := &RZX{}
+	for {
+		var block struct {
+			ID  byte
+			Len uint32
+		}
+		err := binary.Read(r, binary.LittleEndian, &block)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("Can't read RZX block: %s", err)
+		}
+		if block.Len < 5 {
+			return nil, fmt.Errorf("RZX block %02X is too short", block.ID)
+		}
+		buf := make([]byte, block.Len-5)
+		_, err = io.ReadFull(r, buf)
+		if err != nil {
+			return nil, fmt.Errorf("Can't read RZX block %02X: %s", block.ID, err)
+		}
+
+		switch block.ID {
+		case rzxCreatorBlock:
+			err = x.readCreator(buf)
+		case rzxSnapBlock:
+			if x.Snapshot != nil {
+				// Only the first snapshot, and the input after it
+				return x, nil
+			}
+			err = x.readSnapshot(buf)
+		case rzxInputBlock:
+			err = x.readInput(buf)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if x.Snapshot == nil {
+		return nil, errors.New("RZX file has no snapshot")
+	}
+	return x, nil
+}
+
+func (x *RZX) readCreator(buf []byte) error {
+	if len(buf) < 24 {
+		return errors.New("RZX creator block is too short")
+	}
+	x.Creator = string(bytes.TrimRight(buf[:20], "\x00"))
+	x.CreatorMajor = binary.LittleEndian.Uint16(buf[20:])
+	x.CreatorMinor = binary.LittleEndian.Uint16(buf[22:])
+	return nil
+}
+
+func (x *RZX) readSnapshot(buf []byte) error {
+	if len(buf) < 12 {
+		return errors.New("RZX snapshot block is too short")
+	}
+	flags := binary.LittleEndian.Uint32(buf)
+	if flags&rzxExternal != 0 {
+		return errors.New("Can't load an RZX with an external snapshot")
+	}
+	x.SnapshotExt = string(bytes.TrimRight(buf[4:8], "\x00"))
+	size := binary.LittleEndian.Uint32(buf[8:])
+	data, err := rzxData(buf[12:], flags)
+	if err != nil {
+		return fmt.Errorf("Can't read RZX snapshot: %s", err)
+	}
+	if len(data) != int(size) {
+		return fmt.Errorf("RZX snapshot is %d bytes, expected %d", len(data), size)
+	}
+	x.Snapshot = data
+	return nil
+}
+
+func (x *RZX) readInput(buf []byte) error {
+	if len(buf) < 13 {
+		return errors.New("RZX input block is too short")
+	}
+	numFrames := binary.LittleEndian.Uint32(buf)
+	if len(x.Frames) == 0 {
+		x.TStates = binary.LittleEndian.Uint32(buf[5:])
+	}
+	flags := binary.LittleEndian.Uint32(buf[9:])
+	if flags&rzxProtected != 0 {
+		return errors.New("Can't read protected RZX input")
+	}
+	data, err := rzxData(buf[13:], flags)
+	if err != nil {
+		return fmt.Errorf("Can't read RZX input: %s", err)
+	}
+
+	var last []byte
+	for i := uint32(0); i < numFrames; i++ {
+		if len(data) < 4 {
+			return fmt.Errorf("RZX input ends in frame %d of %d", i, numFrames)
+		}
+		f := zog.InputFrame{Fetches: int(binary.LittleEndian.Uint16(data))}
+		numIns := int(binary.LittleEndian.Uint16(data[2:]))
+		data = data[4:]
+		if numIns == rzxRepeat {
+			f.Ins = last
+		} else {
+			if len(data) < numIns {
+				return fmt.Errorf("RZX input ends in frame %d of %d", i, numFrames)
+			}
+			f.Ins = data[:numIns]
+			data = data[numIns:]
+			last = f.Ins
+		}
+		x.Frames = append(x.Frames, f)
+	}
+	return nil
+}
+
+func rzxData(buf []byte, flags uint32) ([]byte, error) {
+	if flags&rzxCompressed == 0 {
+		return buf, nil
+	}
+	zr, err := zlib.NewReader(bytes.NewReader(buf))
+	if err != nil {
+		return nil, err
+	}
+	return ioutil.ReadAll(zr)
+}
+
+// WriteRZX writes a recording with its snapshot, compressing both
+func WriteRZX(w io.Writer, x *RZX) error {
+	frames := &bytes.Buffer{}
+	var last []byte
+	for i, f := range x.Frames {
+		if f.Fetches > 0xffff || len(f.Ins) >= rzxRepeat {
+			return fmt.Errorf("Frame %d is too long for RZX, with %d fetches and %d INs", i, f.Fetches, len(f.Ins))
+		}
+		numIns := len(f.Ins)
+		if i > 0 && numIns > 0 && bytes.Equal(f.Ins, last) {
+			numIns = rzxRepeat
+		}
+		binary.Write(frames, binary.LittleEndian, []uint16{uint16(f.Fetches), uint16(numIns)})
+		if numIns != rzxRepeat {
+			frames.Write(f.Ins)
+		}
+		last = f.Ins
+	}
+
+	header := &bytes.Buffer{}
+	header.WriteString(rzxMagic)
+	header.Write([]byte{rzxMajor, rzxMinor, 0, 0, 0, 0})
+	_, err := w.Write(header.Bytes())
+	if err != nil {
+		return err
+	}
+
+	creator := make([]byte, 24)
+	copy(creator[:19], x.Creator)
+	binary.LittleEndian.PutUint16(creator[20:], x.CreatorMajor)
+	binary.LittleEndian.PutUint16(creator[22:], x.CreatorMinor)
+	err = writeRZXBlock(w, rzxCreatorBlock, creator)
+	if err != nil {
+		return err
+	}
+
+	snap := make([]byte, 12)
+	binary.LittleEndian.PutUint32(snap, rzxCompressed)
+	copy(snap[4:7], x.SnapshotExt)
+	binary.LittleEndian.PutUint32(snap[8:], uint32(len(x.Snapshot)))
+	snap = append(snap, compress(x.Snapshot)...)
+	err = writeRZXBlock(w, rzxSnapBlock, snap)
+	if err != nil {
+		return err
+	}
+
+	input := make([]byte, 13)
+	binary.LittleEndian.PutUint32(input, uint32(len(x.Frames)))
+	binary.LittleEndian.PutUint32(input[5:], x.TStates)
+	binary.LittleEndian.PutUint32(input[9:], rzxCompressed)
+	input = append(input, compress(frames.Bytes())...)
+	return writeRZXBlock(w, rzxInputBlock, input)
+}
+
+func writeRZXBlock(w io.Writer, id byte, data []byte) error {
+	header := make([]byte, 5)
+	header[0] = id
+	binary.LittleEndian.PutUint32(header[1:], uint32(len(data)+5))
+	_, err := w.Write(header)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+func compress(buf []byte) []byte {
+	out := &bytes.Buffer{}
+	zw := zlib.NewWriter(out)
+	zw.Write(buf)
+	zw.Close()
+	return out.Bytes()
+}
@@ -0,0 +1,76 @@
+package file
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/jbert/zog"
+)
+
+func TestRZX(t *testing.T) {
+	z := zog.New(0)
+	err := z.LoadBytes(0x8000, []byte{0xdb, 0xfe, 0x18, 0xfc})
+	if err != nil {
+		t.Fatalf("Can't load: %s", err)
+	}
+	s := z.SaveState("speccy")
+	s.Registers.PC = 0x8000
+	s.Registers.SP = 0xff00
+	s.Registers.R = 0x83
+	err = z.RestoreState(s, "speccy")
+	if err != nil {
+		t.Fatalf("Can't restore: %s", err)
+	}
+
+	buf := &bytes.Buffer{}
+	err = WriteZ80State(buf, z)
+	if err != nil {
+		t.Fatalf("Can't write snapshot: %s", err)
+	}
+	x := &RZX{
+		Creator:      "test",
+		CreatorMajor: 1,
+		CreatorMinor: 2,
+		SnapshotExt:  "z80",
+		Snapshot:     buf.Bytes(),
+		TStates:      1234,
+		Frames: []zog.InputFrame{
+			{Fetches: 100, Ins: []byte{0xbf, 0xbf}},
+			{Fetches: 200, Ins: []byte{0xbf, 0xbf}},
+			{Fetches: 0xffff, Ins: []byte{}},
+			{Fetches: 10, Ins: []byte{0xfe}},
+		},
+	}
+	buf = &bytes.Buffer{}
+	err = WriteRZX(buf, x)
+	if err != nil {
+		t.Fatalf("Can't write: %s", err)
+	}
+	got, err := ReadRZX(buf)
+	if err != nil {
+		t.Fatalf("Can't read: %s", err)
+	}
+	if !reflect.DeepEqual(got, x) {
+		t.Fatalf("Read %+v, expected %+v", got, x)
+	}
+
+	z2 := zog.New(0)
+	err = got.Restore(nil, z2)
+	if err != nil {
+		t.Fatalf("Can't restore: %s", err)
+	}
+	if z2.GetRegisters() != z.GetRegisters() {
+		t.Fatalf("Restored %s, expected %s", z2.GetRegisters().Summary(), z.GetRegisters().Summary())
+	}
+	if n, _ := z2.Mem.Peek(0x8000); n != 0xdb {
+		t.Fatalf("Memory not restored")
+	}
+
+	x.Frames = append(x.Frames, zog.InputFrame{Fetches: 0x10000})
+	buf.Reset()
+	err = WriteRZX(buf, x)
+	if err == nil || buf.Len() != 0 {
+		t.Fatalf("Wrote %d bytes for a frame too long for RZX", buf.Len())
+	}
+}
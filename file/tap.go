@@ -0,0 +1,93 @@
+package file
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// Spectrum BASIC tokens used by the loader
+const (
+	tokenCODE      = 0xaf
+	tokenVAL       = 0xb0
+	tokenUSR       = 0xc0
+	tokenLOAD      = 0xef
+	tokenRANDOMIZE = 0xf9
+	tokenCLEAR     = 0xfd
+)
+
+// Below this, CLEAR would leave no room for BASIC
+const lowestClear = 24000
+
+// WriteTAP writes a .tap with a BASIC loader followed by the code, from
+// the lowest address to the highest. The loader is
+//
+//	10 CLEAR VAL "lo-1": LOAD "" CODE : RANDOMIZE USR VAL "entry"
+func WriteTAP(w io.Writer, p *Program) error {
+	if len(p.Blocks) == 0 {
+		return nil
+	}
+	if p.Low() < ramStart {
+		return fmt.Errorf("Code at %04X is in the Spectrum ROM", p.Low())
+	}
+	name := p.Name
+	if name == "" {
+		name = "zog"
+	}
+
+	line := &bytes.Buffer{}
+	if p.Low()-1 >= lowestClear {
+		line.WriteByte(tokenCLEAR)
+		writeBasicVal(line, p.Low()-1)
+		line.WriteByte(':')
+	}
+	line.Write([]byte{tokenLOAD, '"', '"', tokenCODE, ':', tokenRANDOMIZE, tokenUSR})
+	writeBasicVal(line, int(p.Entry))
+	line.WriteByte('\r')
+
+	basic := &bytes.Buffer{}
+	// Line numbers are big endian, lengths little endian
+	basic.Write([]byte{0, 10, byte(line.Len()), byte(line.Len() >> 8)})
+	basic.Write(line.Bytes())
+
+	// Program header: autostart line and length of the program
+	err := writeTapHeader(w, 0, name, basic.Len(), 10, basic.Len())
+	if err == nil {
+		err = writeTapBlock(w, 0xff, basic.Bytes())
+	}
+	code := p.Image(p.Low(), p.High())
+	if err == nil {
+		err = writeTapHeader(w, 3, name, len(code), p.Low(), 0x8000)
+	}
+	if err == nil {
+		err = writeTapBlock(w, 0xff, code)
+	}
+	return err
+}
+
+// Numbers as VAL "n" avoid having to store them as floating point
+func writeBasicVal(buf *bytes.Buffer, n int) {
+	buf.WriteByte(tokenVAL)
+	fmt.Fprintf(buf, "\"%d\"", n)
+}
+
+func writeTapHeader(w io.Writer, kind byte, name string, length int, param1 int, param2 int) error {
+	h := &bytes.Buffer{}
+	h.WriteByte(kind)
+	fmt.Fprintf(h, "%-10.10s", name)
+	binary.Write(h, binary.LittleEndian, []uint16{uint16(length), uint16(param1), uint16(param2)})
+	return writeTapBlock(w, 0x00, h.Bytes())
+}
+
+func writeTapBlock(w io.Writer, flag byte, data []byte) error {
+	sum := flag
+	for _, b := range data {
+		sum ^= b
+	}
+	block := []byte{byte(len(data) + 2), byte((len(data) + 2) >> 8), flag}
+	block = append(block, data...)
+	block = append(block, sum)
+	_, err := w.Write(block)
+	return err
+}
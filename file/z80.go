@@ -54,7 +54,8 @@ func (h *Z80header) Load(r io.Reader, z *zog.Zog) error {
 	reg.Write16(zog.IY, h.IY)
 
 	reg.Write8(zog.I, h.I)
-	reg.Write8(zog.R, h.R)
+	// Bit 7 of R is kept in Flag1
+	reg.Write8(zog.R, h.R&0x7f|h.Flag1<<7)
 
 	afp := uint16(h.A_P)<<8 | uint16(h.F_P)
 	reg.Write16(zog.AF_PRIME, afp)
@@ -70,8 +71,7 @@ func (h *Z80header) Load(r io.Reader, z *zog.Zog) error {
 	is := zog.InterruptState{
 		IFF1: h.IFF1 != 0,
 		IFF2: h.IFF2 != 0,
-		//		Mode: h.Flag2 & 0x3,
-		Mode: 1,
+		Mode: h.Flag2 & 0x3,
 	}
 	z.LoadInterruptState(is)
 
@@ -95,7 +95,7 @@ func (h *Z80header) IsCompresed() bool {
 
 func (h *Z80header) Z80readMem(r io.Reader) ([]byte, error) {
 	if !h.IsVersion1() {
-		return nil, errors.New("TODO - implement support for version 2+ Z80 files")
+		return h.readPages(r)
 	}
 	buf := make([]byte, 0x10000)
 	n, err := r.Read(buf)
@@ -112,6 +112,70 @@ func (h *Z80header) Z80readMem(r io.Reader) ([]byte, error) {
 	return buf, nil
 }
 
+// Where each 16K page of a 48K version 2 or 3 file goes
+var z80PageAddrs = map[byte]int{4: 0x8000, 5: 0xc000, 8: 0x4000}
+
+// readPages reads the memory of a version 2 or 3 file, after an extra
+// header which starts with the real PC. Only 48K machines are supported.
+func (h *Z80header) readPages(r io.Reader) ([]byte, error) {
+	var extLen uint16
+	err := binary.Read(r, binary.LittleEndian, &extLen)
+	if err != nil {
+		return nil, err
+	}
+	ext := make([]byte, extLen)
+	_, err = io.ReadFull(r, ext)
+	if err != nil {
+		return nil, err
+	}
+	if extLen < 3 {
+		return nil, fmt.Errorf("Extra header too short: %d", extLen)
+	}
+	h.PC = uint16(ext[0]) | uint16(ext[1])<<8
+	// Mode 3 is a 48K with MGT in version 3, and a 128K in version 2
+	hardware := ext[2]
+	if hardware > 1 && !(hardware == 3 && extLen > 23) {
+		return nil, fmt.Errorf("Can't load hardware mode %d, only a 48K Spectrum", hardware)
+	}
+
+	mem := make([]byte, 0xc000)
+	for {
+		var page struct {
+			Len  uint16
+			Page byte
+		}
+		err := binary.Read(r, binary.LittleEndian, &page)
+		if err == io.EOF {
+			return mem, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		size := int(page.Len)
+		if page.Len == 0xffff {
+			size = 0x4000
+		}
+		buf := make([]byte, size)
+		_, err = io.ReadFull(r, buf)
+		if err != nil {
+			return nil, fmt.Errorf("Can't read page %d: %s", page.Page, err)
+		}
+		if page.Len != 0xffff {
+			buf, err = decompressBlock(buf)
+			if err != nil {
+				return nil, fmt.Errorf("Can't decompress page %d: %s", page.Page, err)
+			}
+		}
+		if len(buf) != 0x4000 {
+			return nil, fmt.Errorf("Page %d is %d bytes", page.Page, len(buf))
+		}
+		addr, ok := z80PageAddrs[page.Page]
+		if ok {
+			copy(mem[addr-0x4000:], buf)
+		}
+	}
+}
+
 func DecompressMem(in []byte) ([]byte, error) {
 	if len(in) < 4 {
 		return nil, fmt.Errorf("Missing end-of-block - len %d", len(in))
@@ -120,10 +184,13 @@ func DecompressMem(in []byte) ([]byte, error) {
 	if !bytes.Equal(last, []byte{0x00, 0xed, 0xed, 0x00}) {
 		return nil, fmt.Errorf("Missing end-of-block: %v", last)
 	}
-	in = in[:len(in)-4]
+	return decompressBlock(in[:len(in)-4])
+}
 
+// decompressBlock undoes CompressMem, without the end marker
+func decompressBlock(in []byte) ([]byte, error) {
 	out := []byte{}
-	last = nil
+	var last []byte
 	for _, b := range in {
 		if len(last) == 2 {
 			last = append(last, b)
@@ -155,3 +222,94 @@ func DecompressMem(in []byte) ([]byte, error) {
 	out = append(out, last...) // Drain any partial
 	return out, nil
 }
+
+// WriteZ80 writes a version 1 .z80 snapshot, which starts at the entry
+// point with the stack at the top of memory
+func WriteZ80(w io.Writer, p *Program) error {
+	ram, err := p.spectrumRAM()
+	if err != nil {
+		return err
+	}
+	h := Z80header{
+		PC: p.Entry,
+		IY: sysvarsIY,
+		// Compressed, white border
+		Flag1: 0x20 | 7<<1,
+		IFF1:  1,
+		IFF2:  1,
+		// IM 1
+		Flag2: 1,
+	}
+	if h.PC == 0 {
+		return errors.New("Can't write a version 1 .z80 with an entry point of 0")
+	}
+	err = binary.Write(w, binary.LittleEndian, &h)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(CompressMem(ram))
+	return err
+}
+
+// WriteZ80State writes a Zog running a 48K Spectrum as a version 1 .z80
+func WriteZ80State(w io.Writer, z *zog.Zog) error {
+	reg := z.GetRegisters()
+	is := z.GetInterruptState()
+	if reg.PC == 0 {
+		return errors.New("Can't write a version 1 .z80 with PC at 0")
+	}
+	bit := func(b bool) byte {
+		if b {
+			return 1
+		}
+		return 0
+	}
+	h := Z80header{
+		A: reg.A, F: reg.F,
+		BC: reg.Read16(zog.BC), HL: reg.Read16(zog.HL), PC: reg.PC, SP: reg.SP,
+		I: reg.I, R: reg.R & 0x7f,
+		// Compressed, white border
+		Flag1: 0x20 | 7<<1 | reg.R>>7,
+		DE:    reg.Read16(zog.DE), BC_P: reg.Read16(zog.BC_PRIME), DE_P: reg.Read16(zog.DE_PRIME), HL_P: reg.Read16(zog.HL_PRIME),
+		A_P: reg.A_PRIME, F_P: reg.F_PRIME,
+		IY: reg.Read16(zog.IY), IX: reg.Read16(zog.IX),
+		IFF1: bit(is.IFF1), IFF2: bit(is.IFF2),
+		Flag2: is.Mode & 0x3,
+	}
+	ram, err := z.Mem.PeekBuf(ramStart, 0x10000-ramStart)
+	if err != nil {
+		return err
+	}
+	err = binary.Write(w, binary.LittleEndian, &h)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(CompressMem(ram))
+	return err
+}
+
+// CompressMem is the reverse of DecompressMem. Runs of five or more
+// bytes, or two or more EDs, become ED ED count byte. The byte after a
+// single ED is never the start of a run.
+func CompressMem(in []byte) []byte {
+	out := []byte{}
+	for i := 0; i < len(in); {
+		b := in[i]
+		run := 1
+		for i+run < len(in) && in[i+run] == b && run < 0xff {
+			run++
+		}
+		if run >= 5 || (b == 0xed && run >= 2) {
+			out = append(out, 0xed, 0xed, byte(run), b)
+			i += run
+			continue
+		}
+		out = append(out, b)
+		i++
+		if b == 0xed && i < len(in) {
+			out = append(out, in[i])
+			i++
+		}
+	}
+	return append(out, 0x00, 0xed, 0xed, 0x00)
+}
@@ -0,0 +1,205 @@
+package zog
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/png"
+	"io"
+	"os"
+	"strings"
+)
+
+// Heatmap draws the 64K address space as a 256x256 image, one pixel per
+// byte and one row per 256 bytes. Reads light a byte up red, writes green
+// and executing it blue, so the PC shows as a blue trail. Each frame, the
+// colours fade.
+type Heatmap struct {
+	// Red, green and blue for each address
+	heat   [0x10000][3]byte
+	img    *image.RGBA
+	sinks  []HeatmapSink
+	frames int
+
+	// T-states in a frame, and when the next one is due
+	every uint64
+	next  uint64
+}
+
+const (
+	heatRead = iota
+	heatWrite
+	heatExecute
+)
+
+// HeatmapSink receives each frame of a heatmap. The image is reused once
+// Frame returns, so sinks must copy anything they keep.
+type HeatmapSink interface {
+	Frame(img *image.RGBA) error
+}
+
+// EnableHeatmap watches every memory access, drawing a heatmap frame
+// every so many T-states. A 48K Spectrum frame is 69888.
+func (z *Zog) EnableHeatmap(frameTStates uint64) *Heatmap {
+	if frameTStates == 0 {
+		frameTStates = 1
+	}
+	z.heatmap = &Heatmap{
+		img:   image.NewRGBA(image.Rect(0, 0, 256, 256)),
+		every: frameTStates,
+		next:  z.tstates + frameTStates,
+	}
+	z.Mem.SetWatchFunc(z.memWatchSeen)
+	z.Mem.SetReadWatchFunc(z.memReadSeen)
+	z.Mem.SetDebug(true)
+	return z.heatmap
+}
+
+// AddSink sends the sink each frame
+func (h *Heatmap) AddSink(sink HeatmapSink) {
+	h.sinks = append(h.sinks, sink)
+}
+
+// Frames is how many frames have been drawn
+func (h *Heatmap) Frames() int {
+	return h.frames
+}
+
+func (h *Heatmap) read(addr uint16) {
+	h.heat[addr][heatRead] = 0xff
+}
+
+func (h *Heatmap) write(addr uint16) {
+	h.heat[addr][heatWrite] = 0xff
+}
+
+// execute marks the bytes of an instruction, of which an interrupt has
+// none
+func (h *Heatmap) execute(addr uint16, n int) {
+	for i := 0; i < n; i++ {
+		h.heat[addr+uint16(i)][heatExecute] = 0xff
+	}
+}
+
+// tick draws a frame if one is due
+func (h *Heatmap) tick(tstates uint64) error {
+	if tstates < h.next {
+		return nil
+	}
+	h.next = tstates + h.every
+	return h.Frame()
+}
+
+// Frame draws a frame now, sends it to the sinks, and fades the colours
+func (h *Heatmap) Frame() error {
+	pix := h.img.Pix
+	for addr := range h.heat {
+		c := &h.heat[addr]
+		pix[addr*4] = c[heatRead]
+		pix[addr*4+1] = c[heatWrite]
+		pix[addr*4+2] = c[heatExecute]
+		pix[addr*4+3] = 0xff
+		for i := range c {
+			c[i] = byte(int(c[i]) * 7 / 8)
+		}
+	}
+	h.frames++
+	for _, sink := range h.sinks {
+		err := sink.Frame(h.img)
+		if err != nil {
+			return fmt.Errorf("Can't draw heatmap: %s", err)
+		}
+	}
+	return nil
+}
+
+// PNGHeatmapSink writes frames as PNG files
+type PNGHeatmapSink struct {
+	pattern string
+	n       int
+}
+
+// NewPNGHeatmapSink writes each frame to a file named by formatting its
+// number with the pattern, such as heat%05d.png. A pattern without a %
+// names one file, which each frame overwrites, leaving the last.
+func NewPNGHeatmapSink(pattern string) *PNGHeatmapSink {
+	return &PNGHeatmapSink{pattern: pattern}
+}
+
+func (s *PNGHeatmapSink) Frame(img *image.RGBA) error {
+	fname := s.pattern
+	if strings.Contains(fname, "%") {
+		fname = fmt.Sprintf(s.pattern, s.n)
+	}
+	s.n++
+	f, err := os.Create(fname)
+	if err != nil {
+		return err
+	}
+	err = png.Encode(f, img)
+	if err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// GIFHeatmapSink collects frames into an animated GIF, written on Close
+type GIFHeatmapSink struct {
+	w     io.Writer
+	every int
+	delay int
+	n     int
+	anim  gif.GIF
+}
+
+// NewGIFHeatmapSink keeps one frame in every, each shown for delay
+// hundredths of a second. Each kept frame takes 64K until Close.
+func NewGIFHeatmapSink(w io.Writer, every int, delay int) *GIFHeatmapSink {
+	if every < 1 {
+		every = 1
+	}
+	return &GIFHeatmapSink{w: w, every: every, delay: delay}
+}
+
+func (s *GIFHeatmapSink) Frame(img *image.RGBA) error {
+	s.n++
+	if (s.n-1)%s.every != 0 {
+		return nil
+	}
+	p := image.NewPaletted(img.Bounds(), heatPalette)
+	for i := range p.Pix {
+		c := img.Pix[i*4 : i*4+3]
+		p.Pix[i] = heatLevel(c[0])*36 + heatLevel(c[1])*6 + heatLevel(c[2])
+	}
+	s.anim.Image = append(s.anim.Image, p)
+	s.anim.Delay = append(s.anim.Delay, s.delay)
+	return nil
+}
+
+// Close writes the GIF
+func (s *GIFHeatmapSink) Close() error {
+	if len(s.anim.Image) == 0 {
+		return fmt.Errorf("No heatmap frames to write")
+	}
+	return gif.EncodeAll(s.w, &s.anim)
+}
+
+// heatPalette has six levels of each of red, green and blue, which is
+// enough to see colours fade
+var heatPalette = func() color.Palette {
+	var p color.Palette
+	for r := 0; r < 6; r++ {
+		for g := 0; g < 6; g++ {
+			for b := 0; b < 6; b++ {
+				p = append(p, color.RGBA{byte(r * 51), byte(g * 51), byte(b * 51), 0xff})
+			}
+		}
+	}
+	return p
+}()
+
+func heatLevel(n byte) byte {
+	return byte((int(n) + 25) / 51)
+}
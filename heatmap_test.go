@@ -0,0 +1,91 @@
+package zog
+
+import (
+	"bytes"
+	"image"
+	"image/gif"
+	"testing"
+)
+
+type heatmapCollector []*image.RGBA
+
+func (c *heatmapCollector) Frame(img *image.RGBA) error {
+	cp := *img
+	cp.Pix = append([]byte(nil), img.Pix...)
+	*c = append(*c, &cp)
+	return nil
+}
+
+func TestHeatmap(t *testing.T) {
+	z := traceTestZog(t, "\tORG 100h\n\tLD A, (2000h)\n\tLD (3000h), A\n\tHALT\n")
+	h := z.EnableHeatmap(1000)
+	var frames heatmapCollector
+	h.AddSink(&frames)
+	buf := &bytes.Buffer{}
+	gifSink := NewGIFHeatmapSink(buf, 1, 2)
+	h.AddSink(gifSink)
+
+	err := z.Run()
+	if err != nil {
+		t.Fatalf("Failed to run: %s", err)
+	}
+	err = h.Frame()
+	if err != nil {
+		t.Fatalf("Failed to draw: %s", err)
+	}
+	err = h.Frame()
+	if err != nil {
+		t.Fatalf("Failed to draw: %s", err)
+	}
+	if len(frames) != 2 || h.Frames() != 2 {
+		t.Fatalf("Drew %d frames, expected 2", len(frames))
+	}
+
+	testCases := []struct {
+		addr    uint16
+		r, g, b byte
+	}{
+		{0x0100, 0, 0, 0xff},
+		{0x0102, 0, 0, 0xff},
+		{0x0106, 0, 0, 0xff},
+		{0x0107, 0, 0, 0},
+		{0x2000, 0xff, 0, 0},
+		{0x3000, 0, 0xff, 0},
+		{0x3001, 0, 0, 0},
+	}
+	for _, tc := range testCases {
+		c := frames[0].RGBAAt(int(tc.addr&0xff), int(tc.addr>>8))
+		if c.R != tc.r || c.G != tc.g || c.B != tc.b {
+			t.Errorf("%04X is %v, expected %02X %02X %02X", tc.addr, c, tc.r, tc.g, tc.b)
+		}
+	}
+	if c := frames[1].RGBAAt(0x00, 0x20); c.R != 0xdf {
+		t.Errorf("Read didn't fade, got %v", c)
+	}
+
+	// Memory watches see the read too
+	var reads []uint16
+	z.Mem.SetReadWatchFunc(func(addr uint16, n byte) {
+		reads = append(reads, addr)
+	})
+	z.Mem.Peek16(0x2000)
+	if len(reads) != 2 || reads[0] != 0x2000 || reads[1] != 0x2001 {
+		t.Errorf("Watch saw reads %v", reads)
+	}
+
+	err = gifSink.Close()
+	if err != nil {
+		t.Fatalf("Can't write GIF: %s", err)
+	}
+	anim, err := gif.DecodeAll(buf)
+	if err != nil {
+		t.Fatalf("Can't read GIF: %s", err)
+	}
+	if len(anim.Image) != 2 {
+		t.Fatalf("GIF has %d frames, expected 2", len(anim.Image))
+	}
+	r, g, b, _ := anim.Image[0].At(0x00, 0x30).RGBA()
+	if r != 0 || g != 0xffff || b != 0 {
+		t.Fatalf("GIF has write as %04X %04X %04X", r, g, b)
+	}
+}
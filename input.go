@@ -0,0 +1,149 @@
+package zog
+
+import "fmt"
+
+// InputFrame is what the program read with IN between two interrupts
+type InputFrame struct {
+	// Opcodes fetched before the interrupt which ends the frame, counting
+	// prefixes as RZX files do
+	Fetches int
+	Ins     []byte
+}
+
+// InputLog records the result of each IN, frame by frame, or plays back
+// such a recording in place of the devices. Played back from the state the
+// recording started in, a program does exactly what it did before.
+//
+// While playing, frames end and interrupts happen when the recording
+// says, rather than when devices ask.
+type InputLog struct {
+	z       *Zog
+	frames  []InputFrame
+	playing bool
+	err     error
+
+	// The frame being recorded or played, and how far into it
+	frame   int
+	fetches int
+	ins     []byte
+	next    int
+}
+
+// RecordInput starts recording input, in a frame starting now
+func (z *Zog) RecordInput() *InputLog {
+	z.input = &InputLog{z: z}
+	return z.input
+}
+
+// PlayInput feeds recorded input to the program, starting now at the
+// start of the first frame. Once the recording runs out, input comes from
+// devices again, and is recorded on the end.
+func (z *Zog) PlayInput(frames []InputFrame) *InputLog {
+	z.input = &InputLog{z: z, frames: frames, playing: len(frames) > 0}
+	return z.input
+}
+
+// StopInput stops recording or playing input
+func (z *Zog) StopInput() {
+	z.input = nil
+}
+
+// Frames gives the frames recorded so far, ending with the one in progress
+func (l *InputLog) Frames() []InputFrame {
+	if l.playing {
+		return l.frames
+	}
+	return append(l.frames[:len(l.frames):len(l.frames)], InputFrame{Fetches: l.fetches, Ins: l.ins})
+}
+
+// Playing is whether recorded input is still being played
+func (l *InputLog) Playing() bool {
+	return l.playing
+}
+
+// Frame is the number of the frame being recorded or played
+func (l *InputLog) Frame() int {
+	return l.frame
+}
+
+// Err says why playback stopped before the end of the recording, if it
+// did
+func (l *InputLog) Err() error {
+	return l.err
+}
+
+func (l *InputLog) in(port uint16) byte {
+	if !l.playing {
+		n := l.z.IO.In(port)
+		l.ins = append(l.ins, n)
+		return n
+	}
+	f := &l.frames[l.frame]
+	if l.next >= len(f.Ins) {
+		l.err = fmt.Errorf("Ran out of input in frame %d, after %d INs", l.frame, len(f.Ins))
+		l.record()
+		return l.in(port)
+	}
+	n := f.Ins[l.next]
+	l.next++
+	return n
+}
+
+// interrupted ends the frame being recorded
+func (l *InputLog) interrupted() {
+	if l.playing {
+		return
+	}
+	l.frames = append(l.frames, InputFrame{Fetches: l.fetches, Ins: l.ins})
+	l.frame++
+	l.fetches = 0
+	l.ins = nil
+}
+
+// frameEnded is whether the frame being played has fetched all its
+// opcodes. A halted CPU fetches NOPs until the frame ends.
+func (l *InputLog) frameEnded() bool {
+	return l.fetches >= l.frames[l.frame].Fetches || l.z.halted
+}
+
+// nextFrame moves on to the next frame to play, saying whether there was
+// one. The last frame doesn't end with an interrupt, but carries on being
+// recorded.
+func (l *InputLog) nextFrame() bool {
+	if l.frame == len(l.frames)-1 {
+		l.record()
+		return false
+	}
+	l.frame++
+	l.fetches = 0
+	l.next = 0
+	return true
+}
+
+// record stops playing, and records on the end of the frame being played
+func (l *InputLog) record() {
+	f := l.frames[l.frame]
+	l.frames = l.frames[:l.frame]
+	l.ins = append([]byte(nil), f.Ins[:l.next]...)
+	l.playing = false
+}
+
+// m1Fetches is the number of opcode fetches an instruction takes, which is
+// how much it increments R
+func m1Fetches(buf []byte) int {
+	n := 1
+	i := 0
+	for i < len(buf)-1 && (buf[i] == 0xdd || buf[i] == 0xfd) {
+		n++
+		i++
+	}
+	if i < len(buf)-1 {
+		switch {
+		case buf[i] == 0xcb && i > 0:
+			// The opcode after DDCB and a displacement isn't fetched
+		case buf[i] == 0xcb || buf[i] == 0xed:
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,117 @@
+package zog
+
+import "testing"
+
+func TestM1Fetches(t *testing.T) {
+	testCases := []struct {
+		buf     []byte
+		fetches int
+	}{
+		{[]byte{0x00}, 1},
+		{[]byte{0x21, 0x34, 0x12}, 1},
+		{[]byte{0xcb, 0x47}, 2},
+		{[]byte{0xed, 0xb0}, 2},
+		{[]byte{0xdd, 0x21, 0x34, 0x12}, 2},
+		{[]byte{0xfd, 0xcb, 0x05, 0x46}, 2},
+		{[]byte{0xdd, 0xfd, 0x23}, 3},
+	}
+	for _, tc := range testCases {
+		got := m1Fetches(tc.buf)
+		if got != tc.fetches {
+			t.Errorf("%s has %d fetches, expected %d", bufToHex(tc.buf), got, tc.fetches)
+		}
+	}
+}
+
+func TestInputLog(t *testing.T) {
+	prog := "\tORG 100h\n\tLD SP, 0F000h\n\tLD HL, 3000h\n\tIM 1\n\tEI\nloop:\tIN A, (10h)\n\tLD (2000h), A\n\tBIT 0, A\n\tJR Z, loop\n\tHALT\n\tJR loop\n"
+	z := traceTestZog(t, prog)
+	err := z.LoadBytes(0x38, []byte{0x34, 0xfb, 0xed, 0x4d})
+	if err != nil {
+		t.Fatalf("Can't load handler: %s", err)
+	}
+	src := &tickSource{on: true}
+	z.AddInterruptSource(src)
+	ins := byte(0)
+	err = z.IO.AttachPorts(0x10, 1, IOFuncs{InFunc: func(uint16) byte {
+		ins += 2
+		if ins%6 == 0 {
+			ins++
+		}
+		return ins
+	}})
+	if err != nil {
+		t.Fatalf("Can't attach: %s", err)
+	}
+
+	start := z.SaveState("test")
+	rec := z.RecordInput()
+	run := func() {
+		for i := 0; i < 500; i++ {
+			_, err := z.step()
+			if err != nil {
+				t.Fatalf("Failed to step: %s", err)
+			}
+			// Halted, a device interrupts at once
+			if z.halted {
+				src.polls = 9
+			}
+		}
+	}
+	run()
+	recorded := rec.Frames()
+	end := z.SaveState("test")
+	if len(recorded) < 5 || rec.Frame() != len(recorded)-1 {
+		t.Fatalf("Recorded %d frames, now in %d", len(recorded), rec.Frame())
+	}
+
+	// Without the devices, play it all again
+	src.on = false
+	insBefore := ins
+	err = z.RestoreState(start, "test")
+	if err != nil {
+		t.Fatalf("Can't restore: %s", err)
+	}
+	play := z.PlayInput(recorded)
+	for i := 0; i < 500; i++ {
+		_, err := z.step()
+		if err != nil {
+			t.Fatalf("Failed to step: %s", err)
+		}
+	}
+	if ins != insBefore || play.Err() != nil {
+		t.Fatalf("Playback read from the device: %v", play.Err())
+	}
+	got := z.SaveState("test")
+	if got.Registers != end.Registers || string(got.Memory) != string(end.Memory) {
+		t.Fatalf("Playback ended at %s, expected %s", got.Registers.Summary(), end.Registers.Summary())
+	}
+	if !play.Playing() || play.Frame() != len(recorded)-1 {
+		t.Fatalf("Playback in frame %d of %d", play.Frame(), len(recorded))
+	}
+
+	// Once the recording runs out, the last frame carries on recording
+	src.on = true
+	for i := 0; play.Playing() || ins == insBefore; i++ {
+		if i > 100 {
+			t.Fatalf("Playback never ran out")
+		}
+		_, err := z.step()
+		if err != nil {
+			t.Fatalf("Failed to step: %s", err)
+		}
+	}
+	frames := play.Frames()
+	countIns := func(frames []InputFrame) int {
+		n := 0
+		for _, f := range frames {
+			n += len(f.Ins)
+		}
+		return n
+	}
+	n := len(recorded) - 1
+	carried, recordedLast := frames[n], recorded[n]
+	if play.Err() != nil || carried.Fetches < recordedLast.Fetches || string(carried.Ins[:len(recordedLast.Ins)]) != string(recordedLast.Ins) || countIns(frames) <= countIns(recorded) {
+		t.Fatalf("Expected the last frame to carry on recording, got %v (%v)", frames[n:], play.Err())
+	}
+}
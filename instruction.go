@@ -1,6 +1,7 @@
 package zog
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"strings"
@@ -24,6 +25,14 @@ func (lh *LabelHolder) TStates(z *Zog) int        { panic("Attempt to get t-stat
 
 type Data struct {
 	data []byte
+	// Values patched into data when resolved
+	exprs []dataExpr
+}
+
+type dataExpr struct {
+	offset int
+	word   bool
+	expr   Expr
 }
 
 func NewData(data []byte) *Data {
@@ -39,12 +48,152 @@ func (d *Data) Encode() []byte {
 	return d.data
 }
 func (d *Data) Resolve(a *Assembly) error {
+	for _, de := range d.exprs {
+		if de.word {
+			nn, err := evalWord(a, de.expr)
+			if err != nil {
+				return err
+			}
+			a.operand(de.expr, de.offset, operandWord)
+			d.data[de.offset] = byte(nn)
+			d.data[de.offset+1] = byte(nn >> 8)
+		} else {
+			n, err := evalByte(a, de.expr)
+			if err != nil {
+				return err
+			}
+			a.operand(de.expr, de.offset, operandByte)
+			d.data[de.offset] = n
+		}
+	}
 	return nil
 }
 func (d *Data) Execute(z *Zog) error {
 	return errors.New("Error - trying to execute dummy data instruction")
 }
 
+// Space is a block of zero bytes, where the size depends on symbols
+type Space struct {
+	count Expr
+	fill  Expr
+	n     int
+	value byte
+}
+
+func (s *Space) String() string {
+	if s.fill != nil {
+		return fmt.Sprintf("DEFS %s, %s", s.count, s.fill)
+	}
+	return fmt.Sprintf("DEFS %s", s.count)
+}
+func (s *Space) TStates(z *Zog) int {
+	panic("Error - trying to get t-states for space directive")
+}
+func (s *Space) Encode() []byte {
+	return bytes.Repeat([]byte{s.value}, s.n)
+}
+func (s *Space) Resolve(a *Assembly) error {
+	if s.fill == nil {
+		return nil
+	}
+	v, err := evalByte(a, s.fill)
+	if err != nil {
+		return err
+	}
+	a.operand(s.fill, 0, operandAbsolute)
+	s.value = v
+	return nil
+}
+func (s *Space) Execute(z *Zog) error {
+	return errors.New("Error - trying to execute space directive")
+}
+
+// Align pads to the next multiple of boundary
+type Align struct {
+	boundary Expr
+	Space
+}
+
+func (al *Align) String() string {
+	if al.fill != nil {
+		return fmt.Sprintf("ALIGN %s, %s", al.boundary, al.fill)
+	}
+	return fmt.Sprintf("ALIGN %s", al.boundary)
+}
+
+// Org sets the address of the following instructions
+type Org struct {
+	expr Expr
+}
+
+func (o *Org) String() string            { return fmt.Sprintf("ORG %s", o.expr) }
+func (o *Org) Encode() []byte            { return nil }
+func (o *Org) Resolve(a *Assembly) error { return nil }
+func (o *Org) Execute(z *Zog) error      { panic("Attempt to execute org") }
+func (o *Org) TStates(z *Zog) int        { panic("Attempt to get t-states of org") }
+
+// Section switches to another section, each of which has its own
+// location counter. The unnamed section is absolute, the rest are
+// relocatable and start at 0.
+type Section struct {
+	name string
+}
+
+func (s *Section) String() string {
+	if s.name == "" {
+		return "ASEG"
+	}
+	return fmt.Sprintf("SECTION %s", s.name)
+}
+func (s *Section) Encode() []byte            { return nil }
+func (s *Section) Resolve(a *Assembly) error { return nil }
+func (s *Section) Execute(z *Zog) error      { panic("Attempt to execute section") }
+func (s *Section) TStates(z *Zog) int        { panic("Attempt to get t-states of section") }
+
+// Public exports symbols for other objects to link against
+type Public struct {
+	names []string
+}
+
+func (p *Public) String() string            { return fmt.Sprintf("PUBLIC %s", strings.Join(p.names, ", ")) }
+func (p *Public) Encode() []byte            { return nil }
+func (p *Public) Resolve(a *Assembly) error { return nil }
+func (p *Public) Execute(z *Zog) error      { panic("Attempt to execute public") }
+func (p *Public) TStates(z *Zog) int        { panic("Attempt to get t-states of public") }
+
+// Extern imports symbols defined by other objects
+type Extern struct {
+	names []string
+}
+
+func (e *Extern) String() string            { return fmt.Sprintf("EXTERN %s", strings.Join(e.names, ", ")) }
+func (e *Extern) Encode() []byte            { return nil }
+func (e *Extern) Resolve(a *Assembly) error { return nil }
+func (e *Extern) Execute(z *Zog) error      { panic("Attempt to execute extern") }
+func (e *Extern) TStates(z *Zog) int        { panic("Attempt to get t-states of extern") }
+
+// Equ defines the value of the symbol it is labelled with
+type Equ struct {
+	expr Expr
+}
+
+func (e *Equ) String() string            { return fmt.Sprintf("EQU %s", e.expr) }
+func (e *Equ) Encode() []byte            { return nil }
+func (e *Equ) Resolve(a *Assembly) error { return nil }
+func (e *Equ) Execute(z *Zog) error      { panic("Attempt to execute equ") }
+func (e *Equ) TStates(z *Zog) int        { panic("Attempt to get t-states of equ") }
+
+// Defl is like Equ, but the symbol can be redefined
+type Defl struct {
+	expr Expr
+}
+
+func (d *Defl) String() string            { return fmt.Sprintf("DEFL %s", d.expr) }
+func (d *Defl) Encode() []byte            { return nil }
+func (d *Defl) Resolve(a *Assembly) error { return nil }
+func (d *Defl) Execute(z *Zog) error      { panic("Attempt to execute defl") }
+func (d *Defl) TStates(z *Zog) int        { panic("Attempt to get t-states of defl") }
+
 type LD8 struct {
 	InstBin8
 }
@@ -93,7 +242,7 @@ func (l *LD8) Encode() []byte {
 	}
 
 	if l.dstInfo.ltype != tableR {
-		panic("Non-tableR dst in LD8")
+		panic(operandError("Non-tableR dst in LD8"))
 	}
 	switch l.srcInfo.ltype {
 	case tableR:
@@ -118,7 +267,7 @@ func (l *LD8) Encode() []byte {
 		buf = append(buf, l.srcInfo.imm16...)
 		return buf
 	default:
-		panic("Unknown src type in LD8")
+		panic(operandError("Unknown src type in LD8"))
 	}
 }
 func (l *LD8) Execute(z *Zog) error {
@@ -166,7 +315,7 @@ func (i *INC8) TStates(z *Zog) int {
 func (i *INC8) Encode() []byte {
 	i.inspect()
 	if i.lInfo.ltype != tableR {
-		panic("Non-tableR INC8")
+		panic(operandError("Non-tableR INC8"))
 	}
 	b := encodeXYZ(0, i.lInfo.idxTable, 4)
 	return idxEncodeHelper([]byte{b}, i.idx)
@@ -207,7 +356,7 @@ func (d *DEC8) TStates(z *Zog) int {
 func (d *DEC8) Encode() []byte {
 	d.inspect()
 	if d.lInfo.ltype != tableR {
-		panic("Non-tableR DEC8")
+		panic(operandError("Non-tableR DEC8"))
 	}
 	b := encodeXYZ(0, d.lInfo.idxTable, 5)
 	return idxEncodeHelper([]byte{b}, d.idx)
@@ -247,7 +396,7 @@ func (l *LD16) Encode() []byte {
 			return idxEncodeHelper(buf, l.idx)
 		} else {
 			if l.srcInfo.ltype != tableRP {
-				panic("Non-tableRP src in LD16 (NN), src")
+				panic(operandError("Non-tableRP src in LD16 (NN), src"))
 			}
 			buf := []byte{0xed, encodeXPQZ(1, l.srcInfo.idxTable, 0, 3)}
 			buf = append(buf, l.dstInfo.imm16...)
@@ -256,7 +405,7 @@ func (l *LD16) Encode() []byte {
 	}
 
 	if l.dstInfo.ltype != tableRP {
-		panic("Non-tableRP dst in LD16")
+		panic(operandError("Non-tableRP dst in LD16"))
 	}
 
 	switch l.srcInfo.ltype {
@@ -272,7 +421,7 @@ func (l *LD16) Encode() []byte {
 			return idxEncodeHelper(buf, l.idx)
 		} else {
 			if l.dstInfo.ltype != tableRP {
-				panic("Non-tableRP src in LD16 (NN), src")
+				panic(operandError("Non-tableRP src in LD16 (NN), src"))
 			}
 			buf := []byte{0xed, encodeXPQZ(1, l.dstInfo.idxTable, 1, 3)}
 			buf = append(buf, l.srcInfo.imm16...)
@@ -281,15 +430,15 @@ func (l *LD16) Encode() []byte {
 	case tableRP:
 		if l.srcInfo.isHLLike() {
 			if l.dst != SP {
-				panic("HL-like load to non-SP")
+				panic(operandError("HL-like load to non-SP"))
 			}
 			buf := []byte{encodeXPQZ(3, 3, 1, 1)}
 			return idxEncodeHelper(buf, l.idx)
 		} else {
-			panic("Non-HL like load to something")
+			panic(operandError("Non-HL like load to something"))
 		}
 	default:
-		panic("Unknown src type in LD16")
+		panic(operandError("Unknown src type in LD16"))
 	}
 }
 func (l *LD16) Execute(z *Zog) error {
@@ -324,21 +473,21 @@ func (a *ADD16) String() string {
 func (a *ADD16) Encode() []byte {
 	a.inspect()
 	if a.dstInfo.ltype != tableRP {
-		panic("Non-tableRP dst in ADD16")
+		panic(operandError("Non-tableRP dst in ADD16"))
 	}
 	if a.srcInfo.ltype != tableRP {
-		panic("Non-tableRP src in ADD16")
+		panic(operandError("Non-tableRP src in ADD16"))
 	}
 
 	if !a.dstInfo.isHLLike() {
-		panic("Non-HL dst in ADD16")
+		panic(operandError("Non-HL dst in ADD16"))
 	}
 	switch a.srcInfo.ltype {
 	case tableRP:
 		buf := []byte{encodeXPQZ(0, a.srcInfo.idxTable, 1, 1)}
 		return idxEncodeHelper(buf, a.idx)
 	default:
-		panic("Unknown src type in ADD16")
+		panic(operandError("Unknown src type in ADD16"))
 	}
 }
 func (a *ADD16) Execute(z *Zog) error {
@@ -367,7 +516,7 @@ func (a *ADC16) String() string {
 func (a *ADC16) Encode() []byte {
 	a.inspect()
 	if a.srcInfo.ltype != tableRP {
-		panic("Non-tableRP src in ADC16")
+		panic(operandError("Non-tableRP src in ADC16"))
 	}
 	buf := []byte{0xed, encodeXPQZ(1, a.srcInfo.idxTable, 1, 2)}
 	return idxEncodeHelper(buf, a.idx)
@@ -408,7 +557,7 @@ func (s *SBC16) String() string {
 func (s *SBC16) Encode() []byte {
 	s.inspect()
 	if s.srcInfo.ltype != tableRP {
-		panic("Non-tableRP src in SBC16")
+		panic(operandError("Non-tableRP src in SBC16"))
 	}
 	buf := []byte{0xed, encodeXPQZ(1, s.srcInfo.idxTable, 0, 2)}
 	return idxEncodeHelper(buf, s.idx)
@@ -457,7 +606,7 @@ func (i *INC16) TStates(z *Zog) int {
 func (i *INC16) Encode() []byte {
 	i.inspect()
 	if i.lInfo.ltype != tableRP {
-		panic("Non-tableRP INC16")
+		panic(operandError("Non-tableRP INC16"))
 	}
 	b := encodeXPQZ(0, i.lInfo.idxTable, 0, 3)
 	return idxEncodeHelper([]byte{b}, i.idx)
@@ -489,7 +638,7 @@ func (d *DEC16) TStates(z *Zog) int {
 func (d *DEC16) Encode() []byte {
 	d.inspect()
 	if d.lInfo.ltype != tableRP {
-		panic("Non-tableRP DEC16")
+		panic(operandError("Non-tableRP DEC16"))
 	}
 	b := encodeXPQZ(0, d.lInfo.idxTable, 1, 3)
 	return idxEncodeHelper([]byte{b}, d.idx)
@@ -542,7 +691,7 @@ func (ex *EX) Encode() []byte {
 		return []byte{encodeXYZ(3, 5, 3)}
 	}
 
-	panic("Unrecognised EX instruction")
+	panic(operandError("Unrecognised EX instruction"))
 }
 func (ex *EX) Execute(z *Zog) error {
 	a, err := ex.src.Read16(z)
@@ -566,10 +715,14 @@ func (ex *EX) Execute(z *Zog) error {
 }
 
 type DJNZ struct {
-	d Disp
+	d      Disp
+	target *RelTarget
 }
 
 func (d *DJNZ) String() string {
+	if d.target != nil {
+		return fmt.Sprintf("DJNZ %s", d.target)
+	}
 	return fmt.Sprintf("DJNZ %s", d.d)
 }
 func (d *DJNZ) TStates(z *Zog) int {
@@ -584,6 +737,14 @@ func (d *DJNZ) Encode() []byte {
 	return []byte{b, byte(d.d)}
 }
 func (d *DJNZ) Resolve(a *Assembly) error {
+	if d.target == nil {
+		return nil
+	}
+	disp, err := a.ResolveRelative(d.target)
+	if err != nil {
+		return err
+	}
+	d.d = disp
 	return nil
 }
 func (d *DJNZ) Execute(z *Zog) error {
@@ -604,15 +765,20 @@ func (d *DJNZ) Execute(z *Zog) error {
 }
 
 type JR struct {
-	c Conditional
-	d Disp
+	c      Conditional
+	d      Disp
+	target *RelTarget
 }
 
 func (j *JR) String() string {
+	var dst fmt.Stringer = j.d
+	if j.target != nil {
+		dst = j.target
+	}
 	if j.c == True || j.c == nil {
-		return fmt.Sprintf("JR %s", j.d)
+		return fmt.Sprintf("JR %s", dst)
 	} else {
-		return fmt.Sprintf("JR %s, %s", j.c, j.d)
+		return fmt.Sprintf("JR %s, %s", j.c, dst)
 	}
 }
 func (j *JR) TStates(z *Zog) int {
@@ -635,6 +801,14 @@ func (j *JR) Encode() []byte {
 	return []byte{b, byte(j.d)}
 }
 func (j *JR) Resolve(a *Assembly) error {
+	if j.target == nil {
+		return nil
+	}
+	disp, err := a.ResolveRelative(j.target)
+	if err != nil {
+		return err
+	}
+	j.d = disp
 	return nil
 }
 func (j *JR) Execute(z *Zog) error {
@@ -663,7 +837,7 @@ func (jp *JP) String() string {
 }
 func (jp *JP) TStates(z *Zog) int {
 	switch jp.l.(type) {
-	case Imm16:
+	case Imm16, *Label:
 		return 10
 	case R16:
 		return 4
@@ -682,7 +856,7 @@ func (jp *JP) Encode() []byte {
 		}
 	}
 	if jp.lInfo.ltype != Immediate {
-		panic("Non-immediate (or direct HL-like) JP")
+		panic(operandError("Non-immediate (or direct HL-like) JP"))
 	}
 
 	var buf []byte
@@ -777,18 +951,17 @@ func (o *OUT) Encode() []byte {
 		var idx idxInfo
 		inspectLoc8(o.value, &info, &idx)
 		if info.ltype != tableR {
-			panic("Non-tableR value in OUT")
+			panic(operandError("Non-tableR value in OUT"))
 		}
 		// (HL)? IX?
 		buf := []byte{0xed, encodeXYZ(1, info.idxTable, 1)}
 		return idxEncodeHelper(buf, idx)
 	} else {
-		imm8 := o.port.(Imm8)
-		return []byte{encodeXYZ(3, 2, 3), byte(imm8)}
+		return []byte{encodeXYZ(3, 2, 3), immByte(o.port)}
 	}
 }
 func (o *OUT) Resolve(a *Assembly) error {
-	return nil
+	return a.ResolveLoc8(o.port)
 }
 func (o *OUT) Execute(z *Zog) error {
 	/*
@@ -807,7 +980,11 @@ func (o *OUT) Execute(z *Zog) error {
 	if o.port == C {
 		addr = z.reg.Read16(BC)
 	} else {
-		addr = uint16(v) | (uint16(z.reg.A) << 8)
+		lo, err := o.port.Read8(z)
+		if err != nil {
+			return fmt.Errorf("Failed to read io port location: %s", o.port)
+		}
+		addr = uint16(lo) | (uint16(z.reg.A) << 8)
 	}
 	z.out(addr, v)
 	return nil
@@ -839,19 +1016,18 @@ func (i *IN) Encode() []byte {
 		} else {
 			inspectLoc8(i.dst, &info, &idx)
 			if info.ltype != tableR {
-				panic("Non-tableR dst in IN")
+				panic(operandError("Non-tableR dst in IN"))
 			}
 			y = info.idxTable
 		}
 		buf := []byte{0xed, encodeXYZ(1, y, 0)}
 		return idxEncodeHelper(buf, idx)
 	} else {
-		imm8 := i.port.(Imm8)
-		return []byte{encodeXYZ(3, 3, 3), byte(imm8)}
+		return []byte{encodeXYZ(3, 3, 3), immByte(i.port)}
 	}
 }
 func (i *IN) Resolve(a *Assembly) error {
-	return nil
+	return a.ResolveLoc8(i.port)
 }
 func (i *IN) Execute(z *Zog) error {
 	// See spec comment in OUT
@@ -866,10 +1042,19 @@ func (i *IN) Execute(z *Zog) error {
 		addr = uint16(lo) | (uint16(z.reg.A) << 8)
 	}
 	n := z.in(addr)
+	if i.port != C {
+		// IN A, (n) leaves the flags alone
+		return i.dst.Write8(z, n)
+	}
 	z.SetFlag(F_S, !isPos8(n))
 	z.SetFlag(F_Z, n == 0)
+	z.SetFlag(F_H, false)
 	z.SetFlag(F_N, false)
 	setParity(z, n)
+	if i.dst == F {
+		// IN F, (C) - only sets the flags
+		return nil
+	}
 	return i.dst.Write8(z, n)
 }
 
@@ -893,7 +1078,7 @@ func (p *PUSH) String() string {
 func (p *PUSH) Encode() []byte {
 	p.inspectRP2()
 	if p.lInfo.ltype != tableRP2 {
-		panic("Non-tableRP PUSH")
+		panic(operandError("Non-tableRP PUSH"))
 	}
 	buf := []byte{encodeXPQZ(3, p.lInfo.idxTable, 0, 5)}
 	return idxEncodeHelper(buf, p.idx)
@@ -927,7 +1112,7 @@ func (p *POP) String() string {
 func (p *POP) Encode() []byte {
 	p.inspectRP2()
 	if p.lInfo.ltype != tableRP2 {
-		panic("Non-tableRP PUSH")
+		panic(operandError("Non-tableRP PUSH"))
 	}
 	buf := []byte{encodeXPQZ(3, p.lInfo.idxTable, 0, 1)}
 	return idxEncodeHelper(buf, p.idx)
@@ -1128,7 +1313,7 @@ func (a accum) TStates(z *Zog) int {
 	switch a.l.(type) {
 	case R8:
 		return 4
-	case Imm8:
+	case Imm8, *Expr8:
 		return 7
 	case Contents:
 		return 7
@@ -1150,7 +1335,7 @@ func (a accum) Encode() []byte {
 		buf = []byte{encodeXYZ(3, y, 6)}
 		buf = append(buf, a.lInfo.imm8)
 	default:
-		panic("Unknown accum location type")
+		panic(operandError("Unknown accum location type"))
 	}
 	return idxEncodeHelper(buf, a.idx)
 }
@@ -1299,7 +1484,7 @@ func (r *rot) String() string {
 func (r *rot) Encode() []byte {
 	r.inspect()
 	if r.lInfo.ltype != tableR {
-		panic("Non-tableR src in BIT")
+		panic(operandError("Non-tableR src in BIT"))
 	}
 	y := findInTableROT(r.name)
 	z := r.lInfo.idxTable
@@ -1362,7 +1547,7 @@ func (b *BIT) String() string {
 func (b *BIT) Encode() []byte {
 	b.inspect()
 	if b.lInfo.ltype != tableR {
-		panic("Non-tableR src in BIT")
+		panic(operandError("Non-tableR src in BIT"))
 	}
 	z := b.lInfo.idxTable
 	enc := encodeXYZ(1, b.num, z)
@@ -1417,7 +1602,7 @@ func (r *RES) String() string {
 func (r *RES) Encode() []byte {
 	r.inspect()
 	if r.lInfo.ltype != tableR {
-		panic("Non-tableR src in BIT")
+		panic(operandError("Non-tableR src in BIT"))
 	}
 	z := r.lInfo.idxTable
 	if r.idx.isPrefix && r.cpy != nil {
@@ -1468,7 +1653,7 @@ func (s *SET) String() string {
 func (s *SET) Encode() []byte {
 	s.inspect()
 	if s.lInfo.ltype != tableR {
-		panic("Non-tableR src in SET")
+		panic(operandError("Non-tableR src in SET"))
 	}
 	z := s.lInfo.idxTable
 	if s.idx.isPrefix && s.cpy != nil {
@@ -1839,14 +2024,13 @@ func (s EDSimple) TStates(z *Zog) int {
 		return 16
 	case OUTD:
 		return 16
-	case INIR:
-		return int(z.reg.Read16(BC))*21 + 16
-	case OTIR:
-		return int(z.reg.Read16(BC))*21 + 16
-	case INDR:
-		return int(z.reg.Read16(BC))*21 + 16
-	case OTDR:
-		return int(z.reg.Read16(BC))*21 + 16
+	case INIR, OTIR, INDR, OTDR:
+		// Counted in B alone, where 0 means 256
+		b := int(z.reg.B)
+		if b == 0 {
+			b = 256
+		}
+		return (b-1)*21 + 16
 	default:
 		panic("Unknown edsimple instruction")
 	}
@@ -1903,14 +2087,14 @@ func (s EDSimple) Execute(z *Zog) error {
 	}
 
 	outHelper := func(z *Zog, inc bool) error {
-		bc := z.reg.Read16(BC)
 		hl := z.reg.Read16(HL)
 
 		n, err := z.Mem.Peek(hl)
 		if err != nil {
 			return err
 		}
-		z.out(bc, n)
+		// B is decremented before it goes on the address bus
+		z.out(uint16(z.reg.B-1)<<8|uint16(z.reg.C), n)
 
 		if inc {
 			hl++
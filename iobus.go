@@ -0,0 +1,148 @@
+package zog
+
+import (
+	"fmt"
+	"sync"
+)
+
+// IOReader is a device which answers IN on the ports it is attached to
+type IOReader interface {
+	In(port uint16) byte
+}
+
+// IOWriter is a device which sees OUT on the ports it is attached to
+type IOWriter interface {
+	Out(port uint16, n byte)
+}
+
+type ioAttachment struct {
+	mask  uint16
+	match uint16
+	r     IOReader
+	w     IOWriter
+}
+
+func (a *ioAttachment) decodes(port uint16) bool {
+	return port&a.mask == a.match
+}
+
+// IOBus decodes port addresses to devices. A device is selected when
+// port & mask == match, so partial decoding (e.g. the Spectrum ULA on
+// A0 only) is just a narrow mask. Every selected device sees an OUT. On
+// IN, selected devices drive the bus together (wired-AND) and if none are
+// selected the bus floats.
+type IOBus struct {
+	sync.Mutex
+	Floating    byte
+	attachments []ioAttachment
+}
+
+func NewIOBus() *IOBus {
+	return &IOBus{Floating: 0xff}
+}
+
+// Attach adds dev, which must implement IOReader, IOWriter or both.
+func (b *IOBus) Attach(mask, match uint16, dev interface{}) error {
+	if match&^mask != 0 {
+		return fmt.Errorf("Match [%04X] has bits outside mask [%04X]", match, mask)
+	}
+	if f, ok := dev.(IOFuncs); ok {
+		dev = f.device()
+	}
+	r, isReader := dev.(IOReader)
+	w, isWriter := dev.(IOWriter)
+	if !isReader && !isWriter {
+		return fmt.Errorf("Device %T is neither IOReader nor IOWriter", dev)
+	}
+	b.Lock()
+	defer b.Unlock()
+	b.attachments = append(b.attachments, ioAttachment{mask: mask, match: match, r: r, w: w})
+	return nil
+}
+
+// AttachPorts attaches dev to numPorts consecutive 8-bit ports from base,
+// ignoring the high byte of the address. numPorts must be a power of two
+// and base aligned to it.
+func (b *IOBus) AttachPorts(base byte, numPorts int, dev interface{}) error {
+	if numPorts <= 0 || numPorts > 0x100 || numPorts&(numPorts-1) != 0 {
+		return fmt.Errorf("Bad number of ports: %d", numPorts)
+	}
+	mask := uint16(0x100-numPorts) & 0xff
+	if uint16(base)&^mask != 0 {
+		return fmt.Errorf("Base port [%02X] not aligned to %d ports", base, numPorts)
+	}
+	return b.Attach(mask, uint16(base), dev)
+}
+
+func (b *IOBus) In(port uint16) byte {
+	b.Lock()
+	attachments := b.attachments
+	b.Unlock()
+
+	n := byte(0xff)
+	selected := false
+	for i := range attachments {
+		a := &attachments[i]
+		if a.r != nil && a.decodes(port) {
+			n &= a.r.In(port)
+			selected = true
+		}
+	}
+	if !selected {
+		return b.Floating
+	}
+	return n
+}
+
+func (b *IOBus) Out(port uint16, n byte) {
+	b.Lock()
+	attachments := b.attachments
+	b.Unlock()
+
+	for i := range attachments {
+		a := &attachments[i]
+		if a.w != nil && a.decodes(port) {
+			a.w.Out(port, n)
+		}
+	}
+}
+
+// IOFuncs adapts plain functions to IOReader/IOWriter. Use InFunc or
+// OutFunc alone for a read or write only device.
+type IOFuncs struct {
+	InFunc  func(port uint16) byte
+	OutFunc func(port uint16, n byte)
+}
+
+func (f IOFuncs) device() interface{} {
+	switch {
+	case f.InFunc != nil && f.OutFunc != nil:
+		return f
+	case f.InFunc != nil:
+		return inFunc(f.InFunc)
+	case f.OutFunc != nil:
+		return outFunc(f.OutFunc)
+	default:
+		return nil
+	}
+}
+
+func (f IOFuncs) In(port uint16) byte {
+	return f.InFunc(port)
+}
+
+func (f IOFuncs) Out(port uint16, n byte) {
+	f.OutFunc(port, n)
+}
+
+type inFunc func(port uint16) byte
+
+func (f inFunc) In(port uint16) byte {
+	return f(port)
+}
+
+type outFunc func(port uint16, n byte)
+
+func (f outFunc) Out(port uint16, n byte) {
+	f(port, n)
+}
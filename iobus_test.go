@@ -0,0 +1,122 @@
+package zog
+
+import "testing"
+
+type testPort struct {
+	in  byte
+	out []uint16
+	buf []byte
+}
+
+func (p *testPort) In(port uint16) byte {
+	return p.in
+}
+
+func (p *testPort) Out(port uint16, n byte) {
+	p.out = append(p.out, port)
+	p.buf = append(p.buf, n)
+}
+
+func TestIOBusDecode(t *testing.T) {
+	b := NewIOBus()
+	ula := &testPort{in: 0xbf}
+	kempston := &testPort{in: 0x1f}
+	err := b.Attach(0x0001, 0x0000, ula)
+	if err != nil {
+		t.Fatalf("Can't attach: %s", err)
+	}
+	err = b.Attach(0x00e0, 0x0000, kempston)
+	if err != nil {
+		t.Fatalf("Can't attach: %s", err)
+	}
+	err = b.Attach(0x00ff, 0x0100, kempston)
+	if err == nil {
+		t.Errorf("Attached match outside mask")
+	}
+	err = b.AttachPorts(0x81, 2, kempston)
+	if err == nil {
+		t.Errorf("Attached unaligned ports")
+	}
+
+	testCases := []struct {
+		port     uint16
+		expected byte
+	}{
+		{0x7ffe, 0xbf},
+		{0x001f, 0x1f},
+		// Both selected, wired-AND
+		{0x001e, 0x1f & 0xbf},
+		{0x00ff, 0xff},
+	}
+	b.Floating = 0xee
+	testCases[3].expected = 0xee
+	for _, tc := range testCases {
+		n := b.In(tc.port)
+		if n != tc.expected {
+			t.Errorf("IN %04X: got %02X, expected %02X", tc.port, n, tc.expected)
+		}
+	}
+
+	b.Out(0x12fe, 0x07)
+	b.Out(0x1201, 0x07)
+	if len(ula.out) != 1 || ula.out[0] != 0x12fe {
+		t.Errorf("ULA saw writes %v", ula.out)
+	}
+}
+
+func TestIOBlockInstructions(t *testing.T) {
+	z := New(0)
+	dev := &testPort{in: 0x5a}
+	err := z.IO.AttachPorts(0x10, 1, dev)
+	if err != nil {
+		t.Fatalf("Can't attach: %s", err)
+	}
+
+	a, err := Assemble(`
+	ORG 8000h
+	LD HL, 9000h
+	LD BC, 0310h
+	OTIR
+	LD HL, 9100h
+	LD B, 02h
+	INIR
+	LD A, 00h
+	IN A, (10h)
+	LD C, 10h
+	IN F, (C)
+	HALT
+`)
+	if err != nil {
+		t.Fatalf("Can't assemble: %s", err)
+	}
+	err = z.LoadBytes(0x9000, []byte{1, 2, 3})
+	if err != nil {
+		t.Fatalf("Can't load: %s", err)
+	}
+	err = z.RunAssembly(a)
+	if err != nil {
+		t.Fatalf("Run failed: %s", err)
+	}
+
+	if string(dev.buf) != "\x01\x02\x03" {
+		t.Errorf("OTIR wrote %v", dev.buf)
+	}
+	expectedPorts := []uint16{0x0210, 0x0110, 0x0010}
+	for i, port := range expectedPorts {
+		if i >= len(dev.out) || dev.out[i] != port {
+			t.Errorf("OTIR used ports %04X, expected %04X", dev.out, expectedPorts)
+			break
+		}
+	}
+	in, _ := z.Mem.PeekBuf(0x9100, 3)
+	if string(in) != "\x5a\x5a\x00" {
+		t.Errorf("INIR read %v", in)
+	}
+	r := z.GetRegisters()
+	if r.A != 0x5a {
+		t.Errorf("IN A, (n) read %02X", r.A)
+	}
+	if z.GetFlag(F_Z) || !z.GetFlag(F_PV) {
+		t.Errorf("IN F, (C) flags wrong: %s", z.FlagString())
+	}
+}
@@ -0,0 +1,181 @@
+package zog
+
+import (
+	"fmt"
+)
+
+// Linked is the result of linking objects: code placed at its final
+// addresses, and the symbols the objects export
+type Linked struct {
+	Blocks   []Block
+	Sections []LinkedSection
+	Symbols  map[string]int
+	Entry    uint16
+	HasEntry bool
+}
+
+// LinkedSection is where a section ended up, once the parts from each
+// object were joined together
+type LinkedSection struct {
+	Name string
+	Addr uint16
+	Len  int
+}
+
+// Link places the sections of some objects and fills in the symbols they
+// import from each other. Parts of a section from each object are joined
+// together, in the order the objects are given. Sections go at the
+// address given in addrs. Others follow on from the section before, in
+// the order they were first seen, the first of them following the
+// absolute code.
+func Link(objs []*Object, addrs map[string]uint16) (*Linked, error) {
+	l := &Linked{Symbols: make(map[string]int)}
+
+	// Sizes and order of the sections, and the end of the absolute code
+	var order []string
+	sizes := make(map[string]int)
+	next := 0
+	for _, obj := range objs {
+		for _, sec := range obj.Sections {
+			if sec.Name == "" {
+				if end := int(sec.Addr) + len(sec.Data); end > next {
+					next = end
+				}
+				continue
+			}
+			if _, ok := sizes[sec.Name]; !ok {
+				order = append(order, sec.Name)
+			}
+			sizes[sec.Name] += len(sec.Data)
+		}
+	}
+
+	// Where each object's part of each section goes. Absolute values are
+	// relative to 0, the missing section "".
+	bases := make([]map[string]int, len(objs))
+	for i := range objs {
+		bases[i] = make(map[string]int)
+	}
+	for _, name := range order {
+		base := next
+		if addr, ok := addrs[name]; ok {
+			base = int(addr)
+		}
+		if base+sizes[name] > 0x10000 {
+			return nil, fmt.Errorf("Section %s at %04X runs past the end of memory", name, base)
+		}
+		l.Sections = append(l.Sections, LinkedSection{Name: name, Addr: uint16(base), Len: sizes[name]})
+
+		next = base
+		for i, obj := range objs {
+			bases[i][name] = next
+			for _, sec := range obj.Sections {
+				if sec.Name == name {
+					next += len(sec.Data)
+				}
+			}
+		}
+	}
+	for name := range addrs {
+		if _, ok := sizes[name]; !ok {
+			return nil, fmt.Errorf("No section %s to place", name)
+		}
+	}
+
+	exporter := make(map[string]string)
+	for i, obj := range objs {
+		for _, sym := range obj.Exports {
+			if other, ok := exporter[sym.Name]; ok {
+				return nil, fmt.Errorf("Symbol %s is exported by both %s and %s", sym.Name, other, obj.Name)
+			}
+			exporter[sym.Name] = obj.Name
+			l.Symbols[sym.Name] = bases[i][sym.Section] + sym.Value
+		}
+	}
+	for _, obj := range objs {
+		for _, name := range obj.Imports {
+			if _, ok := l.Symbols[name]; !ok {
+				return nil, fmt.Errorf("Undefined symbol %s, imported by %s", name, obj.Name)
+			}
+		}
+	}
+
+	var blocks []Block
+	for i, obj := range objs {
+		for _, sec := range obj.Sections {
+			addr := int(sec.Addr)
+			if sec.Name != "" {
+				addr = bases[i][sec.Name]
+			}
+			if len(sec.Data) == 0 {
+				continue
+			}
+			data := append([]byte{}, sec.Data...)
+			for _, r := range sec.Relocs {
+				err := l.apply(obj, bases[i], data, r)
+				if err != nil {
+					return nil, err
+				}
+			}
+			blocks = append(blocks, Block{Addr: uint16(addr), Data: data})
+		}
+	}
+	var err error
+	l.Blocks, err = placeBlocks(blocks)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, obj := range objs {
+		if obj.Entry != nil {
+			l.Entry = uint16(bases[i][obj.Entry.Section] + obj.Entry.Value)
+			l.HasEntry = true
+			break
+		}
+	}
+	return l, nil
+}
+
+// apply patches one relocation into the bytes of a section
+func (l *Linked) apply(obj *Object, bases map[string]int, data []byte, r Reloc) error {
+	var v int
+	if r.Symbol != "" {
+		v = l.Symbols[r.Symbol]
+	} else {
+		base, ok := bases[r.Section]
+		if !ok {
+			return fmt.Errorf("%s: relocation against unknown section %s", obj.Name, r.Section)
+		}
+		v = base
+	}
+	v += r.Addend
+
+	size := 1
+	if r.Kind == RelocWord {
+		size = 2
+	}
+	if r.Offset < 0 || r.Offset+size > len(data) {
+		return fmt.Errorf("%s: relocation at %d is outside its section", obj.Name, r.Offset)
+	}
+
+	switch r.Kind {
+	case RelocWord:
+		if v < -32768 || v > 65535 {
+			return fmt.Errorf("%s: value %d relative to %s out of range for word", obj.Name, v, r.Where())
+		}
+		data[r.Offset] = byte(v)
+		data[r.Offset+1] = byte(v >> 8)
+	case RelocByte:
+		if v < -128 || v > 255 {
+			return fmt.Errorf("%s: value %d relative to %s out of range for byte", obj.Name, v, r.Where())
+		}
+		data[r.Offset] = byte(v)
+	case RelocLow:
+		data[r.Offset] = byte(v)
+	case RelocHigh:
+		data[r.Offset] = byte(v >> 8)
+	default:
+		return fmt.Errorf("%s: unknown relocation kind [%s]", obj.Name, r.Kind)
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+package zog
+
+import (
+	"bytes"
+	"testing"
+)
+
+const linkMain = `	extern print, msg
+	public start
+	cseg
+start:	ld hl, msg
+	call print
+	jr start
+	ld a, high(table)
+	ld b, low(table+1)
+	dseg
+table:	dw start, table, print+2
+	end start
+`
+
+const linkLib = `	section code
+	global print, msg
+print:	ld a, (hl)
+	ret
+	section data
+msg:	db "hi", 0
+`
+
+func linkObject(t *testing.T, name, prog string) *Object {
+	assembly, err := Assemble(prog)
+	if err != nil {
+		t.Fatalf("Failed to assemble %s: %s", name, err)
+	}
+	obj, err := assembly.Object(name)
+	if err != nil {
+		t.Fatalf("Failed to make object %s: %s", name, err)
+	}
+	// Go through the file format
+	buf := &bytes.Buffer{}
+	err = obj.Write(buf)
+	if err != nil {
+		t.Fatalf("Failed to write object %s: %s", name, err)
+	}
+	obj, err = ReadObject(buf)
+	if err != nil {
+		t.Fatalf("Failed to read object %s: %s", name, err)
+	}
+	return obj
+}
+
+func TestLink(t *testing.T) {
+	objs := []*Object{linkObject(t, "main", linkMain), linkObject(t, "lib", linkLib)}
+	linked, err := Link(objs, map[string]uint16{"code": 0x8000})
+	if err != nil {
+		t.Fatalf("Failed to link: %s", err)
+	}
+	expected := []byte{
+		// main code at 8000
+		0x21, 0x14, 0x80, 0xcd, 0x0c, 0x80, 0x18, 0xf8, 0x3e, 0x80, 0x06, 0x0f,
+		// lib code at 800C
+		0x7e, 0xc9,
+		// main data at 800E
+		0x00, 0x80, 0x0e, 0x80, 0x0e, 0x80,
+		// lib data at 8014
+		'h', 'i', 0x00,
+	}
+	if len(linked.Blocks) != 1 || linked.Blocks[0].Addr != 0x8000 || !bytes.Equal(linked.Blocks[0].Data, expected) {
+		t.Fatalf("Got %v expected one block at 8000 of [% X]", linked.Blocks, expected)
+	}
+	if !linked.HasEntry || linked.Entry != 0x8000 {
+		t.Fatalf("Got entry %04X expected 8000", linked.Entry)
+	}
+	if linked.Symbols["msg"] != 0x8014 {
+		t.Fatalf("Got msg %04X expected 8014", linked.Symbols["msg"])
+	}
+
+	// Unplaced sections follow the section before
+	linked, err = Link(objs, map[string]uint16{"data": 0x100})
+	if err != nil {
+		t.Fatalf("Failed to link: %s", err)
+	}
+	if linked.Sections[0].Addr != 0 || linked.Sections[1].Addr != 0x100 {
+		t.Fatalf("Got sections %v, expected code at 0000 and data at 0100", linked.Sections)
+	}
+}
+
+func TestLinkAbsolute(t *testing.T) {
+	objs := []*Object{
+		linkObject(t, "boot", "\torg 100h\n\textern main\n\tjp main\n"),
+		linkObject(t, "main", "\tcseg\n\tpublic main\nmain:\tret\n"),
+	}
+	linked, err := Link(objs, nil)
+	if err != nil {
+		t.Fatalf("Failed to link: %s", err)
+	}
+	expected := []byte{0xc3, 0x03, 0x01, 0xc9}
+	if len(linked.Blocks) != 1 || linked.Blocks[0].Addr != 0x100 || !bytes.Equal(linked.Blocks[0].Data, expected) {
+		t.Fatalf("Got %v expected one block at 0100 of [% X]", linked.Blocks, expected)
+	}
+}
+
+func TestLinkErrors(t *testing.T) {
+	testCases := []struct {
+		progs []string
+		addrs map[string]uint16
+	}{
+		{[]string{"\textern foo\n\tdw foo\n"}, nil},
+		{[]string{"\tpublic foo\nfoo:\tnop\n", "\tpublic foo\nfoo:\tnop\n"}, nil},
+		{[]string{"\tcseg\n\tnop\n"}, map[string]uint16{"data": 0}},
+		{[]string{"\tcseg\n\tds 10\n"}, map[string]uint16{"code": 0xfffe}},
+		{[]string{"\tcseg\n\tld a, lbl\nlbl:\n"}, map[string]uint16{"code": 0x8000}},
+		{[]string{"\torg 100h\n\tnop\n", "\torg 100h\n\tnop\n"}, nil},
+	}
+	for _, tc := range testCases {
+		var objs []*Object
+		for _, prog := range tc.progs {
+			objs = append(objs, linkObject(t, "test", prog))
+		}
+		_, err := Link(objs, tc.addrs)
+		if err == nil {
+			t.Fatalf("No error linking %q", tc.progs)
+		}
+	}
+}
+
+func TestRelocErrors(t *testing.T) {
+	testCases := []string{
+		"\textern foo\n\tjr foo\n",
+		"\tcseg\nlbl:\n\tld a, (ix+lbl)\n",
+		"\tcseg\nlbl:\n\tld hl, lbl*2\n",
+		"\tcseg\nlbl:\n\tld hl, high(lbl)\n",
+		"\tcseg\nlbl:\n\tdseg\n\tjr lbl\n",
+		"\textern foo\n\tpublic bar\nbar\tequ foo+1\n",
+		"\tpublic bar\n",
+	}
+	for _, prog := range testCases {
+		assembly, err := Assemble(prog)
+		if err == nil {
+			_, err = assembly.Object("test")
+		}
+		if err == nil {
+			t.Fatalf("No error assembling [%s]", prog)
+		}
+	}
+}
@@ -0,0 +1,195 @@
+package zog
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// SourceLine is a line of source as it was assembled
+type SourceLine struct {
+	File string
+	Line int
+	Text string
+	// From a macro or repeat
+	Expanded bool
+	// The instructions from this line are Linsts[First:First+Count]
+	First int
+	Count int
+}
+
+func (a *Assembly) listLine(l srcLine) int {
+	a.Lines = append(a.Lines, SourceLine{File: l.file, Line: l.line, Text: l.text, Expanded: l.expanded, First: len(a.Linsts)})
+	return len(a.Lines) - 1
+}
+
+func (a *Assembly) listLines(lines []srcLine) {
+	for _, l := range lines {
+		a.listLine(l)
+	}
+}
+
+// Bytes shown on each line of a listing, and how many lines to use for
+// one line of source before giving up
+const listingBytes = 4
+const listingRows = 4
+
+// WriteListing writes each line of source with its address and bytes
+func (a *Assembly) WriteListing(w io.Writer) error {
+	err := a.ResolveAddresses()
+	if err != nil {
+		return err
+	}
+
+	file := ""
+	for _, sl := range a.Lines {
+		if sl.File != file {
+			file = sl.File
+			fmt.Fprintf(w, "\n; %s\n\n", file)
+		}
+		mark := ' '
+		if sl.Expanded {
+			mark = '+'
+		}
+
+		linsts := a.Linsts[sl.First : sl.First+sl.Count]
+		var buf []byte
+		for _, linst := range linsts {
+			buf = append(buf, linst.Inst.Encode()...)
+		}
+
+		// EQU lines show the value rather than the address
+		addr, equals := "    ", ' '
+		if len(linsts) > 0 {
+			addr = fmt.Sprintf("%04X", linsts[0].Addr)
+			if _, ok := linsts[0].Inst.(*Equ); ok {
+				addr = fmt.Sprintf("%04X", uint16(a.Symbols[linsts[0].Label].Value))
+				equals = '='
+			}
+		}
+
+		fmt.Fprintf(w, "%5d%c %s%c %-*s %s\n", sl.Line, mark, addr, equals, 3*listingBytes-1, listingHex(buf, 0), sl.Text)
+		for row := 1; row*listingBytes < len(buf); row++ {
+			if row == listingRows {
+				fmt.Fprintf(w, "%7s...\n", "")
+				break
+			}
+			fmt.Fprintf(w, "%7s%04X  %s\n", "", linsts[0].Addr+uint16(row*listingBytes), listingHex(buf, row))
+		}
+	}
+	_, err = fmt.Fprintf(w, "\n")
+	return err
+}
+
+func listingHex(buf []byte, row int) string {
+	start := row * listingBytes
+	if start >= len(buf) {
+		return ""
+	}
+	end := start + listingBytes
+	if end > len(buf) {
+		end = len(buf)
+	}
+	var hex []string
+	for _, b := range buf[start:end] {
+		hex = append(hex, fmt.Sprintf("%02X", b))
+	}
+	return strings.Join(hex, " ")
+}
+
+// WriteSymbols writes the symbol table in the form sjasmplus and zmac use,
+// one "name: EQU 0x0000ABCD" per line
+func (a *Assembly) WriteSymbols(w io.Writer) error {
+	values := make(map[string]int)
+	for name, sym := range a.Symbols {
+		// Imported symbols have no value until linked
+		if sym.Extern != name {
+			values[name] = sym.Value
+		}
+	}
+	return WriteSymbolValues(w, values)
+}
+
+// WriteSymbolValues writes symbols in the same form as WriteSymbols, in
+// name order
+func WriteSymbolValues(w io.Writer, values map[string]int) error {
+	var names []string
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		_, err := fmt.Fprintf(w, "%s: EQU 0x%08X\n", name, uint32(values[name]))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SourceMapEntry ties a range of assembled bytes to the line which
+// produced them
+type SourceMapEntry struct {
+	Addr uint16 `json:"addr"`
+	Len  int    `json:"len"`
+	File string `json:"file"`
+	Line int    `json:"line"`
+}
+
+type SourceMap struct {
+	Entries []SourceMapEntry `json:"entries"`
+	// The value of each symbol, so that the map can name addresses
+	Symbols map[string]int `json:"symbols,omitempty"`
+}
+
+// SourceMap gives the source line of each range of bytes
+func (a *Assembly) SourceMap() *SourceMap {
+	sm := &SourceMap{Symbols: make(map[string]int)}
+	for name, sym := range a.Symbols {
+		if sym.Extern != name {
+			sm.Symbols[name] = sym.Value
+		}
+	}
+	for _, linst := range a.Linsts {
+		n := len(linst.Inst.Encode())
+		if n == 0 {
+			continue
+		}
+		if len(sm.Entries) > 0 {
+			last := &sm.Entries[len(sm.Entries)-1]
+			if last.File == linst.File && last.Line == linst.Line && int(last.Addr)+last.Len == int(linst.Addr) {
+				last.Len += n
+				continue
+			}
+		}
+		sm.Entries = append(sm.Entries, SourceMapEntry{Addr: linst.Addr, Len: n, File: linst.File, Line: linst.Line})
+	}
+	return sm
+}
+
+// Lookup finds the entry covering an address
+func (sm *SourceMap) Lookup(addr uint16) (SourceMapEntry, bool) {
+	for _, e := range sm.Entries {
+		if addr >= e.Addr && int(addr) < int(e.Addr)+e.Len {
+			return e, true
+		}
+	}
+	return SourceMapEntry{}, false
+}
+
+func (sm *SourceMap) Write(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(sm)
+}
+
+func ReadSourceMap(r io.Reader) (*SourceMap, error) {
+	sm := &SourceMap{}
+	err := json.NewDecoder(r).Decode(sm)
+	if err != nil {
+		return nil, fmt.Errorf("Can't read source map: %s", err)
+	}
+	return sm, nil
+}
@@ -0,0 +1,103 @@
+package zog
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+const listingProg = `	org 100h
+start:	ld a, 1
+count	equ 3
+twice	macro
+	nop
+	nop
+	endm
+	twice
+	db "Hello", 0
+	jp start
+`
+
+func TestListing(t *testing.T) {
+	assembly, err := Assemble(listingProg)
+	if err != nil {
+		t.Fatalf("Failed to assemble: %s", err)
+	}
+	buf := &bytes.Buffer{}
+	err = assembly.WriteListing(buf)
+	if err != nil {
+		t.Fatalf("Failed to write listing: %s", err)
+	}
+	expected := []string{
+		"    1  0100              	org 100h",
+		"    2  0100  3E 01       start:	ld a, 1",
+		"    3  0003=             count	equ 3",
+		"    4                    twice	macro",
+		"    8                    	twice",
+		"    8+ 0102  00          	nop",
+		"    8+ 0103  00          	nop",
+		"    9  0104  48 65 6C 6C 	db \"Hello\", 0",
+		"       0108  6F 00",
+		"   10  010A  C3 00 01    	jp start",
+	}
+	listing := buf.String()
+	for _, line := range expected {
+		if !strings.Contains(listing, line+"\n") {
+			t.Fatalf("Listing doesn't contain [%s]:\n%s", line, listing)
+		}
+	}
+}
+
+func TestSymbols(t *testing.T) {
+	assembly, err := Assemble(listingProg)
+	if err != nil {
+		t.Fatalf("Failed to assemble: %s", err)
+	}
+	buf := &bytes.Buffer{}
+	err = assembly.WriteSymbols(buf)
+	if err != nil {
+		t.Fatalf("Failed to write symbols: %s", err)
+	}
+	expected := "count: EQU 0x00000003\nstart: EQU 0x00000100\n"
+	if buf.String() != expected {
+		t.Fatalf("Symbols [%s] expected [%s]", buf.String(), expected)
+	}
+}
+
+func TestSourceMap(t *testing.T) {
+	assembly, err := Assemble(listingProg)
+	if err != nil {
+		t.Fatalf("Failed to assemble: %s", err)
+	}
+	buf := &bytes.Buffer{}
+	err = assembly.SourceMap().Write(buf)
+	if err != nil {
+		t.Fatalf("Failed to write source map: %s", err)
+	}
+	sm, err := ReadSourceMap(buf)
+	if err != nil {
+		t.Fatalf("Failed to read source map: %s", err)
+	}
+
+	testCases := []struct {
+		addr uint16
+		line int
+	}{
+		{0x100, 2},
+		{0x101, 2},
+		{0x102, 8},
+		{0x103, 8},
+		{0x109, 9},
+		{0x10c, 10},
+	}
+	for _, tc := range testCases {
+		e, ok := sm.Lookup(tc.addr)
+		if !ok || e.Line != tc.line {
+			t.Fatalf("Address %04X maps to %v, expected line %d", tc.addr, e, tc.line)
+		}
+	}
+	_, ok := sm.Lookup(0x10d)
+	if ok {
+		t.Fatalf("Found entry past the end of the code")
+	}
+}
@@ -428,17 +428,32 @@ func (c Contents) Write16(z *Zog, nn uint16) error {
 type IndexedContents struct {
 	addr Loc16
 	d    Disp
+	// Set if the assembler has still to resolve the displacement
+	expr *DispExpr
+}
+
+// DispExpr is an index displacement given by an expression
+type DispExpr struct {
+	Disp
+	expr Expr
+}
+
+func (ic IndexedContents) disp() Disp {
+	if ic.expr != nil {
+		return ic.expr.Disp
+	}
+	return ic.d
 }
 
 func (ic IndexedContents) String() string {
-	return fmt.Sprintf("(%s%+d)", ic.addr, int8(ic.d))
+	return fmt.Sprintf("(%s%+d)", ic.addr, int8(ic.disp()))
 }
 func (ic IndexedContents) Read8(z *Zog) (byte, error) {
 	addr, err := ic.addr.Read16(z)
 	if err != nil {
 		return 0, fmt.Errorf("Can't get contents of [%s]: %s", ic.addr, err)
 	}
-	addr += uint16(ic.d)
+	addr += uint16(ic.disp())
 	n, err := z.Mem.Peek(addr)
 	if err != nil {
 		return 0, fmt.Errorf("Can't read contents of [%s]: %s", ic, err)
@@ -451,7 +466,7 @@ func (ic IndexedContents) Write8(z *Zog, n byte) error {
 	if err != nil {
 		return fmt.Errorf("Can't get contents of [%s]: %s", ic.addr, err)
 	}
-	addr += uint16(ic.d)
+	addr += uint16(ic.disp())
 	err = z.Mem.Poke(addr, n)
 	if err != nil {
 		return fmt.Errorf("Can't write contents of [%s]: %s", ic, err)
@@ -479,6 +494,8 @@ type Label struct {
 	// See assemble/ResolveAddr
 	Imm16
 	name string
+	// An expression to evaluate, rather than a plain label
+	expr Expr
 }
 
 func (l Label) String() string {
@@ -486,6 +503,15 @@ func (l Label) String() string {
 	return fmt.Sprintf("%s (%s)", l.name, addrStr)
 }
 
+// RelTarget is the destination address of a relative jump
+type RelTarget struct {
+	expr Expr
+}
+
+func (t *RelTarget) String() string {
+	return t.expr.String()
+}
+
 type Imm16 uint16
 
 func (nn Imm16) String() string {
@@ -498,6 +524,17 @@ func (nn Imm16) Write16(z *Zog, n uint16) error {
 	return fmt.Errorf("Attempt to write [%02X] to immediate 16bit value [%04X]", n, nn)
 }
 
+// Expr8 is an 8 bit immediate given by an expression. Like Label, it
+// encodes as zero until resolved.
+type Expr8 struct {
+	Imm8
+	expr Expr
+}
+
+func (e *Expr8) String() string {
+	return fmt.Sprintf("%s (%s)", e.expr, e.Imm8)
+}
+
 type Imm8 byte
 
 func (n Imm8) String() string {
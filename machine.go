@@ -6,4 +6,9 @@ type Machine interface {
 	Name() string
 	Start() error
 	Stop()
+	// Save captures the whole machine, CPU, memory and devices, and
+	// Restore puts it back. Neither may be called while Run is executing,
+	// except from a func passed to Do.
+	Save() (*MachineState, error)
+	Restore(*MachineState) error
 }
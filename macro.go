@@ -0,0 +1,649 @@
+package zog
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// The macro layer works on whole source lines before they reach the
+// statement parser. It handles macro definition and expansion, REPT/IRP
+// loops, conditional assembly and INCLUDE/INCBIN. It runs afresh on each
+// pass, so conditions can depend on symbols defined later in the source.
+
+// Guard against runaway recursive macros and includes
+const maxExpansionDepth = 64
+
+type srcLine struct {
+	file string
+	line int
+	text string
+	// From a macro or repeat
+	expanded bool
+}
+
+func splitLines(fname string, s string) []srcLine {
+	var lines []srcLine
+	for i, text := range strings.Split(s, "\n") {
+		lines = append(lines, srcLine{file: fname, line: i + 1, text: strings.TrimRight(text, "\r")})
+	}
+	return lines
+}
+
+type macro struct {
+	name     string
+	params   []string
+	defaults []string
+	body     []srcLine
+}
+
+// One level of IF/ELSE/ENDIF
+type cond struct {
+	// The enclosing block is being assembled
+	outer bool
+	// The current branch is being assembled
+	active bool
+	// One of the branches has been chosen
+	taken bool
+	where srcLine
+}
+
+// statement is a source line as far as the macro layer is concerned
+type statement struct {
+	label string
+	// Lower case
+	op   string
+	args string
+}
+
+var macroKeywords = map[string]bool{
+	"if": true, "ifdef": true, "ifndef": true, "ifidn": true, "ifdif": true,
+	"elseif": true, "else": true, "endif": true,
+	"macro": true, "endm": true, "local": true,
+	"rept": true, "repeat": true, "irp": true, "endr": true, "endrepeat": true,
+	"include": true, "incbin": true, "error": true, "end": true,
+}
+
+func isBlockStart(op string) bool {
+	return op == "macro" || op == "rept" || op == "repeat" || op == "irp"
+}
+
+func isBlockEnd(op string) bool {
+	return op == "endm" || op == "endr" || op == "endrepeat"
+}
+
+func (a *Assembly) isMacro(word string) bool {
+	_, ok := a.macros[strings.ToLower(word)]
+	return ok
+}
+
+func (a *Assembly) parseStatement(text string) statement {
+	code := strings.TrimSpace(stripComment(text))
+	w1, rest := nextWord(code)
+	w2, rest2 := nextWord(rest)
+	lw1 := strings.ToLower(w1)
+	lw2 := strings.ToLower(w2)
+
+	switch {
+	case strings.HasSuffix(w1, ":"):
+		return statement{label: strings.TrimSuffix(w1, ":"), op: lw2, args: rest2}
+	case macroKeywords[lw1] || a.isMacro(w1):
+		return statement{op: lw1, args: rest}
+	case lw2 == "macro" || a.isMacro(w2):
+		// zmac style definition, or a macro call with a label
+		return statement{label: w1, op: lw2, args: rest2}
+	default:
+		return statement{op: lw1, args: rest}
+	}
+}
+
+func nextWord(s string) (string, string) {
+	s = strings.TrimSpace(s)
+	i := strings.IndexAny(s, " \t")
+	if i < 0 {
+		return s, ""
+	}
+	return s[:i], strings.TrimSpace(s[i:])
+}
+
+// stripComment removes a trailing ; comment, taking care over quotes
+func stripComment(s string) string {
+	var quote byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' && isAfPrime(s[:i]):
+		case c == '\'' || c == '"':
+			quote = c
+		case c == ';':
+			return s[:i]
+		}
+	}
+	return s
+}
+
+// isAfPrime is true if a quote after s is the one in AF'
+func isAfPrime(s string) bool {
+	return len(s) >= 2 && strings.ToLower(s[len(s)-2:]) == "af" &&
+		(len(s) == 2 || !isIdentChar(s[len(s)-3]))
+}
+
+func isIdentStart(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == '_' || c == '.' || c == '?' || c == '@'
+}
+
+func isIdentChar(c byte) bool {
+	return isIdentStart(c) || c >= '0' && c <= '9'
+}
+
+// splitArgs splits a comma separated argument list, leaving quoted strings,
+// brackets and zmac style <...> groups intact
+func splitArgs(s string) []string {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil
+	}
+	var args []string
+	var quote byte
+	depth := 0
+	angle := false
+	start := 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case angle:
+			if c == '>' {
+				angle = false
+			}
+		case c == '\'' && isAfPrime(s[:i]):
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '<' && strings.TrimSpace(s[start:i]) == "":
+			angle = true
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+		case c == ',' && depth == 0:
+			args = append(args, cleanArg(s[start:i]))
+			start = i + 1
+		}
+	}
+	return append(args, cleanArg(s[start:]))
+}
+
+func cleanArg(s string) string {
+	s = strings.TrimSpace(s)
+	if len(s) >= 2 && s[0] == '<' && s[len(s)-1] == '>' {
+		s = s[1 : len(s)-1]
+	}
+	return s
+}
+
+func unquote(s string) string {
+	if len(s) >= 2 && (s[0] == '\'' || s[0] == '"') && s[len(s)-1] == s[0] {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
+
+// substitute replaces whole identifiers in a line of macro body. A quoted
+// string which is just a parameter name is also replaced, without the
+// argument's own quotes. As in M80, &param joins a parameter to the text
+// before it.
+func substitute(text string, values map[string]string) string {
+	var b strings.Builder
+	for i := 0; i < len(text); {
+		c := text[i]
+		switch {
+		case c == ';':
+			b.WriteString(text[i:])
+			return b.String()
+		case (c == '\'' || c == '"') && !(c == '\'' && isAfPrime(text[:i])):
+			j := strings.IndexByte(text[i+1:], c)
+			if j < 0 {
+				b.WriteString(text[i:])
+				return b.String()
+			}
+			inner := text[i+1 : i+1+j]
+			if v, ok := values[inner]; ok {
+				inner = unquote(v)
+			}
+			b.WriteByte(c)
+			b.WriteString(inner)
+			b.WriteByte(c)
+			i += j + 2
+		case c == '&' && isParam(text[i+1:], values):
+			i++
+		case isIdentStart(c):
+			j := i + 1
+			for j < len(text) && isIdentChar(text[j]) {
+				j++
+			}
+			word := text[i:j]
+			if v, ok := values[word]; ok {
+				word = v
+			}
+			b.WriteString(word)
+			i = j
+		case c >= '0' && c <= '9':
+			// Don't split numbers like 0ffh
+			j := i + 1
+			for j < len(text) && isIdentChar(text[j]) {
+				j++
+			}
+			b.WriteString(text[i:j])
+			i = j
+		default:
+			b.WriteByte(c)
+			i++
+		}
+	}
+	return b.String()
+}
+
+// isParam is true if s starts with a parameter name
+func isParam(s string, values map[string]string) bool {
+	j := 0
+	for j < len(s) && isIdentChar(s[j]) {
+		j++
+	}
+	_, ok := values[s[:j]]
+	return j > 0 && isIdentStart(s[0]) && ok
+}
+
+func (a *Assembly) active() bool {
+	if len(a.conds) == 0 {
+		return true
+	}
+	return a.conds[len(a.conds)-1].active
+}
+
+// assembleLines notes any errors and carries on, so that as many as
+// possible are reported
+func (a *Assembly) assembleLines(lines []srcLine) {
+	for i := 0; i < len(lines) && !a.ended; i++ {
+		l := lines[i]
+		st := a.parseStatement(l.text)
+		listed := a.listLine(l)
+
+		handled, err := a.conditional(l, st)
+		if err != nil {
+			a.addError(err)
+			continue
+		}
+		if handled || !a.active() {
+			continue
+		}
+
+		switch {
+		case st.op == "macro" || st.op == "rept" || st.op == "repeat" || st.op == "irp":
+			body, end, err := collectBlock(a, lines, i)
+			if err != nil {
+				// The rest of the lines are the body, so give up on them
+				a.addError(err)
+				return
+			}
+			a.listLines(lines[i+1 : end+1])
+			i = end
+			if st.op == "macro" {
+				err = a.defineMacro(l, st, body)
+			} else {
+				err = a.repeat(l, st, body)
+			}
+		case isBlockEnd(st.op):
+			err = l.errorf("%s without MACRO or REPT", strings.ToUpper(st.op))
+		case st.op == "local":
+			err = l.errorf("LOCAL outside of a macro")
+		case st.op == "include":
+			a.defineLabel(l, st)
+			err = a.include(l, st)
+		case st.op == "incbin":
+			err = a.incbin(l, st)
+			a.Lines[listed].Count = len(a.Linsts) - a.Lines[listed].First
+		case st.op == "end":
+			// Ignore the rest of the source, noting the entry point if given
+			a.ended = true
+			if st.args != "" {
+				err = a.entryPoint(l, st.args)
+			}
+		case st.op == "error":
+			a.errs = append(a.errs, l.errorf("%s", unquote(st.args)))
+		case a.isMacro(st.op):
+			err = a.expandMacro(l, st)
+		default:
+			a.assembleLine(l)
+			a.Lines[listed].Count = len(a.Linsts) - a.Lines[listed].First
+		}
+		if err != nil {
+			a.addError(err)
+		}
+	}
+}
+
+// conditional handles IF and friends, returning true if the line was one.
+// A label on the line is defined if the code around the block is active.
+func (a *Assembly) conditional(l srcLine, st statement) (bool, error) {
+	switch st.op {
+	case "if", "ifdef", "ifndef", "ifidn", "ifdif":
+		c := cond{outer: a.active(), where: l}
+		if c.outer {
+			a.defineLabel(l, st)
+		}
+		if c.outer {
+			c.active = a.condition(l, st)
+			c.taken = c.active
+		}
+		a.conds = append(a.conds, c)
+	case "elseif", "else", "endif":
+		if len(a.conds) == 0 {
+			return false, l.errorf("%s without IF", strings.ToUpper(st.op))
+		}
+		c := &a.conds[len(a.conds)-1]
+		if c.outer {
+			a.defineLabel(l, st)
+		}
+		switch st.op {
+		case "elseif":
+			c.active = c.outer && !c.taken && a.condition(l, statement{op: "if", args: st.args})
+			c.taken = c.taken || c.active
+		case "else":
+			c.active = c.outer && !c.taken
+			c.taken = true
+		case "endif":
+			a.conds = a.conds[:len(a.conds)-1]
+		}
+	default:
+		return false, nil
+	}
+	return true, nil
+}
+
+func (a *Assembly) condition(l srcLine, st statement) bool {
+	switch st.op {
+	case "ifdef", "ifndef":
+		// Only symbols defined earlier this pass count, or include guards
+		// would flip each pass
+		sym, ok := a.Symbols[a.qualify(strings.TrimSpace(st.args))]
+		defined := ok && sym.pass == a.pass
+		return defined == (st.op == "ifdef")
+	case "ifidn", "ifdif":
+		args := splitArgs(st.args)
+		for len(args) < 2 {
+			args = append(args, "")
+		}
+		same := strings.EqualFold(unquote(args[0]), unquote(args[1]))
+		return same == (st.op == "ifidn")
+	default:
+		v, _ := a.evalText(l, st.args)
+		return v != 0
+	}
+}
+
+// evalText evaluates an expression for the macro layer. Values which
+// aren't known yet are zero, with the error kept in case they never are.
+func (a *Assembly) evalText(l srcLine, text string) (int, bool) {
+	e, ok := a.parseExpr(l, text)
+	if !ok {
+		return 0, false
+	}
+	a.dollar = a.addr
+	v, err := e.Eval(a)
+	if err != nil {
+		a.errs = append(a.errs, l.wrap(err))
+		return 0, false
+	}
+	return v, true
+}
+
+// entryPoint notes the address given to END, which may be relocatable
+func (a *Assembly) entryPoint(l srcLine, text string) error {
+	e, ok := a.parseExpr(l, text)
+	if !ok {
+		return nil
+	}
+	a.dollar = a.addr
+	v, err := relocEval(a, e)
+	if err != nil {
+		a.errs = append(a.errs, l.wrap(err))
+		return nil
+	}
+	if v.extern != "" || v.part != "" {
+		return l.errorf("entry point %s must be in this object", e)
+	}
+	if v.value < 0 || v.value > 0xffff {
+		a.warn(l.errorf("entry point %d truncated to %04Xh", v.value, uint16(v.value)))
+	}
+	a.Entry, a.EntrySection, a.HasEntry = uint16(v.value), v.section, true
+	return nil
+}
+
+func (a *Assembly) parseExpr(l srcLine, text string) (Expr, bool) {
+	text = strings.TrimSpace(text)
+	p := a.parser
+	p.Buffer = text
+	p.Reset()
+	err := p.Parse(int(ruleexpr))
+	// The expression must account for the whole of the text
+	if tokens := p.Tokens(); err == nil && int(tokens[len(tokens)-1].end) != len([]rune(text)) {
+		err = fmt.Errorf("trailing text")
+	}
+	if err != nil {
+		a.errs = append(a.errs, l.errorf("can't parse expression [%s]", text))
+		return nil, false
+	}
+	p.Execute()
+	return p.Current.popExpr(), true
+}
+
+// collectBlock finds the body of the MACRO or REPT starting at lines[start]
+func collectBlock(a *Assembly, lines []srcLine, start int) ([]srcLine, int, error) {
+	depth := 0
+	for i := start + 1; i < len(lines); i++ {
+		op := a.parseStatement(lines[i].text).op
+		switch {
+		case isBlockStart(op):
+			depth++
+		case isBlockEnd(op):
+			if depth == 0 {
+				return lines[start+1 : i], i, nil
+			}
+			depth--
+		}
+	}
+	return nil, 0, lines[start].errorf("%s without ENDM", strings.ToUpper(a.parseStatement(lines[start].text).op))
+}
+
+func (a *Assembly) defineMacro(l srcLine, st statement, body []srcLine) error {
+	name := st.label
+	params := st.args
+	if name == "" {
+		// sjasm style: MACRO name params
+		name, params = nextWord(st.args)
+	}
+	if name == "" {
+		return l.errorf("MACRO without a name")
+	}
+	m := &macro{name: name, body: body}
+	for _, p := range splitArgs(params) {
+		def := ""
+		if i := strings.IndexByte(p, ':'); i >= 0 {
+			p, def = strings.TrimSpace(p[:i]), strings.TrimSpace(p[i+1:])
+		}
+		m.params = append(m.params, p)
+		m.defaults = append(m.defaults, def)
+	}
+	a.macros[strings.ToLower(name)] = m
+	return nil
+}
+
+// uniqueLabel makes a label for a macro local. Numbering restarts each
+// pass, so the same expansion gets the same name every time.
+func (a *Assembly) uniqueLabel(name string) string {
+	a.uniq++
+	return fmt.Sprintf("_%s_%d", strings.TrimLeft(name, "?"), a.uniq)
+}
+
+func (a *Assembly) expandMacro(l srcLine, st statement) error {
+	m := a.macros[st.op]
+	args := splitArgs(st.args)
+	if len(args) > len(m.params) {
+		return l.errorf("too many arguments for macro %s", m.name)
+	}
+	values := make(map[string]string)
+	for i, p := range m.params {
+		v := m.defaults[i]
+		if i < len(args) && args[i] != "" {
+			v = args[i]
+		}
+		if v == "" && strings.HasPrefix(p, "?") {
+			// zmac style local label
+			v = a.uniqueLabel(p)
+		}
+		values[p] = v
+	}
+
+	var body []srcLine
+	for _, bl := range m.body {
+		bst := a.parseStatement(bl.text)
+		if bst.op == "local" {
+			for _, name := range splitArgs(bst.args) {
+				values[name] = a.uniqueLabel(name)
+			}
+			continue
+		}
+		body = append(body, bl)
+	}
+
+	a.defineLabel(l, st)
+	var expanded []srcLine
+	for _, bl := range body {
+		expanded = append(expanded, srcLine{file: l.file, line: l.line, text: substitute(bl.text, values), expanded: true})
+	}
+	return a.nested(l, expanded)
+}
+
+// defineLabel handles a label on a line which the statement parser
+// doesn't see
+func (a *Assembly) defineLabel(l srcLine, st statement) {
+	if st.label != "" {
+		a.assembleLine(srcLine{file: l.file, line: l.line, text: st.label + ":"})
+	}
+}
+
+func (a *Assembly) nested(l srcLine, lines []srcLine) error {
+	if a.depth >= maxExpansionDepth {
+		return l.errorf("macros or includes nested too deeply")
+	}
+	a.depth++
+	a.assembleLines(lines)
+	a.depth--
+	return nil
+}
+
+// repeat expands REPT (or sjasm REPEAT) and IRP blocks
+func (a *Assembly) repeat(l srcLine, st statement, body []srcLine) error {
+	var param string
+	var items []string
+	if st.op == "irp" {
+		args := splitArgs(st.args)
+		if len(args) < 1 {
+			return l.errorf("IRP needs a parameter name")
+		}
+		param = args[0]
+		items = args[1:]
+		if len(items) == 1 {
+			items = splitArgs(items[0])
+		}
+	} else {
+		n, _ := a.evalText(l, st.args)
+		if n < 0 || n > 0xffff {
+			return l.errorf("invalid repeat count %d", n)
+		}
+		items = make([]string, n)
+	}
+
+	a.defineLabel(l, st)
+	var expanded []srcLine
+	for i, item := range items {
+		values := map[string]string{param: item}
+		for _, bl := range body {
+			// sjasm's iteration counter
+			text := strings.Replace(bl.text, "@#", strconv.Itoa(i), -1)
+			if param != "" {
+				text = substitute(text, values)
+			}
+			expanded = append(expanded, srcLine{file: bl.file, line: bl.line, text: text, expanded: true})
+		}
+	}
+	return a.nested(l, expanded)
+}
+
+// includePath finds a file named relative to the including source
+func includePath(l srcLine, args string) string {
+	fname := unquote(strings.TrimSpace(args))
+	if filepath.IsAbs(fname) || l.file == "" {
+		return fname
+	}
+	return filepath.Join(filepath.Dir(l.file), fname)
+}
+
+func (a *Assembly) include(l srcLine, st statement) error {
+	fname := includePath(l, st.args)
+	lines, ok := a.sources[fname]
+	if !ok {
+		buf, err := ioutil.ReadFile(fname)
+		if err != nil {
+			return l.errorf("can't include: %s", err)
+		}
+		lines = splitLines(fname, string(buf))
+		a.sources[fname] = lines
+	}
+	return a.nested(l, lines)
+}
+
+func (a *Assembly) incbin(l srcLine, st statement) error {
+	args := splitArgs(st.args)
+	if len(args) == 0 {
+		return l.errorf("INCBIN needs a file name")
+	}
+	fname := includePath(l, args[0])
+	buf, ok := a.binaries[fname]
+	if !ok {
+		var err error
+		buf, err = ioutil.ReadFile(fname)
+		if err != nil {
+			return l.errorf("can't incbin: %s", err)
+		}
+		a.binaries[fname] = buf
+	}
+
+	// Optional offset and length
+	skip, length := 0, len(buf)
+	if len(args) > 1 {
+		skip, _ = a.evalText(l, args[1])
+		length = len(buf) - skip
+	}
+	if len(args) > 2 {
+		length, _ = a.evalText(l, args[2])
+	}
+	if skip < 0 || length < 0 || skip+length > len(buf) {
+		return l.errorf("INCBIN range outside of %s", fname)
+	}
+
+	data := make([]byte, length)
+	copy(data, buf[skip:skip+length])
+	linst := LabelledInstruction{Label: st.label, Inst: NewData(data), File: l.file, Line: l.line}
+	a.Linsts = append(a.Linsts, linst)
+	return a.place(&a.Linsts[len(a.Linsts)-1])
+}
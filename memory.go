@@ -11,6 +11,7 @@ type Memory struct {
 	debug     bool
 	watches   Regions
 	watchFunc func(addr uint16, old byte, new byte)
+	readFunc  func(addr uint16, n byte)
 	readonly  Regions
 }
 
@@ -26,6 +27,8 @@ func NewMemory(size uint16) *Memory {
 	return m
 }
 
+// SetDebug passes every read and write to the watch funcs, not just those
+// to watched addresses
 func (m *Memory) SetDebug(debug bool) {
 	m.debug = debug
 }
@@ -34,20 +37,54 @@ func (m *Memory) SetWatchFunc(wf func(uint16, byte, byte)) {
 	m.watchFunc = wf
 }
 
+// SetReadWatchFunc is told about reads from watched addresses, with the
+// byte read. Instruction fetches aren't reads.
+func (m *Memory) SetReadWatchFunc(rf func(uint16, byte)) {
+	m.readFunc = rf
+}
+
+func (m *Memory) AddReadOnly(r Region) {
+	m.Lock()
+	defer m.Unlock()
+	m.readonly.add(Regions{r})
+}
+
+func (m *Memory) RemoveReadOnly(r Region) {
+	m.Lock()
+	defer m.Unlock()
+	var kept Regions
+	for _, ro := range m.readonly {
+		if ro != r {
+			kept = append(kept, ro)
+		}
+	}
+	m.readonly = kept
+}
+
 func (m *Memory) Len() int {
 	return len(m.buf)
 }
 
 func (m *Memory) Peek(addr uint16) (byte, error) {
+	n, err := m.fetch(addr)
+	if err != nil {
+		return 0, err
+	}
+	if m.readFunc != nil && (m.debug || m.watches.contains(addr)) {
+		m.readFunc(addr, n)
+	}
+	return n, nil
+}
+
+// fetch reads a byte without telling the read watch func
+func (m *Memory) fetch(addr uint16) (byte, error) {
 	m.Lock()
-	defer m.Unlock()
-	if int(addr) >= m.Len() {
+	if int(addr) >= len(m.buf) {
+		m.Unlock()
 		return 0, fmt.Errorf("Out of bounds memory read: 0x%04X > 0x%04X", addr, m.Len())
 	}
 	n := m.buf[addr]
-	//	if m.debug || m.watches.contains(addr) {
-	//		fmt.Printf("MEM: %04X -> %02X\n", addr, n)
-	//	}
+	m.Unlock()
 	return n, nil
 }
 
@@ -134,3 +171,22 @@ func (m *Memory) PeekBuf(addr uint16, size int) ([]byte, error) {
 	copy(buf, m.buf[addr:int(addr)+size])
 	return buf, nil
 }
+
+// save copies the whole of memory into buf, reusing it if it's big enough
+func (m *Memory) save(buf []byte) []byte {
+	m.Lock()
+	defer m.Unlock()
+	if cap(buf) < len(m.buf) {
+		buf = make([]byte, len(m.buf))
+	}
+	buf = buf[:len(m.buf)]
+	copy(buf, m.buf)
+	return buf
+}
+
+// restore puts back memory from save, ignoring readonly regions
+func (m *Memory) restore(buf []byte) {
+	m.Lock()
+	defer m.Unlock()
+	copy(m.buf, buf)
+}
@@ -0,0 +1,145 @@
+package zog
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// Objects are written as JSON, marked with this format name and version
+const objectFormat = "zog-object"
+const objectVersion = 1
+
+// Object is an assembly in a form which can be linked with others. The
+// bytes of each section are stored as if the section started at 0, with
+// relocations to patch once it is placed.
+type Object struct {
+	Format   string       `json:"format"`
+	Version  int          `json:"version"`
+	Name     string       `json:"name,omitempty"`
+	Sections []ObjSection `json:"sections"`
+	Exports  []ObjSymbol  `json:"exports,omitempty"`
+	Imports  []string     `json:"imports,omitempty"`
+	Entry    *ObjSymbol   `json:"entry,omitempty"`
+}
+
+// ObjSection is the contents of a relocatable section, or a block of
+// absolute code at the address given by ORG. Absolute blocks have no
+// name.
+type ObjSection struct {
+	Name   string  `json:"name"`
+	Addr   uint16  `json:"addr,omitempty"`
+	Data   []byte  `json:"data"`
+	Relocs []Reloc `json:"relocs,omitempty"`
+}
+
+// ObjSymbol is a value relative to the start of a section, or absolute if
+// there is no section
+type ObjSymbol struct {
+	Name    string `json:"name,omitempty"`
+	Section string `json:"section,omitempty"`
+	Value   int    `json:"value"`
+}
+
+// Object gives the relocatable form of the assembly
+func (a *Assembly) Object(name string) (*Object, error) {
+	err := a.ResolveAddresses()
+	if err != nil {
+		return nil, err
+	}
+
+	obj := &Object{Format: objectFormat, Version: objectVersion, Name: name}
+	sections := make(map[string]int)
+	for _, linst := range a.Linsts {
+		// Keep empty sections, as labels in them may still be used
+		i, ok := sections[linst.Section]
+		if !ok && linst.Section != "" {
+			i = len(obj.Sections)
+			sections[linst.Section] = i
+			obj.Sections = append(obj.Sections, ObjSection{Name: linst.Section})
+		}
+
+		buf := linst.Inst.Encode()
+		if len(buf) == 0 {
+			continue
+		}
+		if int(linst.Addr)+len(buf) > 0x10000 {
+			return nil, a.errorAt(linst.File, linst.Line, "code runs past the end of memory")
+		}
+
+		var sec *ObjSection
+		if linst.Section == "" {
+			// A new absolute block unless this carries on from the last
+			n := len(obj.Sections)
+			if n > 0 && obj.Sections[n-1].Name == "" && int(obj.Sections[n-1].Addr)+len(obj.Sections[n-1].Data) == int(linst.Addr) {
+				sec = &obj.Sections[n-1]
+			} else {
+				obj.Sections = append(obj.Sections, ObjSection{Addr: linst.Addr})
+				sec = &obj.Sections[n]
+			}
+		} else {
+			sec = &obj.Sections[i]
+		}
+
+		offset := int(linst.Addr - sec.Addr)
+		for len(sec.Data) < offset+len(buf) {
+			sec.Data = append(sec.Data, 0)
+		}
+		copy(sec.Data[offset:], buf)
+		for _, r := range linst.Relocs {
+			r.Offset += offset
+			sec.Relocs = append(sec.Relocs, r)
+		}
+	}
+
+	exported := make(map[string]bool)
+	for _, name := range a.exports {
+		if exported[name] {
+			continue
+		}
+		exported[name] = true
+		sym, ok := a.Symbols[name]
+		if !ok {
+			return nil, fmt.Errorf("Exported symbol %s is not defined", name)
+		}
+		if sym.Extern != "" {
+			return nil, a.errorAt(sym.File, sym.Line, "can't export %s, which depends on imported symbol %s", name, sym.Extern)
+		}
+		obj.Exports = append(obj.Exports, ObjSymbol{Name: name, Section: sym.Section, Value: sym.Value})
+	}
+
+	for name, sym := range a.Symbols {
+		if sym.Extern == name {
+			obj.Imports = append(obj.Imports, name)
+		}
+	}
+	sort.Strings(obj.Imports)
+
+	if a.HasEntry {
+		obj.Entry = &ObjSymbol{Section: a.EntrySection, Value: int(a.Entry)}
+	}
+	return obj, nil
+}
+
+func (obj *Object) Write(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(obj)
+}
+
+func ReadObject(r io.Reader) (*Object, error) {
+	obj := &Object{}
+	err := json.NewDecoder(r).Decode(obj)
+	if err != nil {
+		return nil, fmt.Errorf("Can't read object: %s", err)
+	}
+	if obj.Format != objectFormat {
+		return nil, errors.New("Not a zog object file")
+	}
+	if obj.Version != objectVersion {
+		return nil, fmt.Errorf("Unsupported object version %d", obj.Version)
+	}
+	return obj, nil
+}
@@ -0,0 +1,282 @@
+package zog
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Passes are repeated until no symbol changes value. Code which keeps
+// moving after this many passes has a phase error.
+const maxPasses = 10
+
+func (a *Assembly) assemble(fname string, s string) error {
+	lines := splitLines(fname, s)
+
+	a.parser = &PegAssembler{}
+	a.parser.Init()
+	a.parser.Current.assembly = a
+
+	for a.pass = 1; ; a.pass++ {
+		a.startPass()
+		a.assembleLines(lines)
+		if len(a.conds) > 0 {
+			a.addError(a.conds[len(a.conds)-1].where.errorf("IF without ENDIF"))
+		}
+		a.endPass()
+
+		if a.changed == nil {
+			break
+		}
+		if a.pass == maxPasses {
+			sym := a.changed
+			a.addError(a.errorAt(sym.File, sym.Line, "phase error, value of %s still changing after %d passes", sym.Name, a.pass))
+			break
+		}
+	}
+
+	// Anything we couldn't evaluate by now is never going to be known
+	a.Warnings = a.warnings
+	if len(a.errs) > 0 {
+		el := ErrorList(append(a.errs, a.warnings...))
+		el.sort()
+		return el
+	}
+	// Operands were filled in as the last pass went along
+	a.resolved = true
+	return nil
+}
+
+func (a *Assembly) startPass() {
+	a.Linsts = nil
+	a.Lines = nil
+	a.Labels = make(map[string]int)
+	a.addr = 0
+	a.BaseAddr = 0
+	a.emitted = false
+	a.changed = nil
+	a.errs = nil
+	a.warnings = nil
+	a.macros = make(map[string]*macro)
+	a.conds = nil
+	a.uniq = 0
+	a.ended = false
+	a.HasEntry = false
+	a.scope = ""
+	a.section = ""
+	a.counters = make(map[string]uint16)
+	a.exports = nil
+}
+
+func (a *Assembly) endPass() {
+	// Anything not defined this time round has gone away
+	for name, sym := range a.Symbols {
+		if sym.pass != a.pass {
+			delete(a.Symbols, name)
+			a.changed = sym
+		}
+	}
+}
+
+// assembleLine parses a line and places the instructions on it, noting
+// any errors
+func (a *Assembly) assembleLine(l srcLine) {
+	p := a.parser
+	p.Buffer = l.text + "\n"
+	p.Reset()
+	err := p.Parse()
+	if err != nil {
+		e := l.errorf("can't parse [%s]", strings.TrimSpace(l.text))
+		if pe, ok := err.(*parseError); ok {
+			e.Col = parseColumn(l.text, int(pe.max.end))
+		}
+		a.addError(e)
+		return
+	}
+	p.Current.file = l.file
+	p.Current.line = l.line
+
+	start := len(a.Linsts)
+	defer func() {
+		// Operand combinations the statement parser lets through turn up
+		// when encoding
+		r := recover()
+		if r == nil {
+			return
+		}
+		if _, ok := r.(operandError); !ok {
+			panic(r)
+		}
+		a.Linsts = a.Linsts[:start]
+		p.Current.clean()
+		p.Current.errs = nil
+		a.addError(l.errorf("invalid instruction [%s]: %s", strings.TrimSpace(l.text), r))
+	}()
+	p.Execute()
+	for _, err := range p.Current.errs {
+		a.addError(l.wrap(err))
+	}
+	p.Current.errs = nil
+	for i := start; i < len(a.Linsts); i++ {
+		err = a.place(&a.Linsts[i])
+		if err != nil {
+			a.addError(err)
+		}
+	}
+}
+
+// parseColumn turns the position the parser got to into a column
+func parseColumn(text string, pos int) int {
+	runes := []rune(text)
+	if pos > len(runes) {
+		pos = len(runes)
+	}
+	return len(string(runes[:pos])) + 1
+}
+
+// place gives an instruction its address, defines its label and fills in
+// its operands with the symbol values as they stand
+func (a *Assembly) place(linst *LabelledInstruction) error {
+	a.dollar = a.addr
+	switch inst := linst.Inst.(type) {
+	case *Org:
+		addr, ok := a.passEval(linst, inst.expr)
+		if ok {
+			if addr < 0 || addr > 0xffff {
+				a.warnAt(linst.File, linst.Line, "ORG %d truncated to %04Xh", addr, uint16(addr))
+			}
+			a.addr = uint16(addr)
+		}
+		if !a.emitted {
+			a.BaseAddr = a.addr
+		}
+	case *Space:
+		// Leave it empty if unknown for now, it may settle on a later pass
+		inst.n = 0
+		n, ok := a.passEval(linst, inst.count)
+		if ok && (n < 0 || n > 0xffff) {
+			a.errs = append(a.errs, a.errorAt(linst.File, linst.Line, "invalid space size %d", n))
+		} else if ok {
+			inst.n = n
+		}
+	case *Section:
+		a.setSection(inst.name)
+	case *Public:
+		a.exports = append(a.exports, inst.names...)
+	case *Extern:
+		for _, name := range inst.names {
+			err := a.importSymbol(linst, name)
+			if err != nil {
+				return err
+			}
+		}
+	case *Align:
+		inst.n = 0
+		b, ok := a.passEval(linst, inst.boundary)
+		if ok && (b < 1 || b > 0x10000) {
+			a.errs = append(a.errs, a.errorAt(linst.File, linst.Line, "invalid alignment %d", b))
+		} else if ok {
+			inst.n = (b - int(a.addr)%b) % b
+		}
+	}
+	linst.Addr = a.addr
+	linst.Section = a.section
+
+	if linst.Label != "" {
+		var err error
+		switch inst := linst.Inst.(type) {
+		case *Equ:
+			v, known := a.passEval(linst, inst.expr)
+			err = a.define(linst, a.relocBase(linst, v, known, inst.expr), known, false)
+		case *Defl:
+			v, known := a.passEval(linst, inst.expr)
+			err = a.define(linst, a.relocBase(linst, v, known, inst.expr), known, true)
+		default:
+			err = a.define(linst, relValue{value: int(a.addr), section: a.section}, true, false)
+			a.Labels[linst.Label] = len(a.Linsts) - 1
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	// Done now rather than at the end, so that DEFL symbols have the
+	// value they had at this point
+	a.operands = nil
+	err := linst.Inst.Resolve(a)
+	if err != nil {
+		e := a.instError(linst, err)
+		e.Msg = fmt.Sprintf("can't resolve [%s]: %s", linst.Inst, err)
+		a.errs = append(a.errs, e)
+	} else {
+		a.relocate(linst)
+	}
+
+	n := len(linst.Inst.Encode())
+	if int(a.addr)+n > 0x10000 {
+		a.warnAt(linst.File, linst.Line, "location counter wraps past FFFFh")
+	}
+	a.addr += uint16(n)
+	a.emitted = a.emitted || n > 0
+	return nil
+}
+
+// passEval evaluates an expression which is needed during a pass. If it
+// depends on something we don't know yet, note the error in case it is
+// still unknown when the passes finish.
+func (a *Assembly) passEval(linst *LabelledInstruction, e Expr) (int, bool) {
+	v, err := e.Eval(a)
+	if err != nil {
+		a.errs = append(a.errs, a.instError(linst, err))
+		return 0, false
+	}
+	return v, true
+}
+
+// relocBase finds what the value of an EQU or DEFL is relative to
+func (a *Assembly) relocBase(linst *LabelledInstruction, value int, known bool, e Expr) relValue {
+	if !known {
+		return relValue{value: value}
+	}
+	v, err := relocEval(a, e)
+	if err != nil {
+		a.errs = append(a.errs, a.instError(linst, err))
+		return relValue{value: value}
+	}
+	if v.part != "" {
+		// LOW() or HIGH() of something relocatable can't be relocated again
+		a.errs = append(a.errs, a.errorAt(linst.File, linst.Line, "can't relocate %s", e))
+	}
+	return relValue{value: value, section: v.section, extern: v.extern}
+}
+
+// define sets the value of a symbol. Redefinable (DEFL) symbols may be
+// set many times in a pass, and only their first value each pass is
+// checked for convergence.
+func (a *Assembly) define(linst *LabelledInstruction, v relValue, known bool, redefinable bool) error {
+	value := v.value
+	name := linst.Label
+	sym, ok := a.Symbols[name]
+	if ok && sym.pass == a.pass {
+		if !redefinable || !sym.redefinable {
+			return a.errorAt(linst.File, linst.Line, "duplicate symbol %s, previously defined at %s", name, sym.Location())
+		}
+	} else if !ok {
+		sym = &Symbol{Name: name}
+		a.Symbols[name] = sym
+		a.changed = sym
+	} else if sym.first != value || sym.known != known {
+		a.changed = sym
+	}
+	if !ok || sym.pass != a.pass {
+		sym.first = value
+	}
+	sym.redefinable = redefinable
+	sym.Value = value
+	sym.Section = v.section
+	sym.Extern = v.extern
+	sym.known = known
+	sym.File = linst.File
+	sym.Line = linst.Line
+	sym.pass = a.pass
+	return nil
+}
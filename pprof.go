@@ -0,0 +1,174 @@
+package zog
+
+import (
+	"compress/gzip"
+	"io"
+)
+
+// WritePprof writes the profile in the format go tool pprof reads, with
+// a sample for each chain of calls. Each routine is a function, and the
+// call sites within it are its locations.
+func (p *Profiler) WritePprof(w io.Writer) error {
+	pw := &pprofWriter{
+		p:         p,
+		strings:   map[string]uint64{"": 0},
+		strs:      []string{""},
+		functions: make(map[uint16]uint64),
+		locations: make(map[pprofLoc]uint64),
+	}
+	ops, tstates := pw.str("instructions"), pw.str("tstates")
+	count, cycles := pw.str("count"), pw.str("cycles")
+	pw.valueType(1, ops, count)
+	pw.valueType(1, tstates, cycles)
+	pw.sample(p.root)
+	for _, loc := range pw.locs {
+		pw.location(loc)
+	}
+	for _, routine := range pw.funcs {
+		pw.function(routine)
+	}
+	for _, s := range pw.strs {
+		pw.buf.bytes(6, []byte(s))
+	}
+	pw.valueType(11, tstates, cycles)
+	pw.buf.uint64(12, 1)
+	pw.buf.uint64(14, tstates)
+
+	gz := gzip.NewWriter(w)
+	_, err := gz.Write(pw.buf.data)
+	if err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+// pprofLoc is an address in a routine
+type pprofLoc struct {
+	addr    uint16
+	routine uint16
+}
+
+type pprofWriter struct {
+	p   *Profiler
+	buf protoBuf
+
+	strings map[string]uint64
+	strs    []string
+	// IDs start at 1, in the order first seen
+	functions map[uint16]uint64
+	funcs     []uint16
+	locations map[pprofLoc]uint64
+	locs      []pprofLoc
+}
+
+func (pw *pprofWriter) str(s string) uint64 {
+	id, ok := pw.strings[s]
+	if !ok {
+		id = uint64(len(pw.strs))
+		pw.strings[s] = id
+		pw.strs = append(pw.strs, s)
+	}
+	return id
+}
+
+func (pw *pprofWriter) location(loc pprofLoc) {
+	pw.buf.message(4, func(b *protoBuf) {
+		b.uint64(1, pw.locations[loc])
+		b.uint64(3, uint64(loc.addr))
+		b.message(4, func(b *protoBuf) {
+			b.uint64(1, pw.functions[loc.routine])
+		})
+	})
+}
+
+func (pw *pprofWriter) function(routine uint16) {
+	name := pw.str(pw.p.name(routine))
+	pw.buf.message(5, func(b *protoBuf) {
+		b.uint64(1, pw.functions[routine])
+		b.uint64(2, name)
+		b.uint64(3, name)
+	})
+}
+
+func (pw *pprofWriter) locationID(addr, routine uint16) uint64 {
+	if _, ok := pw.functions[routine]; !ok {
+		pw.functions[routine] = uint64(len(pw.funcs) + 1)
+		pw.funcs = append(pw.funcs, routine)
+	}
+	loc := pprofLoc{addr: addr, routine: routine}
+	id, ok := pw.locations[loc]
+	if !ok {
+		id = uint64(len(pw.locs) + 1)
+		pw.locations[loc] = id
+		pw.locs = append(pw.locs, loc)
+	}
+	return id
+}
+
+// sample writes a sample for the node and each node under it. The
+// innermost location is the routine's entry, and the others are the calls
+// which led there.
+func (pw *pprofWriter) sample(n *callNode) {
+	if n.ops > 0 {
+		ids := []uint64{pw.locationID(n.routine, n.routine)}
+		for c := n; c.parent != nil; c = c.parent {
+			ids = append(ids, pw.locationID(c.site, c.parent.routine))
+		}
+		pw.buf.message(2, func(b *protoBuf) {
+			b.packed(1, ids)
+			b.packed(2, []uint64{n.ops, n.tstates})
+		})
+	}
+	for _, child := range n.children {
+		pw.sample(child)
+	}
+}
+
+func (pw *pprofWriter) valueType(field int, typ, unit uint64) {
+	pw.buf.message(field, func(b *protoBuf) {
+		b.uint64(1, typ)
+		b.uint64(2, unit)
+	})
+}
+
+// protoBuf encodes the few protocol buffer types a profile needs
+type protoBuf struct {
+	data []byte
+}
+
+func (b *protoBuf) varint(n uint64) {
+	for n >= 0x80 {
+		b.data = append(b.data, byte(n)|0x80)
+		n >>= 7
+	}
+	b.data = append(b.data, byte(n))
+}
+
+func (b *protoBuf) key(field int, wireType int) {
+	b.varint(uint64(field)<<3 | uint64(wireType))
+}
+
+func (b *protoBuf) uint64(field int, n uint64) {
+	b.key(field, 0)
+	b.varint(n)
+}
+
+func (b *protoBuf) bytes(field int, buf []byte) {
+	b.key(field, 2)
+	b.varint(uint64(len(buf)))
+	b.data = append(b.data, buf...)
+}
+
+func (b *protoBuf) packed(field int, ns []uint64) {
+	var inner protoBuf
+	for _, n := range ns {
+		inner.varint(n)
+	}
+	b.bytes(field, inner.data)
+}
+
+func (b *protoBuf) message(field int, f func(b *protoBuf)) {
+	var inner protoBuf
+	f(&inner)
+	b.bytes(field, inner.data)
+}
@@ -0,0 +1,224 @@
+package zog
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+)
+
+// Profiler follows execution into routines and back out again. CALL, RST
+// and interrupts push a frame onto a shadow of the call stack, and RET,
+// RETI and RETN pop it, so each instruction's T-states can be put down to
+// the routine running it and the calls which led there.
+//
+// Programs don't always return the way they came. A frame is popped when
+// a RET pops its return address or one above it, or a CALL pushes over
+// it, so frames thrown away with their stack don't pile up. Its methods
+// must not be called while Run is executing.
+type Profiler struct {
+	z    *Zog
+	root *callNode
+	// The root first
+	stack []shadowFrame
+}
+
+// callNode is a routine, reached through the chain of calls to its
+// parents. The same routine called by different routines has a node for
+// each.
+type callNode struct {
+	routine uint16
+	// The CALL, RST or interrupted instruction which called it
+	site     uint16
+	parent   *callNode
+	children map[callKey]*callNode
+
+	calls   uint64
+	ops     uint64
+	tstates uint64
+}
+
+type callKey struct {
+	routine uint16
+	site    uint16
+}
+
+type shadowFrame struct {
+	node *callNode
+	// Where the return address is on the stack. The root frame's is off
+	// the top, so it's never popped.
+	retSP int
+}
+
+// EnableProfiler starts profiling, in a root routine running from the
+// current PC
+func (z *Zog) EnableProfiler() *Profiler {
+	root := &callNode{routine: z.reg.PC, children: make(map[callKey]*callNode), calls: 1}
+	z.profiler = &Profiler{
+		z:     z,
+		root:  root,
+		stack: []shadowFrame{{node: root, retSP: 0x10000}},
+	}
+	return z.profiler
+}
+
+// step puts down an instruction to the routine running it, then follows
+// any call or return it made. sp is the stack pointer before it.
+func (p *Profiler) step(addr uint16, inst Instruction, sp uint16, tstates int) {
+	top := p.stack[len(p.stack)-1].node
+	top.ops++
+	top.tstates += uint64(tstates)
+
+	newSP := p.z.reg.SP
+	switch i := inst.(type) {
+	case *CALL, *RST:
+		// Not taken if nothing was pushed
+		if newSP == sp-2 {
+			p.call(p.z.reg.PC, addr, newSP)
+		}
+	case *RET:
+		if newSP == sp+2 {
+			p.ret(sp)
+		}
+	case EDSimple:
+		if i == RETI || i == RETN {
+			p.ret(sp)
+		}
+	}
+}
+
+func (p *Profiler) call(routine, site uint16, retSP uint16) {
+	p.popTo(int(retSP))
+	parent := p.stack[len(p.stack)-1].node
+	key := callKey{routine: routine, site: site}
+	node, ok := parent.children[key]
+	if !ok {
+		node = &callNode{routine: routine, site: site, parent: parent, children: make(map[callKey]*callNode)}
+		parent.children[key] = node
+	}
+	node.calls++
+	p.stack = append(p.stack, shadowFrame{node: node, retSP: int(retSP)})
+}
+
+// ret returns from the frame whose return address was popped from sp. If
+// no frame put it there, the program is using RET to jump, so stays in
+// the routine it's in.
+func (p *Profiler) ret(sp uint16) {
+	p.popTo(int(sp))
+}
+
+// popTo pops frames whose return addresses are at or below sp
+func (p *Profiler) popTo(sp int) {
+	for p.stack[len(p.stack)-1].retSP <= sp {
+		p.stack = p.stack[:len(p.stack)-1]
+	}
+}
+
+// Depth is how many calls deep execution is
+func (p *Profiler) Depth() int {
+	return len(p.stack) - 1
+}
+
+// Routine gives the routine running now
+func (p *Profiler) Routine() uint16 {
+	return p.stack[len(p.stack)-1].node.routine
+}
+
+// name gives a routine's symbol, or its address
+func (p *Profiler) name(addr uint16) string {
+	if p.z.symbols != nil {
+		if name, ok := p.z.symbols.Name(addr); ok {
+			return name
+		}
+	}
+	return fmt.Sprintf("%04X", addr)
+}
+
+// RoutineProfile is the time spent in a routine. Cumulative figures
+// include the routines it calls, counting recursive calls once.
+type RoutineProfile struct {
+	Addr       uint16
+	Name       string
+	Calls      uint64
+	Ops        uint64
+	TStates    uint64
+	CumOps     uint64
+	CumTStates uint64
+}
+
+// Routines gives each routine run, with those which took the most
+// T-states themselves first
+func (p *Profiler) Routines() []RoutineProfile {
+	routines := make(map[uint16]*RoutineProfile)
+	active := make(map[uint16]int)
+	var walk func(n *callNode) (uint64, uint64)
+	walk = func(n *callNode) (uint64, uint64) {
+		rp, ok := routines[n.routine]
+		if !ok {
+			rp = &RoutineProfile{Addr: n.routine, Name: p.name(n.routine)}
+			routines[n.routine] = rp
+		}
+		rp.Calls += n.calls
+		rp.Ops += n.ops
+		rp.TStates += n.tstates
+
+		ops, tstates := n.ops, n.tstates
+		active[n.routine]++
+		for _, child := range n.children {
+			o, t := walk(child)
+			ops += o
+			tstates += t
+		}
+		active[n.routine]--
+		if active[n.routine] == 0 {
+			rp.CumOps += ops
+			rp.CumTStates += tstates
+		}
+		return ops, tstates
+	}
+	walk(p.root)
+
+	var rps []RoutineProfile
+	for _, rp := range routines {
+		rps = append(rps, *rp)
+	}
+	sort.Slice(rps, func(i, j int) bool {
+		if rps[i].TStates != rps[j].TStates {
+			return rps[i].TStates > rps[j].TStates
+		}
+		return rps[i].Addr < rps[j].Addr
+	})
+	return rps
+}
+
+// WriteReport writes a table of the top n routines by T-states spent in
+// them, or all of them if n is 0
+func (p *Profiler) WriteReport(w io.Writer, n int) error {
+	rps := p.Routines()
+	var totalOps, totalTStates uint64
+	for _, rp := range rps {
+		totalOps += rp.Ops
+		totalTStates += rp.TStates
+	}
+	if n > 0 && n < len(rps) {
+		rps = rps[:n]
+	}
+	percent := func(t uint64) float64 {
+		if totalTStates == 0 {
+			return 0
+		}
+		return float64(t) * 100 / float64(totalTStates)
+	}
+
+	_, err := fmt.Fprintf(w, "%d T-states, %d instructions\n", totalTStates, totalOps)
+	if err != nil {
+		return err
+	}
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintf(tw, "self\tself%%\tcum\tcum%%\tops\tcalls\t routine\n")
+	for _, rp := range rps {
+		fmt.Fprintf(tw, "%d\t%.2f%%\t%d\t%.2f%%\t%d\t%d\t %s\n",
+			rp.TStates, percent(rp.TStates), rp.CumTStates, percent(rp.CumTStates), rp.Ops, rp.Calls, rp.Name)
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,145 @@
+package zog
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+func TestProfiler(t *testing.T) {
+	prog := `	ORG 100h
+	LD SP, 0F000h
+	CALL outer
+	CALL outer
+	CALL jumper
+	LD C, 3
+	CALL rec
+	HALT
+outer:	CALL inner
+	CALL inner
+	RET
+inner:	LD B, 10
+loop:	DJNZ loop
+	RET
+jumper:	LD HL, target
+	PUSH HL
+	RET
+target:	RET
+rec:	DEC C
+	CALL NZ, rec
+	RET
+`
+	assembly, err := Assemble(prog)
+	if err != nil {
+		t.Fatalf("Failed to assemble: %s", err)
+	}
+	z := New(0)
+	z.SetClockHz(0)
+	err = z.Load(assembly)
+	if err != nil {
+		t.Fatalf("Failed to load: %s", err)
+	}
+	z.reg.PC = assembly.BaseAddr
+	st := NewSymbolTable()
+	for _, name := range []string{"outer", "inner", "jumper", "rec"} {
+		addr, err := assembly.FindLabelAddr(name)
+		if err != nil {
+			t.Fatalf("No label %s: %s", name, err)
+		}
+		st.Add(addr, name)
+	}
+	z.SetSymbols(st)
+
+	p := z.EnableProfiler()
+	err = z.Run()
+	if err != nil {
+		t.Fatalf("Failed to run: %s", err)
+	}
+	if p.Depth() != 0 || p.Routine() != 0x100 {
+		t.Fatalf("Ended %d deep in %04X", p.Depth(), p.Routine())
+	}
+
+	expected := map[string]RoutineProfile{
+		// LD SP, 3 CALLs, LD, CALL and HALT
+		"0100": {Calls: 1, Ops: 7, CumOps: 74},
+		// 2 CALLs and RET, twice
+		"outer": {Calls: 2, Ops: 6, CumOps: 6 + 48},
+		// LD, 10 DJNZ and RET, four times
+		"inner": {Calls: 4, Ops: 4 * 12, CumOps: 48},
+		// LD, PUSH, and a RET to jump and one to return
+		"jumper": {Calls: 1, Ops: 4, CumOps: 4},
+		// Three deep, counted once
+		"rec": {Calls: 3, Ops: 9, CumOps: 9},
+	}
+	rps := p.Routines()
+	if len(rps) != len(expected) {
+		t.Fatalf("Got %d routines, expected %d: %v", len(rps), len(expected), rps)
+	}
+	got := make(map[string]RoutineProfile)
+	var tstates uint64
+	for _, rp := range rps {
+		e, ok := expected[rp.Name]
+		if !ok {
+			t.Fatalf("Unexpected routine %s", rp.Name)
+		}
+		if rp.Calls != e.Calls || rp.Ops != e.Ops || rp.CumOps != e.CumOps {
+			t.Errorf("%s is %+v, expected %+v", rp.Name, rp, e)
+		}
+		got[rp.Name] = rp
+		tstates += rp.TStates
+	}
+	if tstates != z.TStates() || got["0100"].CumTStates != tstates {
+		t.Errorf("Routines took %d T-states, expected %d", tstates, z.TStates())
+	}
+	if got["outer"].CumTStates != got["outer"].TStates+got["inner"].TStates || got["inner"].CumTStates != got["inner"].TStates {
+		t.Errorf("Cumulative T-states wrong: %+v", got)
+	}
+	if rps[0].Name != "inner" {
+		t.Errorf("Expected inner first, got %s", rps[0].Name)
+	}
+
+	buf := &bytes.Buffer{}
+	err = p.WriteReport(buf, 2)
+	if err != nil {
+		t.Fatalf("Can't write report: %s", err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 4 || !strings.HasSuffix(lines[0], " T-states, 74 instructions") || !strings.HasSuffix(lines[2], " inner") {
+		t.Fatalf("Bad report:\n%s", buf)
+	}
+
+	buf.Reset()
+	err = p.WritePprof(buf)
+	if err != nil {
+		t.Fatalf("Can't write profile: %s", err)
+	}
+	gz, err := gzip.NewReader(buf)
+	if err != nil {
+		t.Fatalf("Profile not gzipped: %s", err)
+	}
+	raw, err := ioutil.ReadAll(gz)
+	if err != nil {
+		t.Fatalf("Can't read profile: %s", err)
+	}
+	for _, s := range []string{"tstates", "outer", "inner", "jumper", "0100"} {
+		if !bytes.Contains(raw, []byte(s)) {
+			t.Errorf("Profile has no %s", s)
+		}
+	}
+}
+
+func TestProtoBuf(t *testing.T) {
+	var b protoBuf
+	b.uint64(1, 150)
+	b.message(2, func(b *protoBuf) {
+		b.bytes(3, []byte("ab"))
+	})
+	b.packed(4, []uint64{3, 270})
+	got := bufToHex(b.data)
+	expected := "089601" + "1204" + "1A026162" + "2203038E02"
+	if got != expected {
+		t.Fatalf("Encoded %s, expected %s", got, expected)
+	}
+}
@@ -0,0 +1,231 @@
+package zog
+
+import (
+	"fmt"
+)
+
+// RelocKind says how a relocated value is stored
+type RelocKind string
+
+const (
+	RelocWord RelocKind = "word"
+	RelocByte RelocKind = "byte"
+	// LOW() and HIGH() of a relocatable value
+	RelocLow  RelocKind = "low"
+	RelocHigh RelocKind = "high"
+)
+
+// Reloc is a value in the assembled bytes which can't be known until the
+// section it refers to is placed, or an imported symbol is defined. The
+// final value is the address of the section or symbol plus the addend.
+type Reloc struct {
+	Offset  int       `json:"offset"`
+	Kind    RelocKind `json:"kind"`
+	Section string    `json:"section,omitempty"`
+	Symbol  string    `json:"symbol,omitempty"`
+	Addend  int       `json:"addend"`
+}
+
+// Where is what the reloc is relative to, for error messages
+func (r Reloc) Where() string {
+	if r.Symbol != "" {
+		return r.Symbol
+	}
+	return fmt.Sprintf("section %s", r.Section)
+}
+
+// relValue is the value of an expression which may be relative to a
+// section or an imported symbol. Values in the absolute section have
+// neither.
+type relValue struct {
+	value   int
+	section string
+	extern  string
+	// Set when only one byte of the value is wanted
+	part RelocKind
+}
+
+func (v relValue) absolute() bool {
+	return v.section == "" && v.extern == ""
+}
+
+// relocEval evaluates an expression, keeping track of what the result is
+// relative to. Only a relocatable value plus or minus a constant, or the
+// difference between two values in the same section, can be relocated.
+func relocEval(a *Assembly, e Expr) (relValue, error) {
+	switch e := e.(type) {
+	case exprSymbol:
+		if e == "$" {
+			return relValue{value: int(a.dollar), section: a.section}, nil
+		}
+		sym, ok := a.Symbols[string(e)]
+		if !ok {
+			break
+		}
+		if !sym.known {
+			return relValue{}, fmt.Errorf("Symbol %s has no value", sym.Name)
+		}
+		return relValue{value: sym.Value, section: sym.Section, extern: sym.Extern}, nil
+	case exprDollar:
+		return relValue{value: int(a.dollar), section: a.section}, nil
+	case *exprUnary:
+		v, err := relocEval(a, e.e)
+		if err != nil || v.absolute() {
+			break
+		}
+		if v.part == "" && e.op == "LOW" {
+			v.part = RelocLow
+			return v, nil
+		}
+		if v.part == "" && e.op == "HIGH" {
+			v.part = RelocHigh
+			return v, nil
+		}
+		return relValue{}, fmt.Errorf("Can't relocate %s", e)
+	case *exprBinary:
+		l, err := relocEval(a, e.l)
+		if err != nil {
+			return relValue{}, err
+		}
+		r, err := relocEval(a, e.r)
+		if err != nil {
+			return relValue{}, err
+		}
+		if l.absolute() && r.absolute() {
+			break
+		}
+		switch {
+		case e.op == "+" && r.absolute() && l.part == "":
+			l.value += r.value
+			return l, nil
+		case e.op == "+" && l.absolute() && r.part == "":
+			r.value += l.value
+			return r, nil
+		case e.op == "-" && r.absolute() && l.part == "":
+			l.value -= r.value
+			return l, nil
+		case e.op == "-" && l.part == "" && r.part == "" && l.extern == "" && r.extern == "" && l.section == r.section:
+			return relValue{value: l.value - r.value}, nil
+		}
+		return relValue{}, fmt.Errorf("Can't relocate %s", e)
+	}
+	v, err := e.Eval(a)
+	return relValue{value: v}, err
+}
+
+type operandKind int
+
+const (
+	operandByte operandKind = iota
+	operandWord
+	// Index displacements and fill bytes, which can't be relocated
+	operandAbsolute
+	// JR and DJNZ targets, which must be in the same section
+	operandRelative
+)
+
+// operand is an expression which has been placed into the bytes of the
+// instruction being resolved. A negative offset counts back from the end
+// of the instruction, which is where Z80 instructions keep their operands.
+type operand struct {
+	expr   Expr
+	offset int
+	kind   operandKind
+}
+
+func (a *Assembly) operand(e Expr, offset int, kind operandKind) {
+	a.operands = append(a.operands, operand{expr: e, offset: offset, kind: kind})
+}
+
+// relocate turns the operands of a resolved instruction into relocations
+func (a *Assembly) relocate(linst *LabelledInstruction) {
+	ops := a.operands
+	a.operands = nil
+	linst.Relocs = nil
+	n := len(linst.Inst.Encode())
+	for _, op := range ops {
+		v, err := relocEval(a, op.expr)
+		if err != nil {
+			a.errs = append(a.errs, a.instError(linst, err))
+			continue
+		}
+		if op.kind == operandRelative {
+			if v.extern != "" || v.section != a.section {
+				a.errs = append(a.errs, a.errorAt(linst.File, linst.Line, "relative jump to %s in another section", op.expr))
+			}
+			continue
+		}
+		if v.absolute() {
+			continue
+		}
+
+		kind := RelocByte
+		var e *Error
+		switch {
+		case op.kind == operandAbsolute:
+			e = a.errorAt(linst.File, linst.Line, "%s can't be relocated", op.expr)
+		case op.kind == operandWord && v.part != "":
+			e = a.errorAt(linst.File, linst.Line, "%s can't be relocated as a word", op.expr)
+		case op.kind == operandWord:
+			kind = RelocWord
+		case v.part != "":
+			kind = v.part
+		}
+		if e != nil {
+			a.errs = append(a.errs, e)
+			continue
+		}
+
+		offset := op.offset
+		if offset < 0 {
+			offset += n
+		}
+		linst.Relocs = append(linst.Relocs, Reloc{Offset: offset, Kind: kind, Section: v.section, Symbol: v.extern, Addend: v.value})
+	}
+}
+
+// setSection saves the location counter of the current section and
+// carries on from where the new one left off
+func (a *Assembly) setSection(name string) {
+	a.counters[a.section] = a.addr
+	a.section = name
+	a.addr = a.counters[name]
+}
+
+// importSymbol declares a symbol which another object defines. It has
+// the value 0 until linked.
+func (a *Assembly) importSymbol(linst *LabelledInstruction, name string) error {
+	sym, ok := a.Symbols[name]
+	if ok && sym.pass == a.pass && sym.Extern != name {
+		return a.errorAt(linst.File, linst.Line, "can't import %s, which is defined at %s", name, sym.Location())
+	}
+	if !ok {
+		sym = &Symbol{Name: name}
+		a.Symbols[name] = sym
+		a.changed = sym
+	}
+	sym.Value = 0
+	sym.known = true
+	sym.Section = ""
+	sym.Extern = name
+	sym.File = linst.File
+	sym.Line = linst.Line
+	sym.pass = a.pass
+	return nil
+}
+
+// Relocatable is true if the assembly has to be linked before it can be
+// run, because it uses relocatable sections or imports symbols
+func (a *Assembly) Relocatable() bool {
+	for _, linst := range a.Linsts {
+		if linst.Section != "" {
+			return true
+		}
+	}
+	for _, sym := range a.Symbols {
+		if sym.Extern != "" {
+			return true
+		}
+	}
+	return false
+}
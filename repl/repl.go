@@ -74,3 +74,12 @@ func (m *Machine) Start() error {
 
 func (m *Machine) Stop() {
 }
+
+// The machine has no device state to save
+func (m *Machine) Save() (*zog.MachineState, error) {
+	return m.z.SaveState(m.Name()), nil
+}
+
+func (m *Machine) Restore(s *zog.MachineState) error {
+	return m.z.RestoreState(s, m.Name())
+}
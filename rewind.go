@@ -0,0 +1,292 @@
+package zog
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Rewind lets a Zog go back to an earlier instruction. It takes a
+// snapshot of the registers, interrupt state and memory every so many
+// instructions, and logs the input read and interrupts taken since the
+// oldest snapshot. Going back restores the nearest snapshot and replays
+// forward from it, feeding the program the same input and interrupts, so
+// it does exactly what it did before.
+//
+// Devices are not snapshotted, and see no output while replaying. Its
+// methods must not be called while Run is executing.
+type Rewind struct {
+	z     *Zog
+	every uint64
+	keep  int
+	// Oldest first
+	snaps  []snapshot
+	events []inputEvent
+
+	// The furthest instruction reached. Up to there, instructions are
+	// replayed from the log, and next is the next event to replay.
+	end    uint64
+	replay bool
+	next   int
+}
+
+type snapshot struct {
+	ops     uint64
+	tstates uint64
+	reg     Registers
+	is      InterruptState
+	halted  bool
+	mem     []byte
+}
+
+// inputEvent is the result of an IN, or an interrupt taken
+type inputEvent struct {
+	ops       uint64
+	interrupt bool
+	port      uint16
+	// The IN result or the interrupt vector
+	value byte
+	mode  byte
+}
+
+// EnableRewind snapshots the Zog every so many instructions, keeping the
+// most recent keep snapshots, which is how far back it can go
+func (z *Zog) EnableRewind(every uint64, keep int) *Rewind {
+	if every == 0 {
+		every = 1
+	}
+	if keep < 1 {
+		keep = 1
+	}
+	z.rewind = &Rewind{
+		z:     z,
+		every: every,
+		keep:  keep,
+		snaps: make([]snapshot, 0, keep),
+		end:   z.ops,
+	}
+	return z.rewind
+}
+
+// Oldest is the earliest instruction the Zog can go back to
+func (r *Rewind) Oldest() uint64 {
+	if len(r.snaps) == 0 {
+		return r.z.ops
+	}
+	return r.snaps[0].ops
+}
+
+// StepBack goes back to before the last instruction
+func (r *Rewind) StepBack() error {
+	if r.z.ops == 0 {
+		return fmt.Errorf("Can't step back before the first instruction")
+	}
+	return r.GoTo(r.z.ops - 1)
+}
+
+// RunBackToWrite goes back to before the last instruction which wrote to
+// addr
+func (r *Rewind) RunBackToWrite(addr uint16) error {
+	z := r.z
+	now := r.markEnd()
+
+	// Replay from each snapshot in turn, newest first, until one reaches
+	// a write
+	for i := len(r.snaps) - 1; i >= 0; i-- {
+		if r.snaps[i].ops >= now {
+			continue
+		}
+		r.restore(&r.snaps[i])
+		hit, last, found := false, uint64(0), false
+		m := z.Mem
+		watchFunc, debug := m.watchFunc, m.debug
+		m.SetWatchFunc(func(a uint16, old byte, new byte) {
+			if a == addr {
+				hit = true
+			}
+		})
+		m.SetDebug(true)
+		err := r.replayUntil(now, func() {
+			if hit {
+				last, found = z.ops-1, true
+				hit = false
+			}
+		})
+		m.SetWatchFunc(watchFunc)
+		m.SetDebug(debug)
+		if err != nil {
+			return err
+		}
+		if found {
+			return r.GoTo(last)
+		}
+	}
+	err := r.GoTo(now)
+	if err != nil {
+		return err
+	}
+	return fmt.Errorf("No write to %04X since instruction %d", addr, r.Oldest())
+}
+
+// RewindFrames goes back n frames, to where the nth most recent interrupt
+// was taken. Going back one frame from part way through a frame goes
+// back to its start.
+func (r *Rewind) RewindFrames(n int) error {
+	now := r.markEnd()
+	frames := 0
+	for i := len(r.events) - 1; i >= 0; i-- {
+		e := &r.events[i]
+		if !e.interrupt || e.ops >= now {
+			continue
+		}
+		frames++
+		if frames == n {
+			return r.GoTo(e.ops)
+		}
+	}
+	return fmt.Errorf("Can't go back %d frames, only %d recorded", n, frames)
+}
+
+// GoTo goes to just before instruction target, which can be anywhere from
+// the oldest snapshot up to the furthest instruction reached
+func (r *Rewind) GoTo(target uint64) error {
+	now := r.markEnd()
+	if target > r.end {
+		return fmt.Errorf("Can't go forward to instruction %d, only reached %d", target, r.end)
+	}
+	if target < now {
+		i := sort.Search(len(r.snaps), func(i int) bool { return r.snaps[i].ops > target }) - 1
+		if i < 0 {
+			return fmt.Errorf("Can't go back to instruction %d, snapshots start at %d", target, r.Oldest())
+		}
+		r.restore(&r.snaps[i])
+	}
+	return r.replayUntil(target, func() {})
+}
+
+// reset forgets the history, which no longer leads to where the Zog is
+func (r *Rewind) reset() {
+	r.snaps = r.snaps[:0]
+	r.events = r.events[:0]
+	r.end = r.z.ops
+	r.replay = false
+	r.next = 0
+}
+
+// markEnd notes how far the Zog has run, and gives where it is now
+func (r *Rewind) markEnd() uint64 {
+	if !r.replay {
+		r.end = r.z.ops
+	}
+	return r.z.ops
+}
+
+// replayUntil steps until instruction target, calling after after each
+// step
+func (r *Rewind) replayUntil(target uint64, after func()) (errRet error) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			errRet = fmt.Errorf("Replay failed: %v", rec)
+		}
+	}()
+	for r.z.ops < target {
+		_, err := r.z.step()
+		if err != nil {
+			return fmt.Errorf("Replay failed at instruction %d: %s", r.z.ops, err)
+		}
+		after()
+	}
+	return nil
+}
+
+func (r *Rewind) restore(s *snapshot) {
+	z := r.z
+	z.Mem.restore(s.mem)
+	z.reg = s.reg
+	z.is = s.is
+	z.halted = s.halted
+	z.ops = s.ops
+	z.tstates = s.tstates
+	r.next = sort.Search(len(r.events), func(i int) bool { return r.events[i].ops >= s.ops })
+	r.replay = z.ops < r.end
+}
+
+// beforeStep leaves replay at the furthest instruction reached, and takes
+// snapshots while running live
+func (r *Rewind) beforeStep() {
+	z := r.z
+	if r.replay {
+		if z.ops < r.end {
+			return
+		}
+		r.replay = false
+	}
+	r.end = z.ops
+	n := len(r.snaps)
+	if n > 0 && (z.ops%r.every != 0 || r.snaps[n-1].ops >= z.ops) {
+		return
+	}
+
+	var s snapshot
+	if len(r.snaps) == r.keep {
+		// Reuse the oldest snapshot's memory, and forget events from
+		// before the new oldest
+		s = r.snaps[0]
+		copy(r.snaps, r.snaps[1:])
+		r.snaps = r.snaps[:len(r.snaps)-1]
+		oldest := z.ops
+		if len(r.snaps) > 0 {
+			oldest = r.snaps[0].ops
+		}
+		n := sort.Search(len(r.events), func(i int) bool { return r.events[i].ops >= oldest })
+		r.events = append(r.events[:0], r.events[n:]...)
+	}
+	s.ops = z.ops
+	s.tstates = z.tstates
+	s.reg = z.reg
+	s.is = z.is
+	s.halted = z.halted
+	s.mem = z.Mem.save(s.mem)
+	r.snaps = append(r.snaps, s)
+}
+
+// replayInstruction is getInstruction while replaying, taking interrupts
+// from the log rather than from devices
+func (r *Rewind) replayInstruction() (Decoded, error) {
+	z := r.z
+	if r.next < len(r.events) {
+		e := &r.events[r.next]
+		if e.interrupt && e.ops == z.ops {
+			r.next++
+			return z.interrupt(e.mode, e.value)
+		}
+	}
+	if z.halted {
+		return Decoded{}, fmt.Errorf("Halted at instruction %d with no interrupt logged", z.ops)
+	}
+	z.decoder.SetAddr(z.reg.PC)
+	return z.decoder.Decode()
+}
+
+func (r *Rewind) interrupted(imMode byte, vector byte) {
+	if r.replay {
+		return
+	}
+	r.events = append(r.events, inputEvent{ops: r.z.ops, interrupt: true, mode: imMode, value: vector})
+}
+
+// logIn notes the result of an IN while running live
+func (r *Rewind) logIn(port uint16, n byte) {
+	r.events = append(r.events, inputEvent{ops: r.z.ops, port: port, value: n})
+}
+
+func (r *Rewind) replayIn(port uint16) byte {
+	z := r.z
+	if r.next < len(r.events) {
+		e := &r.events[r.next]
+		if !e.interrupt && e.ops == z.ops && e.port == port {
+			r.next++
+			return e.value
+		}
+	}
+	panic(fmt.Sprintf("IN from %04X at instruction %d not logged", port, z.ops))
+}
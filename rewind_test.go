@@ -0,0 +1,124 @@
+package zog
+
+import "testing"
+
+// tickSource interrupts on every tenth poll while it is on
+type tickSource struct {
+	on    bool
+	polls int
+}
+
+func (s *tickSource) InterruptPending() (bool, byte) {
+	s.polls++
+	return s.on && s.polls%10 == 0, 0
+}
+
+type rewindState struct {
+	reg          Registers
+	tstates      uint64
+	last, frames byte
+}
+
+func getRewindState(t *testing.T, z *Zog) rewindState {
+	last, err := z.Mem.Peek(0x2000)
+	if err != nil {
+		t.Fatalf("Can't peek: %s", err)
+	}
+	frames, err := z.Mem.Peek(0x3000)
+	if err != nil {
+		t.Fatalf("Can't peek: %s", err)
+	}
+	return rewindState{z.reg, z.TStates(), last, frames}
+}
+
+func TestRewind(t *testing.T) {
+	prog := "\tORG 100h\n\tLD SP, 0F000h\n\tLD HL, 3000h\n\tIM 1\n\tEI\nloop:\tIN A, (10h)\n\tLD (2000h), A\n\tJR loop\n"
+	z := traceTestZog(t, prog)
+	// The interrupt handler counts frames
+	err := z.LoadBytes(0x38, []byte{0x34, 0xfb, 0xed, 0x4d})
+	if err != nil {
+		t.Fatalf("Can't load handler: %s", err)
+	}
+	src := &tickSource{on: true}
+	z.AddInterruptSource(src)
+	ins := byte(0)
+	err = z.IO.AttachPorts(0x10, 1, IOFuncs{InFunc: func(uint16) byte {
+		ins++
+		return ins
+	}})
+	if err != nil {
+		t.Fatalf("Can't attach: %s", err)
+	}
+	r := z.EnableRewind(50, 4)
+
+	// The state before each instruction
+	var states []rewindState
+	step := func() {
+		states = append(states, getRewindState(t, z))
+		_, err := z.step()
+		if err != nil {
+			t.Fatalf("Failed to step: %s", err)
+		}
+	}
+	for i := 0; i < 300; i++ {
+		step()
+	}
+	states = append(states, getRewindState(t, z))
+	if states[300].frames == 0 || states[300].last == 0 {
+		t.Fatalf("Program didn't run: %v", states[300])
+	}
+
+	check := func(what string, err error, ops uint64) {
+		if err != nil {
+			t.Fatalf("Can't %s: %s", what, err)
+		}
+		if z.Ops() != ops {
+			t.Fatalf("After %s at instruction %d, expected %d", what, z.Ops(), ops)
+		}
+		got := getRewindState(t, z)
+		if got != states[ops] {
+			t.Fatalf("After %s state is %v, expected %v", what, got, states[ops])
+		}
+	}
+
+	// Only the log can interrupt or give input now
+	src.on = false
+	insBefore := ins
+	check("step back", r.StepBack(), 299)
+	check("go back", r.GoTo(150), 150)
+	check("go forward", r.GoTo(300), 300)
+	err = r.GoTo(50)
+	if err == nil || r.Oldest() != 100 {
+		t.Fatalf("Expected to only go back to 100, not 50 (%d)", r.Oldest())
+	}
+
+	lastWrite := uint64(0)
+	for i := 0; i < 300; i++ {
+		if states[i+1].frames != states[i].frames {
+			lastWrite = uint64(i)
+		}
+	}
+	check("run back to write", r.RunBackToWrite(0x3000), lastWrite)
+
+	check("rewind frames", r.RewindFrames(2), z.Ops())
+	_, err = z.step()
+	if err != nil || z.reg.PC != 0x38 {
+		t.Fatalf("Rewound to %04X, not an interrupt: %v", z.reg.PC, err)
+	}
+	frameOps := z.Ops() - 1
+	check("rewind frame", r.RewindFrames(1), frameOps)
+	if ins != insBefore {
+		t.Fatalf("Replay read %d times from the device", ins-insBefore)
+	}
+
+	// Back at the end, running carries on from the device
+	check("go forward", r.GoTo(300), 300)
+	states = states[:300]
+	for i := 0; i < 10; i++ {
+		step()
+	}
+	if ins == insBefore {
+		t.Fatalf("Device not read after replay")
+	}
+	check("step back", r.StepBack(), 309)
+}
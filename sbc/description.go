@@ -0,0 +1,199 @@
+package sbc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// Number is an address, size or port. In the JSON it may be a plain
+// number or a string such as "0x8000" or "8000h".
+type Number int
+
+func (n *Number) UnmarshalJSON(b []byte) error {
+	var v interface{}
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return err
+	}
+	switch v := v.(type) {
+	case float64:
+		*n = Number(v)
+		return nil
+	case string:
+		s := strings.ToLower(strings.TrimSpace(v))
+		base := 0
+		if strings.HasSuffix(s, "h") {
+			s = s[:len(s)-1]
+			base = 16
+		}
+		u, err := strconv.ParseUint(s, base, 32)
+		if err != nil {
+			return fmt.Errorf("Can't parse number [%s]: %s", v, err)
+		}
+		*n = Number(u)
+		return nil
+	default:
+		return fmt.Errorf("Can't parse number from %s", b)
+	}
+}
+
+// Description is a data-driven machine, see rc2014.json for an example.
+type Description struct {
+	Name string `json:"name"`
+	Load Number `json:"load"`
+	Run  Number `json:"run"`
+
+	ROMs []ROM `json:"roms"`
+	// If any RAM is listed, memory which is not ROM, RAM or paged
+	// is unmapped and reads as FF. Otherwise it is all RAM.
+	RAM     []Block  `json:"ram"`
+	Paging  []Pager  `json:"paging"`
+	Devices []Device `json:"devices"`
+	// Value read from ports with no device, FF if not set
+	Floating *Number `json:"floating"`
+}
+
+type ROM struct {
+	File string `json:"file"`
+	Addr Number `json:"addr"`
+}
+
+type Block struct {
+	Addr Number `json:"addr"`
+	Size Number `json:"size"`
+}
+
+// Pager switches the memory window at Addr between Pages when written on
+// Port. The value written (ANDed with Mask) selects the page.
+type Pager struct {
+	Port  Number `json:"port"`
+	Mask  Number `json:"mask"`
+	Addr  Number `json:"addr"`
+	Size  Number `json:"size"`
+	Pages []Page `json:"pages"`
+}
+
+// Page is a ROM image if File is set, else RAM.
+type Page struct {
+	File string `json:"file"`
+}
+
+// Device is a peripheral on the I/O ports starting at Port.
+//
+//	acia    - MC6850, on Connect
+//	sio     - Z80 SIO/2, channels on A and B
+//	console - terminal with emulation Term
+//
+// Connections are as for serial.Open.
+type Device struct {
+	Type    string `json:"type"`
+	Port    Number `json:"port"`
+	Connect string `json:"connect"`
+	A       string `json:"a"`
+	B       string `json:"b"`
+	Term    string `json:"term"`
+}
+
+func checkBlock(what string, addr, size Number) error {
+	if addr < 0 || addr > 0xffff {
+		return fmt.Errorf("%s: bad address %04X", what, int(addr))
+	}
+	if size <= 0 || int(addr)+int(size) > 0x10000 {
+		return fmt.Errorf("%s: bad size %04X at %04X", what, int(size), int(addr))
+	}
+	return nil
+}
+
+func checkPort(what string, port Number) error {
+	if port < 0 || port > 0xff {
+		return fmt.Errorf("%s: bad port %02X", what, int(port))
+	}
+	return nil
+}
+
+func (d *Description) check() error {
+	if d.Load < 0 || d.Load > 0xffff || d.Run < 0 || d.Run > 0xffff {
+		return errors.New("Bad load or run address")
+	}
+	for _, b := range d.RAM {
+		err := checkBlock("RAM", b.Addr, b.Size)
+		if err != nil {
+			return err
+		}
+	}
+	for i, p := range d.Paging {
+		what := fmt.Sprintf("Pager %d", i)
+		err := checkBlock(what, p.Addr, p.Size)
+		if err != nil {
+			return err
+		}
+		err = checkPort(what, p.Port)
+		if err != nil {
+			return err
+		}
+		if len(p.Pages) == 0 {
+			return fmt.Errorf("%s: no pages", what)
+		}
+	}
+	for _, dev := range d.Devices {
+		err := checkPort(dev.Type, dev.Port)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolve makes file names relative to dir
+func (d *Description) resolve(dir string) {
+	fix := func(fname string) string {
+		if fname == "" || filepath.IsAbs(fname) {
+			return fname
+		}
+		return filepath.Join(dir, fname)
+	}
+	for i := range d.ROMs {
+		d.ROMs[i].File = fix(d.ROMs[i].File)
+	}
+	for i := range d.Paging {
+		for j := range d.Paging[i].Pages {
+			d.Paging[i].Pages[j].File = fix(d.Paging[i].Pages[j].File)
+		}
+	}
+}
+
+func ParseDescription(buf []byte) (*Description, error) {
+	d := &Description{}
+	err := json.Unmarshal(buf, d)
+	if err != nil {
+		return nil, fmt.Errorf("Can't parse machine description: %s", err)
+	}
+	err = d.check()
+	if err != nil {
+		return nil, fmt.Errorf("Bad machine description: %s", err)
+	}
+	return d, nil
+}
+
+// ReadDescription reads a machine file. ROM file names are relative to
+// the directory of the machine file.
+func ReadDescription(fname string) (*Description, error) {
+	buf, err := ioutil.ReadFile(fname)
+	if err != nil {
+		return nil, fmt.Errorf("Can't read machine file [%s]: %s", fname, err)
+	}
+	d, err := ParseDescription(buf)
+	if err != nil {
+		return nil, err
+	}
+	if d.Name == "" {
+		d.Name = strings.TrimSuffix(filepath.Base(fname), filepath.Ext(fname))
+	}
+	d.resolve(filepath.Dir(fname))
+	return d, nil
+}
@@ -0,0 +1,239 @@
+package sbc
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/jbert/zog"
+	"github.com/jbert/zog/console"
+	"github.com/jbert/zog/serial"
+)
+
+type Machine struct {
+	z    *zog.Zog
+	desc *Description
+
+	pagers []*pager
+
+	closers []io.Closer
+	restore func()
+}
+
+func NewMachine(z *zog.Zog, desc *Description) *Machine {
+	return &Machine{z: z, desc: desc}
+}
+
+func (m Machine) LoadAddr() uint16 {
+	return uint16(m.desc.Load)
+}
+
+func (m Machine) RunAddr() uint16 {
+	return uint16(m.desc.Run)
+}
+
+func (m Machine) Name() string {
+	if m.desc.Name == "" {
+		return "sbc"
+	}
+	return m.desc.Name
+}
+
+func (m *Machine) Start() error {
+	if m.desc.Floating != nil {
+		m.z.IO.Floating = byte(*m.desc.Floating)
+	}
+	err := m.setupMemory()
+	if err != nil {
+		return err
+	}
+	for _, d := range m.desc.Devices {
+		err = m.addDevice(d)
+		if err != nil {
+			return fmt.Errorf("Can't add %s device at %02X: %s", d.Type, int(d.Port), err)
+		}
+	}
+	return nil
+}
+
+func (m *Machine) Stop() {
+	for _, c := range m.closers {
+		c.Close()
+	}
+	m.closers = nil
+	if m.restore != nil {
+		m.restore()
+		m.restore = nil
+	}
+}
+
+// Save includes which page each pager has in, and the RAM pages out.
+// Serial devices' state isn't saved.
+func (m *Machine) Save() (*zog.MachineState, error) {
+	s := m.z.SaveState(m.Name())
+	var pagers []pagerState
+	for _, pg := range m.pagers {
+		pagers = append(pagers, pg.save())
+	}
+	err := s.SetDevice("pagers", pagers)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (m *Machine) Restore(s *zog.MachineState) error {
+	var pagers []pagerState
+	err := s.Device("pagers", &pagers)
+	if err != nil {
+		return err
+	}
+	if len(pagers) != len(m.pagers) {
+		return fmt.Errorf("Machine has %d pagers, state has %d", len(m.pagers), len(pagers))
+	}
+	err = m.z.RestoreState(s, m.Name())
+	if err != nil {
+		return err
+	}
+	for i, pg := range m.pagers {
+		err = pg.restore(pagers[i])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *Machine) setupMemory() error {
+	var mapped [0x10000]bool
+	mark := func(addr, size int) {
+		for i := addr; i < addr+size; i++ {
+			mapped[i] = true
+		}
+	}
+
+	for _, rom := range m.desc.ROMs {
+		fi, err := os.Stat(rom.File)
+		if err != nil {
+			return fmt.Errorf("Can't load ROM: %s", err)
+		}
+		if int(rom.Addr)+int(fi.Size()) > 0x10000 {
+			return fmt.Errorf("ROM [%s] too big for address %04X", rom.File, int(rom.Addr))
+		}
+		err = m.z.LoadROMFile(uint16(rom.Addr), rom.File)
+		if err != nil {
+			return err
+		}
+		mark(int(rom.Addr), int(fi.Size()))
+	}
+
+	for _, p := range m.desc.Paging {
+		pg, err := newPager(m.z, p)
+		if err != nil {
+			return err
+		}
+		err = pg.selectPage(0)
+		if err != nil {
+			return err
+		}
+		m.pagers = append(m.pagers, pg)
+		err = m.z.IO.Attach(0x00ff, uint16(p.Port), zog.IOFuncs{OutFunc: pg.write})
+		if err != nil {
+			return err
+		}
+		mark(int(p.Addr), int(p.Size))
+	}
+
+	if len(m.desc.RAM) == 0 {
+		return nil
+	}
+	for _, b := range m.desc.RAM {
+		mark(int(b.Addr), int(b.Size))
+	}
+	// Everything else floats high and ignores writes
+	for addr := 0; addr < 0x10000; {
+		if mapped[addr] {
+			addr++
+			continue
+		}
+		start := addr
+		for addr < 0x10000 && !mapped[addr] {
+			addr++
+		}
+		buf := make([]byte, addr-start)
+		for i := range buf {
+			buf[i] = 0xff
+		}
+		err := m.z.LoadBytes(uint16(start), buf)
+		if err != nil {
+			return err
+		}
+		m.z.Mem.AddReadOnly(zog.NewRegionSize(uint16(start), len(buf)))
+	}
+	return nil
+}
+
+func (m *Machine) connect(spec string) (io.ReadWriter, error) {
+	if spec == "" {
+		return nil, nil
+	}
+	if spec == "stdio" {
+		err := m.rawMode()
+		if err != nil {
+			return nil, err
+		}
+	}
+	rw, err := serial.Open(spec)
+	if err != nil {
+		return nil, err
+	}
+	m.closers = append(m.closers, rw)
+	return rw, nil
+}
+
+func (m *Machine) rawMode() error {
+	if m.restore != nil {
+		return nil
+	}
+	restore, err := console.RawMode(os.Stdin)
+	if err != nil {
+		return err
+	}
+	m.restore = restore
+	return nil
+}
+
+func (m *Machine) addDevice(d Device) error {
+	base := byte(d.Port)
+	switch d.Type {
+	case "acia":
+		rw, err := m.connect(d.Connect)
+		if err != nil {
+			return err
+		}
+		return serial.NewACIA(rw).Attach(m.z, base)
+	case "sio":
+		a, err := m.connect(d.A)
+		if err != nil {
+			return err
+		}
+		b, err := m.connect(d.B)
+		if err != nil {
+			return err
+		}
+		return serial.NewSIO(a, b).Attach(m.z, base)
+	case "console":
+		emu, err := console.ParseEmulation(d.Term)
+		if err != nil {
+			return err
+		}
+		err = m.rawMode()
+		if err != nil {
+			return err
+		}
+		c := console.New(os.Stdin, os.Stdout, emu)
+		return c.Attach(m.z, base, base+1)
+	default:
+		return fmt.Errorf("Unknown device type [%s]", d.Type)
+	}
+}
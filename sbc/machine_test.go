@@ -0,0 +1,193 @@
+package sbc
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jbert/zog"
+)
+
+func TestParseDescription(t *testing.T) {
+	d, err := ParseDescription([]byte(`{
+		"run": "8000h",
+		"ram": [{"addr": "0x8000", "size": 32768}],
+		"devices": [{"type": "acia", "port": 128}]
+	}`))
+	if err != nil {
+		t.Fatalf("Can't parse: %s", err)
+	}
+	if d.Run != 0x8000 || d.RAM[0].Addr != 0x8000 || d.RAM[0].Size != 0x8000 || d.Devices[0].Port != 0x80 {
+		t.Errorf("Bad parse: %+v", d)
+	}
+
+	badDescs := []string{
+		`{"ram": [{"addr": "0xc000", "size": "0x8000"}]}`,
+		`{"devices": [{"type": "acia", "port": "0x100"}]}`,
+		`{"paging": [{"port": 56, "addr": 0, "size": 16384}]}`,
+		`{"run": "zz"}`,
+	}
+	for _, s := range badDescs {
+		_, err := ParseDescription([]byte(s))
+		if err == nil {
+			t.Errorf("Parsed bad description %s", s)
+		}
+	}
+}
+
+// pagedMachine starts a machine with a ROM page and a RAM page at 0000h
+func pagedMachine(t *testing.T) (*Machine, *zog.Zog) {
+	dir, err := ioutil.TempDir("", "sbc")
+	if err != nil {
+		t.Fatalf("Can't make temp dir: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	rom := []byte{0x00, 0x11, 0x22, 0x33}
+	err = ioutil.WriteFile(filepath.Join(dir, "test.rom"), rom, 0644)
+	if err != nil {
+		t.Fatalf("Can't write ROM: %s", err)
+	}
+	err = ioutil.WriteFile(filepath.Join(dir, "test.json"), []byte(`{
+		"load": "0x8000",
+		"run": "0x8000",
+		"ram": [{"addr": "0x8000", "size": "0x8000"}],
+		"paging": [{"port": "0x38", "mask": 1, "addr": 0, "size": "0x4000",
+			"pages": [{"file": "test.rom"}, {}]}]
+	}`), 0644)
+	if err != nil {
+		t.Fatalf("Can't write machine file: %s", err)
+	}
+
+	d, err := ReadDescription(filepath.Join(dir, "test.json"))
+	if err != nil {
+		t.Fatalf("Can't read description: %s", err)
+	}
+	z := zog.New(0)
+	m := NewMachine(z, d)
+	err = m.Start()
+	if err != nil {
+		t.Fatalf("Can't start: %s", err)
+	}
+	return m, z
+}
+
+func TestPagedROM(t *testing.T) {
+	m, z := pagedMachine(t)
+	defer m.Stop()
+	if m.Name() != "test" {
+		t.Errorf("Name [%s], expected from file name", m.Name())
+	}
+
+	a, err := zog.Assemble(`
+	ORG 8000h
+	LD A, 55h
+	LD (0002h), A
+	LD A, 01h
+	OUT (38h), A
+	LD A, 66h
+	LD (0002h), A
+	LD A, 00h
+	OUT (38h), A
+	LD A, (0002h)
+	LD B, A
+	LD A, 01h
+	OUT (38h), A
+	LD A, (0002h)
+	LD C, A
+	LD A, (5000h)
+	HALT
+`)
+	if err != nil {
+		t.Fatalf("Can't assemble: %s", err)
+	}
+	buf, err := a.Encode()
+	if err != nil {
+		t.Fatalf("Can't encode: %s", err)
+	}
+	err = z.RunBytes(m.LoadAddr(), buf, m.RunAddr())
+	if err != nil {
+		t.Fatalf("Run failed: %s", err)
+	}
+
+	r := z.GetRegisters()
+	if r.B != 0x22 {
+		t.Errorf("ROM byte %02X, expected 22", r.B)
+	}
+	if r.C != 0x66 {
+		t.Errorf("Paged RAM byte %02X, expected 66", r.C)
+	}
+	if r.A != 0xff {
+		t.Errorf("Unmapped byte %02X, expected FF", r.A)
+	}
+}
+
+func TestSaveRestore(t *testing.T) {
+	m, z := pagedMachine(t)
+	defer m.Stop()
+	// Leave something in the RAM page, then page the ROM back in
+	a, err := zog.Assemble(`
+	ORG 8000h
+	LD A, 01h
+	OUT (38h), A
+	LD A, 66h
+	LD (0002h), A
+	LD A, 00h
+	OUT (38h), A
+	LD HL, 1234h
+	HALT
+`)
+	if err != nil {
+		t.Fatalf("Can't assemble: %s", err)
+	}
+	buf, err := a.Encode()
+	if err != nil {
+		t.Fatalf("Can't encode: %s", err)
+	}
+	err = z.RunBytes(m.LoadAddr(), buf, m.RunAddr())
+	if err != nil {
+		t.Fatalf("Run failed: %s", err)
+	}
+	s, err := m.Save()
+	if err != nil {
+		t.Fatalf("Can't save: %s", err)
+	}
+	saved := &bytes.Buffer{}
+	err = s.Write(saved)
+	if err != nil {
+		t.Fatalf("Can't write state: %s", err)
+	}
+
+	m2, z2 := pagedMachine(t)
+	defer m2.Stop()
+	s, err = zog.ReadMachineState(saved)
+	if err != nil {
+		t.Fatalf("Can't read state: %s", err)
+	}
+	err = m2.Restore(s)
+	if err != nil {
+		t.Fatalf("Can't restore: %s", err)
+	}
+	if z2.GetRegisters() != z.GetRegisters() || z2.Ops() != z.Ops() || z2.TStates() != z.TStates() {
+		t.Errorf("Restored CPU %s after %d, expected %s after %d", z2.GetRegisters().Summary(), z2.Ops(), z.GetRegisters().Summary(), z.Ops())
+	}
+	peek := func(what string, expected byte) {
+		n, err := z2.Mem.Peek(0x0002)
+		if err != nil || n != expected {
+			t.Errorf("%s reads %02X, expected %02X (%v)", what, n, expected, err)
+		}
+	}
+	peek("ROM page", 0x22)
+	z2.Mem.Poke(0x0002, 0x77)
+	peek("Written ROM page", 0x22)
+	m2.pagers[0].write(0x38, 1)
+	peek("RAM page", 0x66)
+
+	s.Machine = "other"
+	err = m2.Restore(s)
+	if err == nil {
+		t.Errorf("Restored state from another machine")
+	}
+}
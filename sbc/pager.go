@@ -0,0 +1,127 @@
+package sbc
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/jbert/zog"
+)
+
+type page struct {
+	buf      []byte
+	readonly bool
+}
+
+// pager swaps pages in and out of a window of the flat memory by copying.
+// RAM pages are copied back out when they are switched away from.
+type pager struct {
+	z       *zog.Zog
+	region  zog.Region
+	addr    uint16
+	size    int
+	mask    byte
+	pages   []page
+	current int
+}
+
+func newPager(z *zog.Zog, p Pager) (*pager, error) {
+	pg := &pager{
+		z:       z,
+		addr:    uint16(p.Addr),
+		size:    int(p.Size),
+		region:  zog.NewRegionSize(uint16(p.Addr), int(p.Size)),
+		mask:    byte(p.Mask),
+		current: -1,
+	}
+	if pg.mask == 0 {
+		pg.mask = 0xff
+	}
+	for _, desc := range p.Pages {
+		buf := make([]byte, pg.size)
+		readonly := desc.File != ""
+		if readonly {
+			rom, err := ioutil.ReadFile(desc.File)
+			if err != nil {
+				return nil, fmt.Errorf("Can't load page [%s]: %s", desc.File, err)
+			}
+			for i := range buf {
+				buf[i] = 0xff
+			}
+			copy(buf, rom)
+		}
+		pg.pages = append(pg.pages, page{buf: buf, readonly: readonly})
+	}
+	return pg, nil
+}
+
+func (pg *pager) write(port uint16, n byte) {
+	err := pg.selectPage(int(n&pg.mask) % len(pg.pages))
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (pg *pager) selectPage(n int) error {
+	if n == pg.current {
+		return nil
+	}
+	if pg.current >= 0 {
+		cur := pg.pages[pg.current]
+		if cur.readonly {
+			pg.z.Mem.RemoveReadOnly(pg.region)
+		} else {
+			buf, err := pg.z.Mem.PeekBuf(pg.addr, pg.size)
+			if err != nil {
+				return fmt.Errorf("Can't page out: %s", err)
+			}
+			copy(cur.buf, buf)
+		}
+	}
+
+	next := pg.pages[n]
+	err := pg.z.Mem.Copy(pg.addr, next.buf)
+	if err != nil {
+		return fmt.Errorf("Can't page in: %s", err)
+	}
+	if next.readonly {
+		pg.z.Mem.AddReadOnly(pg.region)
+	}
+	pg.current = n
+	return nil
+}
+
+type pagerState struct {
+	Current int
+	// RAM pages as they were last paged out. ROM pages are left nil, and
+	// the current page is in memory.
+	Pages [][]byte
+}
+
+func (pg *pager) save() pagerState {
+	ps := pagerState{Current: pg.current, Pages: make([][]byte, len(pg.pages))}
+	for i, p := range pg.pages {
+		if !p.readonly {
+			ps.Pages[i] = append([]byte(nil), p.buf...)
+		}
+	}
+	return ps
+}
+
+// restore puts back the pager to go with restored memory, so the current
+// page isn't copied in
+func (pg *pager) restore(ps pagerState) error {
+	if len(ps.Pages) != len(pg.pages) || ps.Current < 0 || ps.Current >= len(pg.pages) {
+		return fmt.Errorf("Pager at %04X has %d pages, state has %d (current %d)", pg.addr, len(pg.pages), len(ps.Pages), ps.Current)
+	}
+	for i, p := range pg.pages {
+		if p.readonly {
+			continue
+		}
+		if len(ps.Pages[i]) != pg.size {
+			return fmt.Errorf("Pager at %04X page %d is %d bytes, state has %d", pg.addr, i, pg.size, len(ps.Pages[i]))
+		}
+		copy(p.buf, ps.Pages[i])
+	}
+	pg.current = ps.Current
+	return nil
+}
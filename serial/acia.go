@@ -0,0 +1,135 @@
+package serial
+
+import (
+	"io"
+	"sync"
+
+	"github.com/jbert/zog"
+)
+
+// MC6850 status register bits
+const (
+	ACIA_RDRF = 0x01 // Receive data register full
+	ACIA_TDRE = 0x02 // Transmit data register empty
+	ACIA_DCD  = 0x04
+	ACIA_CTS  = 0x08
+	ACIA_FE   = 0x10
+	ACIA_OVRN = 0x20
+	ACIA_PE   = 0x40
+	ACIA_IRQ  = 0x80
+)
+
+// ACIA models an MC6850. Register 0 is control (write) / status (read),
+// register 1 is data. On an RC2014 these are ports 0x80 and 0x81.
+type ACIA struct {
+	sync.Mutex
+	l *line
+
+	control byte
+	rdr     byte
+	rdrFull bool
+}
+
+// NewACIA returns an ACIA connected to rw. A nil rw gives a port with
+// nothing plugged in.
+func NewACIA(rw io.ReadWriter) *ACIA {
+	return &ACIA{l: newLine(rw)}
+}
+
+// fill moves a waiting byte into the receive data register, if it is free
+func (a *ACIA) fill() {
+	if a.rdrFull {
+		return
+	}
+	n, ok := a.l.take()
+	if ok {
+		a.rdr = n
+		a.rdrFull = true
+	}
+}
+
+func (a *ACIA) rxIntEnabled() bool {
+	return a.control&0x80 != 0
+}
+
+func (a *ACIA) txIntEnabled() bool {
+	return a.control&0x60 == 0x20
+}
+
+func (a *ACIA) irq() bool {
+	a.fill()
+	return (a.rxIntEnabled() && a.rdrFull) || a.txIntEnabled()
+}
+
+func (a *ACIA) Status() byte {
+	a.Lock()
+	defer a.Unlock()
+	status := byte(ACIA_TDRE)
+	if a.irq() {
+		status |= ACIA_IRQ
+	}
+	if a.rdrFull {
+		status |= ACIA_RDRF
+	}
+	return status
+}
+
+func (a *ACIA) ReadReg(reg byte) byte {
+	if reg&0x01 == 0 {
+		return a.Status()
+	}
+	a.Lock()
+	defer a.Unlock()
+	a.fill()
+	a.rdrFull = false
+	return a.rdr
+}
+
+func (a *ACIA) WriteReg(reg byte, n byte) {
+	if reg&0x01 == 1 {
+		a.l.send(n)
+		return
+	}
+	a.Lock()
+	defer a.Unlock()
+	if n&0x03 == 0x03 {
+		// Master reset
+		a.control = 0
+		a.rdrFull = false
+		return
+	}
+	a.control = n
+}
+
+func (a *ACIA) InterruptPending() (bool, byte) {
+	a.Lock()
+	defer a.Unlock()
+	// The 6850 has no vector, the bus floats high
+	return a.irq(), 0xff
+}
+
+// Attach puts the ACIA on ports base and base+1 and connects its IRQ line.
+func (a *ACIA) Attach(z *zog.Zog, base byte) error {
+	err := z.IO.AttachPorts(base, 2, regs{2, a.ReadReg, a.WriteReg})
+	if err != nil {
+		return err
+	}
+	z.AddInterruptSource(a)
+	return nil
+}
+
+// regs adapts a device with numbered registers to the IO bus, the
+// register being the low bits of the port.
+type regs struct {
+	num   byte
+	read  func(reg byte) byte
+	write func(reg byte, n byte)
+}
+
+func (r regs) In(port uint16) byte {
+	return r.read(byte(port) & (r.num - 1))
+}
+
+func (r regs) Out(port uint16, n byte) {
+	r.write(byte(port)&(r.num-1), n)
+}
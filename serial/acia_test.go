@@ -0,0 +1,123 @@
+package serial
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jbert/zog"
+)
+
+// runWithTimeout runs the program until it halts, stopping it and failing
+// if it hasn't after a few seconds
+func runWithTimeout(t *testing.T, z *zog.Zog, prog string) {
+	a, err := zog.Assemble(prog)
+	if err != nil {
+		t.Fatalf("Can't assemble: %s", err)
+	}
+	done := make(chan error, 1)
+	go func() {
+		done <- z.RunAssembly(a)
+	}()
+	select {
+	case err = <-done:
+	case <-time.After(5 * time.Second):
+		z.Stop()
+		<-done
+		t.Fatalf("Program still running after 5 seconds")
+	}
+	if err != nil {
+		t.Fatalf("Run failed: %s", err)
+	}
+}
+
+func expectBytes(t *testing.T, host []byte, expected string) {
+	if string(host) != expected {
+		t.Errorf("Host got %q, expected %q", host, expected)
+	}
+}
+
+func TestACIAPolled(t *testing.T) {
+	guest, host := Pipe()
+	z := zog.New(0)
+	acia := NewACIA(guest)
+	err := acia.Attach(z, 0x80)
+	if err != nil {
+		t.Fatalf("Can't attach: %s", err)
+	}
+
+	go host.Write([]byte("x"))
+	got := make(chan []byte)
+	go func() {
+		buf := make([]byte, 2)
+		n, _ := host.Read(buf)
+		got <- buf[:n]
+	}()
+
+	// Echo one character back, upper-cased
+	runWithTimeout(t, z, `
+	ORG 0100h
+	LD A, 03h
+	OUT (80h), A
+	LD A, 16h
+	OUT (80h), A
+waitrx:
+	IN A, (80h)
+	AND 01h
+	JP Z, waitrx
+	IN A, (81h)
+	SUB 20h
+	OUT (81h), A
+	HALT
+`)
+	expectBytes(t, <-got, "X")
+	if acia.Status()&ACIA_RDRF != 0 {
+		t.Errorf("RDRF still set after read")
+	}
+}
+
+func TestACIAInterrupt(t *testing.T) {
+	guest, host := Pipe()
+	z := zog.New(0)
+	acia := NewACIA(guest)
+	err := acia.Attach(z, 0x80)
+	if err != nil {
+		t.Fatalf("Can't attach: %s", err)
+	}
+
+	got := make(chan []byte)
+	go func() {
+		buf := make([]byte, 2)
+		n, _ := host.Read(buf)
+		got <- buf[:n]
+	}()
+
+	// Enable the rx interrupt, then HALT until it arrives. The handler
+	// echoes the char and HALTs with interrupts off, which ends the run.
+	z.LoadInterruptState(zog.InterruptState{Mode: 1})
+	a, err := zog.Assemble(`
+	ORG 0038h
+	IN A, (81h)
+	OUT (81h), A
+	HALT
+`)
+	if err != nil {
+		t.Fatalf("Can't assemble handler: %s", err)
+	}
+	err = z.Load(a)
+	if err != nil {
+		t.Fatalf("Can't load handler: %s", err)
+	}
+
+	go host.Write([]byte("i"))
+	runWithTimeout(t, z, `
+	ORG 0100h
+	LD SP, 0f000h
+	LD A, 96h
+	OUT (80h), A
+	IM 1
+	EI
+	HALT
+	HALT
+`)
+	expectBytes(t, <-got, "i")
+}
@@ -0,0 +1,119 @@
+package serial
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"strings"
+)
+
+// line is the host end of a serial port. Received bytes are queued by a
+// background reader so the guest can poll without blocking.
+type line struct {
+	w  io.Writer
+	rx chan byte
+}
+
+func newLine(rw io.ReadWriter) *line {
+	l := &line{rx: make(chan byte, 1024)}
+	if rw == nil {
+		// Disconnected - never receives, discards output
+		l.w = ioutil.Discard
+		return l
+	}
+	l.w = rw
+	go func() {
+		buf := make([]byte, 1)
+		for {
+			n, err := rw.Read(buf)
+			if err != nil {
+				return
+			}
+			if n == 1 {
+				l.rx <- buf[0]
+			}
+		}
+	}()
+	return l
+}
+
+func (l *line) ready() bool {
+	return len(l.rx) > 0
+}
+
+func (l *line) take() (byte, bool) {
+	select {
+	case n := <-l.rx:
+		return n, true
+	default:
+		return 0, false
+	}
+}
+
+func (l *line) send(n byte) {
+	// Output errors (e.g. a closed socket) look like a dropped line
+	l.w.Write([]byte{n})
+}
+
+// Pipe returns two connected in-memory ends. Give one to a device and
+// drive the other from Go code (e.g. tests).
+func Pipe() (io.ReadWriteCloser, io.ReadWriteCloser) {
+	return net.Pipe()
+}
+
+type stdio struct {
+	io.Reader
+	io.Writer
+}
+
+func (s stdio) Close() error {
+	return nil
+}
+
+// Open connects to a host endpoint described by spec:
+//
+//	stdio            - stdin/stdout
+//	pty              - a new pseudo-terminal, the name is printed on stderr
+//	tcp:host:port    - connect to a TCP server
+//	listen:host:port - wait for a single TCP connection
+func Open(spec string) (io.ReadWriteCloser, error) {
+	kind, addr := spec, ""
+	i := strings.Index(spec, ":")
+	if i >= 0 {
+		kind, addr = spec[:i], spec[i+1:]
+	}
+
+	switch kind {
+	case "stdio":
+		return stdio{os.Stdin, os.Stdout}, nil
+	case "pty":
+		f, name, err := OpenPTY()
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(os.Stderr, "Serial port on %s\n", name)
+		return f, nil
+	case "tcp":
+		conn, err := net.Dial("tcp", addr)
+		if err != nil {
+			return nil, fmt.Errorf("Can't connect to [%s]: %s", addr, err)
+		}
+		return conn, nil
+	case "listen":
+		ln, err := net.Listen("tcp", addr)
+		if err != nil {
+			return nil, fmt.Errorf("Can't listen on [%s]: %s", addr, err)
+		}
+		defer ln.Close()
+		fmt.Fprintf(os.Stderr, "Waiting for serial connection on %s\n", ln.Addr())
+		conn, err := ln.Accept()
+		if err != nil {
+			return nil, fmt.Errorf("Can't accept on [%s]: %s", addr, err)
+		}
+		return conn, nil
+	default:
+		return nil, fmt.Errorf("Unknown serial endpoint [%s]", spec)
+	}
+}
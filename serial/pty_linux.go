@@ -0,0 +1,86 @@
+//go:build linux
+// +build linux
+
+package serial
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+func ioctl(fd uintptr, req uintptr, arg unsafe.Pointer) error {
+	_, _, errno := syscall.Syscall(syscall.SYS_IOCTL, fd, req, uintptr(arg))
+	if errno != 0 {
+		return errno
+	}
+	return nil
+}
+
+type pty struct {
+	*os.File
+	slave *os.File
+}
+
+func (p *pty) Close() error {
+	p.slave.Close()
+	return p.File.Close()
+}
+
+// OpenPTY creates a pseudo-terminal in raw mode, returning the master
+// end and the name of the slave for the user to connect to.
+func OpenPTY() (io.ReadWriteCloser, string, error) {
+	master, err := os.OpenFile("/dev/ptmx", os.O_RDWR, 0)
+	if err != nil {
+		return nil, "", fmt.Errorf("Can't open pty: %s", err)
+	}
+
+	var n uint32
+	err = ioctl(master.Fd(), syscall.TIOCGPTN, unsafe.Pointer(&n))
+	if err != nil {
+		master.Close()
+		return nil, "", fmt.Errorf("Can't get pty number: %s", err)
+	}
+	var unlock int32
+	err = ioctl(master.Fd(), syscall.TIOCSPTLCK, unsafe.Pointer(&unlock))
+	if err != nil {
+		master.Close()
+		return nil, "", fmt.Errorf("Can't unlock pty: %s", err)
+	}
+	name := fmt.Sprintf("/dev/pts/%d", n)
+
+	// Make the slave raw, otherwise the line discipline echoes our own
+	// output back to us
+	slave, err := os.OpenFile(name, os.O_RDWR|syscall.O_NOCTTY, 0)
+	if err != nil {
+		master.Close()
+		return nil, "", fmt.Errorf("Can't open pty slave [%s]: %s", name, err)
+	}
+	// We keep the slave open, otherwise reads on the master fail until
+	// someone connects
+	var t syscall.Termios
+	err = ioctl(slave.Fd(), syscall.TCGETS, unsafe.Pointer(&t))
+	if err != nil {
+		slave.Close()
+		master.Close()
+		return nil, "", fmt.Errorf("Can't get pty attributes: %s", err)
+	}
+	t.Iflag &^= syscall.IGNBRK | syscall.BRKINT | syscall.PARMRK | syscall.ISTRIP |
+		syscall.INLCR | syscall.IGNCR | syscall.ICRNL | syscall.IXON
+	t.Oflag &^= syscall.OPOST
+	t.Lflag &^= syscall.ECHO | syscall.ECHONL | syscall.ICANON | syscall.ISIG | syscall.IEXTEN
+	t.Cflag &^= syscall.CSIZE | syscall.PARENB
+	t.Cflag |= syscall.CS8
+	t.Cc[syscall.VMIN] = 1
+	t.Cc[syscall.VTIME] = 0
+	err = ioctl(slave.Fd(), syscall.TCSETS, unsafe.Pointer(&t))
+	if err != nil {
+		slave.Close()
+		master.Close()
+		return nil, "", fmt.Errorf("Can't set pty attributes: %s", err)
+	}
+
+	return &pty{File: master, slave: slave}, name, nil
+}
@@ -0,0 +1,13 @@
+//go:build !linux
+// +build !linux
+
+package serial
+
+import (
+	"errors"
+	"io"
+)
+
+func OpenPTY() (io.ReadWriteCloser, string, error) {
+	return nil, "", errors.New("PTY serial ports are only supported on linux")
+}
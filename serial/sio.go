@@ -0,0 +1,210 @@
+package serial
+
+import (
+	"io"
+	"sync"
+
+	"github.com/jbert/zog"
+)
+
+// RR0 bits
+const (
+	SIO_RX_AVAILABLE = 0x01
+	SIO_INT_PENDING  = 0x02
+	SIO_TX_EMPTY     = 0x04
+	SIO_DCD          = 0x08
+	SIO_CTS          = 0x20
+)
+
+// WR1 bits
+const (
+	SIO_TX_INT_ENABLE   = 0x02
+	SIO_STATUS_AFFECTS  = 0x04
+	SIO_RX_INT_MASK     = 0x18
+	SIO_RX_INT_FIRST    = 0x08
+	SIO_RX_INT_DISABLED = 0x00
+)
+
+type sioChannel struct {
+	l *line
+
+	ptr byte
+	wr  [8]byte
+
+	rx     byte
+	rxFull bool
+
+	txIntPending bool
+	// For 'interrupt on first character' mode
+	rxIntArmed bool
+}
+
+func (c *sioChannel) reset() {
+	c.ptr = 0
+	c.wr = [8]byte{}
+	c.rxFull = false
+	c.txIntPending = false
+	c.rxIntArmed = false
+}
+
+func (c *sioChannel) fill() {
+	if c.rxFull {
+		return
+	}
+	n, ok := c.l.take()
+	if ok {
+		c.rx = n
+		c.rxFull = true
+	}
+}
+
+func (c *sioChannel) rxIntPending() bool {
+	c.fill()
+	if !c.rxFull {
+		return false
+	}
+	switch c.wr[1] & SIO_RX_INT_MASK {
+	case SIO_RX_INT_DISABLED:
+		return false
+	case SIO_RX_INT_FIRST:
+		return c.rxIntArmed
+	default:
+		return true
+	}
+}
+
+func (c *sioChannel) readData() byte {
+	c.fill()
+	c.rxFull = false
+	c.rxIntArmed = false
+	return c.rx
+}
+
+func (c *sioChannel) writeData(n byte) {
+	c.l.send(n)
+	// We transmit instantly, so the buffer is empty again straight away
+	c.txIntPending = c.wr[1]&SIO_TX_INT_ENABLE != 0
+}
+
+func (c *sioChannel) writeControl(n byte) {
+	if c.ptr != 0 {
+		c.wr[c.ptr] = n
+		c.ptr = 0
+		return
+	}
+	c.ptr = n & 0x07
+	switch (n >> 3) & 0x07 {
+	case 3:
+		c.reset()
+	case 4:
+		c.rxIntArmed = true
+	case 5:
+		c.txIntPending = false
+	}
+}
+
+// SIO models a Z80 SIO/2. Registers are laid out as on the RC2014 SIO/2
+// module: 0 - A control, 1 - A data, 2 - B control, 3 - B data.
+type SIO struct {
+	sync.Mutex
+	ch [2]sioChannel
+}
+
+// NewSIO returns an SIO with channels A and B connected to a and b.
+// Either may be nil.
+func NewSIO(a, b io.ReadWriter) *SIO {
+	s := &SIO{}
+	s.ch[0].l = newLine(a)
+	s.ch[1].l = newLine(b)
+	return s
+}
+
+// pending returns whether any interrupt is pending and the vector to
+// use, in SIO priority order.
+func (s *SIO) pending() (bool, byte) {
+	// The vector lives in WR2 of channel B, and WR1 of channel B says
+	// whether to modify it
+	vector := s.ch[1].wr[2]
+	modify := func(v byte) byte {
+		if s.ch[1].wr[1]&SIO_STATUS_AFFECTS == 0 {
+			return vector
+		}
+		return vector&^0x0e | v<<1
+	}
+	switch {
+	case s.ch[0].rxIntPending():
+		return true, modify(6)
+	case s.ch[0].txIntPending:
+		return true, modify(4)
+	case s.ch[1].rxIntPending():
+		return true, modify(2)
+	case s.ch[1].txIntPending:
+		return true, modify(0)
+	}
+	// With nothing pending, RR2 reads 'B tx empty' status
+	return false, modify(3)
+}
+
+func (s *SIO) ReadReg(reg byte) byte {
+	s.Lock()
+	defer s.Unlock()
+	c := &s.ch[(reg>>1)&0x01]
+	if reg&0x01 == 1 {
+		return c.readData()
+	}
+
+	ptr := c.ptr
+	c.ptr = 0
+	switch ptr {
+	case 0:
+		c.fill()
+		rr0 := byte(SIO_TX_EMPTY | SIO_DCD | SIO_CTS)
+		if c.rxFull {
+			rr0 |= SIO_RX_AVAILABLE
+		}
+		if c == &s.ch[0] {
+			if pending, _ := s.pending(); pending {
+				rr0 |= SIO_INT_PENDING
+			}
+		}
+		return rr0
+	case 1:
+		// All sent
+		return 0x01
+	case 2:
+		if c == &s.ch[1] {
+			_, vector := s.pending()
+			return vector
+		}
+		return s.ch[1].wr[2]
+	default:
+		return 0x00
+	}
+}
+
+func (s *SIO) WriteReg(reg byte, n byte) {
+	s.Lock()
+	defer s.Unlock()
+	c := &s.ch[(reg>>1)&0x01]
+	if reg&0x01 == 1 {
+		c.writeData(n)
+		return
+	}
+	c.writeControl(n)
+}
+
+func (s *SIO) InterruptPending() (bool, byte) {
+	s.Lock()
+	defer s.Unlock()
+	return s.pending()
+}
+
+// Attach puts the SIO on ports base to base+3 and connects its INT line.
+func (s *SIO) Attach(z *zog.Zog, base byte) error {
+	err := z.IO.AttachPorts(base, 4, regs{4, s.ReadReg, s.WriteReg})
+	if err != nil {
+		return err
+	}
+	z.AddInterruptSource(s)
+	return nil
+}
@@ -0,0 +1,109 @@
+package serial
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jbert/zog"
+)
+
+func TestSIORegisters(t *testing.T) {
+	guestA, hostA := Pipe()
+	s := NewSIO(guestA, nil)
+
+	if rr0 := s.ReadReg(0); rr0&SIO_RX_AVAILABLE != 0 {
+		t.Errorf("RR0 %02X: rx available with nothing sent", rr0)
+	}
+
+	// Vector 0x40, status affects vector, all-chars rx interrupt on A
+	s.WriteReg(2, 0x02)
+	s.WriteReg(2, 0x40)
+	s.WriteReg(2, 0x01)
+	s.WriteReg(2, SIO_STATUS_AFFECTS)
+	s.WriteReg(0, 0x01)
+	s.WriteReg(0, 0x18)
+
+	hostA.Write([]byte("a"))
+	deadline := time.After(time.Second)
+	for pending, _ := s.InterruptPending(); !pending; pending, _ = s.InterruptPending() {
+		select {
+		case <-deadline:
+			t.Fatalf("No rx interrupt a second after sending")
+		case <-time.After(time.Millisecond):
+		}
+	}
+	pending, vector := s.InterruptPending()
+	if !pending || vector != 0x4c {
+		t.Errorf("Interrupt %v vector %02X, expected A rx vector 4C", pending, vector)
+	}
+	if rr0 := s.ReadReg(0); rr0&(SIO_RX_AVAILABLE|SIO_INT_PENDING) != SIO_RX_AVAILABLE|SIO_INT_PENDING {
+		t.Errorf("RR0 %02X, expected rx available and int pending", rr0)
+	}
+	s.WriteReg(2, 0x02)
+	if rr2 := s.ReadReg(2); rr2 != 0x4c {
+		t.Errorf("RR2 %02X, expected 4C", rr2)
+	}
+	if n := s.ReadReg(1); n != 'a' {
+		t.Errorf("Read %02X, expected 'a'", n)
+	}
+	if pending, vector := s.InterruptPending(); pending {
+		t.Errorf("Interrupt still pending (vector %02X) after read", vector)
+	}
+}
+
+func TestSIOInterruptMode2(t *testing.T) {
+	guestB, hostB := Pipe()
+	z := zog.New(0)
+	s := NewSIO(nil, guestB)
+	err := s.Attach(z, 0x80)
+	if err != nil {
+		t.Fatalf("Can't attach: %s", err)
+	}
+
+	got := make(chan []byte)
+	go func() {
+		buf := make([]byte, 2)
+		n, _ := hostB.Read(buf)
+		got <- buf[:n]
+	}()
+
+	// Vector table at 0x2000, B rx (status affects vector) is entry 4
+	err = z.LoadBytes(0x2004, []byte{0x00, 0x30})
+	if err != nil {
+		t.Fatalf("Can't load vector table: %s", err)
+	}
+	a, err := zog.Assemble(`
+	ORG 3000h
+	IN A, (83h)
+	OUT (83h), A
+	HALT
+`)
+	if err != nil {
+		t.Fatalf("Can't assemble handler: %s", err)
+	}
+	err = z.Load(a)
+	if err != nil {
+		t.Fatalf("Can't load handler: %s", err)
+	}
+
+	go hostB.Write([]byte("v"))
+	runWithTimeout(t, z, `
+	ORG 0100h
+	LD SP, 0f000h
+	LD A, 20h
+	LD I, A
+	LD A, 02h
+	OUT (82h), A
+	LD A, 00h
+	OUT (82h), A
+	LD A, 01h
+	OUT (82h), A
+	LD A, 1ch
+	OUT (82h), A
+	IM 2
+	EI
+	HALT
+	HALT
+`)
+	expectBytes(t, <-got, "v")
+}
@@ -0,0 +1,66 @@
+package speccy
+
+import (
+	"fmt"
+	"image"
+	"sync"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+const heatmapScale = 2
+
+// HeatmapWindow shows a memory heatmap live, alongside the screen
+type HeatmapWindow struct {
+	window   *sdl.Window
+	renderer *sdl.Renderer
+
+	// The last frame, kept until drawn
+	sync.Mutex
+	pix []byte
+}
+
+// ShowHeatmap opens a window which draws heatmap frames as the screen is
+// drawn. Add it to the Zog's heatmap as a sink.
+func (m *Machine) ShowHeatmap() (*HeatmapWindow, error) {
+	window, err := sdl.CreateWindow("Speccy memory", sdl.WINDOWPOS_UNDEFINED, sdl.WINDOWPOS_UNDEFINED,
+		256*heatmapScale, 256*heatmapScale, sdl.WINDOW_SHOWN)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to create window: %s\n", err)
+	}
+	renderer, err := sdl.CreateRenderer(window, -1, sdl.RENDERER_ACCELERATED)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to create renderer: %s\n", err)
+	}
+	renderer.Clear()
+	m.heatmap = &HeatmapWindow{window: window, renderer: renderer}
+	return m.heatmap, nil
+}
+
+func (hw *HeatmapWindow) Frame(img *image.RGBA) error {
+	hw.Lock()
+	defer hw.Unlock()
+	hw.pix = append(hw.pix[:0], img.Pix...)
+	return nil
+}
+
+func (hw *HeatmapWindow) Draw() {
+	hw.Lock()
+	defer hw.Unlock()
+	if len(hw.pix) == 0 {
+		return
+	}
+	for i := 0; i < len(hw.pix)/4; i++ {
+		c := hw.pix[i*4 : i*4+3]
+		hw.renderer.SetDrawColor(c[0], c[1], c[2], 255)
+		rect := sdl.Rect{int32(i%256) * heatmapScale, int32(i/256) * heatmapScale, heatmapScale, heatmapScale}
+		hw.renderer.FillRect(&rect)
+	}
+	hw.renderer.Present()
+	hw.pix = hw.pix[:0]
+}
+
+func (hw *HeatmapWindow) Close() {
+	hw.renderer.Destroy()
+	hw.window.Destroy()
+}
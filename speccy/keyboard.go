@@ -1,8 +1,10 @@
 package speccy
 
 import (
+	"sort"
 	"sync"
 
+	"github.com/jbert/zog"
 	"github.com/veandco/go-sdl2/sdl"
 )
 
@@ -10,6 +12,13 @@ import (
 type keyboardState struct {
 	sync.Mutex
 	keysDown map[sdl.Keycode]struct{}
+	// Passed the hotkeys, which the Spectrum doesn't see
+	hotkey func(zog.Hotkey)
+}
+
+var hotkeys = map[sdl.Keycode]zog.Hotkey{
+	sdl.K_F2: zog.HotkeySaveState,
+	sdl.K_F3: zog.HotkeyLoadState,
 }
 
 func NewKeyboardState() *keyboardState {
@@ -56,12 +65,6 @@ func calcInputByte(addrHi byte, keysdown []sdl.Keycode) byte {
 
 func (ks *keyboardState) keyboardInputHandler(addr uint16) byte {
 	hi := byte(addr >> 8)
-	lo := byte(addr)
-
-	if lo != 0xfe {
-		return 0x00
-	}
-
 	keysdown := ks.keysdown()
 
 	return calcInputByte(hi, keysdown)
@@ -88,6 +91,12 @@ func (ks *keyboardState) update() {
 		switch ev := event.(type) {
 		case *sdl.KeyDownEvent:
 			kc := ev.Keysym.Sym
+			if hk, ok := hotkeys[kc]; ok {
+				if ks.hotkey != nil {
+					ks.hotkey(hk)
+				}
+				continue
+			}
 			//					s := sdl.GetScancodeName(sc)
 			ks.keymove(kc, false)
 			//(*ks)[sc] = struct{}{}
@@ -134,3 +143,24 @@ func (ks *keyboardState) keymove(kc sdl.Keycode, up bool) {
 		}
 	}
 }
+
+// save gives the keys down, in order
+func (ks *keyboardState) save() []sdl.Keycode {
+	ks.Lock()
+	defer ks.Unlock()
+	keys := []sdl.Keycode{}
+	for k := range ks.keysDown {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
+
+func (ks *keyboardState) restore(keys []sdl.Keycode) {
+	ks.Lock()
+	defer ks.Unlock()
+	ks.keysDown = make(map[sdl.Keycode]struct{})
+	for _, k := range keys {
+		ks.keysDown[k] = struct{}{}
+	}
+}
@@ -5,12 +5,15 @@ import (
 	"time"
 
 	"github.com/jbert/zog"
+	"github.com/veandco/go-sdl2/sdl"
 )
 
 type Machine struct {
 	keys   *keyboardState
 	screen *Screen
 	z      *zog.Zog
+	// Nil unless showing a heatmap
+	heatmap *HeatmapWindow
 
 	done chan struct{}
 }
@@ -47,7 +50,12 @@ func (m *Machine) Start() error {
 	if err != nil {
 		return err
 	}
-	m.z.RegisterInputHandler(func(addr uint16) byte { return m.keys.keyboardInputHandler(addr) })
+	m.keys.hotkey = m.z.Hotkey
+	// The ULA decodes A0 only
+	err = m.z.IO.Attach(0x0001, 0x0000, zog.IOFuncs{InFunc: m.keys.keyboardInputHandler})
+	if err != nil {
+		return err
+	}
 	every := time.Second / 50
 	go func() {
 		tick := time.Tick(every)
@@ -57,6 +65,9 @@ func (m *Machine) Start() error {
 				break
 			case <-tick:
 				m.screen.Draw()
+				if m.heatmap != nil {
+					m.heatmap.Draw()
+				}
 				m.z.DoInterrupt()
 			}
 		}
@@ -65,6 +76,40 @@ func (m *Machine) Start() error {
 	return nil
 }
 
+// Save includes the keys down and where the screen is in its flash cycle
+func (m *Machine) Save() (*zog.MachineState, error) {
+	s := m.z.SaveState(m.Name())
+	err := s.SetDevice("keyboard", m.keys.save())
+	if err != nil {
+		return nil, err
+	}
+	err = s.SetDevice("screen", screenState{FlashCount: m.screen.flashCount})
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (m *Machine) Restore(s *zog.MachineState) error {
+	var keys []sdl.Keycode
+	err := s.Device("keyboard", &keys)
+	if err != nil {
+		return err
+	}
+	var ss screenState
+	err = s.Device("screen", &ss)
+	if err != nil {
+		return err
+	}
+	err = m.z.RestoreState(s, m.Name())
+	if err != nil {
+		return err
+	}
+	m.keys.restore(keys)
+	m.screen.flashCount = ss.FlashCount
+	return nil
+}
+
 func (m *Machine) Stop() {
 	close(m.done)
 }
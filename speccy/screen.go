@@ -24,6 +24,10 @@ type Screen struct {
 	flashCount int
 }
 
+type screenState struct {
+	FlashCount int
+}
+
 func NewScreen(mem *zog.Memory) (*Screen, error) {
 	winTitle := "Speccy"
 	window, err := sdl.CreateWindow(winTitle, sdl.WINDOWPOS_UNDEFINED, sdl.WINDOWPOS_UNDEFINED,
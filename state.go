@@ -0,0 +1,201 @@
+package zog
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+)
+
+// MachineStateVersion changes whenever MachineState does, so that old
+// state files are refused rather than misread
+const MachineStateVersion = 1
+
+// MachineState is everything needed to carry on running a machine later:
+// the CPU and memory, and whatever state its devices keep
+type MachineState struct {
+	Version int
+	// The Name of the machine it was saved from
+	Machine string
+
+	Registers  Registers
+	Interrupts InterruptState
+	Halted     bool
+	// An interrupt raised but not yet taken
+	InterruptPending bool
+	InterruptMode    byte
+	Ops              uint64
+	TStates          uint64
+
+	Memory   []byte
+	ReadOnly []RegionState
+
+	// Device state, by device name, as each device wants it
+	Devices map[string]json.RawMessage
+}
+
+// RegionState is a Region as saved, with an exclusive End
+type RegionState struct {
+	Start uint16
+	End   int
+}
+
+// SaveState captures the CPU and memory of a machine. It must not be
+// called while Run is executing, except from a func passed to Do.
+func (z *Zog) SaveState(machine string) *MachineState {
+	// An interrupt may be waiting to be taken
+	select {
+	case imMode := <-z.interruptCh:
+		z.intPending, z.intMode = true, imMode
+	default:
+	}
+
+	s := &MachineState{
+		Version:          MachineStateVersion,
+		Machine:          machine,
+		Registers:        z.reg,
+		Interrupts:       z.is,
+		Halted:           z.halted,
+		InterruptPending: z.intPending,
+		InterruptMode:    z.intMode,
+		Ops:              z.ops,
+		TStates:          z.tstates,
+		Memory:           z.Mem.save(nil),
+		Devices:          make(map[string]json.RawMessage),
+	}
+	z.Mem.Lock()
+	for _, r := range z.Mem.readonly {
+		s.ReadOnly = append(s.ReadOnly, RegionState{r.start, r.end})
+	}
+	z.Mem.Unlock()
+	return s
+}
+
+// RestoreState puts back the CPU and memory saved from the named machine.
+// As with SaveState, Run must not be executing.
+func (z *Zog) RestoreState(s *MachineState, machine string) error {
+	if s.Version != MachineStateVersion {
+		return fmt.Errorf("Can't restore state version %d, expected %d", s.Version, MachineStateVersion)
+	}
+	if s.Machine != machine {
+		return fmt.Errorf("Can't restore state from machine [%s] to [%s]", s.Machine, machine)
+	}
+	if len(s.Memory) != z.Mem.Len() {
+		return fmt.Errorf("Can't restore %d bytes of memory to %d", len(s.Memory), z.Mem.Len())
+	}
+
+	z.reg = s.Registers
+	z.is = s.Interrupts
+	z.halted = s.Halted
+	z.intPending, z.intMode = s.InterruptPending, s.InterruptMode
+	z.ops = s.Ops
+	z.tstates = s.TStates
+	z.Mem.restore(s.Memory)
+	var readonly Regions
+	for _, r := range s.ReadOnly {
+		readonly = append(readonly, Region{r.Start, r.End})
+	}
+	z.Mem.Lock()
+	z.Mem.readonly = readonly
+	z.Mem.Unlock()
+	if z.rewind != nil {
+		z.rewind.reset()
+	}
+	return nil
+}
+
+// SetDevice saves v as the named device's state
+func (s *MachineState) SetDevice(name string, v interface{}) error {
+	buf, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("Can't save %s state: %s", name, err)
+	}
+	if s.Devices == nil {
+		s.Devices = make(map[string]json.RawMessage)
+	}
+	s.Devices[name] = buf
+	return nil
+}
+
+// Device reads the named device's state into v
+func (s *MachineState) Device(name string, v interface{}) error {
+	buf, ok := s.Devices[name]
+	if !ok {
+		return fmt.Errorf("No %s state saved", name)
+	}
+	err := json.Unmarshal(buf, v)
+	if err != nil {
+		return fmt.Errorf("Can't restore %s state: %s", name, err)
+	}
+	return nil
+}
+
+func (s *MachineState) Write(w io.Writer) error {
+	return json.NewEncoder(w).Encode(s)
+}
+
+// ReadMachineState reads a state written by MachineState.Write
+func ReadMachineState(r io.Reader) (*MachineState, error) {
+	s := &MachineState{}
+	err := json.NewDecoder(r).Decode(s)
+	if err != nil {
+		return nil, fmt.Errorf("Can't read state: %s", err)
+	}
+	if s.Version != MachineStateVersion {
+		return nil, fmt.Errorf("Can't read state version %d, expected %d", s.Version, MachineStateVersion)
+	}
+	return s, nil
+}
+
+// SaveMachineFile saves a machine's state to a file
+func SaveMachineFile(m Machine, fname string) error {
+	s, err := m.Save()
+	if err != nil {
+		return err
+	}
+	f, err := os.Create(fname)
+	if err != nil {
+		return fmt.Errorf("Can't create state file: %s", err)
+	}
+	err = s.Write(f)
+	if err != nil {
+		f.Close()
+		return fmt.Errorf("Can't write state file: %s", err)
+	}
+	return f.Close()
+}
+
+// LoadMachineFile restores a machine from a state file
+func LoadMachineFile(m Machine, fname string) error {
+	f, err := os.Open(fname)
+	if err != nil {
+		return fmt.Errorf("Can't open state file: %s", err)
+	}
+	defer f.Close()
+	s, err := ReadMachineState(f)
+	if err != nil {
+		return err
+	}
+	return m.Restore(s)
+}
+
+// Hotkey is something the user asks of the emulator, rather than of the
+// emulated machine, from a machine's keyboard
+type Hotkey int
+
+const (
+	HotkeySaveState Hotkey = iota
+	HotkeyLoadState
+)
+
+// SetHotkeyFunc has machines pass hotkeys pressed to f
+func (z *Zog) SetHotkeyFunc(f func(Hotkey)) {
+	z.hotkeyFunc = f
+}
+
+// Hotkey is called by keyboard devices when a hotkey is pressed
+func (z *Zog) Hotkey(k Hotkey) {
+	if z.hotkeyFunc != nil {
+		z.hotkeyFunc(k)
+	}
+}
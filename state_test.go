@@ -0,0 +1,79 @@
+package zog
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestMachineState(t *testing.T) {
+	z := traceTestZog(t, "\tORG 100h\n\tLD SP, 0F000h\n\tIM 1\n\tEI\nloop:\tINC HL\n\tLD (2000h), HL\n\tJR loop\n")
+	z.Mem.AddReadOnly(NewRegion(0x3000, 0x3100))
+
+	// Save from the running Zog
+	var s *MachineState
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		z.Do(func() {
+			s = z.SaveState("test")
+			z.Stop()
+		})
+	}()
+	err := z.Run()
+	if err != ErrStopped || s == nil {
+		t.Fatalf("Expected to stop after saving, got %v", err)
+	}
+	if s.Registers != z.GetRegisters() || s.Ops != z.Ops() || s.TStates != z.TStates() || s.TStates == 0 {
+		t.Fatalf("Saved %s after %d, stopped at %s after %d", s.Registers.Summary(), s.Ops, z.GetRegisters().Summary(), z.Ops())
+	}
+
+	// With an interrupt waiting
+	go z.DoInterrupt()
+	for i := 0; !s.InterruptPending; i++ {
+		if i > 1000 {
+			t.Fatalf("Interrupt never pending")
+		}
+		time.Sleep(time.Millisecond)
+		s = z.SaveState("test")
+	}
+
+	buf := &bytes.Buffer{}
+	err = s.Write(buf)
+	if err != nil {
+		t.Fatalf("Can't write: %s", err)
+	}
+	s2, err := ReadMachineState(buf)
+	if err != nil {
+		t.Fatalf("Can't read: %s", err)
+	}
+	z2 := New(0)
+	err = z2.RestoreState(s2, "other")
+	if err == nil {
+		t.Fatalf("Restored state from another machine")
+	}
+	err = z2.RestoreState(s2, "test")
+	if err != nil {
+		t.Fatalf("Can't restore: %s", err)
+	}
+	hl := z2.reg.Read16(HL)
+	saved, _ := z2.Mem.Peek16(0x2000)
+	if z2.GetRegisters() != z.GetRegisters() || saved != hl && saved != hl-1 {
+		t.Fatalf("Restored %s with %04X saved", z2.GetRegisters().Summary(), saved)
+	}
+	z2.Mem.Poke(0x3000, 0x42)
+	if n, _ := z2.Mem.Peek(0x3000); n != 0 {
+		t.Fatalf("Restored ROM was written")
+	}
+	_, err = z2.step()
+	if err != nil || z2.reg.PC != 0x38 {
+		t.Fatalf("Pending interrupt not taken, at %04X: %v", z2.reg.PC, err)
+	}
+
+	s2.Version++
+	buf.Reset()
+	s2.Write(buf)
+	_, err = ReadMachineState(buf)
+	if err == nil {
+		t.Fatalf("Read state from the future")
+	}
+}
@@ -0,0 +1,424 @@
+package zog
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// SymbolTable names addresses, so that disassembly and traces can show
+// CALL PRINT_OUT rather than CALL 0x09F4. Variables, such as the
+// Spectrum's system variables, may be longer than a byte and have a
+// description.
+type SymbolTable struct {
+	names map[uint16]string
+	vars  map[uint16]symVar
+	// The size of the largest variable, which is as far back as an
+	// address can be from the start of its variable
+	longest int
+}
+
+type symVar struct {
+	name string
+	size int
+	desc string
+}
+
+func NewSymbolTable() *SymbolTable {
+	return &SymbolTable{names: make(map[uint16]string), vars: make(map[uint16]symVar)}
+}
+
+// Add names an address. A later name for the same address replaces an
+// earlier one.
+func (st *SymbolTable) Add(addr uint16, name string) {
+	st.names[addr] = name
+}
+
+// AddVariable names size bytes of data at an address
+func (st *SymbolTable) AddVariable(addr uint16, name string, size int, desc string) {
+	st.names[addr] = name
+	st.vars[addr] = symVar{name: name, size: size, desc: desc}
+	if size > st.longest {
+		st.longest = size
+	}
+}
+
+// Merge adds all the symbols from another table
+func (st *SymbolTable) Merge(other *SymbolTable) {
+	for addr, name := range other.names {
+		st.names[addr] = name
+	}
+	for addr, v := range other.vars {
+		st.AddVariable(addr, v.name, v.size, v.desc)
+	}
+}
+
+// Name gives the name of an address
+func (st *SymbolTable) Name(addr uint16) (string, bool) {
+	name, ok := st.names[addr]
+	return name, ok
+}
+
+// Describe names an address, and gives the description of the variable
+// it is part of if it is one. Bytes after the first of a variable are
+// named like FRAMES+1.
+func (st *SymbolTable) Describe(addr uint16) (string, string, bool) {
+	for off := 0; off < st.longest && off <= int(addr); off++ {
+		v, ok := st.vars[addr-uint16(off)]
+		if ok && off < v.size {
+			name := v.name
+			if off > 0 {
+				name = fmt.Sprintf("%s+%d", name, off)
+			}
+			return name, v.desc, true
+		}
+	}
+	name, ok := st.names[addr]
+	return name, "", ok
+}
+
+// Word sized numbers in the text of an instruction, which are addresses
+// or could be
+var addrLiteral = regexp.MustCompile(`0x[0-9A-F]{4}\b`)
+
+// Comments gives a note for each address in the text of an instruction
+// which has a name
+func (st *SymbolTable) Comments(text string) []string {
+	var comments []string
+	for _, lit := range addrLiteral.FindAllString(text, -1) {
+		n, _ := strconv.ParseUint(lit[2:], 16, 16)
+		name, desc, ok := st.Describe(uint16(n))
+		if !ok {
+			continue
+		}
+		if desc != "" {
+			name += ": " + desc
+		}
+		comments = append(comments, name)
+	}
+	return comments
+}
+
+// Annotate gives the text of an instruction with named addresses replaced
+// by their names, followed by comments for any variables
+func (st *SymbolTable) Annotate(text string) string {
+	var comments []string
+	text = addrLiteral.ReplaceAllStringFunc(text, func(lit string) string {
+		n, _ := strconv.ParseUint(lit[2:], 16, 16)
+		name, desc, ok := st.Describe(uint16(n))
+		if !ok {
+			return lit
+		}
+		if desc != "" {
+			comments = append(comments, name+": "+desc)
+		}
+		return name
+	})
+	if len(comments) > 0 {
+		text += " ; " + strings.Join(comments, ", ")
+	}
+	return text
+}
+
+// LoadSymbols reads a comma separated list of symbol files and names of
+// built in symbol sets. Later names for an address replace earlier ones.
+func LoadSymbols(names string) (*SymbolTable, error) {
+	st := NewSymbolTable()
+	for _, name := range strings.Split(names, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if builtin, ok := BuiltinSymbols[strings.ToLower(name)]; ok {
+			st.Merge(builtin())
+			continue
+		}
+		f, err := os.Open(name)
+		if err != nil {
+			return nil, fmt.Errorf("Can't read symbols: %s", err)
+		}
+		other, err := ReadSymbols(f)
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", name, err)
+		}
+		st.Merge(other)
+	}
+	return st, nil
+}
+
+// ReadSymbols reads a source map written by zogas -map, or a symbol file
+// of the kind most assemblers write. That is a symbol to a line, in one of
+// the forms
+//
+//	name: EQU 0x1234	(zogas -sym and sjasmplus)
+//	name equ 1234h
+//	name = $1234
+//	name 1234	(zmac, where the value is always hex)
+//
+// Comments start with ;.
+func ReadSymbols(r io.Reader) (*SymbolTable, error) {
+	buf, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("Can't read symbols: %s", err)
+	}
+	if bytes.HasPrefix(bytes.TrimSpace(buf), []byte("{")) {
+		sm := &SourceMap{}
+		err = json.Unmarshal(buf, sm)
+		if err != nil {
+			return nil, fmt.Errorf("Can't read source map: %s", err)
+		}
+		st := NewSymbolTable()
+		for _, name := range sortedNames(sm.Symbols) {
+			st.Add(uint16(sm.Symbols[name]), name)
+		}
+		return st, nil
+	}
+
+	st := NewSymbolTable()
+	scanner := bufio.NewScanner(bytes.NewReader(buf))
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := scanner.Text()
+		if i := strings.Index(line, ";"); i >= 0 {
+			line = line[:i]
+		}
+		fields := strings.Fields(strings.Replace(line, "=", " = ", 1))
+		if len(fields) == 0 {
+			continue
+		}
+		if strings.EqualFold(fields[0], "defc") {
+			fields = fields[1:]
+		}
+		name := strings.TrimSuffix(fields[0], ":")
+
+		var v int
+		switch {
+		case len(fields) == 3 && (strings.EqualFold(fields[1], "equ") || fields[1] == "="):
+			v, err = parseSymbolValue(fields[2])
+		case len(fields) == 2:
+			var n uint64
+			n, err = strconv.ParseUint(strings.TrimRight(fields[1], "'\""), 16, 32)
+			v = int(n)
+		default:
+			err = fmt.Errorf("unrecognised symbol")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("Line %d: can't read symbol [%s]: %s", lineNum, strings.TrimSpace(line), err)
+		}
+		st.Add(uint16(v), name)
+	}
+	return st, scanner.Err()
+}
+
+func sortedNames(values map[string]int) []string {
+	var names []string
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// parseSymbolValue reads a number in any of the usual assembler forms
+func parseSymbolValue(s string) (int, error) {
+	base := 10
+	lower := strings.ToLower(s)
+	switch {
+	case strings.HasPrefix(lower, "0x"):
+		s, base = s[2:], 16
+	case strings.HasPrefix(s, "$"), strings.HasPrefix(s, "#"):
+		s, base = s[1:], 16
+	case strings.HasSuffix(lower, "h"):
+		s, base = s[:len(s)-1], 16
+	}
+	n, err := strconv.ParseInt(s, base, 64)
+	if err != nil {
+		return 0, err
+	}
+	return int(n), nil
+}
+
+// BuiltinSymbols are symbol sets which can be asked for by name instead
+// of a file
+var BuiltinSymbols = map[string]func() *SymbolTable{
+	"spectrum48k": Spectrum48KSymbols,
+	"cpm":         CPMSymbols,
+}
+
+// Spectrum48KSymbols gives the well known entry points of the 48K ROM, as
+// named in The Complete Spectrum ROM Disassembly, and the system
+// variables
+func Spectrum48KSymbols() *SymbolTable {
+	st := NewSymbolTable()
+	for _, s := range spectrumROM {
+		st.Add(s.addr, s.name)
+	}
+	for _, v := range spectrumSysVars {
+		st.AddVariable(v.addr, v.name, v.size, v.desc)
+	}
+	return st
+}
+
+// CPMSymbols gives the fixed addresses in page zero of CP/M
+func CPMSymbols() *SymbolTable {
+	st := NewSymbolTable()
+	st.Add(0x0000, "WBOOT")
+	st.AddVariable(0x0003, "IOBYTE", 1, "I/O device assignment")
+	st.AddVariable(0x0004, "CDISK", 1, "current drive and user")
+	st.Add(0x0005, "BDOS")
+	st.AddVariable(0x005c, "FCB", 36, "default file control block")
+	st.AddVariable(0x0080, "DBUFF", 128, "default DMA buffer")
+	st.Add(0x0100, "TPA")
+	return st
+}
+
+var spectrumROM = []struct {
+	addr uint16
+	name string
+}{
+	{0x0000, "START"},
+	{0x0008, "ERROR_1"},
+	{0x0010, "PRINT_A_1"},
+	{0x0018, "GET_CHAR"},
+	{0x0020, "NEXT_CHAR"},
+	{0x0028, "FP_CALC"},
+	{0x0030, "BC_SPACES"},
+	{0x0038, "MASK_INT"},
+	{0x0053, "ERROR_2"},
+	{0x0066, "RESET"},
+	{0x0074, "CH_ADD_1"},
+	{0x007D, "SKIP_OVER"},
+	{0x0095, "TKN_TABLE"},
+	{0x0205, "KEY_TABLE"},
+	{0x028E, "KEY_SCAN"},
+	{0x02BF, "KEYBOARD"},
+	{0x03B5, "BEEPER"},
+	{0x03F8, "BEEP"},
+	{0x04C2, "SA_BYTES"},
+	{0x053F, "SA_LD_RET"},
+	{0x0556, "LD_BYTES"},
+	{0x05E3, "LD_EDGE_2"},
+	{0x05E7, "LD_EDGE_1"},
+	{0x0605, "SAVE_ETC"},
+	{0x0802, "LD_BLOCK"},
+	{0x0970, "SA_CONTRL"},
+	{0x09F4, "PRINT_OUT"},
+	{0x0C0A, "PO_MSG"},
+	{0x0D6B, "CLS"},
+	{0x0D6E, "CLS_LOWER"},
+	{0x0DAF, "CL_ALL"},
+	{0x0DD9, "CL_SET"},
+	{0x0E44, "CL_LINE"},
+	{0x0E9B, "CL_ADDR"},
+	{0x0EAC, "COPY"},
+	{0x0F2C, "EDITOR"},
+	{0x10A8, "KEY_INPUT"},
+	{0x11B7, "NEW"},
+	{0x11CB, "START_NEW"},
+	{0x12A2, "MAIN_EXEC"},
+	{0x15D4, "WAIT_KEY"},
+	{0x15F2, "PRINT_A_2"},
+	{0x1601, "CHAN_OPEN"},
+	{0x1855, "OUT_LINE"},
+	{0x1A1B, "OUT_NUM_1"},
+	{0x1E94, "FIND_INT1"},
+	{0x1E99, "FIND_INT2"},
+	{0x1F3A, "PAUSE"},
+	{0x203C, "PR_STRING"},
+	{0x2294, "BORDER"},
+	{0x22AA, "PIXEL_ADD"},
+	{0x22DC, "PLOT"},
+	{0x22E5, "PLOT_SUB"},
+	{0x2AB6, "STK_STORE"},
+	{0x2BF1, "STK_FETCH"},
+	{0x2D28, "STACK_A"},
+	{0x2D2B, "STACK_BC"},
+	{0x2DA2, "FP_TO_BC"},
+	{0x2DD5, "FP_TO_A"},
+	{0x2DE3, "PRINT_FP"},
+	{0x3D00, "CHAR_SET"},
+}
+
+var spectrumSysVars = []struct {
+	addr uint16
+	name string
+	size int
+	desc string
+}{
+	{0x5C00, "KSTATE", 8, "keyboard state"},
+	{0x5C08, "LAST_K", 1, "last key pressed"},
+	{0x5C09, "REPDEL", 1, "delay before key repeat"},
+	{0x5C0A, "REPPER", 1, "delay between key repeats"},
+	{0x5C0B, "DEFADD", 2, "arguments of user defined function"},
+	{0x5C0D, "K_DATA", 1, "second byte of colour controls"},
+	{0x5C0E, "TVDATA", 2, "colour and AT controls"},
+	{0x5C10, "STRMS", 38, "channel addresses of streams"},
+	{0x5C36, "CHARS", 2, "character set less 256"},
+	{0x5C38, "RASP", 1, "length of warning buzz"},
+	{0x5C39, "PIP", 1, "length of keyboard click"},
+	{0x5C3A, "ERR_NR", 1, "report code less one"},
+	{0x5C3B, "FLAGS", 1, "BASIC flags"},
+	{0x5C3C, "TV_FLAG", 1, "television flags"},
+	{0x5C3D, "ERR_SP", 2, "error return address on stack"},
+	{0x5C3F, "LIST_SP", 2, "return address from automatic listing"},
+	{0x5C41, "MODE", 1, "K, L, C, E or G cursor"},
+	{0x5C42, "NEWPPC", 2, "line to jump to"},
+	{0x5C44, "NSPPC", 1, "statement to jump to"},
+	{0x5C45, "PPC", 2, "line being executed"},
+	{0x5C47, "SUBPPC", 1, "statement being executed"},
+	{0x5C48, "BORDCR", 1, "border colour"},
+	{0x5C49, "E_PPC", 2, "current line"},
+	{0x5C4B, "VARS", 2, "variables"},
+	{0x5C4D, "DEST", 2, "variable being assigned"},
+	{0x5C4F, "CHANS", 2, "channel data"},
+	{0x5C51, "CURCHL", 2, "current channel"},
+	{0x5C53, "PROG", 2, "BASIC program"},
+	{0x5C55, "NXTLIN", 2, "next line of program"},
+	{0x5C57, "DATADD", 2, "last DATA item"},
+	{0x5C59, "E_LINE", 2, "command being typed"},
+	{0x5C5B, "K_CUR", 2, "cursor"},
+	{0x5C5D, "CH_ADD", 2, "next character to interpret"},
+	{0x5C5F, "X_PTR", 2, "character after syntax error"},
+	{0x5C61, "WORKSP", 2, "temporary work space"},
+	{0x5C63, "STKBOT", 2, "bottom of calculator stack"},
+	{0x5C65, "STKEND", 2, "start of spare space"},
+	{0x5C67, "BREG", 1, "calculator's B register"},
+	{0x5C68, "MEM", 2, "calculator's memory"},
+	{0x5C6A, "FLAGS2", 1, "more flags"},
+	{0x5C6B, "DF_SZ", 1, "lines in lower screen"},
+	{0x5C6C, "S_TOP", 2, "top line of automatic listing"},
+	{0x5C6E, "OLDPPC", 2, "line CONTINUE goes to"},
+	{0x5C70, "OSPCC", 1, "statement CONTINUE goes to"},
+	{0x5C71, "FLAGX", 1, "various flags"},
+	{0x5C72, "STRLEN", 2, "length of string being assigned"},
+	{0x5C74, "T_ADDR", 2, "next item in syntax table"},
+	{0x5C76, "SEED", 2, "seed for RND"},
+	{0x5C78, "FRAMES", 3, "frame counter"},
+	{0x5C7B, "UDG", 2, "first user defined graphic"},
+	{0x5C7D, "COORDS", 2, "last point plotted"},
+	{0x5C7F, "P_POSN", 1, "printer column"},
+	{0x5C80, "PR_CC", 2, "printer buffer position"},
+	{0x5C82, "ECHO_E", 2, "end of input buffer"},
+	{0x5C84, "DF_CC", 2, "print position in display file"},
+	{0x5C86, "DFCCL", 2, "print position in lower screen"},
+	{0x5C88, "S_POSN", 2, "print column and line"},
+	{0x5C8A, "SPOSNL", 2, "lower screen print column and line"},
+	{0x5C8C, "SCR_CT", 1, "scrolls before scroll? prompt"},
+	{0x5C8D, "ATTR_P", 1, "permanent colours"},
+	{0x5C8E, "MASK_P", 1, "permanent transparent colours"},
+	{0x5C8F, "ATTR_T", 1, "temporary colours"},
+	{0x5C90, "MASK_T", 1, "temporary transparent colours"},
+	{0x5C91, "P_FLAG", 1, "print flags"},
+	{0x5C92, "MEMBOT", 30, "calculator's memory area"},
+	{0x5CB0, "NMIADD", 2, "unused"},
+	{0x5CB2, "RAMTOP", 2, "last byte of BASIC system area"},
+	{0x5CB4, "P_RAMT", 2, "last byte of RAM"},
+}
@@ -0,0 +1,126 @@
+package zog
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestReadSymbols(t *testing.T) {
+	testCases := []struct {
+		text string
+		name string
+		addr uint16
+	}{
+		{"start: EQU 0x00008000\n", "start", 0x8000},
+		{"; comment\nloop equ 1234h\n", "loop", 0x1234},
+		{"print = $09F4 ; ROM\n", "print", 0x09f4},
+		{"DEFC done = #4000\n", "done", 0x4000},
+		{"value: equ 100\n", "value", 100},
+		{"delay\t0105\n", "delay", 0x105},
+	}
+	for _, tc := range testCases {
+		st, err := ReadSymbols(strings.NewReader(tc.text))
+		if err != nil {
+			t.Fatalf("Failed to read [%s]: %s", tc.text, err)
+		}
+		name, ok := st.Name(tc.addr)
+		if !ok || name != tc.name {
+			t.Fatalf("Reading [%s] got [%s] at %04X, expected [%s]", tc.text, name, tc.addr, tc.name)
+		}
+	}
+
+	_, err := ReadSymbols(strings.NewReader("what is this\n"))
+	if err == nil {
+		t.Fatalf("Expected an error reading nonsense")
+	}
+
+	// zogas -sym and -map
+	assembly, err := Assemble("\torg 8000h\nstart:\tnop\nend:\tjr start\n")
+	if err != nil {
+		t.Fatalf("Failed to assemble: %s", err)
+	}
+	for _, write := range []func(*bytes.Buffer) error{
+		func(buf *bytes.Buffer) error { return assembly.WriteSymbols(buf) },
+		func(buf *bytes.Buffer) error { return assembly.SourceMap().Write(buf) },
+	} {
+		buf := &bytes.Buffer{}
+		err = write(buf)
+		if err != nil {
+			t.Fatalf("Failed to write: %s", err)
+		}
+		st, err := ReadSymbols(buf)
+		if err != nil {
+			t.Fatalf("Failed to read: %s", err)
+		}
+		if name, _ := st.Name(0x8001); name != "end" {
+			t.Fatalf("Got [%s] at 8001, expected end", name)
+		}
+	}
+}
+
+func TestSpectrumSymbols(t *testing.T) {
+	st, err := LoadSymbols("Spectrum48K")
+	if err != nil {
+		t.Fatalf("Failed to load: %s", err)
+	}
+	testCases := []struct {
+		text     string
+		expected string
+	}{
+		{"CALL 0x09F4", "CALL PRINT_OUT"},
+		{"LD HL, (0x5C78)", "LD HL, (FRAMES) ; FRAMES: frame counter"},
+		{"LD A, (0x5C7A)", "LD A, (FRAMES+2) ; FRAMES+2: frame counter"},
+		{"LD A, 0x0A", "LD A, 0x0A"},
+		{"LD BC, 0x1234", "LD BC, 0x1234"},
+	}
+	for _, tc := range testCases {
+		got := st.Annotate(tc.text)
+		if got != tc.expected {
+			t.Fatalf("Annotating [%s] got [%s] expected [%s]", tc.text, got, tc.expected)
+		}
+	}
+}
+
+func TestDisassembleSymbols(t *testing.T) {
+	assembly, err := Assemble("\torg 8000h\n\tcall 09f4h\n\tld hl, (5c78h)\n\tjr $-6\n")
+	if err != nil {
+		t.Fatalf("Failed to assemble: %s", err)
+	}
+	buf, err := assembly.Encode()
+	if err != nil {
+		t.Fatalf("Failed to encode: %s", err)
+	}
+	d, err := Disassemble(buf, 0x8000, []uint16{0x8000})
+	if err != nil {
+		t.Fatalf("Failed to disassemble: %s", err)
+	}
+	st := Spectrum48KSymbols()
+	st.Add(0x8000, "main")
+	d.Annotate(st)
+	src := &bytes.Buffer{}
+	err = d.Write(src)
+	if err != nil {
+		t.Fatalf("Failed to write: %s", err)
+	}
+
+	expected := []string{
+		"PRINT_OUT\tEQU 0x09F4",
+		"main:\tCALL PRINT_OUT",
+		"\tLD HL, (0x5C78)\t; FRAMES: frame counter",
+		"\tJR main",
+	}
+	for _, line := range expected {
+		if !strings.Contains(src.String(), line+"\n") {
+			t.Fatalf("Disassembly doesn't contain [%s]:\n%s", line, src)
+		}
+	}
+	again, err := Assemble(src.String())
+	if err != nil {
+		t.Fatalf("Failed to assemble disassembly: %s\n%s", err, src)
+	}
+	againBuf, _ := again.Encode()
+	if !bytes.Equal(againBuf, buf) {
+		t.Fatalf("Disassembly doesn't assemble to the same bytes:\n%s", src)
+	}
+}
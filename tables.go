@@ -6,7 +6,7 @@ import (
 )
 
 type DecodeTable struct {
-	r      io.Reader
+	r      io.ByteReader
 	wantIX bool
 	wantIY bool
 }
@@ -27,7 +27,56 @@ var tableBLI [][]Instruction = [][]Instruction{
 	[]Instruction{LDDR, CPDR, INDR, OTDR},
 }
 
-func NewDecodeTable(r io.Reader) *DecodeTable {
+// An opcode is what a byte decodes to after its prefixes. Most need no
+// more bytes, so always decode to the same instruction, which is built
+// once. The rest are decoded each time with their operands.
+type opcode struct {
+	inst Instruction
+}
+
+// Dispatch tables for unprefixed, DD, FD, CB and ED instructions.
+// DDCB and FDCB are cached by the Decoder as it sees them.
+var opTables [5][256]opcode
+
+var tablePrefixes = [5][2]byte{{0, 0}, {0, 0xdd}, {0, 0xfd}, {0xcb, 0}, {0xed, 0}}
+
+func tableIndex(opPrefix, indexPrefix byte) int {
+	switch opPrefix {
+	case 0xcb:
+		return 3
+	case 0xed:
+		return 4
+	}
+	switch indexPrefix {
+	case 0xdd:
+		return 1
+	case 0xfd:
+		return 2
+	}
+	return 0
+}
+
+func init() {
+	for i, prefixes := range tablePrefixes {
+		opPrefix, indexPrefix := prefixes[0], prefixes[1]
+		for n := 0; n < 256; n++ {
+			if opPrefix == 0 && (n == 0xcb || n == 0xdd || n == 0xed || n == 0xfd) {
+				// Prefixes, which never get as far as the table
+				continue
+			}
+			// Decode with nothing after the opcode, so any which need
+			// operands fail
+			d := NewBytesDecoder(nil, 0)
+			d.t.ResetPrefix(indexPrefix)
+			inst, err := decodeOpcode(&d.t, d, opPrefix, indexPrefix, byte(n))
+			if err == nil && d.err == nil {
+				opTables[i][n].inst = inst
+			}
+		}
+	}
+}
+
+func NewDecodeTable(r io.ByteReader) *DecodeTable {
 	return &DecodeTable{r: r}
 }
 
@@ -60,14 +109,11 @@ func (t *DecodeTable) LookupR(i byte) Loc8 {
 			l = IYL
 		}
 	case 6: // (HL)
-		d, err := getImmd(t.r)
-		// TODO: panic is messy here- opens us up to panic on decode
-		if err != nil {
-			panic(fmt.Errorf("Can't get index displacemnt: %s", err))
-		}
-		l = IndexedContents{IX, d}
+		// The Decoder notes if this fails and discards the instruction
+		d, _ := getImmd(t.r)
+		l = IndexedContents{addr: IX, d: d}
 		if t.wantIY {
-			l = IndexedContents{IY, d}
+			l = IndexedContents{addr: IY, d: d}
 		}
 	}
 
@@ -152,7 +198,7 @@ func findInTableR(l Loc8) byte {
 			return byte(i)
 		}
 	}
-	panic(fmt.Sprintf("Not found in table R: %s", l))
+	panic(operandError(fmt.Sprintf("Not found in table R: %s", l)))
 }
 
 func findInTableRP(l Loc16) byte {
@@ -161,7 +207,7 @@ func findInTableRP(l Loc16) byte {
 			return byte(i)
 		}
 	}
-	panic(fmt.Sprintf("Not found in table RP: %s", l))
+	panic(operandError(fmt.Sprintf("Not found in table RP: %s", l)))
 }
 
 func findInTableRP2(l Loc16) byte {
@@ -170,7 +216,7 @@ func findInTableRP2(l Loc16) byte {
 			return byte(i)
 		}
 	}
-	panic(fmt.Sprintf("Not found in table RP2: %s", l))
+	panic(operandError(fmt.Sprintf("Not found in table RP2: %s", l)))
 }
 
 func findInTableALU(name string) byte {
@@ -189,7 +235,7 @@ func findInTableCC(c Conditional) byte {
 			return byte(i)
 		}
 	}
-	panic(fmt.Sprintf("Not found in tableCC: %s", c))
+	panic(operandError(fmt.Sprintf("Not found in tableCC: %s", c)))
 }
 
 func findInTableROT(name string) byte {
@@ -0,0 +1,399 @@
+package zog
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// MaxTraceAccesses is how many memory reads and writes a trace record
+// holds. EX (SP), HL reads and writes two bytes each, and nothing touches
+// more.
+const MaxTraceAccesses = 4
+
+// MemAccess is a read or write of a watched address. A read has the byte
+// read as both Old and New.
+type MemAccess struct {
+	Addr uint16
+	Old  byte
+	New  byte
+	Read bool
+}
+
+// TraceRecord is an executed instruction and the registers after it.
+// Records are fixed size, so a ring of them can be reused forever.
+type TraceRecord struct {
+	Ops uint64
+	PC  uint16
+	// The last bytes of the instruction, which decode to the same
+	// instruction when there are redundant prefixes. Len is the full
+	// length.
+	Bytes [4]byte
+	Len   uint8
+	// Taken because of an interrupt, rather than fetched from PC
+	Interrupt bool
+	Inst      Instruction
+	Reg       Registers
+
+	Accesses    [MaxTraceAccesses]MemAccess
+	NumAccesses uint8
+}
+
+func (rec *TraceRecord) setBytes(buf []byte) {
+	rec.Len = uint8(len(buf))
+	if len(buf) > len(rec.Bytes) {
+		buf = buf[len(buf)-len(rec.Bytes):]
+	}
+	copy(rec.Bytes[:], buf)
+}
+
+func (rec *TraceRecord) addAccess(addr uint16, old, new byte, read bool) {
+	if int(rec.NumAccesses) < len(rec.Accesses) {
+		rec.Accesses[rec.NumAccesses] = MemAccess{Addr: addr, Old: old, New: new, Read: read}
+		rec.NumAccesses++
+	}
+}
+
+func (rec *TraceRecord) String() string {
+	return rec.Format(nil)
+}
+
+// Format gives the record as a line of text, naming addresses from a
+// symbol table if there is one
+func (rec *TraceRecord) Format(st *SymbolTable) string {
+	var s string
+	inst := ""
+	if rec.Inst != nil {
+		inst = rec.Inst.String()
+	}
+	if st == nil {
+		s = fmt.Sprintf("%d: %04X %s %s", rec.Ops, rec.PC, rec.Reg.Summary(), inst)
+	} else {
+		pc := fmt.Sprintf("%04X", rec.PC)
+		if name, ok := st.Name(rec.PC); ok {
+			pc += " " + name + ":"
+		}
+		s = fmt.Sprintf("%d: %s %s %s", rec.Ops, pc, rec.Reg.Summary(), st.Annotate(inst))
+	}
+	for _, a := range rec.Accesses[:rec.NumAccesses] {
+		if a.Read {
+			s += fmt.Sprintf(" R:%04X [%02X]", a.Addr, a.New)
+		} else {
+			s += fmt.Sprintf(" W:%04X [%02X->%02X]", a.Addr, a.Old, a.New)
+		}
+		if st != nil {
+			if name, _, ok := st.Describe(a.Addr); ok {
+				s += " " + name
+			}
+		}
+	}
+	return s
+}
+
+// TraceSink receives trace records. The record is reused once Trace
+// returns, so sinks must copy anything they keep.
+type TraceSink interface {
+	Trace(rec *TraceRecord) error
+}
+
+// TraceFilter picks the records a sink is sent
+type TraceFilter func(rec *TraceRecord) bool
+
+// Filter passes records for instructions in the regions
+func (rs Regions) Filter() TraceFilter {
+	return func(rec *TraceRecord) bool {
+		return rs.contains(rec.PC)
+	}
+}
+
+// TextTraceSink writes records a line at a time
+type TextTraceSink struct {
+	w       io.Writer
+	symbols *SymbolTable
+}
+
+func NewTextTraceSink(w io.Writer, st *SymbolTable) *TextTraceSink {
+	return &TextTraceSink{w: w, symbols: st}
+}
+
+func (s *TextTraceSink) Trace(rec *TraceRecord) error {
+	_, err := fmt.Fprintln(s.w, rec.Format(s.symbols))
+	return err
+}
+
+// ChanTraceSink sends a copy of each record down a channel. Execution
+// waits for the reader.
+type ChanTraceSink chan<- TraceRecord
+
+func (c ChanTraceSink) Trace(rec *TraceRecord) error {
+	c <- *rec
+	return nil
+}
+
+// traceRecordSize is the size of a record in binary: ops, PC, length,
+// flags, bytes, the registers and the accesses
+const traceRecordSize = 8 + 2 + 1 + 1 + 4 + 26 + 1 + MaxTraceAccesses*4
+
+const (
+	traceFlagInterrupt = 0x01
+	// Shifted left by the number of each access which is a read
+	traceFlagRead = 0x02
+)
+
+// MarshalBinary gives the record in a fixed size, little endian form.
+// The instruction itself isn't included, since it can be decoded from
+// the bytes.
+func (rec *TraceRecord) MarshalBinary() ([]byte, error) {
+	buf := make([]byte, traceRecordSize)
+	rec.marshal(buf)
+	return buf, nil
+}
+
+func (rec *TraceRecord) marshal(buf []byte) {
+	le := binary.LittleEndian
+	le.PutUint64(buf[0:], rec.Ops)
+	le.PutUint16(buf[8:], rec.PC)
+	buf[10] = rec.Len
+	buf[11] = 0
+	if rec.Interrupt {
+		buf[11] |= traceFlagInterrupt
+	}
+	copy(buf[12:16], rec.Bytes[:])
+	r := &rec.Reg
+	copy(buf[16:], []byte{
+		r.A, r.F, r.B, r.C, r.D, r.E, r.H, r.L,
+		r.IXH, r.IXL, r.IYH, r.IYL, r.I, r.R,
+		r.A_PRIME, r.F_PRIME, r.B_PRIME, r.C_PRIME, r.D_PRIME, r.E_PRIME, r.H_PRIME, r.L_PRIME,
+	})
+	le.PutUint16(buf[38:], r.SP)
+	le.PutUint16(buf[40:], r.PC)
+	buf[42] = rec.NumAccesses
+	for i, a := range rec.Accesses {
+		off := 43 + i*4
+		le.PutUint16(buf[off:], a.Addr)
+		buf[off+2] = a.Old
+		buf[off+3] = a.New
+		if a.Read {
+			buf[11] |= traceFlagRead << uint(i)
+		}
+	}
+}
+
+// UnmarshalBinary reads a record written by MarshalBinary, decoding its
+// instruction again
+func (rec *TraceRecord) UnmarshalBinary(buf []byte) error {
+	if len(buf) != traceRecordSize {
+		return fmt.Errorf("Trace record is %d bytes, expected %d", len(buf), traceRecordSize)
+	}
+	le := binary.LittleEndian
+	rec.Ops = le.Uint64(buf[0:])
+	rec.PC = le.Uint16(buf[8:])
+	rec.Len = buf[10]
+	rec.Interrupt = buf[11]&traceFlagInterrupt != 0
+	copy(rec.Bytes[:], buf[12:16])
+	r := &rec.Reg
+	for i, p := range []*byte{
+		&r.A, &r.F, &r.B, &r.C, &r.D, &r.E, &r.H, &r.L,
+		&r.IXH, &r.IXL, &r.IYH, &r.IYL, &r.I, &r.R,
+		&r.A_PRIME, &r.F_PRIME, &r.B_PRIME, &r.C_PRIME, &r.D_PRIME, &r.E_PRIME, &r.H_PRIME, &r.L_PRIME,
+	} {
+		*p = buf[16+i]
+	}
+	r.SP = le.Uint16(buf[38:])
+	r.PC = le.Uint16(buf[40:])
+	rec.NumAccesses = buf[42]
+	if int(rec.NumAccesses) > MaxTraceAccesses {
+		return fmt.Errorf("Trace record has %d accesses, at most %d allowed", rec.NumAccesses, MaxTraceAccesses)
+	}
+	for i := range rec.Accesses {
+		off := 43 + i*4
+		rec.Accesses[i] = MemAccess{Addr: le.Uint16(buf[off:]), Old: buf[off+2], New: buf[off+3], Read: buf[11]&(traceFlagRead<<uint(i)) != 0}
+	}
+
+	n := int(rec.Len)
+	if n > len(rec.Bytes) {
+		n = len(rec.Bytes)
+	}
+	rec.Inst = nil
+	if n > 0 {
+		decoded, err := NewBytesDecoder(rec.Bytes[:n], rec.PC).Decode()
+		if err != nil {
+			return fmt.Errorf("Can't decode instruction at %04X: %s", rec.PC, err)
+		}
+		rec.Inst = decoded.Inst
+	}
+	return nil
+}
+
+// A binary trace file starts with a magic string, then a version and the
+// size of each record. Version 1 records hold only writes; version 2 flags
+// reads among them.
+const traceMagic = "ZOGTRACE"
+const traceVersion = 2
+
+// BinaryTraceSink writes a trace file of records in their binary form
+type BinaryTraceSink struct {
+	w   *bufio.Writer
+	buf [traceRecordSize]byte
+}
+
+func NewBinaryTraceSink(w io.Writer) (*BinaryTraceSink, error) {
+	s := &BinaryTraceSink{w: bufio.NewWriter(w)}
+	header := append([]byte(traceMagic), traceVersion, traceRecordSize)
+	_, err := s.w.Write(header)
+	if err != nil {
+		return nil, fmt.Errorf("Can't write trace header: %s", err)
+	}
+	return s, nil
+}
+
+func (s *BinaryTraceSink) Trace(rec *TraceRecord) error {
+	rec.marshal(s.buf[:])
+	_, err := s.w.Write(s.buf[:])
+	return err
+}
+
+func (s *BinaryTraceSink) Flush() error {
+	return s.w.Flush()
+}
+
+// TraceReader reads a trace file written by a BinaryTraceSink
+type TraceReader struct {
+	r       *bufio.Reader
+	buf     [traceRecordSize]byte
+	version byte
+}
+
+func NewTraceReader(r io.Reader) (*TraceReader, error) {
+	tr := &TraceReader{r: bufio.NewReader(r)}
+	header := make([]byte, len(traceMagic)+2)
+	_, err := io.ReadFull(tr.r, header)
+	if err != nil {
+		return nil, fmt.Errorf("Can't read trace header: %s", err)
+	}
+	if string(header[:len(traceMagic)]) != traceMagic {
+		return nil, fmt.Errorf("Not a zog trace file")
+	}
+	version, size := header[len(traceMagic)], header[len(traceMagic)+1]
+	if version < 1 || version > traceVersion || size != traceRecordSize {
+		return nil, fmt.Errorf("Can't read trace version %d with %d byte records", version, size)
+	}
+	tr.version = version
+	return tr, nil
+}
+
+// Read reads the next record, giving io.EOF after the last
+func (tr *TraceReader) Read(rec *TraceRecord) error {
+	_, err := io.ReadFull(tr.r, tr.buf[:])
+	if err == io.ErrUnexpectedEOF {
+		return fmt.Errorf("Trace ends part way through a record")
+	}
+	if err != nil {
+		return err
+	}
+	return rec.UnmarshalBinary(tr.buf[:])
+}
+
+// Fields are the parts of each record which a trace holds. Version 1
+// traces don't have reads.
+func (tr *TraceReader) Fields() []string {
+	fields := append(append([]string{}, traceRegisters...), "writes")
+	if tr.version >= 2 {
+		fields = append(fields, "reads")
+	}
+	return fields
+}
+
+type filteredSink struct {
+	sink   TraceSink
+	filter TraceFilter
+}
+
+// Tracer records each instruction into a ring of the most recent, and
+// passes it to its sinks
+type Tracer struct {
+	ring []TraceRecord
+	// Index of the record being written, and how many have been
+	next  int
+	count uint64
+	sinks []filteredSink
+}
+
+// NewTracer keeps a ring of the last size records
+func NewTracer(size int) *Tracer {
+	if size < 1 {
+		size = 1
+	}
+	return &Tracer{ring: make([]TraceRecord, size)}
+}
+
+// AddSink sends the sink each record the filter passes, or all of them
+// if the filter is nil
+func (t *Tracer) AddSink(sink TraceSink, filter TraceFilter) {
+	t.sinks = append(t.sinks, filteredSink{sink: sink, filter: filter})
+}
+
+// grow makes the ring hold at least size records, dropping any in it
+func (t *Tracer) grow(size int) {
+	if size > len(t.ring) {
+		t.ring = make([]TraceRecord, size)
+		t.next = 0
+		t.count = 0
+	}
+}
+
+// start gives the next record in the ring to fill in
+func (t *Tracer) start() *TraceRecord {
+	rec := &t.ring[t.next]
+	rec.NumAccesses = 0
+	rec.Interrupt = false
+	return rec
+}
+
+// finish passes the record to the sinks and moves on
+func (t *Tracer) finish(rec *TraceRecord) error {
+	t.next = (t.next + 1) % len(t.ring)
+	t.count++
+	for _, fs := range t.sinks {
+		if fs.filter != nil && !fs.filter(rec) {
+			continue
+		}
+		err := fs.sink.Trace(rec)
+		if err != nil {
+			return fmt.Errorf("Can't write trace: %s", err)
+		}
+	}
+	return nil
+}
+
+// Recent sends up to the last n records to a sink, oldest first
+func (t *Tracer) Recent(n int, sink TraceSink) error {
+	if uint64(n) > t.count {
+		n = int(t.count)
+	}
+	if n > len(t.ring) {
+		n = len(t.ring)
+	}
+	for i := n; i > 0; i-- {
+		rec := &t.ring[(t.next-i+len(t.ring))%len(t.ring)]
+		err := sink.Trace(rec)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Flush flushes any sinks which buffer
+func (t *Tracer) Flush() error {
+	for _, fs := range t.sinks {
+		if f, ok := fs.sink.(interface{ Flush() error }); ok {
+			err := f.Flush()
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+package zog
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+)
+
+func traceTestZog(t *testing.T, prog string) *Zog {
+	assembly, err := Assemble(prog)
+	if err != nil {
+		t.Fatalf("Failed to assemble [%s]: %s", prog, err)
+	}
+	z := New(0)
+	z.SetClockHz(0)
+	err = z.Load(assembly)
+	if err != nil {
+		t.Fatalf("Failed to load: %s", err)
+	}
+	z.reg.PC = assembly.BaseAddr
+	return z
+}
+
+type traceCollector []TraceRecord
+
+func (c *traceCollector) Trace(rec *TraceRecord) error {
+	*c = append(*c, *rec)
+	return nil
+}
+
+func TestTrace(t *testing.T) {
+	z := traceTestZog(t, "\tORG 100h\n\tLD HL, 2000h\n\tLD (HL), 42h\n\tLD (2001h), HL\n\tLD A, (2002h)\n\tHALT\n")
+	z.WatchRegions(Regions{NewRegion(0x2000, 0x2010)})
+	ch := make(chan TraceRecord, 10)
+	z.AddTraceSink(ChanTraceSink(ch), nil)
+	text := &bytes.Buffer{}
+	z.AddTraceSink(NewTextTraceSink(text, nil), Regions{NewRegion(0x103, 0x106)}.Filter())
+	bin := &bytes.Buffer{}
+	binSink, err := NewBinaryTraceSink(bin)
+	if err != nil {
+		t.Fatalf("Can't make binary sink: %s", err)
+	}
+	z.AddTraceSink(binSink, nil)
+
+	err = z.Run()
+	if err != nil {
+		t.Fatalf("Failed to run: %s", err)
+	}
+	close(ch)
+	var recs []TraceRecord
+	for rec := range ch {
+		recs = append(recs, rec)
+	}
+
+	expected := []struct {
+		pc       uint16
+		bytes    string
+		inst     string
+		accesses []MemAccess
+	}{
+		{0x100, "210020", "LD HL, 0x2000", nil},
+		{0x103, "3642", "LD (HL), 0x42", []MemAccess{{0x2000, 0x00, 0x42, false}}},
+		{0x105, "220120", "LD (0x2001), HL", []MemAccess{{0x2001, 0x00, 0x00, false}, {0x2002, 0x00, 0x20, false}}},
+		{0x108, "3A0220", "LD A, (0x2002)", []MemAccess{{0x2002, 0x20, 0x20, true}}},
+		{0x10B, "76", "HALT", nil},
+	}
+	if len(recs) != len(expected) {
+		t.Fatalf("Got %d records, expected %d", len(recs), len(expected))
+	}
+	for i, e := range expected {
+		rec := recs[i]
+		got := bufToHex(rec.Bytes[:rec.Len])
+		if rec.PC != e.pc || got != e.bytes || rec.Inst.String() != e.inst || rec.Ops != uint64(i) {
+			t.Fatalf("Record %d is %04X [%s] %s, expected %04X [%s] %s", i, rec.PC, got, rec.Inst, e.pc, e.bytes, e.inst)
+		}
+		accesses := rec.Accesses[:rec.NumAccesses]
+		if len(accesses) != len(e.accesses) {
+			t.Fatalf("Record %d has accesses %v, expected %v", i, accesses, e.accesses)
+		}
+		for j := range accesses {
+			if accesses[j] != e.accesses[j] {
+				t.Fatalf("Record %d has accesses %v, expected %v", i, accesses, e.accesses)
+			}
+		}
+	}
+	if recs[0].Reg.Read16(HL) != 0x2000 {
+		t.Fatalf("Record has registers from before the instruction: %s", recs[0].Reg.Summary())
+	}
+
+	lines := strings.Split(strings.TrimSpace(text.String()), "\n")
+	if len(lines) != 2 || !strings.Contains(lines[0], "0103") || !strings.HasSuffix(lines[1], "W:2001 [00->00] W:2002 [00->20]") {
+		t.Fatalf("Filtered text trace wrong:\n%s", text)
+	}
+
+	tr, err := NewTraceReader(bin)
+	if err != nil {
+		t.Fatalf("Can't read binary trace: %s", err)
+	}
+	for i := range recs {
+		var rec TraceRecord
+		err := tr.Read(&rec)
+		if err != nil {
+			t.Fatalf("Can't read binary record %d: %s", i, err)
+		}
+		if rec.String() != recs[i].String() || rec.Reg != recs[i].Reg {
+			t.Fatalf("Binary record %d is [%s], expected [%s]", i, rec.String(), recs[i].String())
+		}
+	}
+	var rec TraceRecord
+	err = tr.Read(&rec)
+	if err != io.EOF {
+		t.Fatalf("Expected EOF after binary records, got %v", err)
+	}
+}
+
+func TestTraceRing(t *testing.T) {
+	tr := NewTracer(3)
+	for i := 0; i < 5; i++ {
+		rec := tr.start()
+		rec.Ops = uint64(i)
+		tr.finish(rec)
+	}
+	var got traceCollector
+	tr.Recent(10, &got)
+	if len(got) != 3 || got[0].Ops != 2 || got[2].Ops != 4 {
+		t.Fatalf("Recent records wrong: %v", got)
+	}
+}
+
+func TestTraceAllocs(t *testing.T) {
+	// Nothing in the loop needs to build an instruction
+	z := traceTestZog(t, "\tORG 100h\n\tLD BC, 2000h\n\tLD HL, loop\nloop:\tINC A\n\tLD (BC), A\n\tJP (HL)\n")
+	z.WatchRegions(Regions{NewRegion(0x2000, 0x2001)})
+	step := func() {
+		_, err := z.step()
+		if err != nil {
+			t.Fatalf("Failed to step: %s", err)
+		}
+	}
+	for i := 0; i < 4; i++ {
+		step()
+	}
+	allocs := testing.AllocsPerRun(100, step)
+	if allocs > 0 {
+		t.Fatalf("Stepping without tracing made %v allocations", allocs)
+	}
+	z.TraceOnHalt(10)
+	allocs = testing.AllocsPerRun(100, step)
+	if allocs > 0 {
+		t.Fatalf("Stepping with tracing made %v allocations", allocs)
+	}
+}
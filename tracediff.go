@@ -0,0 +1,349 @@
+package zog
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// TraceSource gives trace records one at a time, then io.EOF
+type TraceSource interface {
+	Read(rec *TraceRecord) error
+	// The parts of each record the trace holds: register names, and
+	// "writes" and "reads" if it has memory writes and reads. Only known
+	// after the first Read.
+	Fields() []string
+}
+
+// The registers in a trace, by name. PC is the instruction's address.
+var traceRegisters = []string{"AF", "BC", "DE", "HL", "IX", "IY", "SP", "AF'", "BC'", "DE'", "HL'", "I", "R"}
+
+var traceRegisterLocs = map[string]R16{
+	"AF": AF, "BC": BC, "DE": DE, "HL": HL, "IX": IX, "IY": IY, "SP": SP,
+	"AF'": AF_PRIME, "BC'": BC_PRIME, "DE'": DE_PRIME, "HL'": HL_PRIME,
+}
+
+func traceRegister(r *Registers, name string) uint16 {
+	switch name {
+	case "I":
+		return uint16(r.I)
+	case "R":
+		return uint16(r.R)
+	}
+	return r.Read16(traceRegisterLocs[name])
+}
+
+// OpenTrace reads a zog binary trace, or a text trace from zog or
+// another emulator. before says whether the registers on each line of a
+// text trace are from before the instruction, as other emulators
+// usually log them, or after, as zog does.
+func OpenTrace(r io.Reader, before bool) (TraceSource, error) {
+	br := bufio.NewReader(r)
+	magic, err := br.Peek(len(traceMagic))
+	if err == nil && string(magic) == traceMagic {
+		return NewTraceReader(br)
+	}
+	return NewTextTraceReader(br, before), nil
+}
+
+// TextTraceReader imports a text trace with a line per instruction, such
+// as FUSE's, where registers are written like "PC:8000", "HL=1234" or
+// "SP FFF0". zog's own text traces, starting "ops: PC", can be read too.
+type TextTraceReader struct {
+	s      *bufio.Scanner
+	before bool
+	n      uint64
+	fields []string
+	// With registers from before each instruction, each record needs
+	// the next line
+	pending    TraceRecord
+	hasPending bool
+}
+
+func NewTextTraceReader(r io.Reader, before bool) *TextTraceReader {
+	return &TextTraceReader{s: bufio.NewScanner(r), before: before}
+}
+
+func (tr *TextTraceReader) Fields() []string {
+	return tr.fields
+}
+
+func (tr *TextTraceReader) Read(rec *TraceRecord) error {
+	if !tr.before {
+		err := tr.next(rec)
+		if err != nil {
+			return err
+		}
+		rec.Ops = tr.n
+		tr.n++
+		return nil
+	}
+
+	if !tr.hasPending {
+		err := tr.next(&tr.pending)
+		if err != nil {
+			return err
+		}
+		tr.hasPending = true
+	}
+	var next TraceRecord
+	err := tr.next(&next)
+	if err != nil {
+		// The last line has no registers after it
+		return err
+	}
+	*rec = TraceRecord{Ops: tr.n, PC: tr.pending.PC, Reg: next.Reg}
+	rec.Reg.PC = next.PC
+	tr.n++
+	tr.pending = next
+	return nil
+}
+
+// next reads the next line with a PC on it
+func (tr *TextTraceReader) next(rec *TraceRecord) error {
+	for tr.s.Scan() {
+		*rec = TraceRecord{}
+		fields, ok := parseTraceLine(tr.s.Text(), rec)
+		if !ok {
+			continue
+		}
+		if tr.fields == nil {
+			tr.fields = fields
+		}
+		return nil
+	}
+	err := tr.s.Err()
+	if err == nil {
+		err = io.EOF
+	}
+	return err
+}
+
+func parseTraceHex(s string) (uint16, error) {
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "0X"), "$")
+	s = strings.TrimSuffix(strings.TrimRight(s, ",;"), "H")
+	v, err := strconv.ParseUint(s, 16, 16)
+	return uint16(v), err
+}
+
+// traceRegisterName gives the name a register is known by in traces,
+// allowing for the different ways of writing alternates
+func traceRegisterName(name string) (string, bool) {
+	for _, suffix := range []string{"_", "2", "'"} {
+		if strings.HasSuffix(name, suffix) && len(name) == 3 {
+			name = name[:2] + "'"
+		}
+	}
+	switch name {
+	case "PC", "IR", "I", "R":
+		return name, true
+	}
+	_, ok := traceRegisterLocs[name]
+	return name, ok
+}
+
+// parseTraceLine reads the registers on a line, saying which it found
+// and whether there was a PC. Anything else, such as the instruction, is
+// skipped.
+func parseTraceLine(line string, rec *TraceRecord) ([]string, bool) {
+	tokens := strings.Fields(strings.ToUpper(line))
+	var fields []string
+	hasPC := false
+	seen := make(map[string]bool)
+
+	// zog's format starts "ops: PC"
+	if len(tokens) > 1 && strings.HasSuffix(tokens[0], ":") {
+		_, err := strconv.ParseUint(strings.TrimSuffix(tokens[0], ":"), 10, 64)
+		pc, pcErr := parseTraceHex(tokens[1])
+		if err == nil && pcErr == nil && len(tokens[1]) == 4 {
+			rec.PC, hasPC = pc, true
+			seen["PC"] = true
+			tokens = tokens[2:]
+		}
+	}
+
+	for i := 0; i < len(tokens); i++ {
+		name, value := tokens[i], ""
+		if j := strings.IndexAny(name, ":="); j >= 0 {
+			name, value = name[:j], name[j+1:]
+		}
+		name, ok := traceRegisterName(name)
+		if !ok || seen[name] {
+			continue
+		}
+		if value == "" && i+1 < len(tokens) {
+			value = tokens[i+1]
+		}
+		v, err := parseTraceHex(value)
+		if err != nil {
+			continue
+		}
+		if tokens[i] == name {
+			// The value was the next token
+			i++
+		}
+		seen[name] = true
+		switch name {
+		case "PC":
+			rec.PC, hasPC = v, true
+			continue
+		case "IR":
+			rec.Reg.I, rec.Reg.R = byte(v>>8), byte(v)
+			fields = append(fields, "I", "R")
+			continue
+		case "I":
+			rec.Reg.I = byte(v)
+		case "R":
+			rec.Reg.R = byte(v)
+		default:
+			rec.Reg.Write16(traceRegisterLocs[name], v)
+		}
+		fields = append(fields, name)
+	}
+	return fields, hasPC
+}
+
+// TraceDivergence is where two traces stop agreeing
+type TraceDivergence struct {
+	// How many records agreed, and the last few of them
+	Index   uint64
+	Context []TraceRecord
+	// The records which differ, or nil if a trace ended first
+	A, B *TraceRecord
+	// What differs
+	Fields []string
+}
+
+// TraceDiffOptions control how traces are compared
+type TraceDiffOptions struct {
+	// How many matching records to give before a divergence
+	Context int
+	// Registers not to compare
+	Ignore []string
+	// Skip the start of each trace until it reaches Start
+	HasStart bool
+	Start    uint16
+}
+
+// DiffTraces compares two traces, giving the first place they differ,
+// or nil if they are the same. Only the registers both traces hold are
+// compared, along with memory writes and reads if both have them.
+func DiffTraces(a, b TraceSource, opts TraceDiffOptions) (*TraceDivergence, error) {
+	var recA, recB TraceRecord
+	errA := readFrom(a, &recA, opts)
+	errB := readFrom(b, &recB, opts)
+
+	var fields []string
+	if errA == nil && errB == nil {
+		fields = commonFields(a.Fields(), b.Fields(), opts.Ignore)
+	}
+
+	d := &TraceDivergence{}
+	for {
+		if errA != nil && errA != io.EOF {
+			return nil, fmt.Errorf("Can't read first trace: %s", errA)
+		}
+		if errB != nil && errB != io.EOF {
+			return nil, fmt.Errorf("Can't read second trace: %s", errB)
+		}
+		if errA == io.EOF && errB == io.EOF {
+			return nil, nil
+		}
+		if errA == nil {
+			d.A = &recA
+		}
+		if errB == nil {
+			d.B = &recB
+		}
+		if d.A == nil || d.B == nil {
+			return d, nil
+		}
+		d.Fields = diffRecords(d.A, d.B, fields)
+		if len(d.Fields) > 0 {
+			return d, nil
+		}
+
+		if opts.Context > 0 {
+			if len(d.Context) == opts.Context {
+				d.Context = d.Context[1:]
+			}
+			d.Context = append(d.Context, recA)
+		}
+		d.Index++
+		d.A, d.B = nil, nil
+		errA = a.Read(&recA)
+		errB = b.Read(&recB)
+	}
+}
+
+func readFrom(src TraceSource, rec *TraceRecord, opts TraceDiffOptions) error {
+	for {
+		err := src.Read(rec)
+		if err != nil || !opts.HasStart || rec.PC == opts.Start {
+			return err
+		}
+	}
+}
+
+func commonFields(a, b, ignore []string) []string {
+	var fields []string
+	for _, f := range a {
+		in := func(fs []string) bool {
+			for _, g := range fs {
+				if strings.EqualFold(f, g) {
+					return true
+				}
+			}
+			return false
+		}
+		if in(b) && !in(ignore) && !in(fields) {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+func diffRecords(a, b *TraceRecord, fields []string) []string {
+	var diffs []string
+	if a.PC != b.PC {
+		diffs = append(diffs, "PC")
+	}
+	for _, f := range fields {
+		if f == "writes" || f == "reads" {
+			if !sameAccesses(a, b, f == "reads") {
+				diffs = append(diffs, f)
+			}
+			continue
+		}
+		if traceRegister(&a.Reg, f) != traceRegister(&b.Reg, f) {
+			diffs = append(diffs, f)
+		}
+	}
+	return diffs
+}
+
+// sameAccesses compares the reads, or the writes, in two records
+func sameAccesses(a, b *TraceRecord, reads bool) bool {
+	var as, bs []MemAccess
+	for _, acc := range a.Accesses[:a.NumAccesses] {
+		if acc.Read == reads {
+			as = append(as, acc)
+		}
+	}
+	for _, acc := range b.Accesses[:b.NumAccesses] {
+		if acc.Read == reads {
+			bs = append(bs, acc)
+		}
+	}
+	if len(as) != len(bs) {
+		return false
+	}
+	for i := range as {
+		if as[i] != bs[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,188 @@
+package zog
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestParseTraceLine(t *testing.T) {
+	testCases := []struct {
+		line   string
+		pc     uint16
+		fields string
+		hl     uint16
+	}{
+		{"PC:8000 AF:0044 BC:1234 DE:0000 HL:5C78 IX:0000 IY:5C3A SP:FF4A IR:3F05", 0x8000, "AF,BC,DE,HL,IX,IY,SP,I,R", 0x5c78},
+		{"pc=1234 hl=0001 af'=ffff inc hl", 0x1234, "HL,AF'", 0x0001},
+		{"3: 0105 AF 0044 BC 0000 DE 0000 HL 2000 SP 0000 IX 0000 IY 0000 LD (0x2001), HL W:2001 [00->00]", 0x105, "AF,BC,DE,HL,SP,IX,IY", 0x2000},
+		{"0x0038 HL $0102 SP FFFEh AF_ 1234 LD A, I", 0, "HL,SP,AF'", 0x0102},
+	}
+	for _, tc := range testCases {
+		var rec TraceRecord
+		fields, hasPC := parseTraceLine(tc.line, &rec)
+		if hasPC != (tc.pc != 0) || rec.PC != tc.pc {
+			t.Fatalf("Parsing [%s] got PC %04X (%v) expected %04X", tc.line, rec.PC, hasPC, tc.pc)
+		}
+		if strings.Join(fields, ",") != tc.fields || rec.Reg.Read16(HL) != tc.hl {
+			t.Fatalf("Parsing [%s] got %v HL %04X, expected %s HL %04X", tc.line, fields, rec.Reg.Read16(HL), tc.fields, tc.hl)
+		}
+	}
+}
+
+// fuseTrace writes records as another emulator might, with the registers
+// before each instruction
+func fuseTrace(recs []TraceRecord) string {
+	var lines []string
+	var before Registers
+	for _, rec := range recs {
+		r := &before
+		lines = append(lines, fmt.Sprintf("PC:%04X AF:%04X BC:%04X DE:%04X HL:%04X IX:%04X IY:%04X SP:%04X  %s",
+			rec.PC, r.Read16(AF), r.Read16(BC), r.Read16(DE), r.Read16(HL), r.Read16(IX), r.Read16(IY), r.Read16(SP), rec.Inst))
+		before = rec.Reg
+	}
+	r := &before
+	lines = append(lines, fmt.Sprintf("PC:%04X AF:%04X BC:%04X DE:%04X HL:%04X IX:%04X IY:%04X SP:%04X",
+		r.PC, r.Read16(AF), r.Read16(BC), r.Read16(DE), r.Read16(HL), r.Read16(IX), r.Read16(IY), r.Read16(SP)))
+	return strings.Join(lines, "\n") + "\n"
+}
+
+func TestDiffTraces(t *testing.T) {
+	prog := "\tORG 100h\n\tLD HL, 2000h\n\tLD B, 3\nloop:\tLD (HL), B\n\tINC HL\n\tDJNZ loop\n\tHALT\n"
+	z := traceTestZog(t, prog)
+	recorded := &bytes.Buffer{}
+	err := z.RecordTrace(recorded)
+	if err != nil {
+		t.Fatalf("Can't record: %s", err)
+	}
+	var recs traceCollector
+	z.AddTraceSink(&recs, nil)
+	err = z.Run()
+	if err != nil {
+		t.Fatalf("Failed to run: %s", err)
+	}
+	if len(recs) != 12 || recs[2].NumAccesses != 1 {
+		t.Fatalf("Unexpected trace: %v", recs)
+	}
+	fuse := fuseTrace(recs)
+
+	diff := func(a, b string, opts TraceDiffOptions) *TraceDivergence {
+		srcA, err := OpenTrace(strings.NewReader(a), true)
+		if err != nil {
+			t.Fatalf("Can't open first trace: %s", err)
+		}
+		srcB, err := OpenTrace(strings.NewReader(b), true)
+		if err != nil {
+			t.Fatalf("Can't open second trace: %s", err)
+		}
+		d, err := DiffTraces(srcA, srcB, opts)
+		if err != nil {
+			t.Fatalf("Can't diff: %s", err)
+		}
+		return d
+	}
+
+	d := diff(recorded.String(), fuse, TraceDiffOptions{})
+	if d != nil {
+		t.Fatalf("Traces differ after %d: %v %v %v", d.Index, d.A, d.B, d.Fields)
+	}
+	d = diff(recorded.String(), recorded.String(), TraceDiffOptions{})
+	if d != nil {
+		t.Fatalf("Trace differs from itself after %d: %v", d.Index, d.Fields)
+	}
+
+	// Another emulator which gets INC HL wrong the second time round
+	wrong := strings.Replace(fuse, "HL:2002 IX:0000 IY:0000 SP:0000  DJNZ", "HL:2003 IX:0000 IY:0000 SP:0000  DJNZ", 1)
+	if wrong == fuse {
+		t.Fatalf("Failed to break trace:\n%s", fuse)
+	}
+	d = diff(recorded.String(), wrong, TraceDiffOptions{Context: 2})
+	if d == nil {
+		t.Fatalf("Expected traces to differ")
+	}
+	if d.Index != 6 || d.A.PC != 0x106 || len(d.Context) != 2 || d.Context[1].PC != 0x105 || strings.Join(d.Fields, ",") != "HL" {
+		t.Fatalf("Wrong divergence: after %d at %04X context %v differs %v", d.Index, d.A.PC, d.Context, d.Fields)
+	}
+	d = diff(recorded.String(), wrong, TraceDiffOptions{Ignore: []string{"HL"}})
+	if d != nil {
+		t.Fatalf("Expected ignored HL not to differ: %v", d.Fields)
+	}
+
+	// The other emulator starts earlier, so the traces need lining up
+	d = diff(recorded.String(), "PC:0000 AF:0000 HL:1234\n"+fuse, TraceDiffOptions{})
+	if d == nil || d.Index != 0 || strings.Join(d.Fields, ",") != "PC,HL" {
+		t.Fatalf("Expected traces to differ at once")
+	}
+	d = diff(recorded.String(), "PC:0000 AF:0000 HL:1234\n"+fuse, TraceDiffOptions{HasStart: true, Start: 0x100})
+	if d != nil {
+		t.Fatalf("Traces differ after lining up, after %d: %v", d.Index, d.Fields)
+	}
+
+	// One trace stops early
+	lines := strings.SplitAfter(fuse, "\n")
+	d = diff(recorded.String(), strings.Join(lines[:5], ""), TraceDiffOptions{})
+	if d == nil || d.Index != 4 || d.A == nil || d.B != nil {
+		t.Fatalf("Expected second trace to end after 4: %v", d)
+	}
+}
+
+func TestDiffTraceReads(t *testing.T) {
+	record := func(n byte) []byte {
+		z := traceTestZog(t, "\tORG 100h\n\tLD A, (2000h)\n\tLD A, 7\n\tLD (2001h), A\n\tHALT\n")
+		z.Mem.Poke(0x2000, n)
+		buf := &bytes.Buffer{}
+		err := z.RecordTrace(buf)
+		if err != nil {
+			t.Fatalf("Can't record: %s", err)
+		}
+		err = z.Run()
+		if err != nil {
+			t.Fatalf("Failed to run: %s", err)
+		}
+		return buf.Bytes()
+	}
+	diff := func(a, b []byte) *TraceDivergence {
+		srcA, err := OpenTrace(bytes.NewReader(a), true)
+		if err != nil {
+			t.Fatalf("Can't open first trace: %s", err)
+		}
+		srcB, err := OpenTrace(bytes.NewReader(b), true)
+		if err != nil {
+			t.Fatalf("Can't open second trace: %s", err)
+		}
+		d, err := DiffTraces(srcA, srcB, TraceDiffOptions{Ignore: []string{"AF"}})
+		if err != nil {
+			t.Fatalf("Can't diff: %s", err)
+		}
+		return d
+	}
+
+	a, b := record(1), record(2)
+	d := diff(a, b)
+	if d == nil || d.Index != 0 || strings.Join(d.Fields, ",") != "reads" {
+		t.Fatalf("Expected reads to differ at once: %v", d)
+	}
+
+	// Version 1 traces only have writes
+	v1 := append([]byte{}, a...)
+	v1[len(traceMagic)] = 1
+	tr, err := NewTraceReader(bytes.NewReader(v1))
+	if err != nil {
+		t.Fatalf("Can't read version 1 trace: %s", err)
+	}
+	if fields := strings.Join(tr.Fields(), ","); !strings.HasSuffix(fields, ",writes") {
+		t.Fatalf("Version 1 trace has fields %s", fields)
+	}
+	d = diff(v1, b)
+	if d != nil {
+		t.Fatalf("Expected version 1 trace to compare writes only: %v", d.Fields)
+	}
+
+	v3 := append([]byte{}, a...)
+	v3[len(traceMagic)] = traceVersion + 1
+	_, err = NewTraceReader(bytes.NewReader(v3))
+	if err == nil {
+		t.Fatalf("Expected version %d trace to be refused", traceVersion+1)
+	}
+}
@@ -1,6 +1,7 @@
 package zog
 
 import (
+	"bytes"
 	"fmt"
 	"strconv"
 	"strings"
@@ -21,62 +22,151 @@ type Current struct {
 	r16Contents Loc
 	odigit      byte
 	disp        Disp
+	dispExpr    *DispExpr
+	target      *RelTarget
 	cc          Conditional
 	nn          Loc16
 	n           Loc8
+	exprs       []Expr
+	fill        Expr
+
+	// Directive data being built up
+	data      []byte
+	dataExprs []dataExpr
+	strEnds   []int
+	names     []string
 
 	inst  Instruction
 	label string
+	file  string
+	line  int
+	// Problems found by the actions, reported once the line is parsed
+	errs []error
 
-	assembly Assembly
+	assembly *Assembly
 }
 
 func (c *Current) Init() {
+	c.assembly = &Assembly{}
 	c.assembly.Init()
+	c.line = 1
+}
+
+func (c *Current) NewLine() {
+	c.line++
 }
 
 func (c *Current) GetAssembly() *Assembly {
-	return &c.assembly
+	return c.assembly
 }
 
+// LabelDefn defines a label, which starts a new scope for local labels
 func (c *Current) LabelDefn(label string) {
-	c.label = label
+	if !strings.HasPrefix(label, ".") {
+		c.assembly.scope = label
+	}
+	c.label = c.assembly.qualify(label)
+}
+
+// SymbolDefn defines an EQU or DEFL symbol, which doesn't change scope
+func (c *Current) SymbolDefn(name string) {
+	c.label = c.assembly.qualify(name)
+}
+
+func (c *Current) DataString(s string) {
+	c.data = append(c.data, s...)
+	if s != "" {
+		c.strEnds = append(c.strEnds, len(c.data))
+	}
+}
+
+func (c *Current) DataByte() {
+	c.dataExprs = append(c.dataExprs, dataExpr{offset: len(c.data), expr: c.popExpr()})
+	c.data = append(c.data, 0)
+}
+
+func (c *Current) DataWord() {
+	c.dataExprs = append(c.dataExprs, dataExpr{offset: len(c.data), expr: c.popExpr(), word: true})
+	c.data = append(c.data, 0, 0)
 }
 
 func (c *Current) DefByte() {
-	b, ok := c.n.(Imm8)
-	if !ok {
-		panic("Defbyte must be followed by a byte")
+	c.inst = &Data{data: c.data, exprs: c.dataExprs}
+}
+
+// DefZero is DEFB with a terminating zero
+func (c *Current) DefZero() {
+	c.data = append(c.data, 0)
+	c.DefByte()
+}
+
+// DefLastHigh is DEFB with bit 7 set on the last character of each string
+func (c *Current) DefLastHigh() {
+	for _, end := range c.strEnds {
+		c.data[end-1] |= 0x80
 	}
-	data := []byte{byte(b)}
-	c.inst = NewData(data)
+	c.DefByte()
 }
 
 func (c *Current) DefWord() {
-	w, ok := c.nn.(Imm16)
-	if !ok {
-		panic("Defword must be followed by a word")
-	}
-	ww := uint16(w)
-	data := []byte{byte(ww & 0xff), byte(ww >> 8)}
-	c.inst = NewData(data)
+	c.inst = &Data{data: c.data, exprs: c.dataExprs}
+}
+
+func (c *Current) Fill() {
+	c.fill = c.popExpr()
 }
 
 func (c *Current) DefSpace() {
-	numBytes, ok := c.n.(Imm8)
-	if !ok {
-		panic("Defspace must be followed by number of bytes")
+	e := c.popExpr()
+	v, ok := constValue(e)
+	fill, fillOk := 0, true
+	if c.fill != nil {
+		fill, fillOk = constValue(c.fill)
+	}
+	if ok && fillOk && v >= 0 && v <= 0xffff && fill >= -128 && fill <= 255 {
+		c.inst = NewData(bytes.Repeat([]byte{byte(fill)}, v))
+		return
 	}
-	data := make([]byte, numBytes)
-	c.inst = NewData(data)
+	c.inst = &Space{count: e, fill: c.fill}
+}
+
+func (c *Current) Align() {
+	c.inst = &Align{boundary: c.popExpr(), Space: Space{fill: c.fill}}
+}
+
+func (c *Current) Section(name string) {
+	c.inst = &Section{name: name}
+}
+
+func (c *Current) Name(name string) {
+	c.names = append(c.names, c.assembly.qualify(name))
+}
+
+func (c *Current) Public() {
+	c.inst = &Public{names: c.names}
+}
+
+func (c *Current) Extern() {
+	c.inst = &Extern{names: c.names}
 }
 
 func (c *Current) Org() {
-	addr := c.nn.(Imm16)
-	c.assembly.BaseAddr = uint16(addr)
+	c.inst = &Org{expr: c.popExpr()}
+}
+
+func (c *Current) Equ() {
+	c.inst = &Equ{expr: c.popExpr()}
+}
+
+func (c *Current) Defl() {
+	c.inst = &Defl{expr: c.popExpr()}
 }
 
 func (c *Current) LD8() {
+	if err := checkLD8(c.dst8, c.src8); err != nil {
+		c.errs = append(c.errs, err)
+		return
+	}
 	c.inst = NewLD8(c.dst8, c.src8)
 }
 
@@ -84,12 +174,28 @@ func (c *Current) LD16() {
 	c.inst = NewLD16(c.dst16, c.src16)
 }
 func (c *Current) Push() {
+	if err := checkStack("PUSH", c.src16); err != nil {
+		c.errs = append(c.errs, err)
+		return
+	}
 	c.inst = NewPUSH(c.src16)
 }
 func (c *Current) Pop() {
+	if err := checkStack("POP", c.dst16); err != nil {
+		c.errs = append(c.errs, err)
+		return
+	}
 	c.inst = NewPOP(c.dst16)
 }
 func (c *Current) Ex() {
+	// Some assemblers let you leave the prime off EX AF, AF'
+	if c.dst16 == AF && c.src16 == AF {
+		c.src16 = AF_PRIME
+	}
+	if err := checkEx(c.dst16, c.src16); err != nil {
+		c.errs = append(c.errs, err)
+		return
+	}
 	c.inst = NewEX(c.dst16, c.src16)
 }
 
@@ -124,18 +230,34 @@ func (c *Current) Accum(name string) {
 }
 
 func (c *Current) Rot(name string) {
+	if err := checkBitOp(name, c.loc8, c.cpy8); err != nil {
+		c.errs = append(c.errs, err)
+		return
+	}
 	c.inst = NewRot(name, c.loc8, c.cpy8)
 }
 
 func (c *Current) Bit() {
+	if err := checkBitOp("BIT", c.loc8, nil); err != nil {
+		c.errs = append(c.errs, err)
+		return
+	}
 	c.inst = NewBIT(c.odigit, c.loc8)
 }
 
 func (c *Current) Res() {
+	if err := checkBitOp("RES", c.loc8, c.cpy8); err != nil {
+		c.errs = append(c.errs, err)
+		return
+	}
 	c.inst = NewRES(c.odigit, c.loc8, c.cpy8)
 }
 
 func (c *Current) Set() {
+	if err := checkBitOp("SET", c.loc8, c.cpy8); err != nil {
+		c.errs = append(c.errs, err)
+		return
+	}
 	c.inst = NewSET(c.odigit, c.loc8, c.cpy8)
 }
 
@@ -151,12 +273,21 @@ func (c *Current) Rst() {
 	// n is in  must be a byte
 	imm8, ok := c.n.(Imm8)
 	if !ok {
-		panic("RST without immediate byte")
+		c.errs = append(c.errs, fmt.Errorf("RST needs a constant address, not [%s]", c.n))
+		return
+	}
+	if imm8&^0x38 != 0 {
+		c.errs = append(c.errs, fmt.Errorf("RST to %02Xh, which isn't one of the restart addresses", byte(imm8)))
+		return
 	}
 	c.inst = &RST{byte(imm8)}
 }
 
 func (c *Current) Call() {
+	if _, ok := c.src16.(R16); ok {
+		c.errs = append(c.errs, fmt.Errorf("CALL needs an address, not %s", c.src16))
+		return
+	}
 	c.inst = NewCALL(c.cc, c.src16)
 }
 
@@ -169,11 +300,21 @@ func (c *Current) Jp() {
 }
 
 func (c *Current) Jr() {
-	c.inst = &JR{c.cc, c.disp}
+	switch c.cc {
+	case nil, True, FT_Z, Not{FT_Z}, FT_C, Not{FT_C}:
+	default:
+		c.errs = append(c.errs, fmt.Errorf("JR can't test %s, only Z, NZ, C and NC", c.cc))
+		return
+	}
+	c.inst = &JR{c: c.cc, d: c.disp, target: c.target}
 }
 
 func (c *Current) Djnz() {
-	c.inst = &DJNZ{c.disp}
+	c.inst = &DJNZ{d: c.disp, target: c.target}
+}
+
+func (c *Current) JrTarget() {
+	c.target = &RelTarget{expr: c.popExpr()}
 }
 
 func (c *Current) In() {
@@ -181,6 +322,10 @@ func (c *Current) In() {
 	if port == nil {
 		port = C
 	}
+	if err := checkPort("IN", port, c.r8); err != nil {
+		c.errs = append(c.errs, err)
+		return
+	}
 	c.inst = &IN{dst: c.r8, port: port}
 }
 
@@ -189,41 +334,37 @@ func (c *Current) Out() {
 	if port == nil {
 		port = C
 	}
+	if err := checkPort("OUT", port, c.r8); err != nil {
+		c.errs = append(c.errs, err)
+		return
+	}
 	c.inst = &OUT{port: port, value: c.r8}
 }
 
-func (c *Current) Nhex(s string) {
-	n, err := strconv.ParseUint(s, 16, 8)
-	if err != nil {
-		panic(fmt.Errorf("Invalid byte: %s", s))
+func (c *Current) N() {
+	e := c.popExpr()
+	v, ok := constValue(e)
+	if ok && v >= -128 && v <= 255 {
+		c.n = Imm8(v)
+		return
 	}
-	c.n = Imm8(n)
+	c.n = &Expr8{expr: e}
 }
 
-func (c *Current) NNLabel(label string) {
-	c.nn = &Label{name: label}
-}
-
-func (c *Current) NNhex(s string) {
-	nn, err := strconv.ParseUint(s, 16, 16)
-	if err != nil {
-		panic(fmt.Errorf("Invalid byte: %s", s))
+func (c *Current) NN() {
+	e := c.popExpr()
+	v, ok := constValue(e)
+	if ok && v >= -32768 && v <= 0xffff {
+		c.nn = Imm16(v)
+		return
 	}
-	c.nn = Imm16(nn)
+	c.nn = &Label{name: e.String(), expr: e}
 }
 
 func (c *Current) NNContents() {
 	c.nn = Contents{c.nn}
 }
 
-func (c *Current) Ndec(s string) {
-	n, err := strconv.ParseUint(s, 10, 8)
-	if err != nil {
-		panic(fmt.Errorf("Invalid byte: %s", s))
-	}
-	c.n = Imm8(n)
-}
-
 func (c *Current) ODigit(s string) {
 	n, err := strconv.ParseUint(s, 8, 8)
 	if err != nil {
@@ -233,28 +374,90 @@ func (c *Current) ODigit(s string) {
 }
 
 func (c *Current) Disp0xHex(s string) {
-	s = strings.Replace(s, "0x", "", 1)
-	n, err := strconv.ParseInt(s, 16, 8)
-	if err != nil {
-		panic(fmt.Errorf("Invalid signed decimal byte: %s", s))
-	}
-	c.disp = Disp(int8(n))
+	c.dispByte(strings.Replace(s, "0x", "", 1), 16, s)
 }
 
 func (c *Current) DispHex(s string) {
-	n, err := strconv.ParseInt(s, 16, 8)
+	c.dispByte(s, 16, s+"h")
+}
+
+func (c *Current) DispDecimal(s string) {
+	c.dispByte(s, 10, s)
+}
+
+// dispByte reads a number given as a JR or DJNZ operand, which is a
+// displacement and so must fit in a signed byte
+func (c *Current) dispByte(s string, base int, text string) {
+	n, err := strconv.ParseInt(s, base, 8)
 	if err != nil {
-		panic(fmt.Errorf("Invalid signed decimal byte: %s", s))
+		c.errs = append(c.errs, fmt.Errorf("displacement %s out of range; use a label or $-relative target", text))
+		return
 	}
 	c.disp = Disp(int8(n))
 }
 
-func (c *Current) DispDecimal(s string) {
-	n, err := strconv.ParseInt(s, 10, 8)
+func (c *Current) IndexDisp(sign int) {
+	e := c.popExpr()
+	if sign < 0 {
+		e = &exprUnary{op: "-", e: e}
+	}
+	v, ok := constValue(e)
+	if ok && v >= -128 && v <= 127 {
+		c.disp = Disp(v)
+		return
+	}
+	c.dispExpr = &DispExpr{expr: e}
+}
+
+func (c *Current) Number(s string, base int) {
+	n, err := strconv.ParseInt(s, base, 64)
 	if err != nil {
-		panic(fmt.Errorf("Invalid signed decimal byte: %s", s))
+		panic(fmt.Errorf("Invalid number: %s", s))
 	}
-	c.disp = Disp(int8(n))
+	c.pushExpr(exprNum(n))
+}
+
+// HexOrSymbol is two hex digits and an h, such as ACh, which is a symbol
+// instead if one of that name is defined
+func (c *Current) HexOrSymbol(s string) {
+	name := c.assembly.qualify(s)
+	if _, ok := c.assembly.Symbols[name]; ok {
+		c.pushExpr(exprSymbol(name))
+		return
+	}
+	c.Number(s[:len(s)-1], 16)
+}
+
+func (c *Current) Char(s string) {
+	c.pushExpr(exprNum(s[0]))
+}
+
+func (c *Current) Dollar() {
+	c.pushExpr(exprDollar{})
+}
+
+func (c *Current) Symbol(name string) {
+	c.pushExpr(exprSymbol(c.assembly.qualify(name)))
+}
+
+func (c *Current) Unary(op string) {
+	c.pushExpr(&exprUnary{op: op, e: c.popExpr()})
+}
+
+func (c *Current) Binary(op string) {
+	r := c.popExpr()
+	l := c.popExpr()
+	c.pushExpr(&exprBinary{op: op, l: l, r: r})
+}
+
+func (c *Current) pushExpr(e Expr) {
+	c.exprs = append(c.exprs, e)
+}
+
+func (c *Current) popExpr() Expr {
+	e := c.exprs[len(c.exprs)-1]
+	c.exprs = c.exprs[:len(c.exprs)-1]
+	return e
 }
 
 func (c *Current) Conditional(cc Conditional) {
@@ -368,7 +571,7 @@ func (c *Current) R16Contents() {
 }
 
 func (c *Current) IR16Contents() {
-	c.r16Contents = IndexedContents{c.r16, c.disp}
+	c.r16Contents = IndexedContents{addr: c.r16, d: c.disp, expr: c.dispExpr}
 }
 
 func (c *Current) R8(s string) {
@@ -380,23 +583,131 @@ func (c *Current) R16(s string) {
 }
 
 func (c *Current) Emit() {
-	var linst *LabelledInstruction
-	if c.inst != nil {
-		linst = &LabelledInstruction{Inst: c.inst}
-	}
-	if c.label != "" {
-		if linst == nil {
-			linst = &LabelledInstruction{Inst: &LabelHolder{}}
-		}
-		c.assembly.Labels[c.label] = len(c.assembly.Linsts)
-		linst.Label = c.label
+	inst := c.inst
+	if inst == nil && c.label != "" {
+		inst = &LabelHolder{}
 	}
-	if linst != nil {
-		c.assembly.Linsts = append(c.assembly.Linsts, *linst)
+	if inst != nil {
+		linst := LabelledInstruction{Label: c.label, Inst: inst, File: c.file, Line: c.line}
+		c.assembly.Linsts = append(c.assembly.Linsts, linst)
 	}
 	c.clean()
 }
 
 func (c *Current) clean() {
-	*c = Current{assembly: c.assembly, cc: True}
+	*c = Current{assembly: c.assembly, cc: True, file: c.file, line: c.line, errs: c.errs}
+}
+
+// The grammar accepts some combinations of operands which the Z80 doesn't
+// have. Left alone they would encode as some other instruction.
+
+// indexHalf gives the index register an undocumented IXH, IXL, IYH or IYL
+// is half of
+func indexHalf(l Loc8) (R16, bool) {
+	switch l {
+	case IXH, IXL:
+		return IX, true
+	case IYH, IYL:
+		return IY, true
+	}
+	return 0, false
+}
+
+func isMemory(l Loc8) bool {
+	switch l.(type) {
+	case Contents, IndexedContents:
+		return true
+	}
+	return false
+}
+
+func checkLD8(dst, src Loc8) error {
+	switch {
+	case dst == I || dst == R:
+		if src != A {
+			return fmt.Errorf("LD %s can only load from A", dst)
+		}
+	case src == I || src == R:
+		if dst != A {
+			return fmt.Errorf("LD from %s can only load A", src)
+		}
+	case isMemory(dst) && isMemory(src):
+		return fmt.Errorf("can't load from memory to memory")
+	}
+
+	// Only (HL) and indexed memory go to and from anything other than A
+	if c, ok := dst.(Contents); ok && c.addr != HL && src != A {
+		return fmt.Errorf("LD %s can only load from A", dst)
+	}
+	if c, ok := src.(Contents); ok && c.addr != HL && dst != A {
+		return fmt.Errorf("LD from %s can only load A", src)
+	}
+
+	// With an index prefix H and L mean the halves of that index register
+	dstIdx, dstHalf := indexHalf(dst)
+	srcIdx, srcHalf := indexHalf(src)
+	for _, pair := range [][2]Loc8{{dst, src}, {src, dst}} {
+		if _, half := indexHalf(pair[0]); !half {
+			continue
+		}
+		other := pair[1]
+		if other == H || other == L || other == I || other == R || isMemory(other) {
+			return fmt.Errorf("can't use %s with %s", pair[0], other)
+		}
+	}
+	if dstHalf && srcHalf && dstIdx != srcIdx {
+		return fmt.Errorf("can't use %s with %s", dst, src)
+	}
+	return nil
+}
+
+// checkBitOp checks the operands of the CB prefixed instructions, which
+// don't work on index register halves
+func checkBitOp(name string, l Loc8, cpy Loc8) error {
+	if _, half := indexHalf(l); half || l == I || l == R {
+		return fmt.Errorf("%s can't work on %s", name, l)
+	}
+	if cpy == nil {
+		return nil
+	}
+	if _, ok := l.(IndexedContents); !ok {
+		return fmt.Errorf("%s can only copy the result from indexed memory", name)
+	}
+	if _, half := indexHalf(cpy); half || cpy == I || cpy == R {
+		return fmt.Errorf("%s can't copy the result to %s", name, cpy)
+	}
+	return nil
+}
+
+// checkStack checks PUSH and POP, which work on AF but not SP
+func checkStack(name string, l Loc16) error {
+	switch l {
+	case AF, BC, DE, HL, IX, IY:
+		return nil
+	}
+	return fmt.Errorf("%s can't work on %s", name, l)
+}
+
+func checkEx(dst, src Loc16) error {
+	switch {
+	case dst == AF && src == AF_PRIME:
+	case dst == DE && src == HL:
+	case dst.String() == (Contents{SP}).String() && (src == HL || src == IX || src == IY):
+	default:
+		return fmt.Errorf("can't exchange %s with %s", dst, src)
+	}
+	return nil
+}
+
+func checkPort(name string, port Loc8, r R8) error {
+	if port != C {
+		if r != A {
+			return fmt.Errorf("%s with a port number only works with A", name)
+		}
+		return nil
+	}
+	if _, half := indexHalf(r); half || r == I || r == R {
+		return fmt.Errorf("%s (C) can't use %s", name, r)
+	}
+	return nil
 }
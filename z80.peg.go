@@ -16,16 +16,30 @@ const (
 	ruleUnknown pegRule = iota
 	ruleProgram
 	ruleLine
+	ruleLineEnd
 	ruleStatement
 	ruleDirective
 	ruleTitle
-	ruleAseg
+	ruleSection
+	rulePublic
+	ruleExtern
 	ruleOrg
+	ruleEqu
+	ruleDefl
 	ruleDefb
+	ruleDefz
+	ruleDefc
 	ruleDefw
 	ruleDefs
+	ruleAlign
+	ruledataList
+	ruledataItem
+	rulewordList
+	rulenameList
 	ruleLabelDefn
+	ruleColumnLabel
 	ruleLabelText
+	rulelabelchar
 	rulealphaundnum
 	rulealphaund
 	rulenum
@@ -57,29 +71,38 @@ const (
 	ruleILoc8
 	ruleReg8
 	ruleIReg8
+	ruleLdDst16
 	ruleDst16
 	ruleSrc16
 	ruleLoc16
 	ruleReg16
 	ruleIReg16
+	ruleRegName
 	ruleReg16Contents
 	rulePlainR16C
 	ruleIndexedR16C
+	ruleIndexDisp
 	rulen
 	rulenn
 	ruledisp
 	rulesignedDecimalByte
 	rulesignedHexByteH
 	rulesignedHexByte0x
-	rulehexByteH
-	rulehexByte0x
-	ruledecimalByte
-	ruleLabelNN
-	rulehexWordH
-	rulehexWord0x
-	rulehexWord
-	rulezeroHexWord
+	ruleexpr
+	rulecmpExpr
+	rulecmpWord
+	ruleorExpr
+	rulexorExpr
+	ruleandExpr
+	ruleshiftExpr
+	ruleaddExpr
+	rulemulExpr
+	ruleunaryExpr
+	ruleprimary
+	rulenumber
+	rulecharLit
 	rulenn_contents
+	ruleopEnd
 	ruleAlu
 	ruleAdd
 	ruleAdc
@@ -150,6 +173,9 @@ const (
 	ruleJp
 	ruleJr
 	ruleDjnz
+	ruleJpIndirect
+	ruleJpReg
+	ruleJrTarget
 	ruleIO
 	ruleIN
 	ruleOUT
@@ -195,7 +221,6 @@ const (
 	ruleAction3
 	ruleAction4
 	ruleAction5
-	rulePegText
 	ruleAction6
 	ruleAction7
 	ruleAction8
@@ -210,6 +235,7 @@ const (
 	ruleAction17
 	ruleAction18
 	ruleAction19
+	rulePegText
 	ruleAction20
 	ruleAction21
 	ruleAction22
@@ -306,22 +332,111 @@ const (
 	ruleAction113
 	ruleAction114
 	ruleAction115
+	ruleAction116
+	ruleAction117
+	ruleAction118
+	ruleAction119
+	ruleAction120
+	ruleAction121
+	ruleAction122
+	ruleAction123
+	ruleAction124
+	ruleAction125
+	ruleAction126
+	ruleAction127
+	ruleAction128
+	ruleAction129
+	ruleAction130
+	ruleAction131
+	ruleAction132
+	ruleAction133
+	ruleAction134
+	ruleAction135
+	ruleAction136
+	ruleAction137
+	ruleAction138
+	ruleAction139
+	ruleAction140
+	ruleAction141
+	ruleAction142
+	ruleAction143
+	ruleAction144
+	ruleAction145
+	ruleAction146
+	ruleAction147
+	ruleAction148
+	ruleAction149
+	ruleAction150
+	ruleAction151
+	ruleAction152
+	ruleAction153
+	ruleAction154
+	ruleAction155
+	ruleAction156
+	ruleAction157
+	ruleAction158
+	ruleAction159
+	ruleAction160
+	ruleAction161
+	ruleAction162
+	ruleAction163
+	ruleAction164
+	ruleAction165
+	ruleAction166
+	ruleAction167
+	ruleAction168
+	ruleAction169
+	ruleAction170
+	ruleAction171
+	ruleAction172
+	ruleAction173
+	ruleAction174
+	ruleAction175
+	ruleAction176
+	ruleAction177
+	ruleAction178
+	ruleAction179
+	ruleAction180
+	ruleAction181
+	ruleAction182
+	ruleAction183
+	ruleAction184
+	ruleAction185
+	ruleAction186
+	ruleAction187
+	ruleAction188
+	ruleAction189
+	ruleAction190
 )
 
 var rul3s = [...]string{
 	"Unknown",
 	"Program",
 	"Line",
+	"LineEnd",
 	"Statement",
 	"Directive",
 	"Title",
-	"Aseg",
+	"Section",
+	"Public",
+	"Extern",
 	"Org",
+	"Equ",
+	"Defl",
 	"Defb",
+	"Defz",
+	"Defc",
 	"Defw",
 	"Defs",
+	"Align",
+	"dataList",
+	"dataItem",
+	"wordList",
+	"nameList",
 	"LabelDefn",
+	"ColumnLabel",
 	"LabelText",
+	"labelchar",
 	"alphaundnum",
 	"alphaund",
 	"num",
@@ -353,29 +468,38 @@ var rul3s = [...]string{
 	"ILoc8",
 	"Reg8",
 	"IReg8",
+	"LdDst16",
 	"Dst16",
 	"Src16",
 	"Loc16",
 	"Reg16",
 	"IReg16",
+	"RegName",
 	"Reg16Contents",
 	"PlainR16C",
 	"IndexedR16C",
+	"IndexDisp",
 	"n",
 	"nn",
 	"disp",
 	"signedDecimalByte",
 	"signedHexByteH",
 	"signedHexByte0x",
-	"hexByteH",
-	"hexByte0x",
-	"decimalByte",
-	"LabelNN",
-	"hexWordH",
-	"hexWord0x",
-	"hexWord",
-	"zeroHexWord",
+	"expr",
+	"cmpExpr",
+	"cmpWord",
+	"orExpr",
+	"xorExpr",
+	"andExpr",
+	"shiftExpr",
+	"addExpr",
+	"mulExpr",
+	"unaryExpr",
+	"primary",
+	"number",
+	"charLit",
 	"nn_contents",
+	"opEnd",
 	"Alu",
 	"Add",
 	"Adc",
@@ -446,6 +570,9 @@ var rul3s = [...]string{
 	"Jp",
 	"Jr",
 	"Djnz",
+	"JpIndirect",
+	"JpReg",
+	"JrTarget",
 	"IO",
 	"IN",
 	"OUT",
@@ -491,7 +618,6 @@ var rul3s = [...]string{
 	"Action3",
 	"Action4",
 	"Action5",
-	"PegText",
 	"Action6",
 	"Action7",
 	"Action8",
@@ -506,6 +632,7 @@ var rul3s = [...]string{
 	"Action17",
 	"Action18",
 	"Action19",
+	"PegText",
 	"Action20",
 	"Action21",
 	"Action22",
@@ -602,6 +729,81 @@ var rul3s = [...]string{
 	"Action113",
 	"Action114",
 	"Action115",
+	"Action116",
+	"Action117",
+	"Action118",
+	"Action119",
+	"Action120",
+	"Action121",
+	"Action122",
+	"Action123",
+	"Action124",
+	"Action125",
+	"Action126",
+	"Action127",
+	"Action128",
+	"Action129",
+	"Action130",
+	"Action131",
+	"Action132",
+	"Action133",
+	"Action134",
+	"Action135",
+	"Action136",
+	"Action137",
+	"Action138",
+	"Action139",
+	"Action140",
+	"Action141",
+	"Action142",
+	"Action143",
+	"Action144",
+	"Action145",
+	"Action146",
+	"Action147",
+	"Action148",
+	"Action149",
+	"Action150",
+	"Action151",
+	"Action152",
+	"Action153",
+	"Action154",
+	"Action155",
+	"Action156",
+	"Action157",
+	"Action158",
+	"Action159",
+	"Action160",
+	"Action161",
+	"Action162",
+	"Action163",
+	"Action164",
+	"Action165",
+	"Action166",
+	"Action167",
+	"Action168",
+	"Action169",
+	"Action170",
+	"Action171",
+	"Action172",
+	"Action173",
+	"Action174",
+	"Action175",
+	"Action176",
+	"Action177",
+	"Action178",
+	"Action179",
+	"Action180",
+	"Action181",
+	"Action182",
+	"Action183",
+	"Action184",
+	"Action185",
+	"Action186",
+	"Action187",
+	"Action188",
+	"Action189",
+	"Action190",
 }
 
 type token32 struct {
@@ -718,7 +920,7 @@ type PegAssembler struct {
 
 	Buffer string
 	buffer []rune
-	rules  [293]func() bool
+	rules  [394]func() bool
 	parse  func(rule ...int) error
 	reset  func()
 	Pretty bool
@@ -813,234 +1015,384 @@ func (p *PegAssembler) Execute() {
 		case ruleAction0:
 			p.Emit()
 		case ruleAction1:
-			p.Org()
+			p.NewLine()
 		case ruleAction2:
-			p.DefByte()
+			p.Section(buffer[begin:end])
 		case ruleAction3:
-			p.DefWord()
+			p.Section("code")
 		case ruleAction4:
-			p.DefSpace()
+			p.Section("data")
 		case ruleAction5:
-			p.LabelDefn(buffer[begin:end])
+			p.Section("")
 		case ruleAction6:
-			p.LD8()
+			p.Public()
 		case ruleAction7:
-			p.LD16()
+			p.Extern()
 		case ruleAction8:
-			p.Push()
+			p.Org()
 		case ruleAction9:
-			p.Pop()
+			p.Equ()
 		case ruleAction10:
-			p.Ex()
+			p.Defl()
 		case ruleAction11:
-			p.Inc8()
+			p.Defl()
 		case ruleAction12:
-			p.Inc8()
+			p.DefByte()
 		case ruleAction13:
-			p.Inc16()
+			p.DefZero()
 		case ruleAction14:
-			p.Dec8()
+			p.DefLastHigh()
 		case ruleAction15:
-			p.Dec8()
+			p.DefWord()
 		case ruleAction16:
-			p.Dec16()
+			p.Fill()
 		case ruleAction17:
-			p.Add16()
+			p.DefSpace()
 		case ruleAction18:
-			p.Adc16()
+			p.Fill()
 		case ruleAction19:
-			p.Sbc16()
+			p.Align()
 		case ruleAction20:
-			p.Dst8()
+			p.DataString(buffer[begin:end])
 		case ruleAction21:
-			p.Src8()
+			p.DataString(buffer[begin:end])
 		case ruleAction22:
-			p.Loc8()
+			p.DataByte()
 		case ruleAction23:
-			p.Copy8()
+			p.DataWord()
 		case ruleAction24:
-			p.Loc8()
+			p.DataWord()
 		case ruleAction25:
-			p.R8(buffer[begin:end])
+			p.Name(buffer[begin:end])
 		case ruleAction26:
-			p.R8(buffer[begin:end])
+			p.Name(buffer[begin:end])
 		case ruleAction27:
-			p.Dst16()
+			p.SymbolDefn(buffer[begin:end])
 		case ruleAction28:
-			p.Src16()
+			p.LabelDefn(buffer[begin:end])
 		case ruleAction29:
-			p.Loc16()
+			p.SymbolDefn(buffer[begin:end])
 		case ruleAction30:
-			p.R16(buffer[begin:end])
+			p.LabelDefn(buffer[begin:end])
 		case ruleAction31:
-			p.R16(buffer[begin:end])
+			p.LD8()
 		case ruleAction32:
-			p.R16Contents()
+			p.LD16()
 		case ruleAction33:
-			p.IR16Contents()
+			p.Push()
 		case ruleAction34:
-			p.DispDecimal(buffer[begin:end])
+			p.Pop()
 		case ruleAction35:
-			p.DispHex(buffer[begin:end])
+			p.Ex()
 		case ruleAction36:
-			p.Disp0xHex(buffer[begin:end])
+			p.Inc8()
 		case ruleAction37:
-			p.Nhex(buffer[begin:end])
+			p.Inc8()
 		case ruleAction38:
-			p.Nhex(buffer[begin:end])
+			p.Inc16()
 		case ruleAction39:
-			p.Ndec(buffer[begin:end])
+			p.Dec8()
 		case ruleAction40:
-			p.NNLabel(buffer[begin:end])
+			p.Dec8()
 		case ruleAction41:
-			p.NNhex(buffer[begin:end])
+			p.Dec16()
 		case ruleAction42:
-			p.NNContents()
+			p.Add16()
 		case ruleAction43:
-			p.Accum("ADD")
+			p.Adc16()
 		case ruleAction44:
-			p.Accum("ADC")
+			p.Sbc16()
 		case ruleAction45:
-			p.Accum("SUB")
+			p.Dst8()
 		case ruleAction46:
-			p.Accum("SBC")
+			p.Src8()
 		case ruleAction47:
-			p.Accum("AND")
+			p.Loc8()
 		case ruleAction48:
-			p.Accum("XOR")
+			p.Copy8()
 		case ruleAction49:
-			p.Accum("OR")
+			p.Loc8()
 		case ruleAction50:
-			p.Accum("CP")
+			p.R8(buffer[begin:end])
 		case ruleAction51:
-			p.Rot("RLC")
+			p.R8(buffer[begin:end])
 		case ruleAction52:
-			p.Rot("RRC")
+			p.Dst16()
 		case ruleAction53:
-			p.Rot("RL")
+			p.Dst16()
 		case ruleAction54:
-			p.Rot("RR")
+			p.Src16()
 		case ruleAction55:
-			p.Rot("SLA")
+			p.Loc16()
 		case ruleAction56:
-			p.Rot("SRA")
+			p.R16(buffer[begin:end])
 		case ruleAction57:
-			p.Rot("SLL")
+			p.R16(buffer[begin:end])
 		case ruleAction58:
-			p.Rot("SRL")
+			p.R16Contents()
 		case ruleAction59:
-			p.Bit()
+			p.IR16Contents()
 		case ruleAction60:
-			p.Res()
+			p.IndexDisp(1)
 		case ruleAction61:
-			p.Set()
+			p.IndexDisp(-1)
 		case ruleAction62:
-			p.Simple(buffer[begin:end])
+			p.N()
 		case ruleAction63:
-			p.Simple(buffer[begin:end])
+			p.NN()
 		case ruleAction64:
-			p.Simple(buffer[begin:end])
+			p.DispDecimal(buffer[begin:end])
 		case ruleAction65:
-			p.Simple(buffer[begin:end])
+			p.DispHex(buffer[begin:end])
 		case ruleAction66:
-			p.Simple(buffer[begin:end])
+			p.Disp0xHex(buffer[begin:end])
 		case ruleAction67:
-			p.Simple(buffer[begin:end])
+			p.Binary("==")
 		case ruleAction68:
-			p.Simple(buffer[begin:end])
+			p.Binary("!=")
 		case ruleAction69:
-			p.Simple(buffer[begin:end])
+			p.Binary("<=")
 		case ruleAction70:
-			p.Simple(buffer[begin:end])
+			p.Binary(">=")
 		case ruleAction71:
-			p.Simple(buffer[begin:end])
+			p.Binary("<")
 		case ruleAction72:
-			p.Simple(buffer[begin:end])
+			p.Binary(">")
 		case ruleAction73:
-			p.Simple(buffer[begin:end])
+			p.Binary("==")
 		case ruleAction74:
-			p.Simple(buffer[begin:end])
+			p.Binary("!=")
 		case ruleAction75:
-			p.EDSimple(buffer[begin:end])
+			p.Binary("<=")
 		case ruleAction76:
-			p.EDSimple(buffer[begin:end])
+			p.Binary(">=")
 		case ruleAction77:
-			p.EDSimple(buffer[begin:end])
+			p.Binary("<")
 		case ruleAction78:
-			p.EDSimple(buffer[begin:end])
+			p.Binary(">")
 		case ruleAction79:
-			p.EDSimple(buffer[begin:end])
+			p.Binary("|")
 		case ruleAction80:
-			p.EDSimple(buffer[begin:end])
+			p.Binary("|")
 		case ruleAction81:
-			p.EDSimple(buffer[begin:end])
+			p.Binary("^")
 		case ruleAction82:
-			p.EDSimple(buffer[begin:end])
+			p.Binary("^")
 		case ruleAction83:
-			p.EDSimple(buffer[begin:end])
+			p.Binary("&")
 		case ruleAction84:
-			p.EDSimple(buffer[begin:end])
+			p.Binary("&")
 		case ruleAction85:
-			p.EDSimple(buffer[begin:end])
+			p.Binary("<<")
 		case ruleAction86:
-			p.EDSimple(buffer[begin:end])
+			p.Binary(">>")
 		case ruleAction87:
-			p.EDSimple(buffer[begin:end])
+			p.Binary("<<")
 		case ruleAction88:
-			p.EDSimple(buffer[begin:end])
+			p.Binary(">>")
 		case ruleAction89:
-			p.EDSimple(buffer[begin:end])
+			p.Binary("+")
 		case ruleAction90:
-			p.EDSimple(buffer[begin:end])
+			p.Binary("-")
 		case ruleAction91:
-			p.EDSimple(buffer[begin:end])
+			p.Binary("*")
 		case ruleAction92:
-			p.EDSimple(buffer[begin:end])
+			p.Binary("/")
 		case ruleAction93:
-			p.EDSimple(buffer[begin:end])
+			p.Binary("%")
 		case ruleAction94:
-			p.EDSimple(buffer[begin:end])
+			p.Binary("%")
 		case ruleAction95:
-			p.EDSimple(buffer[begin:end])
+			p.Unary("-")
 		case ruleAction96:
-			p.EDSimple(buffer[begin:end])
+			p.Unary("~")
 		case ruleAction97:
-			p.EDSimple(buffer[begin:end])
+			p.Unary("!")
 		case ruleAction98:
-			p.EDSimple(buffer[begin:end])
+			p.Unary("~")
 		case ruleAction99:
-			p.Rst()
+			p.Unary("LOW")
 		case ruleAction100:
-			p.Call()
+			p.Unary("HIGH")
 		case ruleAction101:
-			p.Ret()
+			p.Dollar()
 		case ruleAction102:
-			p.Jp()
+			p.Symbol(buffer[begin:end])
 		case ruleAction103:
-			p.Jr()
+			p.Number(buffer[begin:end], 16)
 		case ruleAction104:
-			p.Djnz()
+			p.Number(buffer[begin:end], 2)
 		case ruleAction105:
-			p.In()
+			p.Number(buffer[begin:end], 16)
 		case ruleAction106:
-			p.Out()
+			p.HexOrSymbol(buffer[begin:end])
 		case ruleAction107:
-			p.ODigit(buffer[begin:end])
+			p.Number(buffer[begin:end], 2)
 		case ruleAction108:
-			p.Conditional(Not{FT_Z})
+			p.Number(buffer[begin:end], 2)
 		case ruleAction109:
-			p.Conditional(FT_Z)
+			p.Number(buffer[begin:end], 8)
 		case ruleAction110:
-			p.Conditional(Not{FT_C})
+			p.Number(buffer[begin:end], 10)
 		case ruleAction111:
-			p.Conditional(FT_C)
+			p.Char(buffer[begin:end])
 		case ruleAction112:
-			p.Conditional(FT_PO)
+			p.Char(buffer[begin:end])
 		case ruleAction113:
-			p.Conditional(FT_PE)
+			p.NNContents()
 		case ruleAction114:
-			p.Conditional(FT_P)
+			p.Accum("ADD")
 		case ruleAction115:
+			p.Accum("ADC")
+		case ruleAction116:
+			p.Accum("SUB")
+		case ruleAction117:
+			p.Accum("SBC")
+		case ruleAction118:
+			p.Accum("AND")
+		case ruleAction119:
+			p.Accum("XOR")
+		case ruleAction120:
+			p.Accum("OR")
+		case ruleAction121:
+			p.Accum("CP")
+		case ruleAction122:
+			p.Rot("RLC")
+		case ruleAction123:
+			p.Rot("RRC")
+		case ruleAction124:
+			p.Rot("RL")
+		case ruleAction125:
+			p.Rot("RR")
+		case ruleAction126:
+			p.Rot("SLA")
+		case ruleAction127:
+			p.Rot("SRA")
+		case ruleAction128:
+			p.Rot("SLL")
+		case ruleAction129:
+			p.Rot("SRL")
+		case ruleAction130:
+			p.Bit()
+		case ruleAction131:
+			p.Res()
+		case ruleAction132:
+			p.Set()
+		case ruleAction133:
+			p.Simple(buffer[begin:end])
+		case ruleAction134:
+			p.Simple(buffer[begin:end])
+		case ruleAction135:
+			p.Simple(buffer[begin:end])
+		case ruleAction136:
+			p.Simple(buffer[begin:end])
+		case ruleAction137:
+			p.Simple(buffer[begin:end])
+		case ruleAction138:
+			p.Simple(buffer[begin:end])
+		case ruleAction139:
+			p.Simple(buffer[begin:end])
+		case ruleAction140:
+			p.Simple(buffer[begin:end])
+		case ruleAction141:
+			p.Simple(buffer[begin:end])
+		case ruleAction142:
+			p.Simple(buffer[begin:end])
+		case ruleAction143:
+			p.Simple(buffer[begin:end])
+		case ruleAction144:
+			p.Simple(buffer[begin:end])
+		case ruleAction145:
+			p.Simple(buffer[begin:end])
+		case ruleAction146:
+			p.EDSimple(buffer[begin:end])
+		case ruleAction147:
+			p.EDSimple(buffer[begin:end])
+		case ruleAction148:
+			p.EDSimple(buffer[begin:end])
+		case ruleAction149:
+			p.EDSimple(buffer[begin:end])
+		case ruleAction150:
+			p.EDSimple(buffer[begin:end])
+		case ruleAction151:
+			p.EDSimple(buffer[begin:end])
+		case ruleAction152:
+			p.EDSimple(buffer[begin:end])
+		case ruleAction153:
+			p.EDSimple(buffer[begin:end])
+		case ruleAction154:
+			p.EDSimple(buffer[begin:end])
+		case ruleAction155:
+			p.EDSimple(buffer[begin:end])
+		case ruleAction156:
+			p.EDSimple(buffer[begin:end])
+		case ruleAction157:
+			p.EDSimple(buffer[begin:end])
+		case ruleAction158:
+			p.EDSimple(buffer[begin:end])
+		case ruleAction159:
+			p.EDSimple(buffer[begin:end])
+		case ruleAction160:
+			p.EDSimple(buffer[begin:end])
+		case ruleAction161:
+			p.EDSimple(buffer[begin:end])
+		case ruleAction162:
+			p.EDSimple(buffer[begin:end])
+		case ruleAction163:
+			p.EDSimple(buffer[begin:end])
+		case ruleAction164:
+			p.EDSimple(buffer[begin:end])
+		case ruleAction165:
+			p.EDSimple(buffer[begin:end])
+		case ruleAction166:
+			p.EDSimple(buffer[begin:end])
+		case ruleAction167:
+			p.EDSimple(buffer[begin:end])
+		case ruleAction168:
+			p.EDSimple(buffer[begin:end])
+		case ruleAction169:
+			p.EDSimple(buffer[begin:end])
+		case ruleAction170:
+			p.Rst()
+		case ruleAction171:
+			p.Call()
+		case ruleAction172:
+			p.Ret()
+		case ruleAction173:
+			p.Jp()
+		case ruleAction174:
+			p.Jp()
+		case ruleAction175:
+			p.Jr()
+		case ruleAction176:
+			p.Djnz()
+		case ruleAction177:
+			p.Src16()
+		case ruleAction178:
+			p.R16(buffer[begin:end])
+		case ruleAction179:
+			p.JrTarget()
+		case ruleAction180:
+			p.In()
+		case ruleAction181:
+			p.Out()
+		case ruleAction182:
+			p.ODigit(buffer[begin:end])
+		case ruleAction183:
+			p.Conditional(Not{FT_Z})
+		case ruleAction184:
+			p.Conditional(FT_Z)
+		case ruleAction185:
+			p.Conditional(Not{FT_C})
+		case ruleAction186:
+			p.Conditional(FT_C)
+		case ruleAction187:
+			p.Conditional(FT_PO)
+		case ruleAction188:
+			p.Conditional(FT_PE)
+		case ruleAction189:
+			p.Conditional(FT_P)
+		case ruleAction190:
 			p.Conditional(FT_M)
 
 		}
@@ -1123,82 +1475,107 @@ func (p *PegAssembler) Init() {
 				position1 := position
 				{
 					position4 := position
-				l5:
-					{
-						position6, tokenIndex6 := position, tokenIndex
-						if !_rules[rulews]() {
-							goto l6
-						}
-						goto l5
-					l6:
-						position, tokenIndex = position6, tokenIndex6
-					}
 					{
-						position7, tokenIndex7 := position, tokenIndex
+						position5, tokenIndex5 := position, tokenIndex
 						{
-							position9 := position
-							if !_rules[ruleLabelText]() {
-								goto l7
-							}
-							if buffer[position] != rune(':') {
-								goto l7
-							}
-							position++
+							position7, tokenIndex7 := position, tokenIndex
+						l9:
 							{
 								position10, tokenIndex10 := position, tokenIndex
 								if !_rules[rulews]() {
 									goto l10
 								}
-								goto l11
+								goto l9
 							l10:
 								position, tokenIndex = position10, tokenIndex10
 							}
-						l11:
-							{
-								add(ruleAction5, position)
-							}
-							add(ruleLabelDefn, position9)
-						}
-						goto l8
-					l7:
-						position, tokenIndex = position7, tokenIndex7
-					}
-				l8:
-				l13:
-					{
-						position14, tokenIndex14 := position, tokenIndex
-						if !_rules[rulews]() {
-							goto l14
-						}
-						goto l13
-					l14:
-						position, tokenIndex = position14, tokenIndex14
-					}
-					{
-						position15, tokenIndex15 := position, tokenIndex
-						{
-							position17 := position
 							{
-								position18, tokenIndex18 := position, tokenIndex
+								position11 := position
 								{
-									position20 := position
+									position12, tokenIndex12 := position, tokenIndex
+									if !_rules[ruleLabelText]() {
+										goto l13
+									}
 									{
-										position21, tokenIndex21 := position, tokenIndex
+										position14, tokenIndex14 := position, tokenIndex
 										{
-											position23 := position
-											{
-												position24, tokenIndex24 := position, tokenIndex
-												{
-													position26, tokenIndex26 := position, tokenIndex
-													if buffer[position] != rune('d') {
-														goto l27
-													}
-													position++
-													goto l26
-												l27:
+											position15, tokenIndex15 := position, tokenIndex
+											if !_rules[rulews]() {
+												goto l15
+											}
+											goto l16
+										l15:
+											position, tokenIndex = position15, tokenIndex15
+										}
+									l16:
+										if buffer[position] != rune(':') {
+											goto l13
+										}
+										position++
+										if buffer[position] != rune('=') {
+											goto l13
+										}
+										position++
+										position, tokenIndex = position14, tokenIndex14
+									}
+									{
+										add(ruleAction27, position)
+									}
+									goto l12
+								l13:
+									position, tokenIndex = position12, tokenIndex12
+									if !_rules[ruleLabelText]() {
+										goto l18
+									}
+									if buffer[position] != rune(':') {
+										goto l18
+									}
+									position++
+									{
+										position19, tokenIndex19 := position, tokenIndex
+										if !_rules[rulews]() {
+											goto l19
+										}
+										goto l20
+									l19:
+										position, tokenIndex = position19, tokenIndex19
+									}
+								l20:
+									{
+										add(ruleAction28, position)
+									}
+									goto l12
+								l18:
+									position, tokenIndex = position12, tokenIndex12
+									if !_rules[ruleLabelText]() {
+										goto l8
+									}
+									{
+										position22, tokenIndex22 := position, tokenIndex
+										{
+											position23, tokenIndex23 := position, tokenIndex
+											if !_rules[rulews]() {
+												goto l23
+											}
+											goto l24
+										l23:
+											position, tokenIndex = position23, tokenIndex23
+										}
+									l24:
+										{
+											switch buffer[position] {
+											case 'S', 's':
+												{
+													position26, tokenIndex26 := position, tokenIndex
+													if buffer[position] != rune('s') {
+														goto l27
+													}
+													position++
+													goto l26
+												l27:
 													position, tokenIndex = position26, tokenIndex26
-													if buffer[position] != rune('D') {
-														goto l25
+													if buffer[position] != rune('S') {
+														goto l8
 													}
 													position++
 												}
@@ -1213,14508 +1590,14389 @@ func (p *PegAssembler) Init() {
 												l29:
 													position, tokenIndex = position28, tokenIndex28
 													if buffer[position] != rune('E') {
-														goto l25
+														goto l8
 													}
 													position++
 												}
 											l28:
 												{
 													position30, tokenIndex30 := position, tokenIndex
-													if buffer[position] != rune('f') {
+													if buffer[position] != rune('t') {
 														goto l31
 													}
 													position++
 													goto l30
 												l31:
 													position, tokenIndex = position30, tokenIndex30
-													if buffer[position] != rune('F') {
-														goto l25
+													if buffer[position] != rune('T') {
+														goto l8
 													}
 													position++
 												}
 											l30:
+												if !_rules[rulews]() {
+													goto l8
+												}
+												break
+											case 'D', 'd':
 												{
 													position32, tokenIndex32 := position, tokenIndex
-													if buffer[position] != rune('b') {
+													if buffer[position] != rune('d') {
 														goto l33
 													}
 													position++
 													goto l32
 												l33:
 													position, tokenIndex = position32, tokenIndex32
-													if buffer[position] != rune('B') {
-														goto l25
+													if buffer[position] != rune('D') {
+														goto l8
 													}
 													position++
 												}
 											l32:
-												goto l24
-											l25:
-												position, tokenIndex = position24, tokenIndex24
 												{
 													position34, tokenIndex34 := position, tokenIndex
-													if buffer[position] != rune('d') {
+													if buffer[position] != rune('e') {
 														goto l35
 													}
 													position++
 													goto l34
 												l35:
 													position, tokenIndex = position34, tokenIndex34
-													if buffer[position] != rune('D') {
-														goto l22
+													if buffer[position] != rune('E') {
+														goto l8
 													}
 													position++
 												}
 											l34:
 												{
 													position36, tokenIndex36 := position, tokenIndex
-													if buffer[position] != rune('b') {
+													if buffer[position] != rune('f') {
 														goto l37
 													}
 													position++
 													goto l36
 												l37:
 													position, tokenIndex = position36, tokenIndex36
-													if buffer[position] != rune('B') {
-														goto l22
+													if buffer[position] != rune('F') {
+														goto l8
 													}
 													position++
 												}
 											l36:
-											}
-										l24:
-											if !_rules[rulews]() {
-												goto l22
-											}
-											if !_rules[rulen]() {
-												goto l22
-											}
-											{
-												add(ruleAction2, position)
-											}
-											add(ruleDefb, position23)
-										}
-										goto l21
-									l22:
-										position, tokenIndex = position21, tokenIndex21
-										{
-											position40 := position
-											{
-												position41, tokenIndex41 := position, tokenIndex
 												{
-													position43, tokenIndex43 := position, tokenIndex
-													if buffer[position] != rune('d') {
-														goto l44
+													position38, tokenIndex38 := position, tokenIndex
+													if buffer[position] != rune('l') {
+														goto l39
 													}
 													position++
-													goto l43
-												l44:
-													position, tokenIndex = position43, tokenIndex43
-													if buffer[position] != rune('D') {
-														goto l42
+													goto l38
+												l39:
+													position, tokenIndex = position38, tokenIndex38
+													if buffer[position] != rune('L') {
+														goto l8
 													}
 													position++
 												}
-											l43:
+											l38:
+												if !_rules[rulews]() {
+													goto l8
+												}
+												break
+											case '=':
+												if buffer[position] != rune('=') {
+													goto l8
+												}
+												position++
+												break
+											default:
 												{
-													position45, tokenIndex45 := position, tokenIndex
+													position40, tokenIndex40 := position, tokenIndex
 													if buffer[position] != rune('e') {
-														goto l46
+														goto l41
 													}
 													position++
-													goto l45
-												l46:
-													position, tokenIndex = position45, tokenIndex45
+													goto l40
+												l41:
+													position, tokenIndex = position40, tokenIndex40
 													if buffer[position] != rune('E') {
-														goto l42
+														goto l8
 													}
 													position++
 												}
-											l45:
+											l40:
 												{
-													position47, tokenIndex47 := position, tokenIndex
-													if buffer[position] != rune('f') {
-														goto l48
+													position42, tokenIndex42 := position, tokenIndex
+													if buffer[position] != rune('q') {
+														goto l43
 													}
 													position++
-													goto l47
-												l48:
-													position, tokenIndex = position47, tokenIndex47
-													if buffer[position] != rune('F') {
-														goto l42
+													goto l42
+												l43:
+													position, tokenIndex = position42, tokenIndex42
+													if buffer[position] != rune('Q') {
+														goto l8
 													}
 													position++
 												}
-											l47:
+											l42:
 												{
-													position49, tokenIndex49 := position, tokenIndex
-													if buffer[position] != rune('s') {
-														goto l50
+													position44, tokenIndex44 := position, tokenIndex
+													if buffer[position] != rune('u') {
+														goto l45
 													}
 													position++
-													goto l49
-												l50:
-													position, tokenIndex = position49, tokenIndex49
-													if buffer[position] != rune('S') {
-														goto l42
+													goto l44
+												l45:
+													position, tokenIndex = position44, tokenIndex44
+													if buffer[position] != rune('U') {
+														goto l8
 													}
 													position++
 												}
-											l49:
-												goto l41
-											l42:
-												position, tokenIndex = position41, tokenIndex41
-												{
-													position51, tokenIndex51 := position, tokenIndex
-													if buffer[position] != rune('d') {
-														goto l52
-													}
-													position++
-													goto l51
-												l52:
-													position, tokenIndex = position51, tokenIndex51
-													if buffer[position] != rune('D') {
-														goto l39
+											l44:
+												if !_rules[rulews]() {
+													goto l8
+												}
+												break
+											}
+										}
+
+										position, tokenIndex = position22, tokenIndex22
+									}
+									{
+										add(ruleAction29, position)
+									}
+								}
+							l12:
+								add(ruleLabelDefn, position11)
+							}
+							goto l7
+						l8:
+							position, tokenIndex = position7, tokenIndex7
+							{
+								position47 := position
+								{
+									position48, tokenIndex48 := position, tokenIndex
+									if !_rules[ruleStatement]() {
+										goto l48
+									}
+									{
+										position49, tokenIndex49 := position, tokenIndex
+										if !_rules[rulews]() {
+											goto l49
+										}
+										goto l50
+									l49:
+										position, tokenIndex = position49, tokenIndex49
+									}
+								l50:
+									{
+										position51, tokenIndex51 := position, tokenIndex
+										if !_rules[ruleComment]() {
+											goto l51
+										}
+										goto l52
+									l51:
+										position, tokenIndex = position51, tokenIndex51
+									}
+								l52:
+									{
+										position53, tokenIndex53 := position, tokenIndex
+										if !_rules[rulews]() {
+											goto l53
+										}
+										goto l54
+									l53:
+										position, tokenIndex = position53, tokenIndex53
+									}
+								l54:
+									{
+										position55, tokenIndex55 := position, tokenIndex
+										{
+											position56, tokenIndex56 := position, tokenIndex
+											{
+												switch buffer[position] {
+												case '\n':
+													if buffer[position] != rune('\n') {
+														goto l56
 													}
 													position++
-												}
-											l51:
-												{
-													position53, tokenIndex53 := position, tokenIndex
-													if buffer[position] != rune('s') {
-														goto l54
+													break
+												case '\r':
+													if buffer[position] != rune('\r') {
+														goto l56
 													}
 													position++
-													goto l53
-												l54:
-													position, tokenIndex = position53, tokenIndex53
-													if buffer[position] != rune('S') {
-														goto l39
+													break
+												default:
+													if buffer[position] != rune(':') {
+														goto l56
 													}
 													position++
+													break
 												}
-											l53:
-											}
-										l41:
-											if !_rules[rulews]() {
-												goto l39
 											}
-											if !_rules[rulen]() {
-												goto l39
-											}
-											{
-												add(ruleAction4, position)
-											}
-											add(ruleDefs, position40)
+
+											goto l55
+										l56:
+											position, tokenIndex = position56, tokenIndex56
 										}
-										goto l21
-									l39:
-										position, tokenIndex = position21, tokenIndex21
+										if !matchDot() {
+											goto l55
+										}
+										goto l48
+									l55:
+										position, tokenIndex = position55, tokenIndex55
+									}
+									goto l5
+								l48:
+									position, tokenIndex = position48, tokenIndex48
+								}
+								if !_rules[ruleLabelText]() {
+									goto l5
+								}
+								{
+									position58, tokenIndex58 := position, tokenIndex
+									{
+										position59, tokenIndex59 := position, tokenIndex
 										{
 											switch buffer[position] {
-											case 'D', 'd':
-												{
-													position57 := position
-													{
-														position58, tokenIndex58 := position, tokenIndex
-														{
-															position60, tokenIndex60 := position, tokenIndex
-															if buffer[position] != rune('d') {
-																goto l61
-															}
-															position++
-															goto l60
-														l61:
-															position, tokenIndex = position60, tokenIndex60
-															if buffer[position] != rune('D') {
-																goto l59
-															}
-															position++
-														}
-													l60:
-														{
-															position62, tokenIndex62 := position, tokenIndex
-															if buffer[position] != rune('e') {
-																goto l63
-															}
-															position++
-															goto l62
-														l63:
-															position, tokenIndex = position62, tokenIndex62
-															if buffer[position] != rune('E') {
-																goto l59
-															}
-															position++
-														}
-													l62:
-														{
-															position64, tokenIndex64 := position, tokenIndex
-															if buffer[position] != rune('f') {
-																goto l65
-															}
-															position++
-															goto l64
-														l65:
-															position, tokenIndex = position64, tokenIndex64
-															if buffer[position] != rune('F') {
-																goto l59
-															}
-															position++
-														}
-													l64:
-														{
-															position66, tokenIndex66 := position, tokenIndex
-															if buffer[position] != rune('w') {
-																goto l67
-															}
-															position++
-															goto l66
-														l67:
-															position, tokenIndex = position66, tokenIndex66
-															if buffer[position] != rune('W') {
-																goto l59
-															}
-															position++
-														}
-													l66:
-														goto l58
-													l59:
-														position, tokenIndex = position58, tokenIndex58
-														{
-															position68, tokenIndex68 := position, tokenIndex
-															if buffer[position] != rune('d') {
-																goto l69
-															}
-															position++
-															goto l68
-														l69:
-															position, tokenIndex = position68, tokenIndex68
-															if buffer[position] != rune('D') {
-																goto l19
-															}
-															position++
-														}
-													l68:
-														{
-															position70, tokenIndex70 := position, tokenIndex
-															if buffer[position] != rune('w') {
-																goto l71
-															}
-															position++
-															goto l70
-														l71:
-															position, tokenIndex = position70, tokenIndex70
-															if buffer[position] != rune('W') {
-																goto l19
-															}
-															position++
-														}
-													l70:
-													}
-												l58:
-													if !_rules[rulews]() {
-														goto l19
-													}
-													if !_rules[rulenn]() {
-														goto l19
-													}
-													{
-														add(ruleAction3, position)
-													}
-													add(ruleDefw, position57)
+											case '\n':
+												if buffer[position] != rune('\n') {
+													goto l59
 												}
+												position++
 												break
-											case 'O', 'o':
-												{
-													position73 := position
-													{
-														position74, tokenIndex74 := position, tokenIndex
-														if buffer[position] != rune('o') {
-															goto l75
-														}
-														position++
-														goto l74
-													l75:
-														position, tokenIndex = position74, tokenIndex74
-														if buffer[position] != rune('O') {
-															goto l19
-														}
-														position++
-													}
-												l74:
-													{
-														position76, tokenIndex76 := position, tokenIndex
-														if buffer[position] != rune('r') {
-															goto l77
-														}
-														position++
-														goto l76
-													l77:
-														position, tokenIndex = position76, tokenIndex76
-														if buffer[position] != rune('R') {
-															goto l19
-														}
-														position++
-													}
-												l76:
-													{
-														position78, tokenIndex78 := position, tokenIndex
-														if buffer[position] != rune('g') {
-															goto l79
-														}
-														position++
-														goto l78
-													l79:
-														position, tokenIndex = position78, tokenIndex78
-														if buffer[position] != rune('G') {
-															goto l19
-														}
-														position++
-													}
-												l78:
-													if !_rules[rulews]() {
-														goto l19
-													}
-													if !_rules[rulenn]() {
-														goto l19
-													}
-													{
-														add(ruleAction1, position)
-													}
-													add(ruleOrg, position73)
+											case '\r':
+												if buffer[position] != rune('\r') {
+													goto l59
 												}
+												position++
 												break
-											case 'a':
-												{
-													position81 := position
-													if buffer[position] != rune('a') {
-														goto l19
-													}
-													position++
-													if buffer[position] != rune('s') {
-														goto l19
-													}
-													position++
-													if buffer[position] != rune('e') {
-														goto l19
-													}
-													position++
-													if buffer[position] != rune('g') {
-														goto l19
-													}
-													position++
-													add(ruleAseg, position81)
+											case '\t':
+												if buffer[position] != rune('\t') {
+													goto l59
 												}
+												position++
 												break
 											default:
-												{
-													position82 := position
-													{
-														position83, tokenIndex83 := position, tokenIndex
-														if buffer[position] != rune('.') {
-															goto l83
-														}
-														position++
-														goto l84
-													l83:
-														position, tokenIndex = position83, tokenIndex83
-													}
-												l84:
-													if buffer[position] != rune('t') {
-														goto l19
-													}
-													position++
-													if buffer[position] != rune('i') {
-														goto l19
-													}
-													position++
-													if buffer[position] != rune('t') {
-														goto l19
-													}
-													position++
-													if buffer[position] != rune('l') {
-														goto l19
-													}
-													position++
-													if buffer[position] != rune('e') {
-														goto l19
-													}
-													position++
-													if !_rules[rulews]() {
-														goto l19
-													}
-													if buffer[position] != rune('\'') {
-														goto l19
-													}
-													position++
-												l85:
-													{
-														position86, tokenIndex86 := position, tokenIndex
-														{
-															position87, tokenIndex87 := position, tokenIndex
-															if buffer[position] != rune('\'') {
-																goto l87
-															}
-															position++
-															goto l86
-														l87:
-															position, tokenIndex = position87, tokenIndex87
-														}
-														if !matchDot() {
-															goto l86
-														}
-														goto l85
-													l86:
-														position, tokenIndex = position86, tokenIndex86
-													}
-													if buffer[position] != rune('\'') {
-														goto l19
-													}
-													position++
-													add(ruleTitle, position82)
+												if buffer[position] != rune(' ') {
+													goto l59
 												}
+												position++
 												break
 											}
 										}
 
+										goto l58
+									l59:
+										position, tokenIndex = position59, tokenIndex59
+									}
+									if !matchDot() {
+										goto l58
+									}
+									goto l5
+								l58:
+									position, tokenIndex = position58, tokenIndex58
+								}
+								{
+									add(ruleAction30, position)
+								}
+								add(ruleColumnLabel, position47)
+							}
+						}
+					l7:
+						goto l6
+					l5:
+						position, tokenIndex = position5, tokenIndex5
+					}
+				l6:
+				l62:
+					{
+						position63, tokenIndex63 := position, tokenIndex
+						if !_rules[rulews]() {
+							goto l63
+						}
+						goto l62
+					l63:
+						position, tokenIndex = position63, tokenIndex63
+					}
+					{
+						position64, tokenIndex64 := position, tokenIndex
+						if !_rules[ruleStatement]() {
+							goto l64
+						}
+						goto l65
+					l64:
+						position, tokenIndex = position64, tokenIndex64
+					}
+				l65:
+					{
+						position66, tokenIndex66 := position, tokenIndex
+						if !_rules[rulews]() {
+							goto l66
+						}
+						goto l67
+					l66:
+						position, tokenIndex = position66, tokenIndex66
+					}
+				l67:
+					{
+						position68, tokenIndex68 := position, tokenIndex
+						if !_rules[ruleComment]() {
+							goto l68
+						}
+						goto l69
+					l68:
+						position, tokenIndex = position68, tokenIndex68
+					}
+				l69:
+					{
+						position70, tokenIndex70 := position, tokenIndex
+						if !_rules[rulews]() {
+							goto l70
+						}
+						goto l71
+					l70:
+						position, tokenIndex = position70, tokenIndex70
+					}
+				l71:
+					{
+						add(ruleAction0, position)
+					}
+					{
+						position73 := position
+						{
+							position74, tokenIndex74 := position, tokenIndex
+							{
+								position76, tokenIndex76 := position, tokenIndex
+								if buffer[position] != rune('\r') {
+									goto l76
+								}
+								position++
+								goto l77
+							l76:
+								position, tokenIndex = position76, tokenIndex76
+							}
+						l77:
+							if buffer[position] != rune('\n') {
+								goto l75
+							}
+							position++
+							{
+								add(ruleAction1, position)
+							}
+							goto l74
+						l75:
+							position, tokenIndex = position74, tokenIndex74
+							if buffer[position] != rune(':') {
+								goto l0
+							}
+							position++
+						}
+					l74:
+						add(ruleLineEnd, position73)
+					}
+					add(ruleLine, position4)
+				}
+			l2:
+				{
+					position3, tokenIndex3 := position, tokenIndex
+					{
+						position79 := position
+						{
+							position80, tokenIndex80 := position, tokenIndex
+							{
+								position82, tokenIndex82 := position, tokenIndex
+							l84:
+								{
+									position85, tokenIndex85 := position, tokenIndex
+									if !_rules[rulews]() {
+										goto l85
 									}
-								l21:
-									add(ruleDirective, position20)
+									goto l84
+								l85:
+									position, tokenIndex = position85, tokenIndex85
 								}
-								goto l18
-							l19:
-								position, tokenIndex = position18, tokenIndex18
 								{
-									position88 := position
+									position86 := position
 									{
-										position89, tokenIndex89 := position, tokenIndex
+										position87, tokenIndex87 := position, tokenIndex
+										if !_rules[ruleLabelText]() {
+											goto l88
+										}
 										{
-											position91 := position
+											position89, tokenIndex89 := position, tokenIndex
 											{
-												position92, tokenIndex92 := position, tokenIndex
-												{
-													position94 := position
-													{
-														position95, tokenIndex95 := position, tokenIndex
-														if buffer[position] != rune('p') {
-															goto l96
-														}
-														position++
-														goto l95
-													l96:
-														position, tokenIndex = position95, tokenIndex95
-														if buffer[position] != rune('P') {
-															goto l93
-														}
-														position++
-													}
-												l95:
-													{
-														position97, tokenIndex97 := position, tokenIndex
-														if buffer[position] != rune('u') {
-															goto l98
-														}
-														position++
-														goto l97
-													l98:
-														position, tokenIndex = position97, tokenIndex97
-														if buffer[position] != rune('U') {
-															goto l93
-														}
-														position++
-													}
-												l97:
-													{
-														position99, tokenIndex99 := position, tokenIndex
-														if buffer[position] != rune('s') {
-															goto l100
-														}
-														position++
-														goto l99
-													l100:
-														position, tokenIndex = position99, tokenIndex99
-														if buffer[position] != rune('S') {
-															goto l93
-														}
-														position++
-													}
-												l99:
+												position90, tokenIndex90 := position, tokenIndex
+												if !_rules[rulews]() {
+													goto l90
+												}
+												goto l91
+											l90:
+												position, tokenIndex = position90, tokenIndex90
+											}
+										l91:
+											if buffer[position] != rune(':') {
+												goto l88
+											}
+											position++
+											if buffer[position] != rune('=') {
+												goto l88
+											}
+											position++
+											position, tokenIndex = position89, tokenIndex89
+										}
+										{
+											add(ruleAction27, position)
+										}
+										goto l87
+									l88:
+										position, tokenIndex = position87, tokenIndex87
+										if !_rules[ruleLabelText]() {
+											goto l93
+										}
+										if buffer[position] != rune(':') {
+											goto l93
+										}
+										position++
+										{
+											position94, tokenIndex94 := position, tokenIndex
+											if !_rules[rulews]() {
+												goto l94
+											}
+											goto l95
+										l94:
+											position, tokenIndex = position94, tokenIndex94
+										}
+									l95:
+										{
+											add(ruleAction28, position)
+										}
+										goto l87
+									l93:
+										position, tokenIndex = position87, tokenIndex87
+										if !_rules[ruleLabelText]() {
+											goto l83
+										}
+										{
+											position97, tokenIndex97 := position, tokenIndex
+											{
+												position98, tokenIndex98 := position, tokenIndex
+												if !_rules[rulews]() {
+													goto l98
+												}
+												goto l99
+											l98:
+												position, tokenIndex = position98, tokenIndex98
+											}
+										l99:
+											{
+												switch buffer[position] {
+												case 'S', 's':
 													{
 														position101, tokenIndex101 := position, tokenIndex
-														if buffer[position] != rune('h') {
+														if buffer[position] != rune('s') {
 															goto l102
 														}
 														position++
 														goto l101
 													l102:
 														position, tokenIndex = position101, tokenIndex101
-														if buffer[position] != rune('H') {
-															goto l93
+														if buffer[position] != rune('S') {
+															goto l83
 														}
 														position++
 													}
 												l101:
-													if !_rules[rulews]() {
-														goto l93
-													}
-													if !_rules[ruleSrc16]() {
-														goto l93
-													}
-													{
-														add(ruleAction8, position)
-													}
-													add(rulePush, position94)
-												}
-												goto l92
-											l93:
-												position, tokenIndex = position92, tokenIndex92
-												{
-													switch buffer[position] {
-													case 'E', 'e':
-														{
-															position105 := position
-															{
-																position106, tokenIndex106 := position, tokenIndex
-																if buffer[position] != rune('e') {
-																	goto l107
-																}
-																position++
-																goto l106
-															l107:
-																position, tokenIndex = position106, tokenIndex106
-																if buffer[position] != rune('E') {
-																	goto l90
-																}
-																position++
-															}
-														l106:
-															{
-																position108, tokenIndex108 := position, tokenIndex
-																if buffer[position] != rune('x') {
-																	goto l109
-																}
-																position++
-																goto l108
-															l109:
-																position, tokenIndex = position108, tokenIndex108
-																if buffer[position] != rune('X') {
-																	goto l90
-																}
-																position++
-															}
-														l108:
-															if !_rules[rulews]() {
-																goto l90
-															}
-															if !_rules[ruleDst16]() {
-																goto l90
-															}
-															if !_rules[rulesep]() {
-																goto l90
-															}
-															if !_rules[ruleSrc16]() {
-																goto l90
-															}
-															{
-																add(ruleAction10, position)
-															}
-															add(ruleEx, position105)
-														}
-														break
-													case 'P', 'p':
-														{
-															position111 := position
-															{
-																position112, tokenIndex112 := position, tokenIndex
-																if buffer[position] != rune('p') {
-																	goto l113
-																}
-																position++
-																goto l112
-															l113:
-																position, tokenIndex = position112, tokenIndex112
-																if buffer[position] != rune('P') {
-																	goto l90
-																}
-																position++
-															}
-														l112:
-															{
-																position114, tokenIndex114 := position, tokenIndex
-																if buffer[position] != rune('o') {
-																	goto l115
-																}
-																position++
-																goto l114
-															l115:
-																position, tokenIndex = position114, tokenIndex114
-																if buffer[position] != rune('O') {
-																	goto l90
-																}
-																position++
-															}
-														l114:
-															{
-																position116, tokenIndex116 := position, tokenIndex
-																if buffer[position] != rune('p') {
-																	goto l117
-																}
-																position++
-																goto l116
-															l117:
-																position, tokenIndex = position116, tokenIndex116
-																if buffer[position] != rune('P') {
-																	goto l90
-																}
-																position++
-															}
-														l116:
-															if !_rules[rulews]() {
-																goto l90
-															}
-															if !_rules[ruleDst16]() {
-																goto l90
-															}
-															{
-																add(ruleAction9, position)
-															}
-															add(rulePop, position111)
-														}
-														break
-													default:
-														{
-															position119 := position
-															{
-																position120, tokenIndex120 := position, tokenIndex
-																{
-																	position122 := position
-																	{
-																		position123, tokenIndex123 := position, tokenIndex
-																		if buffer[position] != rune('l') {
-																			goto l124
-																		}
-																		position++
-																		goto l123
-																	l124:
-																		position, tokenIndex = position123, tokenIndex123
-																		if buffer[position] != rune('L') {
-																			goto l121
-																		}
-																		position++
-																	}
-																l123:
-																	{
-																		position125, tokenIndex125 := position, tokenIndex
-																		if buffer[position] != rune('d') {
-																			goto l126
-																		}
-																		position++
-																		goto l125
-																	l126:
-																		position, tokenIndex = position125, tokenIndex125
-																		if buffer[position] != rune('D') {
-																			goto l121
-																		}
-																		position++
-																	}
-																l125:
-																	if !_rules[rulews]() {
-																		goto l121
-																	}
-																	if !_rules[ruleDst16]() {
-																		goto l121
-																	}
-																	if !_rules[rulesep]() {
-																		goto l121
-																	}
-																	if !_rules[ruleSrc16]() {
-																		goto l121
-																	}
-																	{
-																		add(ruleAction7, position)
-																	}
-																	add(ruleLoad16, position122)
-																}
-																goto l120
-															l121:
-																position, tokenIndex = position120, tokenIndex120
-																{
-																	position128 := position
-																	{
-																		position129, tokenIndex129 := position, tokenIndex
-																		if buffer[position] != rune('l') {
-																			goto l130
-																		}
-																		position++
-																		goto l129
-																	l130:
-																		position, tokenIndex = position129, tokenIndex129
-																		if buffer[position] != rune('L') {
-																			goto l90
-																		}
-																		position++
-																	}
-																l129:
-																	{
-																		position131, tokenIndex131 := position, tokenIndex
-																		if buffer[position] != rune('d') {
-																			goto l132
-																		}
-																		position++
-																		goto l131
-																	l132:
-																		position, tokenIndex = position131, tokenIndex131
-																		if buffer[position] != rune('D') {
-																			goto l90
-																		}
-																		position++
-																	}
-																l131:
-																	if !_rules[rulews]() {
-																		goto l90
-																	}
-																	{
-																		position133 := position
-																		{
-																			position134, tokenIndex134 := position, tokenIndex
-																			if !_rules[ruleReg8]() {
-																				goto l135
-																			}
-																			goto l134
-																		l135:
-																			position, tokenIndex = position134, tokenIndex134
-																			if !_rules[ruleReg16Contents]() {
-																				goto l136
-																			}
-																			goto l134
-																		l136:
-																			position, tokenIndex = position134, tokenIndex134
-																			if !_rules[rulenn_contents]() {
-																				goto l90
-																			}
-																		}
-																	l134:
-																		{
-																			add(ruleAction20, position)
-																		}
-																		add(ruleDst8, position133)
-																	}
-																	if !_rules[rulesep]() {
-																		goto l90
-																	}
-																	if !_rules[ruleSrc8]() {
-																		goto l90
-																	}
-																	{
-																		add(ruleAction6, position)
-																	}
-																	add(ruleLoad8, position128)
-																}
-															}
-														l120:
-															add(ruleLoad, position119)
-														}
-														break
-													}
-												}
-
-											}
-										l92:
-											add(ruleAssignment, position91)
-										}
-										goto l89
-									l90:
-										position, tokenIndex = position89, tokenIndex89
-										{
-											position140 := position
-											{
-												position141, tokenIndex141 := position, tokenIndex
-												{
-													position143 := position
 													{
-														position144, tokenIndex144 := position, tokenIndex
-														if buffer[position] != rune('i') {
-															goto l145
+														position103, tokenIndex103 := position, tokenIndex
+														if buffer[position] != rune('e') {
+															goto l104
 														}
 														position++
-														goto l144
-													l145:
-														position, tokenIndex = position144, tokenIndex144
-														if buffer[position] != rune('I') {
-															goto l142
+														goto l103
+													l104:
+														position, tokenIndex = position103, tokenIndex103
+														if buffer[position] != rune('E') {
+															goto l83
 														}
 														position++
 													}
-												l144:
+												l103:
 													{
-														position146, tokenIndex146 := position, tokenIndex
-														if buffer[position] != rune('n') {
-															goto l147
+														position105, tokenIndex105 := position, tokenIndex
+														if buffer[position] != rune('t') {
+															goto l106
 														}
 														position++
-														goto l146
-													l147:
-														position, tokenIndex = position146, tokenIndex146
-														if buffer[position] != rune('N') {
-															goto l142
+														goto l105
+													l106:
+														position, tokenIndex = position105, tokenIndex105
+														if buffer[position] != rune('T') {
+															goto l83
 														}
 														position++
 													}
-												l146:
+												l105:
+													if !_rules[rulews]() {
+														goto l83
+													}
+													break
+												case 'D', 'd':
 													{
-														position148, tokenIndex148 := position, tokenIndex
-														if buffer[position] != rune('c') {
-															goto l149
+														position107, tokenIndex107 := position, tokenIndex
+														if buffer[position] != rune('d') {
+															goto l108
 														}
 														position++
-														goto l148
-													l149:
-														position, tokenIndex = position148, tokenIndex148
-														if buffer[position] != rune('C') {
-															goto l142
+														goto l107
+													l108:
+														position, tokenIndex = position107, tokenIndex107
+														if buffer[position] != rune('D') {
+															goto l83
 														}
 														position++
 													}
-												l148:
-													if !_rules[rulews]() {
-														goto l142
-													}
-													if !_rules[ruleILoc8]() {
-														goto l142
-													}
-													{
-														add(ruleAction11, position)
-													}
-													add(ruleInc16Indexed8, position143)
-												}
-												goto l141
-											l142:
-												position, tokenIndex = position141, tokenIndex141
-												{
-													position152 := position
+												l107:
 													{
-														position153, tokenIndex153 := position, tokenIndex
-														if buffer[position] != rune('i') {
-															goto l154
+														position109, tokenIndex109 := position, tokenIndex
+														if buffer[position] != rune('e') {
+															goto l110
 														}
 														position++
-														goto l153
-													l154:
-														position, tokenIndex = position153, tokenIndex153
-														if buffer[position] != rune('I') {
-															goto l151
+														goto l109
+													l110:
+														position, tokenIndex = position109, tokenIndex109
+														if buffer[position] != rune('E') {
+															goto l83
 														}
 														position++
 													}
-												l153:
+												l109:
 													{
-														position155, tokenIndex155 := position, tokenIndex
-														if buffer[position] != rune('n') {
-															goto l156
+														position111, tokenIndex111 := position, tokenIndex
+														if buffer[position] != rune('f') {
+															goto l112
 														}
 														position++
-														goto l155
-													l156:
-														position, tokenIndex = position155, tokenIndex155
-														if buffer[position] != rune('N') {
-															goto l151
+														goto l111
+													l112:
+														position, tokenIndex = position111, tokenIndex111
+														if buffer[position] != rune('F') {
+															goto l83
 														}
 														position++
 													}
-												l155:
+												l111:
 													{
-														position157, tokenIndex157 := position, tokenIndex
-														if buffer[position] != rune('c') {
-															goto l158
+														position113, tokenIndex113 := position, tokenIndex
+														if buffer[position] != rune('l') {
+															goto l114
 														}
 														position++
-														goto l157
-													l158:
-														position, tokenIndex = position157, tokenIndex157
-														if buffer[position] != rune('C') {
-															goto l151
+														goto l113
+													l114:
+														position, tokenIndex = position113, tokenIndex113
+														if buffer[position] != rune('L') {
+															goto l83
 														}
 														position++
 													}
-												l157:
+												l113:
 													if !_rules[rulews]() {
-														goto l151
+														goto l83
 													}
-													if !_rules[ruleLoc16]() {
-														goto l151
-													}
-													{
-														add(ruleAction13, position)
+													break
+												case '=':
+													if buffer[position] != rune('=') {
+														goto l83
 													}
-													add(ruleInc16, position152)
-												}
-												goto l141
-											l151:
-												position, tokenIndex = position141, tokenIndex141
-												{
-													position160 := position
+													position++
+													break
+												default:
 													{
-														position161, tokenIndex161 := position, tokenIndex
-														if buffer[position] != rune('i') {
-															goto l162
+														position115, tokenIndex115 := position, tokenIndex
+														if buffer[position] != rune('e') {
+															goto l116
 														}
 														position++
-														goto l161
-													l162:
-														position, tokenIndex = position161, tokenIndex161
-														if buffer[position] != rune('I') {
-															goto l139
+														goto l115
+													l116:
+														position, tokenIndex = position115, tokenIndex115
+														if buffer[position] != rune('E') {
+															goto l83
 														}
 														position++
 													}
-												l161:
+												l115:
 													{
-														position163, tokenIndex163 := position, tokenIndex
-														if buffer[position] != rune('n') {
-															goto l164
+														position117, tokenIndex117 := position, tokenIndex
+														if buffer[position] != rune('q') {
+															goto l118
 														}
 														position++
-														goto l163
-													l164:
-														position, tokenIndex = position163, tokenIndex163
-														if buffer[position] != rune('N') {
-															goto l139
+														goto l117
+													l118:
+														position, tokenIndex = position117, tokenIndex117
+														if buffer[position] != rune('Q') {
+															goto l83
 														}
 														position++
 													}
-												l163:
+												l117:
 													{
-														position165, tokenIndex165 := position, tokenIndex
-														if buffer[position] != rune('c') {
-															goto l166
+														position119, tokenIndex119 := position, tokenIndex
+														if buffer[position] != rune('u') {
+															goto l120
 														}
 														position++
-														goto l165
-													l166:
-														position, tokenIndex = position165, tokenIndex165
-														if buffer[position] != rune('C') {
-															goto l139
-														}
+														goto l119
+													l120:
+														position, tokenIndex = position119, tokenIndex119
+														if buffer[position] != rune('U') {
+															goto l83
+														}
 														position++
 													}
-												l165:
+												l119:
 													if !_rules[rulews]() {
-														goto l139
-													}
-													if !_rules[ruleLoc8]() {
-														goto l139
+														goto l83
 													}
-													{
-														add(ruleAction12, position)
-													}
-													add(ruleInc8, position160)
+													break
 												}
 											}
-										l141:
-											add(ruleInc, position140)
+
+											position, tokenIndex = position97, tokenIndex97
 										}
-										goto l89
-									l139:
-										position, tokenIndex = position89, tokenIndex89
 										{
-											position169 := position
-											{
-												position170, tokenIndex170 := position, tokenIndex
-												{
-													position172 := position
-													{
-														position173, tokenIndex173 := position, tokenIndex
-														if buffer[position] != rune('d') {
-															goto l174
-														}
-														position++
-														goto l173
-													l174:
-														position, tokenIndex = position173, tokenIndex173
-														if buffer[position] != rune('D') {
-															goto l171
-														}
-														position++
-													}
-												l173:
-													{
-														position175, tokenIndex175 := position, tokenIndex
-														if buffer[position] != rune('e') {
-															goto l176
-														}
-														position++
-														goto l175
-													l176:
-														position, tokenIndex = position175, tokenIndex175
-														if buffer[position] != rune('E') {
-															goto l171
-														}
-														position++
-													}
-												l175:
-													{
-														position177, tokenIndex177 := position, tokenIndex
-														if buffer[position] != rune('c') {
-															goto l178
-														}
-														position++
-														goto l177
-													l178:
-														position, tokenIndex = position177, tokenIndex177
-														if buffer[position] != rune('C') {
-															goto l171
-														}
-														position++
-													}
-												l177:
-													if !_rules[rulews]() {
-														goto l171
-													}
-													if !_rules[ruleILoc8]() {
-														goto l171
-													}
-													{
-														add(ruleAction14, position)
-													}
-													add(ruleDec16Indexed8, position172)
-												}
-												goto l170
-											l171:
-												position, tokenIndex = position170, tokenIndex170
-												{
-													position181 := position
-													{
-														position182, tokenIndex182 := position, tokenIndex
-														if buffer[position] != rune('d') {
-															goto l183
-														}
-														position++
-														goto l182
-													l183:
-														position, tokenIndex = position182, tokenIndex182
-														if buffer[position] != rune('D') {
-															goto l180
-														}
-														position++
-													}
-												l182:
-													{
-														position184, tokenIndex184 := position, tokenIndex
-														if buffer[position] != rune('e') {
-															goto l185
-														}
-														position++
-														goto l184
-													l185:
-														position, tokenIndex = position184, tokenIndex184
-														if buffer[position] != rune('E') {
-															goto l180
-														}
-														position++
-													}
-												l184:
-													{
-														position186, tokenIndex186 := position, tokenIndex
-														if buffer[position] != rune('c') {
-															goto l187
-														}
-														position++
-														goto l186
-													l187:
-														position, tokenIndex = position186, tokenIndex186
-														if buffer[position] != rune('C') {
-															goto l180
-														}
-														position++
-													}
-												l186:
-													if !_rules[rulews]() {
-														goto l180
-													}
-													if !_rules[ruleLoc16]() {
-														goto l180
-													}
-													{
-														add(ruleAction16, position)
-													}
-													add(ruleDec16, position181)
-												}
-												goto l170
-											l180:
-												position, tokenIndex = position170, tokenIndex170
-												{
-													position189 := position
-													{
-														position190, tokenIndex190 := position, tokenIndex
-														if buffer[position] != rune('d') {
-															goto l191
-														}
-														position++
-														goto l190
-													l191:
-														position, tokenIndex = position190, tokenIndex190
-														if buffer[position] != rune('D') {
-															goto l168
-														}
-														position++
-													}
-												l190:
-													{
-														position192, tokenIndex192 := position, tokenIndex
-														if buffer[position] != rune('e') {
-															goto l193
-														}
-														position++
-														goto l192
-													l193:
-														position, tokenIndex = position192, tokenIndex192
-														if buffer[position] != rune('E') {
-															goto l168
-														}
-														position++
-													}
-												l192:
-													{
-														position194, tokenIndex194 := position, tokenIndex
-														if buffer[position] != rune('c') {
-															goto l195
-														}
-														position++
-														goto l194
-													l195:
-														position, tokenIndex = position194, tokenIndex194
-														if buffer[position] != rune('C') {
-															goto l168
-														}
-														position++
-													}
-												l194:
-													if !_rules[rulews]() {
-														goto l168
-													}
-													if !_rules[ruleLoc8]() {
-														goto l168
-													}
-													{
-														add(ruleAction15, position)
-													}
-													add(ruleDec8, position189)
-												}
+											add(ruleAction29, position)
+										}
+									}
+								l87:
+									add(ruleLabelDefn, position86)
+								}
+								goto l82
+							l83:
+								position, tokenIndex = position82, tokenIndex82
+								{
+									position122 := position
+									{
+										position123, tokenIndex123 := position, tokenIndex
+										if !_rules[ruleStatement]() {
+											goto l123
+										}
+										{
+											position124, tokenIndex124 := position, tokenIndex
+											if !_rules[rulews]() {
+												goto l124
 											}
-										l170:
-											add(ruleDec, position169)
+											goto l125
+										l124:
+											position, tokenIndex = position124, tokenIndex124
 										}
-										goto l89
-									l168:
-										position, tokenIndex = position89, tokenIndex89
+									l125:
+										{
+											position126, tokenIndex126 := position, tokenIndex
+											if !_rules[ruleComment]() {
+												goto l126
+											}
+											goto l127
+										l126:
+											position, tokenIndex = position126, tokenIndex126
+										}
+									l127:
+										{
+											position128, tokenIndex128 := position, tokenIndex
+											if !_rules[rulews]() {
+												goto l128
+											}
+											goto l129
+										l128:
+											position, tokenIndex = position128, tokenIndex128
+										}
+									l129:
 										{
-											position198 := position
+											position130, tokenIndex130 := position, tokenIndex
 											{
-												position199, tokenIndex199 := position, tokenIndex
-												{
-													position201 := position
-													{
-														position202, tokenIndex202 := position, tokenIndex
-														if buffer[position] != rune('a') {
-															goto l203
-														}
-														position++
-														goto l202
-													l203:
-														position, tokenIndex = position202, tokenIndex202
-														if buffer[position] != rune('A') {
-															goto l200
-														}
-														position++
-													}
-												l202:
-													{
-														position204, tokenIndex204 := position, tokenIndex
-														if buffer[position] != rune('d') {
-															goto l205
-														}
-														position++
-														goto l204
-													l205:
-														position, tokenIndex = position204, tokenIndex204
-														if buffer[position] != rune('D') {
-															goto l200
-														}
-														position++
-													}
-												l204:
-													{
-														position206, tokenIndex206 := position, tokenIndex
-														if buffer[position] != rune('d') {
-															goto l207
-														}
-														position++
-														goto l206
-													l207:
-														position, tokenIndex = position206, tokenIndex206
-														if buffer[position] != rune('D') {
-															goto l200
-														}
-														position++
-													}
-												l206:
-													if !_rules[rulews]() {
-														goto l200
-													}
-													if !_rules[ruleDst16]() {
-														goto l200
-													}
-													if !_rules[rulesep]() {
-														goto l200
-													}
-													if !_rules[ruleSrc16]() {
-														goto l200
-													}
-													{
-														add(ruleAction17, position)
-													}
-													add(ruleAdd16, position201)
-												}
-												goto l199
-											l200:
-												position, tokenIndex = position199, tokenIndex199
-												{
-													position210 := position
-													{
-														position211, tokenIndex211 := position, tokenIndex
-														if buffer[position] != rune('a') {
-															goto l212
-														}
-														position++
-														goto l211
-													l212:
-														position, tokenIndex = position211, tokenIndex211
-														if buffer[position] != rune('A') {
-															goto l209
-														}
-														position++
-													}
-												l211:
-													{
-														position213, tokenIndex213 := position, tokenIndex
-														if buffer[position] != rune('d') {
-															goto l214
-														}
-														position++
-														goto l213
-													l214:
-														position, tokenIndex = position213, tokenIndex213
-														if buffer[position] != rune('D') {
-															goto l209
-														}
-														position++
-													}
-												l213:
-													{
-														position215, tokenIndex215 := position, tokenIndex
-														if buffer[position] != rune('c') {
-															goto l216
-														}
-														position++
-														goto l215
-													l216:
-														position, tokenIndex = position215, tokenIndex215
-														if buffer[position] != rune('C') {
-															goto l209
-														}
-														position++
-													}
-												l215:
-													if !_rules[rulews]() {
-														goto l209
-													}
-													if !_rules[ruleDst16]() {
-														goto l209
-													}
-													if !_rules[rulesep]() {
-														goto l209
-													}
-													if !_rules[ruleSrc16]() {
-														goto l209
-													}
-													{
-														add(ruleAction18, position)
-													}
-													add(ruleAdc16, position210)
-												}
-												goto l199
-											l209:
-												position, tokenIndex = position199, tokenIndex199
+												position131, tokenIndex131 := position, tokenIndex
 												{
-													position218 := position
-													{
-														position219, tokenIndex219 := position, tokenIndex
-														if buffer[position] != rune('s') {
-															goto l220
-														}
-														position++
-														goto l219
-													l220:
-														position, tokenIndex = position219, tokenIndex219
-														if buffer[position] != rune('S') {
-															goto l197
-														}
-														position++
-													}
-												l219:
-													{
-														position221, tokenIndex221 := position, tokenIndex
-														if buffer[position] != rune('b') {
-															goto l222
-														}
-														position++
-														goto l221
-													l222:
-														position, tokenIndex = position221, tokenIndex221
-														if buffer[position] != rune('B') {
-															goto l197
+													switch buffer[position] {
+													case '\n':
+														if buffer[position] != rune('\n') {
+															goto l131
 														}
 														position++
-													}
-												l221:
-													{
-														position223, tokenIndex223 := position, tokenIndex
-														if buffer[position] != rune('c') {
-															goto l224
+														break
+													case '\r':
+														if buffer[position] != rune('\r') {
+															goto l131
 														}
 														position++
-														goto l223
-													l224:
-														position, tokenIndex = position223, tokenIndex223
-														if buffer[position] != rune('C') {
-															goto l197
+														break
+													default:
+														if buffer[position] != rune(':') {
+															goto l131
 														}
 														position++
+														break
 													}
-												l223:
-													if !_rules[rulews]() {
-														goto l197
-													}
-													if !_rules[ruleDst16]() {
-														goto l197
-													}
-													if !_rules[rulesep]() {
-														goto l197
-													}
-													if !_rules[ruleSrc16]() {
-														goto l197
-													}
-													{
-														add(ruleAction19, position)
-													}
-													add(ruleSbc16, position218)
 												}
+
+												goto l130
+											l131:
+												position, tokenIndex = position131, tokenIndex131
+											}
+											if !matchDot() {
+												goto l130
 											}
-										l199:
-											add(ruleAlu16, position198)
+											goto l123
+										l130:
+											position, tokenIndex = position130, tokenIndex130
 										}
-										goto l89
-									l197:
-										position, tokenIndex = position89, tokenIndex89
+										goto l80
+									l123:
+										position, tokenIndex = position123, tokenIndex123
+									}
+									if !_rules[ruleLabelText]() {
+										goto l80
+									}
+									{
+										position133, tokenIndex133 := position, tokenIndex
 										{
-											position227 := position
+											position134, tokenIndex134 := position, tokenIndex
 											{
-												position228, tokenIndex228 := position, tokenIndex
-												{
-													position230 := position
-													{
-														position231, tokenIndex231 := position, tokenIndex
-														if buffer[position] != rune('a') {
-															goto l232
-														}
-														position++
-														goto l231
-													l232:
-														position, tokenIndex = position231, tokenIndex231
-														if buffer[position] != rune('A') {
-															goto l229
-														}
-														position++
+												switch buffer[position] {
+												case '\n':
+													if buffer[position] != rune('\n') {
+														goto l134
 													}
-												l231:
-													{
-														position233, tokenIndex233 := position, tokenIndex
-														if buffer[position] != rune('d') {
-															goto l234
-														}
-														position++
-														goto l233
-													l234:
-														position, tokenIndex = position233, tokenIndex233
-														if buffer[position] != rune('D') {
-															goto l229
-														}
-														position++
+													position++
+													break
+												case '\r':
+													if buffer[position] != rune('\r') {
+														goto l134
 													}
-												l233:
-													{
-														position235, tokenIndex235 := position, tokenIndex
-														if buffer[position] != rune('d') {
-															goto l236
-														}
-														position++
-														goto l235
-													l236:
-														position, tokenIndex = position235, tokenIndex235
-														if buffer[position] != rune('D') {
-															goto l229
-														}
-														position++
+													position++
+													break
+												case '\t':
+													if buffer[position] != rune('\t') {
+														goto l134
 													}
-												l235:
-													if !_rules[rulews]() {
-														goto l229
-													}
-													{
-														position237, tokenIndex237 := position, tokenIndex
-														if buffer[position] != rune('a') {
-															goto l238
-														}
-														position++
-														goto l237
-													l238:
-														position, tokenIndex = position237, tokenIndex237
-														if buffer[position] != rune('A') {
-															goto l229
-														}
-														position++
-													}
-												l237:
-													if !_rules[rulesep]() {
-														goto l229
-													}
-													if !_rules[ruleSrc8]() {
-														goto l229
-													}
-													{
-														add(ruleAction43, position)
-													}
-													add(ruleAdd, position230)
-												}
-												goto l228
-											l229:
-												position, tokenIndex = position228, tokenIndex228
-												{
-													position241 := position
-													{
-														position242, tokenIndex242 := position, tokenIndex
-														if buffer[position] != rune('a') {
-															goto l243
-														}
-														position++
-														goto l242
-													l243:
-														position, tokenIndex = position242, tokenIndex242
-														if buffer[position] != rune('A') {
-															goto l240
-														}
-														position++
-													}
-												l242:
-													{
-														position244, tokenIndex244 := position, tokenIndex
-														if buffer[position] != rune('d') {
-															goto l245
-														}
-														position++
-														goto l244
-													l245:
-														position, tokenIndex = position244, tokenIndex244
-														if buffer[position] != rune('D') {
-															goto l240
-														}
-														position++
-													}
-												l244:
-													{
-														position246, tokenIndex246 := position, tokenIndex
-														if buffer[position] != rune('c') {
-															goto l247
-														}
-														position++
-														goto l246
-													l247:
-														position, tokenIndex = position246, tokenIndex246
-														if buffer[position] != rune('C') {
-															goto l240
-														}
-														position++
-													}
-												l246:
-													if !_rules[rulews]() {
-														goto l240
-													}
-													{
-														position248, tokenIndex248 := position, tokenIndex
-														if buffer[position] != rune('a') {
-															goto l249
-														}
-														position++
-														goto l248
-													l249:
-														position, tokenIndex = position248, tokenIndex248
-														if buffer[position] != rune('A') {
-															goto l240
-														}
-														position++
-													}
-												l248:
-													if !_rules[rulesep]() {
-														goto l240
-													}
-													if !_rules[ruleSrc8]() {
-														goto l240
-													}
-													{
-														add(ruleAction44, position)
-													}
-													add(ruleAdc, position241)
-												}
-												goto l228
-											l240:
-												position, tokenIndex = position228, tokenIndex228
-												{
-													position252 := position
-													{
-														position253, tokenIndex253 := position, tokenIndex
-														if buffer[position] != rune('s') {
-															goto l254
-														}
-														position++
-														goto l253
-													l254:
-														position, tokenIndex = position253, tokenIndex253
-														if buffer[position] != rune('S') {
-															goto l251
-														}
-														position++
-													}
-												l253:
-													{
-														position255, tokenIndex255 := position, tokenIndex
-														if buffer[position] != rune('u') {
-															goto l256
-														}
-														position++
-														goto l255
-													l256:
-														position, tokenIndex = position255, tokenIndex255
-														if buffer[position] != rune('U') {
-															goto l251
-														}
-														position++
-													}
-												l255:
-													{
-														position257, tokenIndex257 := position, tokenIndex
-														if buffer[position] != rune('b') {
-															goto l258
-														}
-														position++
-														goto l257
-													l258:
-														position, tokenIndex = position257, tokenIndex257
-														if buffer[position] != rune('B') {
-															goto l251
-														}
-														position++
-													}
-												l257:
-													if !_rules[rulews]() {
-														goto l251
-													}
-													if !_rules[ruleSrc8]() {
-														goto l251
-													}
-													{
-														add(ruleAction45, position)
-													}
-													add(ruleSub, position252)
-												}
-												goto l228
-											l251:
-												position, tokenIndex = position228, tokenIndex228
-												{
-													switch buffer[position] {
-													case 'C', 'c':
-														{
-															position261 := position
-															{
-																position262, tokenIndex262 := position, tokenIndex
-																if buffer[position] != rune('c') {
-																	goto l263
-																}
-																position++
-																goto l262
-															l263:
-																position, tokenIndex = position262, tokenIndex262
-																if buffer[position] != rune('C') {
-																	goto l226
-																}
-																position++
-															}
-														l262:
-															{
-																position264, tokenIndex264 := position, tokenIndex
-																if buffer[position] != rune('p') {
-																	goto l265
-																}
-																position++
-																goto l264
-															l265:
-																position, tokenIndex = position264, tokenIndex264
-																if buffer[position] != rune('P') {
-																	goto l226
-																}
-																position++
-															}
-														l264:
-															if !_rules[rulews]() {
-																goto l226
-															}
-															if !_rules[ruleSrc8]() {
-																goto l226
-															}
-															{
-																add(ruleAction50, position)
-															}
-															add(ruleCp, position261)
-														}
-														break
-													case 'O', 'o':
-														{
-															position267 := position
-															{
-																position268, tokenIndex268 := position, tokenIndex
-																if buffer[position] != rune('o') {
-																	goto l269
-																}
-																position++
-																goto l268
-															l269:
-																position, tokenIndex = position268, tokenIndex268
-																if buffer[position] != rune('O') {
-																	goto l226
-																}
-																position++
-															}
-														l268:
-															{
-																position270, tokenIndex270 := position, tokenIndex
-																if buffer[position] != rune('r') {
-																	goto l271
-																}
-																position++
-																goto l270
-															l271:
-																position, tokenIndex = position270, tokenIndex270
-																if buffer[position] != rune('R') {
-																	goto l226
-																}
-																position++
-															}
-														l270:
-															if !_rules[rulews]() {
-																goto l226
-															}
-															if !_rules[ruleSrc8]() {
-																goto l226
-															}
-															{
-																add(ruleAction49, position)
-															}
-															add(ruleOr, position267)
-														}
-														break
-													case 'X', 'x':
-														{
-															position273 := position
-															{
-																position274, tokenIndex274 := position, tokenIndex
-																if buffer[position] != rune('x') {
-																	goto l275
-																}
-																position++
-																goto l274
-															l275:
-																position, tokenIndex = position274, tokenIndex274
-																if buffer[position] != rune('X') {
-																	goto l226
-																}
-																position++
-															}
-														l274:
-															{
-																position276, tokenIndex276 := position, tokenIndex
-																if buffer[position] != rune('o') {
-																	goto l277
-																}
-																position++
-																goto l276
-															l277:
-																position, tokenIndex = position276, tokenIndex276
-																if buffer[position] != rune('O') {
-																	goto l226
-																}
-																position++
-															}
-														l276:
-															{
-																position278, tokenIndex278 := position, tokenIndex
-																if buffer[position] != rune('r') {
-																	goto l279
-																}
-																position++
-																goto l278
-															l279:
-																position, tokenIndex = position278, tokenIndex278
-																if buffer[position] != rune('R') {
-																	goto l226
-																}
-																position++
-															}
-														l278:
-															if !_rules[rulews]() {
-																goto l226
-															}
-															if !_rules[ruleSrc8]() {
-																goto l226
-															}
-															{
-																add(ruleAction48, position)
-															}
-															add(ruleXor, position273)
-														}
-														break
-													case 'A', 'a':
-														{
-															position281 := position
-															{
-																position282, tokenIndex282 := position, tokenIndex
-																if buffer[position] != rune('a') {
-																	goto l283
-																}
-																position++
-																goto l282
-															l283:
-																position, tokenIndex = position282, tokenIndex282
-																if buffer[position] != rune('A') {
-																	goto l226
-																}
-																position++
-															}
-														l282:
-															{
-																position284, tokenIndex284 := position, tokenIndex
-																if buffer[position] != rune('n') {
-																	goto l285
-																}
-																position++
-																goto l284
-															l285:
-																position, tokenIndex = position284, tokenIndex284
-																if buffer[position] != rune('N') {
-																	goto l226
-																}
-																position++
-															}
-														l284:
-															{
-																position286, tokenIndex286 := position, tokenIndex
-																if buffer[position] != rune('d') {
-																	goto l287
-																}
-																position++
-																goto l286
-															l287:
-																position, tokenIndex = position286, tokenIndex286
-																if buffer[position] != rune('D') {
-																	goto l226
-																}
-																position++
-															}
-														l286:
-															if !_rules[rulews]() {
-																goto l226
-															}
-															{
-																position288, tokenIndex288 := position, tokenIndex
-																{
-																	position290, tokenIndex290 := position, tokenIndex
-																	if buffer[position] != rune('a') {
-																		goto l291
-																	}
-																	position++
-																	goto l290
-																l291:
-																	position, tokenIndex = position290, tokenIndex290
-																	if buffer[position] != rune('A') {
-																		goto l288
-																	}
-																	position++
-																}
-															l290:
-																if !_rules[rulesep]() {
-																	goto l288
-																}
-																goto l289
-															l288:
-																position, tokenIndex = position288, tokenIndex288
-															}
-														l289:
-															if !_rules[ruleSrc8]() {
-																goto l226
-															}
-															{
-																add(ruleAction47, position)
-															}
-															add(ruleAnd, position281)
-														}
-														break
-													default:
-														{
-															position293 := position
-															{
-																position294, tokenIndex294 := position, tokenIndex
-																if buffer[position] != rune('s') {
-																	goto l295
-																}
-																position++
-																goto l294
-															l295:
-																position, tokenIndex = position294, tokenIndex294
-																if buffer[position] != rune('S') {
-																	goto l226
-																}
-																position++
-															}
-														l294:
-															{
-																position296, tokenIndex296 := position, tokenIndex
-																if buffer[position] != rune('b') {
-																	goto l297
-																}
-																position++
-																goto l296
-															l297:
-																position, tokenIndex = position296, tokenIndex296
-																if buffer[position] != rune('B') {
-																	goto l226
-																}
-																position++
-															}
-														l296:
-															{
-																position298, tokenIndex298 := position, tokenIndex
-																if buffer[position] != rune('c') {
-																	goto l299
-																}
-																position++
-																goto l298
-															l299:
-																position, tokenIndex = position298, tokenIndex298
-																if buffer[position] != rune('C') {
-																	goto l226
-																}
-																position++
-															}
-														l298:
-															if !_rules[rulews]() {
-																goto l226
-															}
-															{
-																position300, tokenIndex300 := position, tokenIndex
-																if buffer[position] != rune('a') {
-																	goto l301
-																}
-																position++
-																goto l300
-															l301:
-																position, tokenIndex = position300, tokenIndex300
-																if buffer[position] != rune('A') {
-																	goto l226
-																}
-																position++
-															}
-														l300:
-															if !_rules[rulesep]() {
-																goto l226
-															}
-															if !_rules[ruleSrc8]() {
-																goto l226
-															}
-															{
-																add(ruleAction46, position)
-															}
-															add(ruleSbc, position293)
-														}
-														break
+													position++
+													break
+												default:
+													if buffer[position] != rune(' ') {
+														goto l134
 													}
+													position++
+													break
 												}
-
 											}
-										l228:
-											add(ruleAlu, position227)
+
+											goto l133
+										l134:
+											position, tokenIndex = position134, tokenIndex134
 										}
-										goto l89
-									l226:
-										position, tokenIndex = position89, tokenIndex89
-										{
-											position304 := position
-											{
-												position305, tokenIndex305 := position, tokenIndex
-												{
-													position307 := position
-													{
-														position308, tokenIndex308 := position, tokenIndex
-														{
-															position310 := position
-															{
-																position311, tokenIndex311 := position, tokenIndex
-																if buffer[position] != rune('r') {
-																	goto l312
-																}
-																position++
-																goto l311
-															l312:
-																position, tokenIndex = position311, tokenIndex311
-																if buffer[position] != rune('R') {
-																	goto l309
-																}
-																position++
-															}
-														l311:
-															{
-																position313, tokenIndex313 := position, tokenIndex
-																if buffer[position] != rune('l') {
-																	goto l314
-																}
-																position++
-																goto l313
-															l314:
-																position, tokenIndex = position313, tokenIndex313
-																if buffer[position] != rune('L') {
-																	goto l309
-																}
-																position++
-															}
-														l313:
-															{
-																position315, tokenIndex315 := position, tokenIndex
-																if buffer[position] != rune('c') {
-																	goto l316
-																}
-																position++
-																goto l315
-															l316:
-																position, tokenIndex = position315, tokenIndex315
-																if buffer[position] != rune('C') {
-																	goto l309
+										if !matchDot() {
+											goto l133
+										}
+										goto l80
+									l133:
+										position, tokenIndex = position133, tokenIndex133
+									}
+									{
+										add(ruleAction30, position)
+									}
+									add(ruleColumnLabel, position122)
+								}
+							}
+						l82:
+							goto l81
+						l80:
+							position, tokenIndex = position80, tokenIndex80
+						}
+					l81:
+					l137:
+						{
+							position138, tokenIndex138 := position, tokenIndex
+							if !_rules[rulews]() {
+								goto l138
+							}
+							goto l137
+						l138:
+							position, tokenIndex = position138, tokenIndex138
+						}
+						{
+							position139, tokenIndex139 := position, tokenIndex
+							if !_rules[ruleStatement]() {
+								goto l139
+							}
+							goto l140
+						l139:
+							position, tokenIndex = position139, tokenIndex139
+						}
+					l140:
+						{
+							position141, tokenIndex141 := position, tokenIndex
+							if !_rules[rulews]() {
+								goto l141
+							}
+							goto l142
+						l141:
+							position, tokenIndex = position141, tokenIndex141
+						}
+					l142:
+						{
+							position143, tokenIndex143 := position, tokenIndex
+							if !_rules[ruleComment]() {
+								goto l143
+							}
+							goto l144
+						l143:
+							position, tokenIndex = position143, tokenIndex143
+						}
+					l144:
+						{
+							position145, tokenIndex145 := position, tokenIndex
+							if !_rules[rulews]() {
+								goto l145
+							}
+							goto l146
+						l145:
+							position, tokenIndex = position145, tokenIndex145
+						}
+					l146:
+						{
+							add(ruleAction0, position)
+						}
+						{
+							position148 := position
+							{
+								position149, tokenIndex149 := position, tokenIndex
+								{
+									position151, tokenIndex151 := position, tokenIndex
+									if buffer[position] != rune('\r') {
+										goto l151
+									}
+									position++
+									goto l152
+								l151:
+									position, tokenIndex = position151, tokenIndex151
+								}
+							l152:
+								if buffer[position] != rune('\n') {
+									goto l150
+								}
+								position++
+								{
+									add(ruleAction1, position)
+								}
+								goto l149
+							l150:
+								position, tokenIndex = position149, tokenIndex149
+								if buffer[position] != rune(':') {
+									goto l3
+								}
+								position++
+							}
+						l149:
+							add(ruleLineEnd, position148)
+						}
+						add(ruleLine, position79)
+					}
+					goto l2
+				l3:
+					position, tokenIndex = position3, tokenIndex3
+				}
+				{
+					position154, tokenIndex154 := position, tokenIndex
+					if !matchDot() {
+						goto l154
+					}
+					goto l0
+				l154:
+					position, tokenIndex = position154, tokenIndex154
+				}
+				add(ruleProgram, position1)
+			}
+			return true
+		l0:
+			position, tokenIndex = position0, tokenIndex0
+			return false
+		},
+		/* 1 Line <- <(((ws* LabelDefn) / ColumnLabel)? ws* Statement? ws? Comment? ws? Action0 LineEnd)> */
+		nil,
+		/* 2 LineEnd <- <(('\r'? '\n' Action1) / ':')> */
+		nil,
+		/* 3 Statement <- <(Directive / Instruction)> */
+		func() bool {
+			position157, tokenIndex157 := position, tokenIndex
+			{
+				position158 := position
+				{
+					position159, tokenIndex159 := position, tokenIndex
+					{
+						position161 := position
+						{
+							position162, tokenIndex162 := position, tokenIndex
+							{
+								position164 := position
+								{
+									switch buffer[position] {
+									case 'A', 'a':
+										{
+											position166, tokenIndex166 := position, tokenIndex
+											if buffer[position] != rune('a') {
+												goto l167
+											}
+											position++
+											goto l166
+										l167:
+											position, tokenIndex = position166, tokenIndex166
+											if buffer[position] != rune('A') {
+												goto l163
+											}
+											position++
+										}
+									l166:
+										{
+											position168, tokenIndex168 := position, tokenIndex
+											if buffer[position] != rune('s') {
+												goto l169
+											}
+											position++
+											goto l168
+										l169:
+											position, tokenIndex = position168, tokenIndex168
+											if buffer[position] != rune('S') {
+												goto l163
+											}
+											position++
+										}
+									l168:
+										{
+											position170, tokenIndex170 := position, tokenIndex
+											if buffer[position] != rune('e') {
+												goto l171
+											}
+											position++
+											goto l170
+										l171:
+											position, tokenIndex = position170, tokenIndex170
+											if buffer[position] != rune('E') {
+												goto l163
+											}
+											position++
+										}
+									l170:
+										{
+											position172, tokenIndex172 := position, tokenIndex
+											if buffer[position] != rune('g') {
+												goto l173
+											}
+											position++
+											goto l172
+										l173:
+											position, tokenIndex = position172, tokenIndex172
+											if buffer[position] != rune('G') {
+												goto l163
+											}
+											position++
+										}
+									l172:
+										{
+											add(ruleAction5, position)
+										}
+										break
+									case 'D', 'd':
+										{
+											position175, tokenIndex175 := position, tokenIndex
+											if buffer[position] != rune('d') {
+												goto l176
+											}
+											position++
+											goto l175
+										l176:
+											position, tokenIndex = position175, tokenIndex175
+											if buffer[position] != rune('D') {
+												goto l163
+											}
+											position++
+										}
+									l175:
+										{
+											position177, tokenIndex177 := position, tokenIndex
+											if buffer[position] != rune('s') {
+												goto l178
+											}
+											position++
+											goto l177
+										l178:
+											position, tokenIndex = position177, tokenIndex177
+											if buffer[position] != rune('S') {
+												goto l163
+											}
+											position++
+										}
+									l177:
+										{
+											position179, tokenIndex179 := position, tokenIndex
+											if buffer[position] != rune('e') {
+												goto l180
+											}
+											position++
+											goto l179
+										l180:
+											position, tokenIndex = position179, tokenIndex179
+											if buffer[position] != rune('E') {
+												goto l163
+											}
+											position++
+										}
+									l179:
+										{
+											position181, tokenIndex181 := position, tokenIndex
+											if buffer[position] != rune('g') {
+												goto l182
+											}
+											position++
+											goto l181
+										l182:
+											position, tokenIndex = position181, tokenIndex181
+											if buffer[position] != rune('G') {
+												goto l163
+											}
+											position++
+										}
+									l181:
+										{
+											add(ruleAction4, position)
+										}
+										break
+									case 'C', 'c':
+										{
+											position184, tokenIndex184 := position, tokenIndex
+											if buffer[position] != rune('c') {
+												goto l185
+											}
+											position++
+											goto l184
+										l185:
+											position, tokenIndex = position184, tokenIndex184
+											if buffer[position] != rune('C') {
+												goto l163
+											}
+											position++
+										}
+									l184:
+										{
+											position186, tokenIndex186 := position, tokenIndex
+											if buffer[position] != rune('s') {
+												goto l187
+											}
+											position++
+											goto l186
+										l187:
+											position, tokenIndex = position186, tokenIndex186
+											if buffer[position] != rune('S') {
+												goto l163
+											}
+											position++
+										}
+									l186:
+										{
+											position188, tokenIndex188 := position, tokenIndex
+											if buffer[position] != rune('e') {
+												goto l189
+											}
+											position++
+											goto l188
+										l189:
+											position, tokenIndex = position188, tokenIndex188
+											if buffer[position] != rune('E') {
+												goto l163
+											}
+											position++
+										}
+									l188:
+										{
+											position190, tokenIndex190 := position, tokenIndex
+											if buffer[position] != rune('g') {
+												goto l191
+											}
+											position++
+											goto l190
+										l191:
+											position, tokenIndex = position190, tokenIndex190
+											if buffer[position] != rune('G') {
+												goto l163
+											}
+											position++
+										}
+									l190:
+										{
+											add(ruleAction3, position)
+										}
+										break
+									default:
+										{
+											position193, tokenIndex193 := position, tokenIndex
+											if buffer[position] != rune('s') {
+												goto l194
+											}
+											position++
+											goto l193
+										l194:
+											position, tokenIndex = position193, tokenIndex193
+											if buffer[position] != rune('S') {
+												goto l163
+											}
+											position++
+										}
+									l193:
+										{
+											position195, tokenIndex195 := position, tokenIndex
+											if buffer[position] != rune('e') {
+												goto l196
+											}
+											position++
+											goto l195
+										l196:
+											position, tokenIndex = position195, tokenIndex195
+											if buffer[position] != rune('E') {
+												goto l163
+											}
+											position++
+										}
+									l195:
+										{
+											position197, tokenIndex197 := position, tokenIndex
+											if buffer[position] != rune('c') {
+												goto l198
+											}
+											position++
+											goto l197
+										l198:
+											position, tokenIndex = position197, tokenIndex197
+											if buffer[position] != rune('C') {
+												goto l163
+											}
+											position++
+										}
+									l197:
+										{
+											position199, tokenIndex199 := position, tokenIndex
+											if buffer[position] != rune('t') {
+												goto l200
+											}
+											position++
+											goto l199
+										l200:
+											position, tokenIndex = position199, tokenIndex199
+											if buffer[position] != rune('T') {
+												goto l163
+											}
+											position++
+										}
+									l199:
+										{
+											position201, tokenIndex201 := position, tokenIndex
+											if buffer[position] != rune('i') {
+												goto l202
+											}
+											position++
+											goto l201
+										l202:
+											position, tokenIndex = position201, tokenIndex201
+											if buffer[position] != rune('I') {
+												goto l163
+											}
+											position++
+										}
+									l201:
+										{
+											position203, tokenIndex203 := position, tokenIndex
+											if buffer[position] != rune('o') {
+												goto l204
+											}
+											position++
+											goto l203
+										l204:
+											position, tokenIndex = position203, tokenIndex203
+											if buffer[position] != rune('O') {
+												goto l163
+											}
+											position++
+										}
+									l203:
+										{
+											position205, tokenIndex205 := position, tokenIndex
+											if buffer[position] != rune('n') {
+												goto l206
+											}
+											position++
+											goto l205
+										l206:
+											position, tokenIndex = position205, tokenIndex205
+											if buffer[position] != rune('N') {
+												goto l163
+											}
+											position++
+										}
+									l205:
+										if !_rules[rulews]() {
+											goto l163
+										}
+										if !_rules[ruleLabelText]() {
+											goto l163
+										}
+										{
+											add(ruleAction2, position)
+										}
+										break
+									}
+								}
+
+								add(ruleSection, position164)
+							}
+							goto l162
+						l163:
+							position, tokenIndex = position162, tokenIndex162
+							{
+								position209 := position
+								{
+									switch buffer[position] {
+									case 'E', 'e':
+										{
+											position211, tokenIndex211 := position, tokenIndex
+											if buffer[position] != rune('e') {
+												goto l212
+											}
+											position++
+											goto l211
+										l212:
+											position, tokenIndex = position211, tokenIndex211
+											if buffer[position] != rune('E') {
+												goto l208
+											}
+											position++
+										}
+									l211:
+										{
+											position213, tokenIndex213 := position, tokenIndex
+											if buffer[position] != rune('x') {
+												goto l214
+											}
+											position++
+											goto l213
+										l214:
+											position, tokenIndex = position213, tokenIndex213
+											if buffer[position] != rune('X') {
+												goto l208
+											}
+											position++
+										}
+									l213:
+										{
+											position215, tokenIndex215 := position, tokenIndex
+											if buffer[position] != rune('p') {
+												goto l216
+											}
+											position++
+											goto l215
+										l216:
+											position, tokenIndex = position215, tokenIndex215
+											if buffer[position] != rune('P') {
+												goto l208
+											}
+											position++
+										}
+									l215:
+										{
+											position217, tokenIndex217 := position, tokenIndex
+											if buffer[position] != rune('o') {
+												goto l218
+											}
+											position++
+											goto l217
+										l218:
+											position, tokenIndex = position217, tokenIndex217
+											if buffer[position] != rune('O') {
+												goto l208
+											}
+											position++
+										}
+									l217:
+										{
+											position219, tokenIndex219 := position, tokenIndex
+											if buffer[position] != rune('r') {
+												goto l220
+											}
+											position++
+											goto l219
+										l220:
+											position, tokenIndex = position219, tokenIndex219
+											if buffer[position] != rune('R') {
+												goto l208
+											}
+											position++
+										}
+									l219:
+										{
+											position221, tokenIndex221 := position, tokenIndex
+											if buffer[position] != rune('t') {
+												goto l222
+											}
+											position++
+											goto l221
+										l222:
+											position, tokenIndex = position221, tokenIndex221
+											if buffer[position] != rune('T') {
+												goto l208
+											}
+											position++
+										}
+									l221:
+										break
+									case 'X', 'x':
+										{
+											position223, tokenIndex223 := position, tokenIndex
+											if buffer[position] != rune('x') {
+												goto l224
+											}
+											position++
+											goto l223
+										l224:
+											position, tokenIndex = position223, tokenIndex223
+											if buffer[position] != rune('X') {
+												goto l208
+											}
+											position++
+										}
+									l223:
+										{
+											position225, tokenIndex225 := position, tokenIndex
+											if buffer[position] != rune('d') {
+												goto l226
+											}
+											position++
+											goto l225
+										l226:
+											position, tokenIndex = position225, tokenIndex225
+											if buffer[position] != rune('D') {
+												goto l208
+											}
+											position++
+										}
+									l225:
+										{
+											position227, tokenIndex227 := position, tokenIndex
+											if buffer[position] != rune('e') {
+												goto l228
+											}
+											position++
+											goto l227
+										l228:
+											position, tokenIndex = position227, tokenIndex227
+											if buffer[position] != rune('E') {
+												goto l208
+											}
+											position++
+										}
+									l227:
+										{
+											position229, tokenIndex229 := position, tokenIndex
+											if buffer[position] != rune('f') {
+												goto l230
+											}
+											position++
+											goto l229
+										l230:
+											position, tokenIndex = position229, tokenIndex229
+											if buffer[position] != rune('F') {
+												goto l208
+											}
+											position++
+										}
+									l229:
+										break
+									case 'G', 'g':
+										{
+											position231, tokenIndex231 := position, tokenIndex
+											if buffer[position] != rune('g') {
+												goto l232
+											}
+											position++
+											goto l231
+										l232:
+											position, tokenIndex = position231, tokenIndex231
+											if buffer[position] != rune('G') {
+												goto l208
+											}
+											position++
+										}
+									l231:
+										{
+											position233, tokenIndex233 := position, tokenIndex
+											if buffer[position] != rune('l') {
+												goto l234
+											}
+											position++
+											goto l233
+										l234:
+											position, tokenIndex = position233, tokenIndex233
+											if buffer[position] != rune('L') {
+												goto l208
+											}
+											position++
+										}
+									l233:
+										{
+											position235, tokenIndex235 := position, tokenIndex
+											if buffer[position] != rune('o') {
+												goto l236
+											}
+											position++
+											goto l235
+										l236:
+											position, tokenIndex = position235, tokenIndex235
+											if buffer[position] != rune('O') {
+												goto l208
+											}
+											position++
+										}
+									l235:
+										{
+											position237, tokenIndex237 := position, tokenIndex
+											if buffer[position] != rune('b') {
+												goto l238
+											}
+											position++
+											goto l237
+										l238:
+											position, tokenIndex = position237, tokenIndex237
+											if buffer[position] != rune('B') {
+												goto l208
+											}
+											position++
+										}
+									l237:
+										{
+											position239, tokenIndex239 := position, tokenIndex
+											if buffer[position] != rune('a') {
+												goto l240
+											}
+											position++
+											goto l239
+										l240:
+											position, tokenIndex = position239, tokenIndex239
+											if buffer[position] != rune('A') {
+												goto l208
+											}
+											position++
+										}
+									l239:
+										{
+											position241, tokenIndex241 := position, tokenIndex
+											if buffer[position] != rune('l') {
+												goto l242
+											}
+											position++
+											goto l241
+										l242:
+											position, tokenIndex = position241, tokenIndex241
+											if buffer[position] != rune('L') {
+												goto l208
+											}
+											position++
+										}
+									l241:
+										break
+									default:
+										{
+											position243, tokenIndex243 := position, tokenIndex
+											if buffer[position] != rune('p') {
+												goto l244
+											}
+											position++
+											goto l243
+										l244:
+											position, tokenIndex = position243, tokenIndex243
+											if buffer[position] != rune('P') {
+												goto l208
+											}
+											position++
+										}
+									l243:
+										{
+											position245, tokenIndex245 := position, tokenIndex
+											if buffer[position] != rune('u') {
+												goto l246
+											}
+											position++
+											goto l245
+										l246:
+											position, tokenIndex = position245, tokenIndex245
+											if buffer[position] != rune('U') {
+												goto l208
+											}
+											position++
+										}
+									l245:
+										{
+											position247, tokenIndex247 := position, tokenIndex
+											if buffer[position] != rune('b') {
+												goto l248
+											}
+											position++
+											goto l247
+										l248:
+											position, tokenIndex = position247, tokenIndex247
+											if buffer[position] != rune('B') {
+												goto l208
+											}
+											position++
+										}
+									l247:
+										{
+											position249, tokenIndex249 := position, tokenIndex
+											if buffer[position] != rune('l') {
+												goto l250
+											}
+											position++
+											goto l249
+										l250:
+											position, tokenIndex = position249, tokenIndex249
+											if buffer[position] != rune('L') {
+												goto l208
+											}
+											position++
+										}
+									l249:
+										{
+											position251, tokenIndex251 := position, tokenIndex
+											if buffer[position] != rune('i') {
+												goto l252
+											}
+											position++
+											goto l251
+										l252:
+											position, tokenIndex = position251, tokenIndex251
+											if buffer[position] != rune('I') {
+												goto l208
+											}
+											position++
+										}
+									l251:
+										{
+											position253, tokenIndex253 := position, tokenIndex
+											if buffer[position] != rune('c') {
+												goto l254
+											}
+											position++
+											goto l253
+										l254:
+											position, tokenIndex = position253, tokenIndex253
+											if buffer[position] != rune('C') {
+												goto l208
+											}
+											position++
+										}
+									l253:
+										break
+									}
+								}
+
+								if !_rules[rulews]() {
+									goto l208
+								}
+								if !_rules[rulenameList]() {
+									goto l208
+								}
+								{
+									add(ruleAction6, position)
+								}
+								add(rulePublic, position209)
+							}
+							goto l162
+						l208:
+							position, tokenIndex = position162, tokenIndex162
+							{
+								position257 := position
+								{
+									position258, tokenIndex258 := position, tokenIndex
+									{
+										position260, tokenIndex260 := position, tokenIndex
+										if buffer[position] != rune('e') {
+											goto l261
+										}
+										position++
+										goto l260
+									l261:
+										position, tokenIndex = position260, tokenIndex260
+										if buffer[position] != rune('E') {
+											goto l259
+										}
+										position++
+									}
+								l260:
+									{
+										position262, tokenIndex262 := position, tokenIndex
+										if buffer[position] != rune('x') {
+											goto l263
+										}
+										position++
+										goto l262
+									l263:
+										position, tokenIndex = position262, tokenIndex262
+										if buffer[position] != rune('X') {
+											goto l259
+										}
+										position++
+									}
+								l262:
+									{
+										position264, tokenIndex264 := position, tokenIndex
+										if buffer[position] != rune('t') {
+											goto l265
+										}
+										position++
+										goto l264
+									l265:
+										position, tokenIndex = position264, tokenIndex264
+										if buffer[position] != rune('T') {
+											goto l259
+										}
+										position++
+									}
+								l264:
+									{
+										position266, tokenIndex266 := position, tokenIndex
+										if buffer[position] != rune('e') {
+											goto l267
+										}
+										position++
+										goto l266
+									l267:
+										position, tokenIndex = position266, tokenIndex266
+										if buffer[position] != rune('E') {
+											goto l259
+										}
+										position++
+									}
+								l266:
+									{
+										position268, tokenIndex268 := position, tokenIndex
+										if buffer[position] != rune('r') {
+											goto l269
+										}
+										position++
+										goto l268
+									l269:
+										position, tokenIndex = position268, tokenIndex268
+										if buffer[position] != rune('R') {
+											goto l259
+										}
+										position++
+									}
+								l268:
+									{
+										position270, tokenIndex270 := position, tokenIndex
+										if buffer[position] != rune('n') {
+											goto l271
+										}
+										position++
+										goto l270
+									l271:
+										position, tokenIndex = position270, tokenIndex270
+										if buffer[position] != rune('N') {
+											goto l259
+										}
+										position++
+									}
+								l270:
+									goto l258
+								l259:
+									position, tokenIndex = position258, tokenIndex258
+									{
+										position273, tokenIndex273 := position, tokenIndex
+										if buffer[position] != rune('e') {
+											goto l274
+										}
+										position++
+										goto l273
+									l274:
+										position, tokenIndex = position273, tokenIndex273
+										if buffer[position] != rune('E') {
+											goto l272
+										}
+										position++
+									}
+								l273:
+									{
+										position275, tokenIndex275 := position, tokenIndex
+										if buffer[position] != rune('x') {
+											goto l276
+										}
+										position++
+										goto l275
+									l276:
+										position, tokenIndex = position275, tokenIndex275
+										if buffer[position] != rune('X') {
+											goto l272
+										}
+										position++
+									}
+								l275:
+									{
+										position277, tokenIndex277 := position, tokenIndex
+										if buffer[position] != rune('t') {
+											goto l278
+										}
+										position++
+										goto l277
+									l278:
+										position, tokenIndex = position277, tokenIndex277
+										if buffer[position] != rune('T') {
+											goto l272
+										}
+										position++
+									}
+								l277:
+									{
+										position279, tokenIndex279 := position, tokenIndex
+										if buffer[position] != rune('r') {
+											goto l280
+										}
+										position++
+										goto l279
+									l280:
+										position, tokenIndex = position279, tokenIndex279
+										if buffer[position] != rune('R') {
+											goto l272
+										}
+										position++
+									}
+								l279:
+									{
+										position281, tokenIndex281 := position, tokenIndex
+										if buffer[position] != rune('n') {
+											goto l282
+										}
+										position++
+										goto l281
+									l282:
+										position, tokenIndex = position281, tokenIndex281
+										if buffer[position] != rune('N') {
+											goto l272
+										}
+										position++
+									}
+								l281:
+									goto l258
+								l272:
+									position, tokenIndex = position258, tokenIndex258
+									{
+										position283, tokenIndex283 := position, tokenIndex
+										if buffer[position] != rune('x') {
+											goto l284
+										}
+										position++
+										goto l283
+									l284:
+										position, tokenIndex = position283, tokenIndex283
+										if buffer[position] != rune('X') {
+											goto l256
+										}
+										position++
+									}
+								l283:
+									{
+										position285, tokenIndex285 := position, tokenIndex
+										if buffer[position] != rune('r') {
+											goto l286
+										}
+										position++
+										goto l285
+									l286:
+										position, tokenIndex = position285, tokenIndex285
+										if buffer[position] != rune('R') {
+											goto l256
+										}
+										position++
+									}
+								l285:
+									{
+										position287, tokenIndex287 := position, tokenIndex
+										if buffer[position] != rune('e') {
+											goto l288
+										}
+										position++
+										goto l287
+									l288:
+										position, tokenIndex = position287, tokenIndex287
+										if buffer[position] != rune('E') {
+											goto l256
+										}
+										position++
+									}
+								l287:
+									{
+										position289, tokenIndex289 := position, tokenIndex
+										if buffer[position] != rune('f') {
+											goto l290
+										}
+										position++
+										goto l289
+									l290:
+										position, tokenIndex = position289, tokenIndex289
+										if buffer[position] != rune('F') {
+											goto l256
+										}
+										position++
+									}
+								l289:
+								}
+							l258:
+								if !_rules[rulews]() {
+									goto l256
+								}
+								if !_rules[rulenameList]() {
+									goto l256
+								}
+								{
+									add(ruleAction7, position)
+								}
+								add(ruleExtern, position257)
+							}
+							goto l162
+						l256:
+							position, tokenIndex = position162, tokenIndex162
+							{
+								position293 := position
+								{
+									position294, tokenIndex294 := position, tokenIndex
+									{
+										position296, tokenIndex296 := position, tokenIndex
+										if buffer[position] != rune(':') {
+											goto l297
+										}
+										position++
+										if buffer[position] != rune('=') {
+											goto l297
+										}
+										position++
+										{
+											position298, tokenIndex298 := position, tokenIndex
+											if !_rules[rulews]() {
+												goto l298
+											}
+											goto l299
+										l298:
+											position, tokenIndex = position298, tokenIndex298
+										}
+									l299:
+										goto l296
+									l297:
+										position, tokenIndex = position296, tokenIndex296
+										{
+											position300, tokenIndex300 := position, tokenIndex
+											if buffer[position] != rune('d') {
+												goto l301
+											}
+											position++
+											goto l300
+										l301:
+											position, tokenIndex = position300, tokenIndex300
+											if buffer[position] != rune('D') {
+												goto l295
+											}
+											position++
+										}
+									l300:
+										{
+											position302, tokenIndex302 := position, tokenIndex
+											if buffer[position] != rune('e') {
+												goto l303
+											}
+											position++
+											goto l302
+										l303:
+											position, tokenIndex = position302, tokenIndex302
+											if buffer[position] != rune('E') {
+												goto l295
+											}
+											position++
+										}
+									l302:
+										{
+											position304, tokenIndex304 := position, tokenIndex
+											if buffer[position] != rune('f') {
+												goto l305
+											}
+											position++
+											goto l304
+										l305:
+											position, tokenIndex = position304, tokenIndex304
+											if buffer[position] != rune('F') {
+												goto l295
+											}
+											position++
+										}
+									l304:
+										{
+											position306, tokenIndex306 := position, tokenIndex
+											if buffer[position] != rune('l') {
+												goto l307
+											}
+											position++
+											goto l306
+										l307:
+											position, tokenIndex = position306, tokenIndex306
+											if buffer[position] != rune('L') {
+												goto l295
+											}
+											position++
+										}
+									l306:
+										if !_rules[rulews]() {
+											goto l295
+										}
+									}
+								l296:
+									if !_rules[ruleexpr]() {
+										goto l295
+									}
+									{
+										add(ruleAction10, position)
+									}
+									goto l294
+								l295:
+									position, tokenIndex = position294, tokenIndex294
+									{
+										position309, tokenIndex309 := position, tokenIndex
+										if buffer[position] != rune('s') {
+											goto l310
+										}
+										position++
+										goto l309
+									l310:
+										position, tokenIndex = position309, tokenIndex309
+										if buffer[position] != rune('S') {
+											goto l292
+										}
+										position++
+									}
+								l309:
+									{
+										position311, tokenIndex311 := position, tokenIndex
+										if buffer[position] != rune('e') {
+											goto l312
+										}
+										position++
+										goto l311
+									l312:
+										position, tokenIndex = position311, tokenIndex311
+										if buffer[position] != rune('E') {
+											goto l292
+										}
+										position++
+									}
+								l311:
+									{
+										position313, tokenIndex313 := position, tokenIndex
+										if buffer[position] != rune('t') {
+											goto l314
+										}
+										position++
+										goto l313
+									l314:
+										position, tokenIndex = position313, tokenIndex313
+										if buffer[position] != rune('T') {
+											goto l292
+										}
+										position++
+									}
+								l313:
+									if !_rules[rulews]() {
+										goto l292
+									}
+									if !_rules[ruleexpr]() {
+										goto l292
+									}
+									{
+										position315, tokenIndex315 := position, tokenIndex
+										{
+											position316, tokenIndex316 := position, tokenIndex
+											if !_rules[rulews]() {
+												goto l316
+											}
+											goto l317
+										l316:
+											position, tokenIndex = position316, tokenIndex316
+										}
+									l317:
+										if buffer[position] != rune(',') {
+											goto l315
+										}
+										position++
+										goto l292
+									l315:
+										position, tokenIndex = position315, tokenIndex315
+									}
+									{
+										add(ruleAction11, position)
+									}
+								}
+							l294:
+								add(ruleDefl, position293)
+							}
+							goto l162
+						l292:
+							position, tokenIndex = position162, tokenIndex162
+							{
+								position320 := position
+								{
+									position321, tokenIndex321 := position, tokenIndex
+									{
+										position323, tokenIndex323 := position, tokenIndex
+										if buffer[position] != rune('d') {
+											goto l324
+										}
+										position++
+										goto l323
+									l324:
+										position, tokenIndex = position323, tokenIndex323
+										if buffer[position] != rune('D') {
+											goto l322
+										}
+										position++
+									}
+								l323:
+									{
+										position325, tokenIndex325 := position, tokenIndex
+										if buffer[position] != rune('e') {
+											goto l326
+										}
+										position++
+										goto l325
+									l326:
+										position, tokenIndex = position325, tokenIndex325
+										if buffer[position] != rune('E') {
+											goto l322
+										}
+										position++
+									}
+								l325:
+									{
+										position327, tokenIndex327 := position, tokenIndex
+										if buffer[position] != rune('f') {
+											goto l328
+										}
+										position++
+										goto l327
+									l328:
+										position, tokenIndex = position327, tokenIndex327
+										if buffer[position] != rune('F') {
+											goto l322
+										}
+										position++
+									}
+								l327:
+									{
+										position329, tokenIndex329 := position, tokenIndex
+										if buffer[position] != rune('b') {
+											goto l330
+										}
+										position++
+										goto l329
+									l330:
+										position, tokenIndex = position329, tokenIndex329
+										if buffer[position] != rune('B') {
+											goto l322
+										}
+										position++
+									}
+								l329:
+									goto l321
+								l322:
+									position, tokenIndex = position321, tokenIndex321
+									{
+										position332, tokenIndex332 := position, tokenIndex
+										if buffer[position] != rune('d') {
+											goto l333
+										}
+										position++
+										goto l332
+									l333:
+										position, tokenIndex = position332, tokenIndex332
+										if buffer[position] != rune('D') {
+											goto l331
+										}
+										position++
+									}
+								l332:
+									{
+										position334, tokenIndex334 := position, tokenIndex
+										if buffer[position] != rune('b') {
+											goto l335
+										}
+										position++
+										goto l334
+									l335:
+										position, tokenIndex = position334, tokenIndex334
+										if buffer[position] != rune('B') {
+											goto l331
+										}
+										position++
+									}
+								l334:
+									goto l321
+								l331:
+									position, tokenIndex = position321, tokenIndex321
+									{
+										position337, tokenIndex337 := position, tokenIndex
+										if buffer[position] != rune('d') {
+											goto l338
+										}
+										position++
+										goto l337
+									l338:
+										position, tokenIndex = position337, tokenIndex337
+										if buffer[position] != rune('D') {
+											goto l336
+										}
+										position++
+									}
+								l337:
+									{
+										position339, tokenIndex339 := position, tokenIndex
+										if buffer[position] != rune('e') {
+											goto l340
+										}
+										position++
+										goto l339
+									l340:
+										position, tokenIndex = position339, tokenIndex339
+										if buffer[position] != rune('E') {
+											goto l336
+										}
+										position++
+									}
+								l339:
+									{
+										position341, tokenIndex341 := position, tokenIndex
+										if buffer[position] != rune('f') {
+											goto l342
+										}
+										position++
+										goto l341
+									l342:
+										position, tokenIndex = position341, tokenIndex341
+										if buffer[position] != rune('F') {
+											goto l336
+										}
+										position++
+									}
+								l341:
+									{
+										position343, tokenIndex343 := position, tokenIndex
+										if buffer[position] != rune('m') {
+											goto l344
+										}
+										position++
+										goto l343
+									l344:
+										position, tokenIndex = position343, tokenIndex343
+										if buffer[position] != rune('M') {
+											goto l336
+										}
+										position++
+									}
+								l343:
+									goto l321
+								l336:
+									position, tokenIndex = position321, tokenIndex321
+									{
+										position345, tokenIndex345 := position, tokenIndex
+										if buffer[position] != rune('d') {
+											goto l346
+										}
+										position++
+										goto l345
+									l346:
+										position, tokenIndex = position345, tokenIndex345
+										if buffer[position] != rune('D') {
+											goto l319
+										}
+										position++
+									}
+								l345:
+									{
+										position347, tokenIndex347 := position, tokenIndex
+										if buffer[position] != rune('m') {
+											goto l348
+										}
+										position++
+										goto l347
+									l348:
+										position, tokenIndex = position347, tokenIndex347
+										if buffer[position] != rune('M') {
+											goto l319
+										}
+										position++
+									}
+								l347:
+								}
+							l321:
+								if !_rules[rulews]() {
+									goto l319
+								}
+								if !_rules[ruledataList]() {
+									goto l319
+								}
+								{
+									add(ruleAction12, position)
+								}
+								add(ruleDefb, position320)
+							}
+							goto l162
+						l319:
+							position, tokenIndex = position162, tokenIndex162
+							{
+								position351 := position
+								{
+									position352, tokenIndex352 := position, tokenIndex
+									{
+										position354, tokenIndex354 := position, tokenIndex
+										if buffer[position] != rune('d') {
+											goto l355
+										}
+										position++
+										goto l354
+									l355:
+										position, tokenIndex = position354, tokenIndex354
+										if buffer[position] != rune('D') {
+											goto l353
+										}
+										position++
+									}
+								l354:
+									{
+										position356, tokenIndex356 := position, tokenIndex
+										if buffer[position] != rune('e') {
+											goto l357
+										}
+										position++
+										goto l356
+									l357:
+										position, tokenIndex = position356, tokenIndex356
+										if buffer[position] != rune('E') {
+											goto l353
+										}
+										position++
+									}
+								l356:
+									{
+										position358, tokenIndex358 := position, tokenIndex
+										if buffer[position] != rune('f') {
+											goto l359
+										}
+										position++
+										goto l358
+									l359:
+										position, tokenIndex = position358, tokenIndex358
+										if buffer[position] != rune('F') {
+											goto l353
+										}
+										position++
+									}
+								l358:
+									{
+										position360, tokenIndex360 := position, tokenIndex
+										if buffer[position] != rune('z') {
+											goto l361
+										}
+										position++
+										goto l360
+									l361:
+										position, tokenIndex = position360, tokenIndex360
+										if buffer[position] != rune('Z') {
+											goto l353
+										}
+										position++
+									}
+								l360:
+									goto l352
+								l353:
+									position, tokenIndex = position352, tokenIndex352
+									{
+										position362, tokenIndex362 := position, tokenIndex
+										if buffer[position] != rune('d') {
+											goto l363
+										}
+										position++
+										goto l362
+									l363:
+										position, tokenIndex = position362, tokenIndex362
+										if buffer[position] != rune('D') {
+											goto l350
+										}
+										position++
+									}
+								l362:
+									{
+										position364, tokenIndex364 := position, tokenIndex
+										if buffer[position] != rune('z') {
+											goto l365
+										}
+										position++
+										goto l364
+									l365:
+										position, tokenIndex = position364, tokenIndex364
+										if buffer[position] != rune('Z') {
+											goto l350
+										}
+										position++
+									}
+								l364:
+								}
+							l352:
+								if !_rules[rulews]() {
+									goto l350
+								}
+								if !_rules[ruledataList]() {
+									goto l350
+								}
+								{
+									add(ruleAction13, position)
+								}
+								add(ruleDefz, position351)
+							}
+							goto l162
+						l350:
+							position, tokenIndex = position162, tokenIndex162
+							{
+								position368 := position
+								{
+									position369, tokenIndex369 := position, tokenIndex
+									if buffer[position] != rune('d') {
+										goto l370
+									}
+									position++
+									goto l369
+								l370:
+									position, tokenIndex = position369, tokenIndex369
+									if buffer[position] != rune('D') {
+										goto l367
+									}
+									position++
+								}
+							l369:
+								{
+									position371, tokenIndex371 := position, tokenIndex
+									if buffer[position] != rune('c') {
+										goto l372
+									}
+									position++
+									goto l371
+								l372:
+									position, tokenIndex = position371, tokenIndex371
+									if buffer[position] != rune('C') {
+										goto l367
+									}
+									position++
+								}
+							l371:
+								if !_rules[rulews]() {
+									goto l367
+								}
+								if !_rules[ruledataList]() {
+									goto l367
+								}
+								{
+									add(ruleAction14, position)
+								}
+								add(ruleDefc, position368)
+							}
+							goto l162
+						l367:
+							position, tokenIndex = position162, tokenIndex162
+							{
+								position375 := position
+								{
+									position376, tokenIndex376 := position, tokenIndex
+									{
+										position378, tokenIndex378 := position, tokenIndex
+										if buffer[position] != rune('d') {
+											goto l379
+										}
+										position++
+										goto l378
+									l379:
+										position, tokenIndex = position378, tokenIndex378
+										if buffer[position] != rune('D') {
+											goto l377
+										}
+										position++
+									}
+								l378:
+									{
+										position380, tokenIndex380 := position, tokenIndex
+										if buffer[position] != rune('e') {
+											goto l381
+										}
+										position++
+										goto l380
+									l381:
+										position, tokenIndex = position380, tokenIndex380
+										if buffer[position] != rune('E') {
+											goto l377
+										}
+										position++
+									}
+								l380:
+									{
+										position382, tokenIndex382 := position, tokenIndex
+										if buffer[position] != rune('f') {
+											goto l383
+										}
+										position++
+										goto l382
+									l383:
+										position, tokenIndex = position382, tokenIndex382
+										if buffer[position] != rune('F') {
+											goto l377
+										}
+										position++
+									}
+								l382:
+									{
+										position384, tokenIndex384 := position, tokenIndex
+										if buffer[position] != rune('s') {
+											goto l385
+										}
+										position++
+										goto l384
+									l385:
+										position, tokenIndex = position384, tokenIndex384
+										if buffer[position] != rune('S') {
+											goto l377
+										}
+										position++
+									}
+								l384:
+									goto l376
+								l377:
+									position, tokenIndex = position376, tokenIndex376
+									{
+										position386, tokenIndex386 := position, tokenIndex
+										if buffer[position] != rune('d') {
+											goto l387
+										}
+										position++
+										goto l386
+									l387:
+										position, tokenIndex = position386, tokenIndex386
+										if buffer[position] != rune('D') {
+											goto l374
+										}
+										position++
+									}
+								l386:
+									{
+										position388, tokenIndex388 := position, tokenIndex
+										if buffer[position] != rune('s') {
+											goto l389
+										}
+										position++
+										goto l388
+									l389:
+										position, tokenIndex = position388, tokenIndex388
+										if buffer[position] != rune('S') {
+											goto l374
+										}
+										position++
+									}
+								l388:
+								}
+							l376:
+								if !_rules[rulews]() {
+									goto l374
+								}
+								if !_rules[ruleexpr]() {
+									goto l374
+								}
+								{
+									position390, tokenIndex390 := position, tokenIndex
+									if !_rules[rulesep]() {
+										goto l390
+									}
+									if !_rules[ruleexpr]() {
+										goto l390
+									}
+									{
+										add(ruleAction16, position)
+									}
+									goto l391
+								l390:
+									position, tokenIndex = position390, tokenIndex390
+								}
+							l391:
+								{
+									add(ruleAction17, position)
+								}
+								add(ruleDefs, position375)
+							}
+							goto l162
+						l374:
+							position, tokenIndex = position162, tokenIndex162
+							{
+								switch buffer[position] {
+								case 'A', 'a':
+									{
+										position395 := position
+										{
+											position396, tokenIndex396 := position, tokenIndex
+											if buffer[position] != rune('a') {
+												goto l397
+											}
+											position++
+											goto l396
+										l397:
+											position, tokenIndex = position396, tokenIndex396
+											if buffer[position] != rune('A') {
+												goto l160
+											}
+											position++
+										}
+									l396:
+										{
+											position398, tokenIndex398 := position, tokenIndex
+											if buffer[position] != rune('l') {
+												goto l399
+											}
+											position++
+											goto l398
+										l399:
+											position, tokenIndex = position398, tokenIndex398
+											if buffer[position] != rune('L') {
+												goto l160
+											}
+											position++
+										}
+									l398:
+										{
+											position400, tokenIndex400 := position, tokenIndex
+											if buffer[position] != rune('i') {
+												goto l401
+											}
+											position++
+											goto l400
+										l401:
+											position, tokenIndex = position400, tokenIndex400
+											if buffer[position] != rune('I') {
+												goto l160
+											}
+											position++
+										}
+									l400:
+										{
+											position402, tokenIndex402 := position, tokenIndex
+											if buffer[position] != rune('g') {
+												goto l403
+											}
+											position++
+											goto l402
+										l403:
+											position, tokenIndex = position402, tokenIndex402
+											if buffer[position] != rune('G') {
+												goto l160
+											}
+											position++
+										}
+									l402:
+										{
+											position404, tokenIndex404 := position, tokenIndex
+											if buffer[position] != rune('n') {
+												goto l405
+											}
+											position++
+											goto l404
+										l405:
+											position, tokenIndex = position404, tokenIndex404
+											if buffer[position] != rune('N') {
+												goto l160
+											}
+											position++
+										}
+									l404:
+										if !_rules[rulews]() {
+											goto l160
+										}
+										if !_rules[ruleexpr]() {
+											goto l160
+										}
+										{
+											position406, tokenIndex406 := position, tokenIndex
+											if !_rules[rulesep]() {
+												goto l406
+											}
+											if !_rules[ruleexpr]() {
+												goto l406
+											}
+											{
+												add(ruleAction18, position)
+											}
+											goto l407
+										l406:
+											position, tokenIndex = position406, tokenIndex406
+										}
+									l407:
+										{
+											add(ruleAction19, position)
+										}
+										add(ruleAlign, position395)
+									}
+									break
+								case 'D', 'd':
+									{
+										position410 := position
+										{
+											position411, tokenIndex411 := position, tokenIndex
+											{
+												position413, tokenIndex413 := position, tokenIndex
+												if buffer[position] != rune('d') {
+													goto l414
+												}
+												position++
+												goto l413
+											l414:
+												position, tokenIndex = position413, tokenIndex413
+												if buffer[position] != rune('D') {
+													goto l412
+												}
+												position++
+											}
+										l413:
+											{
+												position415, tokenIndex415 := position, tokenIndex
+												if buffer[position] != rune('e') {
+													goto l416
+												}
+												position++
+												goto l415
+											l416:
+												position, tokenIndex = position415, tokenIndex415
+												if buffer[position] != rune('E') {
+													goto l412
+												}
+												position++
+											}
+										l415:
+											{
+												position417, tokenIndex417 := position, tokenIndex
+												if buffer[position] != rune('f') {
+													goto l418
+												}
+												position++
+												goto l417
+											l418:
+												position, tokenIndex = position417, tokenIndex417
+												if buffer[position] != rune('F') {
+													goto l412
+												}
+												position++
+											}
+										l417:
+											{
+												position419, tokenIndex419 := position, tokenIndex
+												if buffer[position] != rune('w') {
+													goto l420
+												}
+												position++
+												goto l419
+											l420:
+												position, tokenIndex = position419, tokenIndex419
+												if buffer[position] != rune('W') {
+													goto l412
+												}
+												position++
+											}
+										l419:
+											goto l411
+										l412:
+											position, tokenIndex = position411, tokenIndex411
+											{
+												position421, tokenIndex421 := position, tokenIndex
+												if buffer[position] != rune('d') {
+													goto l422
+												}
+												position++
+												goto l421
+											l422:
+												position, tokenIndex = position421, tokenIndex421
+												if buffer[position] != rune('D') {
+													goto l160
+												}
+												position++
+											}
+										l421:
+											{
+												position423, tokenIndex423 := position, tokenIndex
+												if buffer[position] != rune('w') {
+													goto l424
+												}
+												position++
+												goto l423
+											l424:
+												position, tokenIndex = position423, tokenIndex423
+												if buffer[position] != rune('W') {
+													goto l160
+												}
+												position++
+											}
+										l423:
+										}
+									l411:
+										if !_rules[rulews]() {
+											goto l160
+										}
+										{
+											position425 := position
+											if !_rules[ruleexpr]() {
+												goto l160
+											}
+											{
+												add(ruleAction23, position)
+											}
+										l427:
+											{
+												position428, tokenIndex428 := position, tokenIndex
+												if !_rules[rulesep]() {
+													goto l428
+												}
+												if !_rules[ruleexpr]() {
+													goto l428
+												}
+												{
+													add(ruleAction24, position)
+												}
+												goto l427
+											l428:
+												position, tokenIndex = position428, tokenIndex428
+											}
+											add(rulewordList, position425)
+										}
+										{
+											add(ruleAction15, position)
+										}
+										add(ruleDefw, position410)
+									}
+									break
+								case '=', 'E', 'e':
+									{
+										position431 := position
+										{
+											position432, tokenIndex432 := position, tokenIndex
+											{
+												position434, tokenIndex434 := position, tokenIndex
+												if buffer[position] != rune('e') {
+													goto l435
+												}
+												position++
+												goto l434
+											l435:
+												position, tokenIndex = position434, tokenIndex434
+												if buffer[position] != rune('E') {
+													goto l433
+												}
+												position++
+											}
+										l434:
+											{
+												position436, tokenIndex436 := position, tokenIndex
+												if buffer[position] != rune('q') {
+													goto l437
+												}
+												position++
+												goto l436
+											l437:
+												position, tokenIndex = position436, tokenIndex436
+												if buffer[position] != rune('Q') {
+													goto l433
+												}
+												position++
+											}
+										l436:
+											{
+												position438, tokenIndex438 := position, tokenIndex
+												if buffer[position] != rune('u') {
+													goto l439
+												}
+												position++
+												goto l438
+											l439:
+												position, tokenIndex = position438, tokenIndex438
+												if buffer[position] != rune('U') {
+													goto l433
+												}
+												position++
+											}
+										l438:
+											if !_rules[rulews]() {
+												goto l433
+											}
+											goto l432
+										l433:
+											position, tokenIndex = position432, tokenIndex432
+											if buffer[position] != rune('=') {
+												goto l160
+											}
+											position++
+											{
+												position440, tokenIndex440 := position, tokenIndex
+												if !_rules[rulews]() {
+													goto l440
+												}
+												goto l441
+											l440:
+												position, tokenIndex = position440, tokenIndex440
+											}
+										l441:
+										}
+									l432:
+										if !_rules[ruleexpr]() {
+											goto l160
+										}
+										{
+											add(ruleAction9, position)
+										}
+										add(ruleEqu, position431)
+									}
+									break
+								case 'O', 'o':
+									{
+										position443 := position
+										{
+											position444, tokenIndex444 := position, tokenIndex
+											if buffer[position] != rune('o') {
+												goto l445
+											}
+											position++
+											goto l444
+										l445:
+											position, tokenIndex = position444, tokenIndex444
+											if buffer[position] != rune('O') {
+												goto l160
+											}
+											position++
+										}
+									l444:
+										{
+											position446, tokenIndex446 := position, tokenIndex
+											if buffer[position] != rune('r') {
+												goto l447
+											}
+											position++
+											goto l446
+										l447:
+											position, tokenIndex = position446, tokenIndex446
+											if buffer[position] != rune('R') {
+												goto l160
+											}
+											position++
+										}
+									l446:
+										{
+											position448, tokenIndex448 := position, tokenIndex
+											if buffer[position] != rune('g') {
+												goto l449
+											}
+											position++
+											goto l448
+										l449:
+											position, tokenIndex = position448, tokenIndex448
+											if buffer[position] != rune('G') {
+												goto l160
+											}
+											position++
+										}
+									l448:
+										if !_rules[rulews]() {
+											goto l160
+										}
+										if !_rules[ruleexpr]() {
+											goto l160
+										}
+										{
+											add(ruleAction8, position)
+										}
+										add(ruleOrg, position443)
+									}
+									break
+								default:
+									{
+										position451 := position
+										{
+											position452, tokenIndex452 := position, tokenIndex
+											if buffer[position] != rune('.') {
+												goto l452
+											}
+											position++
+											goto l453
+										l452:
+											position, tokenIndex = position452, tokenIndex452
+										}
+									l453:
+										{
+											position454, tokenIndex454 := position, tokenIndex
+											if buffer[position] != rune('t') {
+												goto l455
+											}
+											position++
+											goto l454
+										l455:
+											position, tokenIndex = position454, tokenIndex454
+											if buffer[position] != rune('T') {
+												goto l160
+											}
+											position++
+										}
+									l454:
+										{
+											position456, tokenIndex456 := position, tokenIndex
+											if buffer[position] != rune('i') {
+												goto l457
+											}
+											position++
+											goto l456
+										l457:
+											position, tokenIndex = position456, tokenIndex456
+											if buffer[position] != rune('I') {
+												goto l160
+											}
+											position++
+										}
+									l456:
+										{
+											position458, tokenIndex458 := position, tokenIndex
+											if buffer[position] != rune('t') {
+												goto l459
+											}
+											position++
+											goto l458
+										l459:
+											position, tokenIndex = position458, tokenIndex458
+											if buffer[position] != rune('T') {
+												goto l160
+											}
+											position++
+										}
+									l458:
+										{
+											position460, tokenIndex460 := position, tokenIndex
+											if buffer[position] != rune('l') {
+												goto l461
+											}
+											position++
+											goto l460
+										l461:
+											position, tokenIndex = position460, tokenIndex460
+											if buffer[position] != rune('L') {
+												goto l160
+											}
+											position++
+										}
+									l460:
+										{
+											position462, tokenIndex462 := position, tokenIndex
+											if buffer[position] != rune('e') {
+												goto l463
+											}
+											position++
+											goto l462
+										l463:
+											position, tokenIndex = position462, tokenIndex462
+											if buffer[position] != rune('E') {
+												goto l160
+											}
+											position++
+										}
+									l462:
+										if !_rules[rulews]() {
+											goto l160
+										}
+										if buffer[position] != rune('\'') {
+											goto l160
+										}
+										position++
+									l464:
+										{
+											position465, tokenIndex465 := position, tokenIndex
+											{
+												position466, tokenIndex466 := position, tokenIndex
+												if buffer[position] != rune('\'') {
+													goto l466
+												}
+												position++
+												goto l465
+											l466:
+												position, tokenIndex = position466, tokenIndex466
+											}
+											if !matchDot() {
+												goto l465
+											}
+											goto l464
+										l465:
+											position, tokenIndex = position465, tokenIndex465
+										}
+										if buffer[position] != rune('\'') {
+											goto l160
+										}
+										position++
+										add(ruleTitle, position451)
+									}
+									break
+								}
+							}
+
+						}
+					l162:
+						add(ruleDirective, position161)
+					}
+					goto l159
+				l160:
+					position, tokenIndex = position159, tokenIndex159
+					{
+						position467 := position
+						{
+							position468, tokenIndex468 := position, tokenIndex
+							{
+								position470 := position
+								{
+									position471, tokenIndex471 := position, tokenIndex
+									{
+										position473 := position
+										{
+											position474, tokenIndex474 := position, tokenIndex
+											if buffer[position] != rune('p') {
+												goto l475
+											}
+											position++
+											goto l474
+										l475:
+											position, tokenIndex = position474, tokenIndex474
+											if buffer[position] != rune('P') {
+												goto l472
+											}
+											position++
+										}
+									l474:
+										{
+											position476, tokenIndex476 := position, tokenIndex
+											if buffer[position] != rune('u') {
+												goto l477
+											}
+											position++
+											goto l476
+										l477:
+											position, tokenIndex = position476, tokenIndex476
+											if buffer[position] != rune('U') {
+												goto l472
+											}
+											position++
+										}
+									l476:
+										{
+											position478, tokenIndex478 := position, tokenIndex
+											if buffer[position] != rune('s') {
+												goto l479
+											}
+											position++
+											goto l478
+										l479:
+											position, tokenIndex = position478, tokenIndex478
+											if buffer[position] != rune('S') {
+												goto l472
+											}
+											position++
+										}
+									l478:
+										{
+											position480, tokenIndex480 := position, tokenIndex
+											if buffer[position] != rune('h') {
+												goto l481
+											}
+											position++
+											goto l480
+										l481:
+											position, tokenIndex = position480, tokenIndex480
+											if buffer[position] != rune('H') {
+												goto l472
+											}
+											position++
+										}
+									l480:
+										if !_rules[rulews]() {
+											goto l472
+										}
+										if !_rules[ruleSrc16]() {
+											goto l472
+										}
+										{
+											add(ruleAction33, position)
+										}
+										add(rulePush, position473)
+									}
+									goto l471
+								l472:
+									position, tokenIndex = position471, tokenIndex471
+									{
+										switch buffer[position] {
+										case 'E', 'e':
+											{
+												position484 := position
+												{
+													position485, tokenIndex485 := position, tokenIndex
+													if buffer[position] != rune('e') {
+														goto l486
+													}
+													position++
+													goto l485
+												l486:
+													position, tokenIndex = position485, tokenIndex485
+													if buffer[position] != rune('E') {
+														goto l469
+													}
+													position++
+												}
+											l485:
+												{
+													position487, tokenIndex487 := position, tokenIndex
+													if buffer[position] != rune('x') {
+														goto l488
+													}
+													position++
+													goto l487
+												l488:
+													position, tokenIndex = position487, tokenIndex487
+													if buffer[position] != rune('X') {
+														goto l469
+													}
+													position++
+												}
+											l487:
+												if !_rules[rulews]() {
+													goto l469
+												}
+												if !_rules[ruleDst16]() {
+													goto l469
+												}
+												if !_rules[rulesep]() {
+													goto l469
+												}
+												if !_rules[ruleSrc16]() {
+													goto l469
+												}
+												{
+													add(ruleAction35, position)
+												}
+												add(ruleEx, position484)
+											}
+											break
+										case 'P', 'p':
+											{
+												position490 := position
+												{
+													position491, tokenIndex491 := position, tokenIndex
+													if buffer[position] != rune('p') {
+														goto l492
+													}
+													position++
+													goto l491
+												l492:
+													position, tokenIndex = position491, tokenIndex491
+													if buffer[position] != rune('P') {
+														goto l469
+													}
+													position++
+												}
+											l491:
+												{
+													position493, tokenIndex493 := position, tokenIndex
+													if buffer[position] != rune('o') {
+														goto l494
+													}
+													position++
+													goto l493
+												l494:
+													position, tokenIndex = position493, tokenIndex493
+													if buffer[position] != rune('O') {
+														goto l469
+													}
+													position++
+												}
+											l493:
+												{
+													position495, tokenIndex495 := position, tokenIndex
+													if buffer[position] != rune('p') {
+														goto l496
+													}
+													position++
+													goto l495
+												l496:
+													position, tokenIndex = position495, tokenIndex495
+													if buffer[position] != rune('P') {
+														goto l469
+													}
+													position++
+												}
+											l495:
+												if !_rules[rulews]() {
+													goto l469
+												}
+												if !_rules[ruleDst16]() {
+													goto l469
+												}
+												{
+													add(ruleAction34, position)
+												}
+												add(rulePop, position490)
+											}
+											break
+										default:
+											{
+												position498 := position
+												{
+													position499, tokenIndex499 := position, tokenIndex
+													{
+														position501 := position
+														{
+															position502, tokenIndex502 := position, tokenIndex
+															if buffer[position] != rune('l') {
+																goto l503
+															}
+															position++
+															goto l502
+														l503:
+															position, tokenIndex = position502, tokenIndex502
+															if buffer[position] != rune('L') {
+																goto l500
+															}
+															position++
+														}
+													l502:
+														{
+															position504, tokenIndex504 := position, tokenIndex
+															if buffer[position] != rune('d') {
+																goto l505
+															}
+															position++
+															goto l504
+														l505:
+															position, tokenIndex = position504, tokenIndex504
+															if buffer[position] != rune('D') {
+																goto l500
+															}
+															position++
+														}
+													l504:
+														if !_rules[rulews]() {
+															goto l500
+														}
+														{
+															position506 := position
+															{
+																position507, tokenIndex507 := position, tokenIndex
+																if !_rules[ruleReg16]() {
+																	goto l508
 																}
-																position++
+																goto l507
+															l508:
+																position, tokenIndex = position507, tokenIndex507
+																if !_rules[rulenn_contents]() {
+																	goto l500
+																}
+															}
+														l507:
+															{
+																add(ruleAction52, position)
+															}
+															add(ruleLdDst16, position506)
+														}
+														if !_rules[rulesep]() {
+															goto l500
+														}
+														if !_rules[ruleSrc16]() {
+															goto l500
+														}
+														{
+															add(ruleAction32, position)
+														}
+														add(ruleLoad16, position501)
+													}
+													goto l499
+												l500:
+													position, tokenIndex = position499, tokenIndex499
+													{
+														position511 := position
+														{
+															position512, tokenIndex512 := position, tokenIndex
+															if buffer[position] != rune('l') {
+																goto l513
+															}
+															position++
+															goto l512
+														l513:
+															position, tokenIndex = position512, tokenIndex512
+															if buffer[position] != rune('L') {
+																goto l469
 															}
-														l315:
-															if !_rules[rulews]() {
-																goto l309
+															position++
+														}
+													l512:
+														{
+															position514, tokenIndex514 := position, tokenIndex
+															if buffer[position] != rune('d') {
+																goto l515
 															}
-															if !_rules[ruleLoc8]() {
-																goto l309
+															position++
+															goto l514
+														l515:
+															position, tokenIndex = position514, tokenIndex514
+															if buffer[position] != rune('D') {
+																goto l469
 															}
+															position++
+														}
+													l514:
+														if !_rules[rulews]() {
+															goto l469
+														}
+														{
+															position516 := position
 															{
-																position317, tokenIndex317 := position, tokenIndex
-																if !_rules[rulesep]() {
-																	goto l317
+																position517, tokenIndex517 := position, tokenIndex
+																if !_rules[ruleReg8]() {
+																	goto l518
 																}
-																if !_rules[ruleCopy8]() {
-																	goto l317
+																goto l517
+															l518:
+																position, tokenIndex = position517, tokenIndex517
+																if !_rules[ruleReg16Contents]() {
+																	goto l519
+																}
+																goto l517
+															l519:
+																position, tokenIndex = position517, tokenIndex517
+																if !_rules[rulenn_contents]() {
+																	goto l469
 																}
-																goto l318
-															l317:
-																position, tokenIndex = position317, tokenIndex317
 															}
-														l318:
+														l517:
 															{
-																add(ruleAction51, position)
+																add(ruleAction45, position)
 															}
-															add(ruleRlc, position310)
+															add(ruleDst8, position516)
+														}
+														if !_rules[rulesep]() {
+															goto l469
+														}
+														if !_rules[ruleSrc8]() {
+															goto l469
+														}
+														{
+															add(ruleAction31, position)
+														}
+														add(ruleLoad8, position511)
+													}
+												}
+											l499:
+												add(ruleLoad, position498)
+											}
+											break
+										}
+									}
+
+								}
+							l471:
+								add(ruleAssignment, position470)
+							}
+							goto l468
+						l469:
+							position, tokenIndex = position468, tokenIndex468
+							{
+								position523 := position
+								{
+									position524, tokenIndex524 := position, tokenIndex
+									{
+										position526 := position
+										{
+											position527, tokenIndex527 := position, tokenIndex
+											if buffer[position] != rune('i') {
+												goto l528
+											}
+											position++
+											goto l527
+										l528:
+											position, tokenIndex = position527, tokenIndex527
+											if buffer[position] != rune('I') {
+												goto l525
+											}
+											position++
+										}
+									l527:
+										{
+											position529, tokenIndex529 := position, tokenIndex
+											if buffer[position] != rune('n') {
+												goto l530
+											}
+											position++
+											goto l529
+										l530:
+											position, tokenIndex = position529, tokenIndex529
+											if buffer[position] != rune('N') {
+												goto l525
+											}
+											position++
+										}
+									l529:
+										{
+											position531, tokenIndex531 := position, tokenIndex
+											if buffer[position] != rune('c') {
+												goto l532
+											}
+											position++
+											goto l531
+										l532:
+											position, tokenIndex = position531, tokenIndex531
+											if buffer[position] != rune('C') {
+												goto l525
+											}
+											position++
+										}
+									l531:
+										if !_rules[rulews]() {
+											goto l525
+										}
+										if !_rules[ruleILoc8]() {
+											goto l525
+										}
+										{
+											add(ruleAction36, position)
+										}
+										add(ruleInc16Indexed8, position526)
+									}
+									goto l524
+								l525:
+									position, tokenIndex = position524, tokenIndex524
+									{
+										position535 := position
+										{
+											position536, tokenIndex536 := position, tokenIndex
+											if buffer[position] != rune('i') {
+												goto l537
+											}
+											position++
+											goto l536
+										l537:
+											position, tokenIndex = position536, tokenIndex536
+											if buffer[position] != rune('I') {
+												goto l534
+											}
+											position++
+										}
+									l536:
+										{
+											position538, tokenIndex538 := position, tokenIndex
+											if buffer[position] != rune('n') {
+												goto l539
+											}
+											position++
+											goto l538
+										l539:
+											position, tokenIndex = position538, tokenIndex538
+											if buffer[position] != rune('N') {
+												goto l534
+											}
+											position++
+										}
+									l538:
+										{
+											position540, tokenIndex540 := position, tokenIndex
+											if buffer[position] != rune('c') {
+												goto l541
+											}
+											position++
+											goto l540
+										l541:
+											position, tokenIndex = position540, tokenIndex540
+											if buffer[position] != rune('C') {
+												goto l534
+											}
+											position++
+										}
+									l540:
+										if !_rules[rulews]() {
+											goto l534
+										}
+										if !_rules[ruleLoc16]() {
+											goto l534
+										}
+										{
+											add(ruleAction38, position)
+										}
+										add(ruleInc16, position535)
+									}
+									goto l524
+								l534:
+									position, tokenIndex = position524, tokenIndex524
+									{
+										position543 := position
+										{
+											position544, tokenIndex544 := position, tokenIndex
+											if buffer[position] != rune('i') {
+												goto l545
+											}
+											position++
+											goto l544
+										l545:
+											position, tokenIndex = position544, tokenIndex544
+											if buffer[position] != rune('I') {
+												goto l522
+											}
+											position++
+										}
+									l544:
+										{
+											position546, tokenIndex546 := position, tokenIndex
+											if buffer[position] != rune('n') {
+												goto l547
+											}
+											position++
+											goto l546
+										l547:
+											position, tokenIndex = position546, tokenIndex546
+											if buffer[position] != rune('N') {
+												goto l522
+											}
+											position++
+										}
+									l546:
+										{
+											position548, tokenIndex548 := position, tokenIndex
+											if buffer[position] != rune('c') {
+												goto l549
+											}
+											position++
+											goto l548
+										l549:
+											position, tokenIndex = position548, tokenIndex548
+											if buffer[position] != rune('C') {
+												goto l522
+											}
+											position++
+										}
+									l548:
+										if !_rules[rulews]() {
+											goto l522
+										}
+										if !_rules[ruleLoc8]() {
+											goto l522
+										}
+										{
+											add(ruleAction37, position)
+										}
+										add(ruleInc8, position543)
+									}
+								}
+							l524:
+								add(ruleInc, position523)
+							}
+							goto l468
+						l522:
+							position, tokenIndex = position468, tokenIndex468
+							{
+								position552 := position
+								{
+									position553, tokenIndex553 := position, tokenIndex
+									{
+										position555 := position
+										{
+											position556, tokenIndex556 := position, tokenIndex
+											if buffer[position] != rune('d') {
+												goto l557
+											}
+											position++
+											goto l556
+										l557:
+											position, tokenIndex = position556, tokenIndex556
+											if buffer[position] != rune('D') {
+												goto l554
+											}
+											position++
+										}
+									l556:
+										{
+											position558, tokenIndex558 := position, tokenIndex
+											if buffer[position] != rune('e') {
+												goto l559
+											}
+											position++
+											goto l558
+										l559:
+											position, tokenIndex = position558, tokenIndex558
+											if buffer[position] != rune('E') {
+												goto l554
+											}
+											position++
+										}
+									l558:
+										{
+											position560, tokenIndex560 := position, tokenIndex
+											if buffer[position] != rune('c') {
+												goto l561
+											}
+											position++
+											goto l560
+										l561:
+											position, tokenIndex = position560, tokenIndex560
+											if buffer[position] != rune('C') {
+												goto l554
+											}
+											position++
+										}
+									l560:
+										if !_rules[rulews]() {
+											goto l554
+										}
+										if !_rules[ruleILoc8]() {
+											goto l554
+										}
+										{
+											add(ruleAction39, position)
+										}
+										add(ruleDec16Indexed8, position555)
+									}
+									goto l553
+								l554:
+									position, tokenIndex = position553, tokenIndex553
+									{
+										position564 := position
+										{
+											position565, tokenIndex565 := position, tokenIndex
+											if buffer[position] != rune('d') {
+												goto l566
+											}
+											position++
+											goto l565
+										l566:
+											position, tokenIndex = position565, tokenIndex565
+											if buffer[position] != rune('D') {
+												goto l563
+											}
+											position++
+										}
+									l565:
+										{
+											position567, tokenIndex567 := position, tokenIndex
+											if buffer[position] != rune('e') {
+												goto l568
+											}
+											position++
+											goto l567
+										l568:
+											position, tokenIndex = position567, tokenIndex567
+											if buffer[position] != rune('E') {
+												goto l563
+											}
+											position++
+										}
+									l567:
+										{
+											position569, tokenIndex569 := position, tokenIndex
+											if buffer[position] != rune('c') {
+												goto l570
+											}
+											position++
+											goto l569
+										l570:
+											position, tokenIndex = position569, tokenIndex569
+											if buffer[position] != rune('C') {
+												goto l563
+											}
+											position++
+										}
+									l569:
+										if !_rules[rulews]() {
+											goto l563
+										}
+										if !_rules[ruleLoc16]() {
+											goto l563
+										}
+										{
+											add(ruleAction41, position)
+										}
+										add(ruleDec16, position564)
+									}
+									goto l553
+								l563:
+									position, tokenIndex = position553, tokenIndex553
+									{
+										position572 := position
+										{
+											position573, tokenIndex573 := position, tokenIndex
+											if buffer[position] != rune('d') {
+												goto l574
+											}
+											position++
+											goto l573
+										l574:
+											position, tokenIndex = position573, tokenIndex573
+											if buffer[position] != rune('D') {
+												goto l551
+											}
+											position++
+										}
+									l573:
+										{
+											position575, tokenIndex575 := position, tokenIndex
+											if buffer[position] != rune('e') {
+												goto l576
+											}
+											position++
+											goto l575
+										l576:
+											position, tokenIndex = position575, tokenIndex575
+											if buffer[position] != rune('E') {
+												goto l551
+											}
+											position++
+										}
+									l575:
+										{
+											position577, tokenIndex577 := position, tokenIndex
+											if buffer[position] != rune('c') {
+												goto l578
+											}
+											position++
+											goto l577
+										l578:
+											position, tokenIndex = position577, tokenIndex577
+											if buffer[position] != rune('C') {
+												goto l551
+											}
+											position++
+										}
+									l577:
+										if !_rules[rulews]() {
+											goto l551
+										}
+										if !_rules[ruleLoc8]() {
+											goto l551
+										}
+										{
+											add(ruleAction40, position)
+										}
+										add(ruleDec8, position572)
+									}
+								}
+							l553:
+								add(ruleDec, position552)
+							}
+							goto l468
+						l551:
+							position, tokenIndex = position468, tokenIndex468
+							{
+								position581 := position
+								{
+									position582, tokenIndex582 := position, tokenIndex
+									{
+										position584 := position
+										{
+											position585, tokenIndex585 := position, tokenIndex
+											if buffer[position] != rune('a') {
+												goto l586
+											}
+											position++
+											goto l585
+										l586:
+											position, tokenIndex = position585, tokenIndex585
+											if buffer[position] != rune('A') {
+												goto l583
+											}
+											position++
+										}
+									l585:
+										{
+											position587, tokenIndex587 := position, tokenIndex
+											if buffer[position] != rune('d') {
+												goto l588
+											}
+											position++
+											goto l587
+										l588:
+											position, tokenIndex = position587, tokenIndex587
+											if buffer[position] != rune('D') {
+												goto l583
+											}
+											position++
+										}
+									l587:
+										{
+											position589, tokenIndex589 := position, tokenIndex
+											if buffer[position] != rune('d') {
+												goto l590
+											}
+											position++
+											goto l589
+										l590:
+											position, tokenIndex = position589, tokenIndex589
+											if buffer[position] != rune('D') {
+												goto l583
+											}
+											position++
+										}
+									l589:
+										if !_rules[rulews]() {
+											goto l583
+										}
+										if !_rules[ruleDst16]() {
+											goto l583
+										}
+										if !_rules[rulesep]() {
+											goto l583
+										}
+										if !_rules[ruleSrc16]() {
+											goto l583
+										}
+										{
+											add(ruleAction42, position)
+										}
+										add(ruleAdd16, position584)
+									}
+									goto l582
+								l583:
+									position, tokenIndex = position582, tokenIndex582
+									{
+										position593 := position
+										{
+											position594, tokenIndex594 := position, tokenIndex
+											if buffer[position] != rune('a') {
+												goto l595
+											}
+											position++
+											goto l594
+										l595:
+											position, tokenIndex = position594, tokenIndex594
+											if buffer[position] != rune('A') {
+												goto l592
+											}
+											position++
+										}
+									l594:
+										{
+											position596, tokenIndex596 := position, tokenIndex
+											if buffer[position] != rune('d') {
+												goto l597
+											}
+											position++
+											goto l596
+										l597:
+											position, tokenIndex = position596, tokenIndex596
+											if buffer[position] != rune('D') {
+												goto l592
+											}
+											position++
+										}
+									l596:
+										{
+											position598, tokenIndex598 := position, tokenIndex
+											if buffer[position] != rune('c') {
+												goto l599
+											}
+											position++
+											goto l598
+										l599:
+											position, tokenIndex = position598, tokenIndex598
+											if buffer[position] != rune('C') {
+												goto l592
+											}
+											position++
+										}
+									l598:
+										if !_rules[rulews]() {
+											goto l592
+										}
+										if !_rules[ruleDst16]() {
+											goto l592
+										}
+										if !_rules[rulesep]() {
+											goto l592
+										}
+										if !_rules[ruleSrc16]() {
+											goto l592
+										}
+										{
+											add(ruleAction43, position)
+										}
+										add(ruleAdc16, position593)
+									}
+									goto l582
+								l592:
+									position, tokenIndex = position582, tokenIndex582
+									{
+										position601 := position
+										{
+											position602, tokenIndex602 := position, tokenIndex
+											if buffer[position] != rune('s') {
+												goto l603
+											}
+											position++
+											goto l602
+										l603:
+											position, tokenIndex = position602, tokenIndex602
+											if buffer[position] != rune('S') {
+												goto l580
+											}
+											position++
+										}
+									l602:
+										{
+											position604, tokenIndex604 := position, tokenIndex
+											if buffer[position] != rune('b') {
+												goto l605
+											}
+											position++
+											goto l604
+										l605:
+											position, tokenIndex = position604, tokenIndex604
+											if buffer[position] != rune('B') {
+												goto l580
+											}
+											position++
+										}
+									l604:
+										{
+											position606, tokenIndex606 := position, tokenIndex
+											if buffer[position] != rune('c') {
+												goto l607
+											}
+											position++
+											goto l606
+										l607:
+											position, tokenIndex = position606, tokenIndex606
+											if buffer[position] != rune('C') {
+												goto l580
+											}
+											position++
+										}
+									l606:
+										if !_rules[rulews]() {
+											goto l580
+										}
+										if !_rules[ruleDst16]() {
+											goto l580
+										}
+										if !_rules[rulesep]() {
+											goto l580
+										}
+										if !_rules[ruleSrc16]() {
+											goto l580
+										}
+										{
+											add(ruleAction44, position)
+										}
+										add(ruleSbc16, position601)
+									}
+								}
+							l582:
+								add(ruleAlu16, position581)
+							}
+							goto l468
+						l580:
+							position, tokenIndex = position468, tokenIndex468
+							{
+								position610 := position
+								{
+									position611, tokenIndex611 := position, tokenIndex
+									{
+										position613 := position
+										{
+											position614, tokenIndex614 := position, tokenIndex
+											if buffer[position] != rune('a') {
+												goto l615
+											}
+											position++
+											goto l614
+										l615:
+											position, tokenIndex = position614, tokenIndex614
+											if buffer[position] != rune('A') {
+												goto l612
+											}
+											position++
+										}
+									l614:
+										{
+											position616, tokenIndex616 := position, tokenIndex
+											if buffer[position] != rune('d') {
+												goto l617
+											}
+											position++
+											goto l616
+										l617:
+											position, tokenIndex = position616, tokenIndex616
+											if buffer[position] != rune('D') {
+												goto l612
+											}
+											position++
+										}
+									l616:
+										{
+											position618, tokenIndex618 := position, tokenIndex
+											if buffer[position] != rune('d') {
+												goto l619
+											}
+											position++
+											goto l618
+										l619:
+											position, tokenIndex = position618, tokenIndex618
+											if buffer[position] != rune('D') {
+												goto l612
+											}
+											position++
+										}
+									l618:
+										if !_rules[rulews]() {
+											goto l612
+										}
+										{
+											position620, tokenIndex620 := position, tokenIndex
+											{
+												position622, tokenIndex622 := position, tokenIndex
+												if buffer[position] != rune('a') {
+													goto l623
+												}
+												position++
+												goto l622
+											l623:
+												position, tokenIndex = position622, tokenIndex622
+												if buffer[position] != rune('A') {
+													goto l620
+												}
+												position++
+											}
+										l622:
+											if !_rules[rulesep]() {
+												goto l620
+											}
+											goto l621
+										l620:
+											position, tokenIndex = position620, tokenIndex620
+										}
+									l621:
+										if !_rules[ruleSrc8]() {
+											goto l612
+										}
+										{
+											add(ruleAction114, position)
+										}
+										add(ruleAdd, position613)
+									}
+									goto l611
+								l612:
+									position, tokenIndex = position611, tokenIndex611
+									{
+										position626 := position
+										{
+											position627, tokenIndex627 := position, tokenIndex
+											if buffer[position] != rune('a') {
+												goto l628
+											}
+											position++
+											goto l627
+										l628:
+											position, tokenIndex = position627, tokenIndex627
+											if buffer[position] != rune('A') {
+												goto l625
+											}
+											position++
+										}
+									l627:
+										{
+											position629, tokenIndex629 := position, tokenIndex
+											if buffer[position] != rune('d') {
+												goto l630
+											}
+											position++
+											goto l629
+										l630:
+											position, tokenIndex = position629, tokenIndex629
+											if buffer[position] != rune('D') {
+												goto l625
+											}
+											position++
+										}
+									l629:
+										{
+											position631, tokenIndex631 := position, tokenIndex
+											if buffer[position] != rune('c') {
+												goto l632
+											}
+											position++
+											goto l631
+										l632:
+											position, tokenIndex = position631, tokenIndex631
+											if buffer[position] != rune('C') {
+												goto l625
+											}
+											position++
+										}
+									l631:
+										if !_rules[rulews]() {
+											goto l625
+										}
+										{
+											position633, tokenIndex633 := position, tokenIndex
+											{
+												position635, tokenIndex635 := position, tokenIndex
+												if buffer[position] != rune('a') {
+													goto l636
+												}
+												position++
+												goto l635
+											l636:
+												position, tokenIndex = position635, tokenIndex635
+												if buffer[position] != rune('A') {
+													goto l633
+												}
+												position++
+											}
+										l635:
+											if !_rules[rulesep]() {
+												goto l633
+											}
+											goto l634
+										l633:
+											position, tokenIndex = position633, tokenIndex633
+										}
+									l634:
+										if !_rules[ruleSrc8]() {
+											goto l625
+										}
+										{
+											add(ruleAction115, position)
+										}
+										add(ruleAdc, position626)
+									}
+									goto l611
+								l625:
+									position, tokenIndex = position611, tokenIndex611
+									{
+										position639 := position
+										{
+											position640, tokenIndex640 := position, tokenIndex
+											if buffer[position] != rune('s') {
+												goto l641
+											}
+											position++
+											goto l640
+										l641:
+											position, tokenIndex = position640, tokenIndex640
+											if buffer[position] != rune('S') {
+												goto l638
+											}
+											position++
+										}
+									l640:
+										{
+											position642, tokenIndex642 := position, tokenIndex
+											if buffer[position] != rune('u') {
+												goto l643
+											}
+											position++
+											goto l642
+										l643:
+											position, tokenIndex = position642, tokenIndex642
+											if buffer[position] != rune('U') {
+												goto l638
+											}
+											position++
+										}
+									l642:
+										{
+											position644, tokenIndex644 := position, tokenIndex
+											if buffer[position] != rune('b') {
+												goto l645
+											}
+											position++
+											goto l644
+										l645:
+											position, tokenIndex = position644, tokenIndex644
+											if buffer[position] != rune('B') {
+												goto l638
+											}
+											position++
+										}
+									l644:
+										if !_rules[rulews]() {
+											goto l638
+										}
+										{
+											position646, tokenIndex646 := position, tokenIndex
+											{
+												position648, tokenIndex648 := position, tokenIndex
+												if buffer[position] != rune('a') {
+													goto l649
+												}
+												position++
+												goto l648
+											l649:
+												position, tokenIndex = position648, tokenIndex648
+												if buffer[position] != rune('A') {
+													goto l646
+												}
+												position++
+											}
+										l648:
+											if !_rules[rulesep]() {
+												goto l646
+											}
+											goto l647
+										l646:
+											position, tokenIndex = position646, tokenIndex646
+										}
+									l647:
+										if !_rules[ruleSrc8]() {
+											goto l638
+										}
+										{
+											add(ruleAction116, position)
+										}
+										add(ruleSub, position639)
+									}
+									goto l611
+								l638:
+									position, tokenIndex = position611, tokenIndex611
+									{
+										switch buffer[position] {
+										case 'C', 'c':
+											{
+												position652 := position
+												{
+													position653, tokenIndex653 := position, tokenIndex
+													if buffer[position] != rune('c') {
+														goto l654
+													}
+													position++
+													goto l653
+												l654:
+													position, tokenIndex = position653, tokenIndex653
+													if buffer[position] != rune('C') {
+														goto l609
+													}
+													position++
+												}
+											l653:
+												{
+													position655, tokenIndex655 := position, tokenIndex
+													if buffer[position] != rune('p') {
+														goto l656
+													}
+													position++
+													goto l655
+												l656:
+													position, tokenIndex = position655, tokenIndex655
+													if buffer[position] != rune('P') {
+														goto l609
+													}
+													position++
+												}
+											l655:
+												if !_rules[rulews]() {
+													goto l609
+												}
+												{
+													position657, tokenIndex657 := position, tokenIndex
+													{
+														position659, tokenIndex659 := position, tokenIndex
+														if buffer[position] != rune('a') {
+															goto l660
 														}
-														goto l308
-													l309:
-														position, tokenIndex = position308, tokenIndex308
-														{
-															position321 := position
-															{
-																position322, tokenIndex322 := position, tokenIndex
-																if buffer[position] != rune('r') {
-																	goto l323
-																}
-																position++
-																goto l322
-															l323:
-																position, tokenIndex = position322, tokenIndex322
-																if buffer[position] != rune('R') {
-																	goto l320
-																}
-																position++
-															}
-														l322:
-															{
-																position324, tokenIndex324 := position, tokenIndex
-																if buffer[position] != rune('r') {
-																	goto l325
-																}
-																position++
-																goto l324
-															l325:
-																position, tokenIndex = position324, tokenIndex324
-																if buffer[position] != rune('R') {
-																	goto l320
-																}
-																position++
-															}
-														l324:
-															{
-																position326, tokenIndex326 := position, tokenIndex
-																if buffer[position] != rune('c') {
-																	goto l327
-																}
-																position++
-																goto l326
-															l327:
-																position, tokenIndex = position326, tokenIndex326
-																if buffer[position] != rune('C') {
-																	goto l320
-																}
-																position++
-															}
-														l326:
-															if !_rules[rulews]() {
-																goto l320
-															}
-															if !_rules[ruleLoc8]() {
-																goto l320
-															}
-															{
-																position328, tokenIndex328 := position, tokenIndex
-																if !_rules[rulesep]() {
-																	goto l328
-																}
-																if !_rules[ruleCopy8]() {
-																	goto l328
-																}
-																goto l329
-															l328:
-																position, tokenIndex = position328, tokenIndex328
-															}
-														l329:
-															{
-																add(ruleAction52, position)
-															}
-															add(ruleRrc, position321)
+														position++
+														goto l659
+													l660:
+														position, tokenIndex = position659, tokenIndex659
+														if buffer[position] != rune('A') {
+															goto l657
 														}
-														goto l308
-													l320:
-														position, tokenIndex = position308, tokenIndex308
-														{
-															position332 := position
-															{
-																position333, tokenIndex333 := position, tokenIndex
-																if buffer[position] != rune('r') {
-																	goto l334
-																}
-																position++
-																goto l333
-															l334:
-																position, tokenIndex = position333, tokenIndex333
-																if buffer[position] != rune('R') {
-																	goto l331
-																}
-																position++
-															}
-														l333:
-															{
-																position335, tokenIndex335 := position, tokenIndex
-																if buffer[position] != rune('l') {
-																	goto l336
-																}
-																position++
-																goto l335
-															l336:
-																position, tokenIndex = position335, tokenIndex335
-																if buffer[position] != rune('L') {
-																	goto l331
-																}
-																position++
-															}
-														l335:
-															if !_rules[rulews]() {
-																goto l331
-															}
-															if !_rules[ruleLoc8]() {
-																goto l331
-															}
-															{
-																position337, tokenIndex337 := position, tokenIndex
-																if !_rules[rulesep]() {
-																	goto l337
-																}
-																if !_rules[ruleCopy8]() {
-																	goto l337
-																}
-																goto l338
-															l337:
-																position, tokenIndex = position337, tokenIndex337
-															}
-														l338:
-															{
-																add(ruleAction53, position)
-															}
-															add(ruleRl, position332)
+														position++
+													}
+												l659:
+													if !_rules[rulesep]() {
+														goto l657
+													}
+													goto l658
+												l657:
+													position, tokenIndex = position657, tokenIndex657
+												}
+											l658:
+												if !_rules[ruleSrc8]() {
+													goto l609
+												}
+												{
+													add(ruleAction121, position)
+												}
+												add(ruleCp, position652)
+											}
+											break
+										case 'O', 'o':
+											{
+												position662 := position
+												{
+													position663, tokenIndex663 := position, tokenIndex
+													if buffer[position] != rune('o') {
+														goto l664
+													}
+													position++
+													goto l663
+												l664:
+													position, tokenIndex = position663, tokenIndex663
+													if buffer[position] != rune('O') {
+														goto l609
+													}
+													position++
+												}
+											l663:
+												{
+													position665, tokenIndex665 := position, tokenIndex
+													if buffer[position] != rune('r') {
+														goto l666
+													}
+													position++
+													goto l665
+												l666:
+													position, tokenIndex = position665, tokenIndex665
+													if buffer[position] != rune('R') {
+														goto l609
+													}
+													position++
+												}
+											l665:
+												if !_rules[rulews]() {
+													goto l609
+												}
+												{
+													position667, tokenIndex667 := position, tokenIndex
+													{
+														position669, tokenIndex669 := position, tokenIndex
+														if buffer[position] != rune('a') {
+															goto l670
 														}
-														goto l308
-													l331:
-														position, tokenIndex = position308, tokenIndex308
-														{
-															position341 := position
-															{
-																position342, tokenIndex342 := position, tokenIndex
-																if buffer[position] != rune('r') {
-																	goto l343
-																}
-																position++
-																goto l342
-															l343:
-																position, tokenIndex = position342, tokenIndex342
-																if buffer[position] != rune('R') {
-																	goto l340
-																}
-																position++
-															}
-														l342:
-															{
-																position344, tokenIndex344 := position, tokenIndex
-																if buffer[position] != rune('r') {
-																	goto l345
-																}
-																position++
-																goto l344
-															l345:
-																position, tokenIndex = position344, tokenIndex344
-																if buffer[position] != rune('R') {
-																	goto l340
-																}
-																position++
-															}
-														l344:
-															if !_rules[rulews]() {
-																goto l340
-															}
-															if !_rules[ruleLoc8]() {
-																goto l340
-															}
-															{
-																position346, tokenIndex346 := position, tokenIndex
-																if !_rules[rulesep]() {
-																	goto l346
-																}
-																if !_rules[ruleCopy8]() {
-																	goto l346
-																}
-																goto l347
-															l346:
-																position, tokenIndex = position346, tokenIndex346
-															}
-														l347:
-															{
-																add(ruleAction54, position)
-															}
-															add(ruleRr, position341)
+														position++
+														goto l669
+													l670:
+														position, tokenIndex = position669, tokenIndex669
+														if buffer[position] != rune('A') {
+															goto l667
+														}
+														position++
+													}
+												l669:
+													if !_rules[rulesep]() {
+														goto l667
+													}
+													goto l668
+												l667:
+													position, tokenIndex = position667, tokenIndex667
+												}
+											l668:
+												if !_rules[ruleSrc8]() {
+													goto l609
+												}
+												{
+													add(ruleAction120, position)
+												}
+												add(ruleOr, position662)
+											}
+											break
+										case 'X', 'x':
+											{
+												position672 := position
+												{
+													position673, tokenIndex673 := position, tokenIndex
+													if buffer[position] != rune('x') {
+														goto l674
+													}
+													position++
+													goto l673
+												l674:
+													position, tokenIndex = position673, tokenIndex673
+													if buffer[position] != rune('X') {
+														goto l609
+													}
+													position++
+												}
+											l673:
+												{
+													position675, tokenIndex675 := position, tokenIndex
+													if buffer[position] != rune('o') {
+														goto l676
+													}
+													position++
+													goto l675
+												l676:
+													position, tokenIndex = position675, tokenIndex675
+													if buffer[position] != rune('O') {
+														goto l609
+													}
+													position++
+												}
+											l675:
+												{
+													position677, tokenIndex677 := position, tokenIndex
+													if buffer[position] != rune('r') {
+														goto l678
+													}
+													position++
+													goto l677
+												l678:
+													position, tokenIndex = position677, tokenIndex677
+													if buffer[position] != rune('R') {
+														goto l609
+													}
+													position++
+												}
+											l677:
+												if !_rules[rulews]() {
+													goto l609
+												}
+												{
+													position679, tokenIndex679 := position, tokenIndex
+													{
+														position681, tokenIndex681 := position, tokenIndex
+														if buffer[position] != rune('a') {
+															goto l682
+														}
+														position++
+														goto l681
+													l682:
+														position, tokenIndex = position681, tokenIndex681
+														if buffer[position] != rune('A') {
+															goto l679
+														}
+														position++
+													}
+												l681:
+													if !_rules[rulesep]() {
+														goto l679
+													}
+													goto l680
+												l679:
+													position, tokenIndex = position679, tokenIndex679
+												}
+											l680:
+												if !_rules[ruleSrc8]() {
+													goto l609
+												}
+												{
+													add(ruleAction119, position)
+												}
+												add(ruleXor, position672)
+											}
+											break
+										case 'A', 'a':
+											{
+												position684 := position
+												{
+													position685, tokenIndex685 := position, tokenIndex
+													if buffer[position] != rune('a') {
+														goto l686
+													}
+													position++
+													goto l685
+												l686:
+													position, tokenIndex = position685, tokenIndex685
+													if buffer[position] != rune('A') {
+														goto l609
+													}
+													position++
+												}
+											l685:
+												{
+													position687, tokenIndex687 := position, tokenIndex
+													if buffer[position] != rune('n') {
+														goto l688
+													}
+													position++
+													goto l687
+												l688:
+													position, tokenIndex = position687, tokenIndex687
+													if buffer[position] != rune('N') {
+														goto l609
+													}
+													position++
+												}
+											l687:
+												{
+													position689, tokenIndex689 := position, tokenIndex
+													if buffer[position] != rune('d') {
+														goto l690
+													}
+													position++
+													goto l689
+												l690:
+													position, tokenIndex = position689, tokenIndex689
+													if buffer[position] != rune('D') {
+														goto l609
+													}
+													position++
+												}
+											l689:
+												if !_rules[rulews]() {
+													goto l609
+												}
+												{
+													position691, tokenIndex691 := position, tokenIndex
+													{
+														position693, tokenIndex693 := position, tokenIndex
+														if buffer[position] != rune('a') {
+															goto l694
+														}
+														position++
+														goto l693
+													l694:
+														position, tokenIndex = position693, tokenIndex693
+														if buffer[position] != rune('A') {
+															goto l691
+														}
+														position++
+													}
+												l693:
+													if !_rules[rulesep]() {
+														goto l691
+													}
+													goto l692
+												l691:
+													position, tokenIndex = position691, tokenIndex691
+												}
+											l692:
+												if !_rules[ruleSrc8]() {
+													goto l609
+												}
+												{
+													add(ruleAction118, position)
+												}
+												add(ruleAnd, position684)
+											}
+											break
+										default:
+											{
+												position696 := position
+												{
+													position697, tokenIndex697 := position, tokenIndex
+													if buffer[position] != rune('s') {
+														goto l698
+													}
+													position++
+													goto l697
+												l698:
+													position, tokenIndex = position697, tokenIndex697
+													if buffer[position] != rune('S') {
+														goto l609
+													}
+													position++
+												}
+											l697:
+												{
+													position699, tokenIndex699 := position, tokenIndex
+													if buffer[position] != rune('b') {
+														goto l700
+													}
+													position++
+													goto l699
+												l700:
+													position, tokenIndex = position699, tokenIndex699
+													if buffer[position] != rune('B') {
+														goto l609
+													}
+													position++
+												}
+											l699:
+												{
+													position701, tokenIndex701 := position, tokenIndex
+													if buffer[position] != rune('c') {
+														goto l702
+													}
+													position++
+													goto l701
+												l702:
+													position, tokenIndex = position701, tokenIndex701
+													if buffer[position] != rune('C') {
+														goto l609
+													}
+													position++
+												}
+											l701:
+												if !_rules[rulews]() {
+													goto l609
+												}
+												{
+													position703, tokenIndex703 := position, tokenIndex
+													{
+														position705, tokenIndex705 := position, tokenIndex
+														if buffer[position] != rune('a') {
+															goto l706
 														}
-														goto l308
-													l340:
-														position, tokenIndex = position308, tokenIndex308
-														{
-															position350 := position
-															{
-																position351, tokenIndex351 := position, tokenIndex
-																if buffer[position] != rune('s') {
-																	goto l352
-																}
-																position++
-																goto l351
-															l352:
-																position, tokenIndex = position351, tokenIndex351
-																if buffer[position] != rune('S') {
-																	goto l349
-																}
-																position++
-															}
-														l351:
+														position++
+														goto l705
+													l706:
+														position, tokenIndex = position705, tokenIndex705
+														if buffer[position] != rune('A') {
+															goto l703
+														}
+														position++
+													}
+												l705:
+													if !_rules[rulesep]() {
+														goto l703
+													}
+													goto l704
+												l703:
+													position, tokenIndex = position703, tokenIndex703
+												}
+											l704:
+												if !_rules[ruleSrc8]() {
+													goto l609
+												}
+												{
+													add(ruleAction117, position)
+												}
+												add(ruleSbc, position696)
+											}
+											break
+										}
+									}
+
+								}
+							l611:
+								add(ruleAlu, position610)
+							}
+							goto l468
+						l609:
+							position, tokenIndex = position468, tokenIndex468
+							{
+								position709 := position
+								{
+									position710, tokenIndex710 := position, tokenIndex
+									{
+										position712 := position
+										{
+											position713, tokenIndex713 := position, tokenIndex
+											{
+												position715 := position
+												{
+													position716, tokenIndex716 := position, tokenIndex
+													if buffer[position] != rune('r') {
+														goto l717
+													}
+													position++
+													goto l716
+												l717:
+													position, tokenIndex = position716, tokenIndex716
+													if buffer[position] != rune('R') {
+														goto l714
+													}
+													position++
+												}
+											l716:
+												{
+													position718, tokenIndex718 := position, tokenIndex
+													if buffer[position] != rune('l') {
+														goto l719
+													}
+													position++
+													goto l718
+												l719:
+													position, tokenIndex = position718, tokenIndex718
+													if buffer[position] != rune('L') {
+														goto l714
+													}
+													position++
+												}
+											l718:
+												{
+													position720, tokenIndex720 := position, tokenIndex
+													if buffer[position] != rune('c') {
+														goto l721
+													}
+													position++
+													goto l720
+												l721:
+													position, tokenIndex = position720, tokenIndex720
+													if buffer[position] != rune('C') {
+														goto l714
+													}
+													position++
+												}
+											l720:
+												if !_rules[rulews]() {
+													goto l714
+												}
+												if !_rules[ruleLoc8]() {
+													goto l714
+												}
+												{
+													position722, tokenIndex722 := position, tokenIndex
+													if !_rules[rulesep]() {
+														goto l722
+													}
+													if !_rules[ruleCopy8]() {
+														goto l722
+													}
+													goto l723
+												l722:
+													position, tokenIndex = position722, tokenIndex722
+												}
+											l723:
+												{
+													add(ruleAction122, position)
+												}
+												add(ruleRlc, position715)
+											}
+											goto l713
+										l714:
+											position, tokenIndex = position713, tokenIndex713
+											{
+												position726 := position
+												{
+													position727, tokenIndex727 := position, tokenIndex
+													if buffer[position] != rune('r') {
+														goto l728
+													}
+													position++
+													goto l727
+												l728:
+													position, tokenIndex = position727, tokenIndex727
+													if buffer[position] != rune('R') {
+														goto l725
+													}
+													position++
+												}
+											l727:
+												{
+													position729, tokenIndex729 := position, tokenIndex
+													if buffer[position] != rune('r') {
+														goto l730
+													}
+													position++
+													goto l729
+												l730:
+													position, tokenIndex = position729, tokenIndex729
+													if buffer[position] != rune('R') {
+														goto l725
+													}
+													position++
+												}
+											l729:
+												{
+													position731, tokenIndex731 := position, tokenIndex
+													if buffer[position] != rune('c') {
+														goto l732
+													}
+													position++
+													goto l731
+												l732:
+													position, tokenIndex = position731, tokenIndex731
+													if buffer[position] != rune('C') {
+														goto l725
+													}
+													position++
+												}
+											l731:
+												if !_rules[rulews]() {
+													goto l725
+												}
+												if !_rules[ruleLoc8]() {
+													goto l725
+												}
+												{
+													position733, tokenIndex733 := position, tokenIndex
+													if !_rules[rulesep]() {
+														goto l733
+													}
+													if !_rules[ruleCopy8]() {
+														goto l733
+													}
+													goto l734
+												l733:
+													position, tokenIndex = position733, tokenIndex733
+												}
+											l734:
+												{
+													add(ruleAction123, position)
+												}
+												add(ruleRrc, position726)
+											}
+											goto l713
+										l725:
+											position, tokenIndex = position713, tokenIndex713
+											{
+												position737 := position
+												{
+													position738, tokenIndex738 := position, tokenIndex
+													if buffer[position] != rune('r') {
+														goto l739
+													}
+													position++
+													goto l738
+												l739:
+													position, tokenIndex = position738, tokenIndex738
+													if buffer[position] != rune('R') {
+														goto l736
+													}
+													position++
+												}
+											l738:
+												{
+													position740, tokenIndex740 := position, tokenIndex
+													if buffer[position] != rune('l') {
+														goto l741
+													}
+													position++
+													goto l740
+												l741:
+													position, tokenIndex = position740, tokenIndex740
+													if buffer[position] != rune('L') {
+														goto l736
+													}
+													position++
+												}
+											l740:
+												if !_rules[rulews]() {
+													goto l736
+												}
+												if !_rules[ruleLoc8]() {
+													goto l736
+												}
+												{
+													position742, tokenIndex742 := position, tokenIndex
+													if !_rules[rulesep]() {
+														goto l742
+													}
+													if !_rules[ruleCopy8]() {
+														goto l742
+													}
+													goto l743
+												l742:
+													position, tokenIndex = position742, tokenIndex742
+												}
+											l743:
+												{
+													add(ruleAction124, position)
+												}
+												add(ruleRl, position737)
+											}
+											goto l713
+										l736:
+											position, tokenIndex = position713, tokenIndex713
+											{
+												position746 := position
+												{
+													position747, tokenIndex747 := position, tokenIndex
+													if buffer[position] != rune('r') {
+														goto l748
+													}
+													position++
+													goto l747
+												l748:
+													position, tokenIndex = position747, tokenIndex747
+													if buffer[position] != rune('R') {
+														goto l745
+													}
+													position++
+												}
+											l747:
+												{
+													position749, tokenIndex749 := position, tokenIndex
+													if buffer[position] != rune('r') {
+														goto l750
+													}
+													position++
+													goto l749
+												l750:
+													position, tokenIndex = position749, tokenIndex749
+													if buffer[position] != rune('R') {
+														goto l745
+													}
+													position++
+												}
+											l749:
+												if !_rules[rulews]() {
+													goto l745
+												}
+												if !_rules[ruleLoc8]() {
+													goto l745
+												}
+												{
+													position751, tokenIndex751 := position, tokenIndex
+													if !_rules[rulesep]() {
+														goto l751
+													}
+													if !_rules[ruleCopy8]() {
+														goto l751
+													}
+													goto l752
+												l751:
+													position, tokenIndex = position751, tokenIndex751
+												}
+											l752:
+												{
+													add(ruleAction125, position)
+												}
+												add(ruleRr, position746)
+											}
+											goto l713
+										l745:
+											position, tokenIndex = position713, tokenIndex713
+											{
+												position755 := position
+												{
+													position756, tokenIndex756 := position, tokenIndex
+													if buffer[position] != rune('s') {
+														goto l757
+													}
+													position++
+													goto l756
+												l757:
+													position, tokenIndex = position756, tokenIndex756
+													if buffer[position] != rune('S') {
+														goto l754
+													}
+													position++
+												}
+											l756:
+												{
+													position758, tokenIndex758 := position, tokenIndex
+													if buffer[position] != rune('l') {
+														goto l759
+													}
+													position++
+													goto l758
+												l759:
+													position, tokenIndex = position758, tokenIndex758
+													if buffer[position] != rune('L') {
+														goto l754
+													}
+													position++
+												}
+											l758:
+												{
+													position760, tokenIndex760 := position, tokenIndex
+													if buffer[position] != rune('a') {
+														goto l761
+													}
+													position++
+													goto l760
+												l761:
+													position, tokenIndex = position760, tokenIndex760
+													if buffer[position] != rune('A') {
+														goto l754
+													}
+													position++
+												}
+											l760:
+												if !_rules[rulews]() {
+													goto l754
+												}
+												if !_rules[ruleLoc8]() {
+													goto l754
+												}
+												{
+													position762, tokenIndex762 := position, tokenIndex
+													if !_rules[rulesep]() {
+														goto l762
+													}
+													if !_rules[ruleCopy8]() {
+														goto l762
+													}
+													goto l763
+												l762:
+													position, tokenIndex = position762, tokenIndex762
+												}
+											l763:
+												{
+													add(ruleAction126, position)
+												}
+												add(ruleSla, position755)
+											}
+											goto l713
+										l754:
+											position, tokenIndex = position713, tokenIndex713
+											{
+												position766 := position
+												{
+													position767, tokenIndex767 := position, tokenIndex
+													if buffer[position] != rune('s') {
+														goto l768
+													}
+													position++
+													goto l767
+												l768:
+													position, tokenIndex = position767, tokenIndex767
+													if buffer[position] != rune('S') {
+														goto l765
+													}
+													position++
+												}
+											l767:
+												{
+													position769, tokenIndex769 := position, tokenIndex
+													if buffer[position] != rune('r') {
+														goto l770
+													}
+													position++
+													goto l769
+												l770:
+													position, tokenIndex = position769, tokenIndex769
+													if buffer[position] != rune('R') {
+														goto l765
+													}
+													position++
+												}
+											l769:
+												{
+													position771, tokenIndex771 := position, tokenIndex
+													if buffer[position] != rune('a') {
+														goto l772
+													}
+													position++
+													goto l771
+												l772:
+													position, tokenIndex = position771, tokenIndex771
+													if buffer[position] != rune('A') {
+														goto l765
+													}
+													position++
+												}
+											l771:
+												if !_rules[rulews]() {
+													goto l765
+												}
+												if !_rules[ruleLoc8]() {
+													goto l765
+												}
+												{
+													position773, tokenIndex773 := position, tokenIndex
+													if !_rules[rulesep]() {
+														goto l773
+													}
+													if !_rules[ruleCopy8]() {
+														goto l773
+													}
+													goto l774
+												l773:
+													position, tokenIndex = position773, tokenIndex773
+												}
+											l774:
+												{
+													add(ruleAction127, position)
+												}
+												add(ruleSra, position766)
+											}
+											goto l713
+										l765:
+											position, tokenIndex = position713, tokenIndex713
+											{
+												position777 := position
+												{
+													position778, tokenIndex778 := position, tokenIndex
+													if buffer[position] != rune('s') {
+														goto l779
+													}
+													position++
+													goto l778
+												l779:
+													position, tokenIndex = position778, tokenIndex778
+													if buffer[position] != rune('S') {
+														goto l776
+													}
+													position++
+												}
+											l778:
+												{
+													position780, tokenIndex780 := position, tokenIndex
+													if buffer[position] != rune('l') {
+														goto l781
+													}
+													position++
+													goto l780
+												l781:
+													position, tokenIndex = position780, tokenIndex780
+													if buffer[position] != rune('L') {
+														goto l776
+													}
+													position++
+												}
+											l780:
+												{
+													position782, tokenIndex782 := position, tokenIndex
+													if buffer[position] != rune('l') {
+														goto l783
+													}
+													position++
+													goto l782
+												l783:
+													position, tokenIndex = position782, tokenIndex782
+													if buffer[position] != rune('L') {
+														goto l776
+													}
+													position++
+												}
+											l782:
+												if !_rules[rulews]() {
+													goto l776
+												}
+												if !_rules[ruleLoc8]() {
+													goto l776
+												}
+												{
+													position784, tokenIndex784 := position, tokenIndex
+													if !_rules[rulesep]() {
+														goto l784
+													}
+													if !_rules[ruleCopy8]() {
+														goto l784
+													}
+													goto l785
+												l784:
+													position, tokenIndex = position784, tokenIndex784
+												}
+											l785:
+												{
+													add(ruleAction128, position)
+												}
+												add(ruleSll, position777)
+											}
+											goto l713
+										l776:
+											position, tokenIndex = position713, tokenIndex713
+											{
+												position787 := position
+												{
+													position788, tokenIndex788 := position, tokenIndex
+													if buffer[position] != rune('s') {
+														goto l789
+													}
+													position++
+													goto l788
+												l789:
+													position, tokenIndex = position788, tokenIndex788
+													if buffer[position] != rune('S') {
+														goto l711
+													}
+													position++
+												}
+											l788:
+												{
+													position790, tokenIndex790 := position, tokenIndex
+													if buffer[position] != rune('r') {
+														goto l791
+													}
+													position++
+													goto l790
+												l791:
+													position, tokenIndex = position790, tokenIndex790
+													if buffer[position] != rune('R') {
+														goto l711
+													}
+													position++
+												}
+											l790:
+												{
+													position792, tokenIndex792 := position, tokenIndex
+													if buffer[position] != rune('l') {
+														goto l793
+													}
+													position++
+													goto l792
+												l793:
+													position, tokenIndex = position792, tokenIndex792
+													if buffer[position] != rune('L') {
+														goto l711
+													}
+													position++
+												}
+											l792:
+												if !_rules[rulews]() {
+													goto l711
+												}
+												if !_rules[ruleLoc8]() {
+													goto l711
+												}
+												{
+													position794, tokenIndex794 := position, tokenIndex
+													if !_rules[rulesep]() {
+														goto l794
+													}
+													if !_rules[ruleCopy8]() {
+														goto l794
+													}
+													goto l795
+												l794:
+													position, tokenIndex = position794, tokenIndex794
+												}
+											l795:
+												{
+													add(ruleAction129, position)
+												}
+												add(ruleSrl, position787)
+											}
+										}
+									l713:
+										add(ruleRot, position712)
+									}
+									goto l710
+								l711:
+									position, tokenIndex = position710, tokenIndex710
+									{
+										switch buffer[position] {
+										case 'S', 's':
+											{
+												position798 := position
+												{
+													position799, tokenIndex799 := position, tokenIndex
+													if buffer[position] != rune('s') {
+														goto l800
+													}
+													position++
+													goto l799
+												l800:
+													position, tokenIndex = position799, tokenIndex799
+													if buffer[position] != rune('S') {
+														goto l708
+													}
+													position++
+												}
+											l799:
+												{
+													position801, tokenIndex801 := position, tokenIndex
+													if buffer[position] != rune('e') {
+														goto l802
+													}
+													position++
+													goto l801
+												l802:
+													position, tokenIndex = position801, tokenIndex801
+													if buffer[position] != rune('E') {
+														goto l708
+													}
+													position++
+												}
+											l801:
+												{
+													position803, tokenIndex803 := position, tokenIndex
+													if buffer[position] != rune('t') {
+														goto l804
+													}
+													position++
+													goto l803
+												l804:
+													position, tokenIndex = position803, tokenIndex803
+													if buffer[position] != rune('T') {
+														goto l708
+													}
+													position++
+												}
+											l803:
+												if !_rules[rulews]() {
+													goto l708
+												}
+												if !_rules[ruleoctaldigit]() {
+													goto l708
+												}
+												if !_rules[rulesep]() {
+													goto l708
+												}
+												if !_rules[ruleLoc8]() {
+													goto l708
+												}
+												{
+													position805, tokenIndex805 := position, tokenIndex
+													if !_rules[rulesep]() {
+														goto l805
+													}
+													if !_rules[ruleCopy8]() {
+														goto l805
+													}
+													goto l806
+												l805:
+													position, tokenIndex = position805, tokenIndex805
+												}
+											l806:
+												{
+													add(ruleAction132, position)
+												}
+												add(ruleSet, position798)
+											}
+											break
+										case 'R', 'r':
+											{
+												position808 := position
+												{
+													position809, tokenIndex809 := position, tokenIndex
+													if buffer[position] != rune('r') {
+														goto l810
+													}
+													position++
+													goto l809
+												l810:
+													position, tokenIndex = position809, tokenIndex809
+													if buffer[position] != rune('R') {
+														goto l708
+													}
+													position++
+												}
+											l809:
+												{
+													position811, tokenIndex811 := position, tokenIndex
+													if buffer[position] != rune('e') {
+														goto l812
+													}
+													position++
+													goto l811
+												l812:
+													position, tokenIndex = position811, tokenIndex811
+													if buffer[position] != rune('E') {
+														goto l708
+													}
+													position++
+												}
+											l811:
+												{
+													position813, tokenIndex813 := position, tokenIndex
+													if buffer[position] != rune('s') {
+														goto l814
+													}
+													position++
+													goto l813
+												l814:
+													position, tokenIndex = position813, tokenIndex813
+													if buffer[position] != rune('S') {
+														goto l708
+													}
+													position++
+												}
+											l813:
+												if !_rules[rulews]() {
+													goto l708
+												}
+												if !_rules[ruleoctaldigit]() {
+													goto l708
+												}
+												if !_rules[rulesep]() {
+													goto l708
+												}
+												if !_rules[ruleLoc8]() {
+													goto l708
+												}
+												{
+													position815, tokenIndex815 := position, tokenIndex
+													if !_rules[rulesep]() {
+														goto l815
+													}
+													if !_rules[ruleCopy8]() {
+														goto l815
+													}
+													goto l816
+												l815:
+													position, tokenIndex = position815, tokenIndex815
+												}
+											l816:
+												{
+													add(ruleAction131, position)
+												}
+												add(ruleRes, position808)
+											}
+											break
+										default:
+											{
+												position818 := position
+												{
+													position819, tokenIndex819 := position, tokenIndex
+													if buffer[position] != rune('b') {
+														goto l820
+													}
+													position++
+													goto l819
+												l820:
+													position, tokenIndex = position819, tokenIndex819
+													if buffer[position] != rune('B') {
+														goto l708
+													}
+													position++
+												}
+											l819:
+												{
+													position821, tokenIndex821 := position, tokenIndex
+													if buffer[position] != rune('i') {
+														goto l822
+													}
+													position++
+													goto l821
+												l822:
+													position, tokenIndex = position821, tokenIndex821
+													if buffer[position] != rune('I') {
+														goto l708
+													}
+													position++
+												}
+											l821:
+												{
+													position823, tokenIndex823 := position, tokenIndex
+													if buffer[position] != rune('t') {
+														goto l824
+													}
+													position++
+													goto l823
+												l824:
+													position, tokenIndex = position823, tokenIndex823
+													if buffer[position] != rune('T') {
+														goto l708
+													}
+													position++
+												}
+											l823:
+												if !_rules[rulews]() {
+													goto l708
+												}
+												if !_rules[ruleoctaldigit]() {
+													goto l708
+												}
+												if !_rules[rulesep]() {
+													goto l708
+												}
+												if !_rules[ruleLoc8]() {
+													goto l708
+												}
+												{
+													add(ruleAction130, position)
+												}
+												add(ruleBit, position818)
+											}
+											break
+										}
+									}
+
+								}
+							l710:
+								add(ruleBitOp, position709)
+							}
+							goto l468
+						l708:
+							position, tokenIndex = position468, tokenIndex468
+							{
+								position827 := position
+								{
+									position828, tokenIndex828 := position, tokenIndex
+									{
+										position830 := position
+										{
+											position831 := position
+											{
+												position832, tokenIndex832 := position, tokenIndex
+												if buffer[position] != rune('r') {
+													goto l833
+												}
+												position++
+												goto l832
+											l833:
+												position, tokenIndex = position832, tokenIndex832
+												if buffer[position] != rune('R') {
+													goto l829
+												}
+												position++
+											}
+										l832:
+											{
+												position834, tokenIndex834 := position, tokenIndex
+												if buffer[position] != rune('e') {
+													goto l835
+												}
+												position++
+												goto l834
+											l835:
+												position, tokenIndex = position834, tokenIndex834
+												if buffer[position] != rune('E') {
+													goto l829
+												}
+												position++
+											}
+										l834:
+											{
+												position836, tokenIndex836 := position, tokenIndex
+												if buffer[position] != rune('t') {
+													goto l837
+												}
+												position++
+												goto l836
+											l837:
+												position, tokenIndex = position836, tokenIndex836
+												if buffer[position] != rune('T') {
+													goto l829
+												}
+												position++
+											}
+										l836:
+											{
+												position838, tokenIndex838 := position, tokenIndex
+												if buffer[position] != rune('n') {
+													goto l839
+												}
+												position++
+												goto l838
+											l839:
+												position, tokenIndex = position838, tokenIndex838
+												if buffer[position] != rune('N') {
+													goto l829
+												}
+												position++
+											}
+										l838:
+											add(rulePegText, position831)
+										}
+										{
+											add(ruleAction147, position)
+										}
+										add(ruleRetn, position830)
+									}
+									goto l828
+								l829:
+									position, tokenIndex = position828, tokenIndex828
+									{
+										position842 := position
+										{
+											position843 := position
+											{
+												position844, tokenIndex844 := position, tokenIndex
+												if buffer[position] != rune('r') {
+													goto l845
+												}
+												position++
+												goto l844
+											l845:
+												position, tokenIndex = position844, tokenIndex844
+												if buffer[position] != rune('R') {
+													goto l841
+												}
+												position++
+											}
+										l844:
+											{
+												position846, tokenIndex846 := position, tokenIndex
+												if buffer[position] != rune('e') {
+													goto l847
+												}
+												position++
+												goto l846
+											l847:
+												position, tokenIndex = position846, tokenIndex846
+												if buffer[position] != rune('E') {
+													goto l841
+												}
+												position++
+											}
+										l846:
+											{
+												position848, tokenIndex848 := position, tokenIndex
+												if buffer[position] != rune('t') {
+													goto l849
+												}
+												position++
+												goto l848
+											l849:
+												position, tokenIndex = position848, tokenIndex848
+												if buffer[position] != rune('T') {
+													goto l841
+												}
+												position++
+											}
+										l848:
+											{
+												position850, tokenIndex850 := position, tokenIndex
+												if buffer[position] != rune('i') {
+													goto l851
+												}
+												position++
+												goto l850
+											l851:
+												position, tokenIndex = position850, tokenIndex850
+												if buffer[position] != rune('I') {
+													goto l841
+												}
+												position++
+											}
+										l850:
+											add(rulePegText, position843)
+										}
+										{
+											add(ruleAction148, position)
+										}
+										add(ruleReti, position842)
+									}
+									goto l828
+								l841:
+									position, tokenIndex = position828, tokenIndex828
+									{
+										position854 := position
+										{
+											position855 := position
+											{
+												position856, tokenIndex856 := position, tokenIndex
+												if buffer[position] != rune('r') {
+													goto l857
+												}
+												position++
+												goto l856
+											l857:
+												position, tokenIndex = position856, tokenIndex856
+												if buffer[position] != rune('R') {
+													goto l853
+												}
+												position++
+											}
+										l856:
+											{
+												position858, tokenIndex858 := position, tokenIndex
+												if buffer[position] != rune('r') {
+													goto l859
+												}
+												position++
+												goto l858
+											l859:
+												position, tokenIndex = position858, tokenIndex858
+												if buffer[position] != rune('R') {
+													goto l853
+												}
+												position++
+											}
+										l858:
+											{
+												position860, tokenIndex860 := position, tokenIndex
+												if buffer[position] != rune('d') {
+													goto l861
+												}
+												position++
+												goto l860
+											l861:
+												position, tokenIndex = position860, tokenIndex860
+												if buffer[position] != rune('D') {
+													goto l853
+												}
+												position++
+											}
+										l860:
+											add(rulePegText, position855)
+										}
+										{
+											add(ruleAction149, position)
+										}
+										add(ruleRrd, position854)
+									}
+									goto l828
+								l853:
+									position, tokenIndex = position828, tokenIndex828
+									{
+										position864 := position
+										{
+											position865 := position
+											{
+												position866, tokenIndex866 := position, tokenIndex
+												if buffer[position] != rune('i') {
+													goto l867
+												}
+												position++
+												goto l866
+											l867:
+												position, tokenIndex = position866, tokenIndex866
+												if buffer[position] != rune('I') {
+													goto l863
+												}
+												position++
+											}
+										l866:
+											{
+												position868, tokenIndex868 := position, tokenIndex
+												if buffer[position] != rune('m') {
+													goto l869
+												}
+												position++
+												goto l868
+											l869:
+												position, tokenIndex = position868, tokenIndex868
+												if buffer[position] != rune('M') {
+													goto l863
+												}
+												position++
+											}
+										l868:
+											if buffer[position] != rune(' ') {
+												goto l863
+											}
+											position++
+											if buffer[position] != rune('0') {
+												goto l863
+											}
+											position++
+											add(rulePegText, position865)
+										}
+										{
+											add(ruleAction151, position)
+										}
+										add(ruleIm0, position864)
+									}
+									goto l828
+								l863:
+									position, tokenIndex = position828, tokenIndex828
+									{
+										position872 := position
+										{
+											position873 := position
+											{
+												position874, tokenIndex874 := position, tokenIndex
+												if buffer[position] != rune('i') {
+													goto l875
+												}
+												position++
+												goto l874
+											l875:
+												position, tokenIndex = position874, tokenIndex874
+												if buffer[position] != rune('I') {
+													goto l871
+												}
+												position++
+											}
+										l874:
+											{
+												position876, tokenIndex876 := position, tokenIndex
+												if buffer[position] != rune('m') {
+													goto l877
+												}
+												position++
+												goto l876
+											l877:
+												position, tokenIndex = position876, tokenIndex876
+												if buffer[position] != rune('M') {
+													goto l871
+												}
+												position++
+											}
+										l876:
+											if buffer[position] != rune(' ') {
+												goto l871
+											}
+											position++
+											if buffer[position] != rune('1') {
+												goto l871
+											}
+											position++
+											add(rulePegText, position873)
+										}
+										{
+											add(ruleAction152, position)
+										}
+										add(ruleIm1, position872)
+									}
+									goto l828
+								l871:
+									position, tokenIndex = position828, tokenIndex828
+									{
+										position880 := position
+										{
+											position881 := position
+											{
+												position882, tokenIndex882 := position, tokenIndex
+												if buffer[position] != rune('i') {
+													goto l883
+												}
+												position++
+												goto l882
+											l883:
+												position, tokenIndex = position882, tokenIndex882
+												if buffer[position] != rune('I') {
+													goto l879
+												}
+												position++
+											}
+										l882:
+											{
+												position884, tokenIndex884 := position, tokenIndex
+												if buffer[position] != rune('m') {
+													goto l885
+												}
+												position++
+												goto l884
+											l885:
+												position, tokenIndex = position884, tokenIndex884
+												if buffer[position] != rune('M') {
+													goto l879
+												}
+												position++
+											}
+										l884:
+											if buffer[position] != rune(' ') {
+												goto l879
+											}
+											position++
+											if buffer[position] != rune('2') {
+												goto l879
+											}
+											position++
+											add(rulePegText, position881)
+										}
+										{
+											add(ruleAction153, position)
+										}
+										add(ruleIm2, position880)
+									}
+									goto l828
+								l879:
+									position, tokenIndex = position828, tokenIndex828
+									{
+										switch buffer[position] {
+										case 'I', 'O', 'i', 'o':
+											{
+												position888 := position
+												{
+													position889, tokenIndex889 := position, tokenIndex
+													{
+														position891 := position
+														{
+															position892 := position
 															{
-																position353, tokenIndex353 := position, tokenIndex
-																if buffer[position] != rune('l') {
-																	goto l354
+																position893, tokenIndex893 := position, tokenIndex
+																if buffer[position] != rune('i') {
+																	goto l894
 																}
 																position++
-																goto l353
-															l354:
-																position, tokenIndex = position353, tokenIndex353
-																if buffer[position] != rune('L') {
-																	goto l349
+																goto l893
+															l894:
+																position, tokenIndex = position893, tokenIndex893
+																if buffer[position] != rune('I') {
+																	goto l890
 																}
 																position++
 															}
-														l353:
+														l893:
 															{
-																position355, tokenIndex355 := position, tokenIndex
-																if buffer[position] != rune('a') {
-																	goto l356
+																position895, tokenIndex895 := position, tokenIndex
+																if buffer[position] != rune('n') {
+																	goto l896
 																}
 																position++
-																goto l355
-															l356:
-																position, tokenIndex = position355, tokenIndex355
-																if buffer[position] != rune('A') {
-																	goto l349
+																goto l895
+															l896:
+																position, tokenIndex = position895, tokenIndex895
+																if buffer[position] != rune('N') {
+																	goto l890
 																}
 																position++
 															}
-														l355:
-															if !_rules[rulews]() {
-																goto l349
-															}
-															if !_rules[ruleLoc8]() {
-																goto l349
-															}
-															{
-																position357, tokenIndex357 := position, tokenIndex
-																if !_rules[rulesep]() {
-																	goto l357
-																}
-																if !_rules[ruleCopy8]() {
-																	goto l357
-																}
-																goto l358
-															l357:
-																position, tokenIndex = position357, tokenIndex357
-															}
-														l358:
-															{
-																add(ruleAction55, position)
-															}
-															add(ruleSla, position350)
-														}
-														goto l308
-													l349:
-														position, tokenIndex = position308, tokenIndex308
-														{
-															position361 := position
+														l895:
 															{
-																position362, tokenIndex362 := position, tokenIndex
-																if buffer[position] != rune('s') {
-																	goto l363
+																position897, tokenIndex897 := position, tokenIndex
+																if buffer[position] != rune('i') {
+																	goto l898
 																}
 																position++
-																goto l362
-															l363:
-																position, tokenIndex = position362, tokenIndex362
-																if buffer[position] != rune('S') {
-																	goto l360
+																goto l897
+															l898:
+																position, tokenIndex = position897, tokenIndex897
+																if buffer[position] != rune('I') {
+																	goto l890
 																}
 																position++
 															}
-														l362:
+														l897:
 															{
-																position364, tokenIndex364 := position, tokenIndex
+																position899, tokenIndex899 := position, tokenIndex
 																if buffer[position] != rune('r') {
-																	goto l365
+																	goto l900
 																}
 																position++
-																goto l364
-															l365:
-																position, tokenIndex = position364, tokenIndex364
+																goto l899
+															l900:
+																position, tokenIndex = position899, tokenIndex899
 																if buffer[position] != rune('R') {
-																	goto l360
-																}
-																position++
-															}
-														l364:
-															{
-																position366, tokenIndex366 := position, tokenIndex
-																if buffer[position] != rune('a') {
-																	goto l367
-																}
-																position++
-																goto l366
-															l367:
-																position, tokenIndex = position366, tokenIndex366
-																if buffer[position] != rune('A') {
-																	goto l360
+																	goto l890
 																}
 																position++
 															}
-														l366:
-															if !_rules[rulews]() {
-																goto l360
-															}
-															if !_rules[ruleLoc8]() {
-																goto l360
-															}
-															{
-																position368, tokenIndex368 := position, tokenIndex
-																if !_rules[rulesep]() {
-																	goto l368
-																}
-																if !_rules[ruleCopy8]() {
-																	goto l368
-																}
-																goto l369
-															l368:
-																position, tokenIndex = position368, tokenIndex368
-															}
-														l369:
-															{
-																add(ruleAction56, position)
-															}
-															add(ruleSra, position361)
+														l899:
+															add(rulePegText, position892)
 														}
-														goto l308
-													l360:
-														position, tokenIndex = position308, tokenIndex308
 														{
-															position372 := position
-															{
-																position373, tokenIndex373 := position, tokenIndex
-																if buffer[position] != rune('s') {
-																	goto l374
-																}
-																position++
-																goto l373
-															l374:
-																position, tokenIndex = position373, tokenIndex373
-																if buffer[position] != rune('S') {
-																	goto l371
-																}
-																position++
-															}
-														l373:
-															{
-																position375, tokenIndex375 := position, tokenIndex
-																if buffer[position] != rune('l') {
-																	goto l376
-																}
-																position++
-																goto l375
-															l376:
-																position, tokenIndex = position375, tokenIndex375
-																if buffer[position] != rune('L') {
-																	goto l371
-																}
-																position++
-															}
-														l375:
-															{
-																position377, tokenIndex377 := position, tokenIndex
-																if buffer[position] != rune('l') {
-																	goto l378
-																}
-																position++
-																goto l377
-															l378:
-																position, tokenIndex = position377, tokenIndex377
-																if buffer[position] != rune('L') {
-																	goto l371
-																}
-																position++
-															}
-														l377:
-															if !_rules[rulews]() {
-																goto l371
-															}
-															if !_rules[ruleLoc8]() {
-																goto l371
-															}
-															{
-																position379, tokenIndex379 := position, tokenIndex
-																if !_rules[rulesep]() {
-																	goto l379
-																}
-																if !_rules[ruleCopy8]() {
-																	goto l379
-																}
-																goto l380
-															l379:
-																position, tokenIndex = position379, tokenIndex379
-															}
-														l380:
-															{
-																add(ruleAction57, position)
-															}
-															add(ruleSll, position372)
+															add(ruleAction164, position)
 														}
-														goto l308
-													l371:
-														position, tokenIndex = position308, tokenIndex308
+														add(ruleInir, position891)
+													}
+													goto l889
+												l890:
+													position, tokenIndex = position889, tokenIndex889
+													{
+														position903 := position
 														{
-															position382 := position
+															position904 := position
 															{
-																position383, tokenIndex383 := position, tokenIndex
-																if buffer[position] != rune('s') {
-																	goto l384
+																position905, tokenIndex905 := position, tokenIndex
+																if buffer[position] != rune('i') {
+																	goto l906
 																}
 																position++
-																goto l383
-															l384:
-																position, tokenIndex = position383, tokenIndex383
-																if buffer[position] != rune('S') {
-																	goto l306
+																goto l905
+															l906:
+																position, tokenIndex = position905, tokenIndex905
+																if buffer[position] != rune('I') {
+																	goto l902
 																}
 																position++
 															}
-														l383:
+														l905:
 															{
-																position385, tokenIndex385 := position, tokenIndex
-																if buffer[position] != rune('r') {
-																	goto l386
+																position907, tokenIndex907 := position, tokenIndex
+																if buffer[position] != rune('n') {
+																	goto l908
 																}
 																position++
-																goto l385
-															l386:
-																position, tokenIndex = position385, tokenIndex385
-																if buffer[position] != rune('R') {
-																	goto l306
+																goto l907
+															l908:
+																position, tokenIndex = position907, tokenIndex907
+																if buffer[position] != rune('N') {
+																	goto l902
 																}
 																position++
 															}
-														l385:
+														l907:
 															{
-																position387, tokenIndex387 := position, tokenIndex
-																if buffer[position] != rune('l') {
-																	goto l388
+																position909, tokenIndex909 := position, tokenIndex
+																if buffer[position] != rune('i') {
+																	goto l910
 																}
 																position++
-																goto l387
-															l388:
-																position, tokenIndex = position387, tokenIndex387
-																if buffer[position] != rune('L') {
-																	goto l306
+																goto l909
+															l910:
+																position, tokenIndex = position909, tokenIndex909
+																if buffer[position] != rune('I') {
+																	goto l902
 																}
 																position++
 															}
-														l387:
-															if !_rules[rulews]() {
-																goto l306
-															}
-															if !_rules[ruleLoc8]() {
-																goto l306
-															}
-															{
-																position389, tokenIndex389 := position, tokenIndex
-																if !_rules[rulesep]() {
-																	goto l389
-																}
-																if !_rules[ruleCopy8]() {
-																	goto l389
-																}
-																goto l390
-															l389:
-																position, tokenIndex = position389, tokenIndex389
-															}
-														l390:
-															{
-																add(ruleAction58, position)
-															}
-															add(ruleSrl, position382)
+														l909:
+															add(rulePegText, position904)
+														}
+														{
+															add(ruleAction156, position)
 														}
+														add(ruleIni, position903)
 													}
-												l308:
-													add(ruleRot, position307)
-												}
-												goto l305
-											l306:
-												position, tokenIndex = position305, tokenIndex305
-												{
-													switch buffer[position] {
-													case 'S', 's':
+													goto l889
+												l902:
+													position, tokenIndex = position889, tokenIndex889
+													{
+														position913 := position
 														{
-															position393 := position
-															{
-																position394, tokenIndex394 := position, tokenIndex
-																if buffer[position] != rune('s') {
-																	goto l395
-																}
-																position++
-																goto l394
-															l395:
-																position, tokenIndex = position394, tokenIndex394
-																if buffer[position] != rune('S') {
-																	goto l303
-																}
-																position++
-															}
-														l394:
+															position914 := position
 															{
-																position396, tokenIndex396 := position, tokenIndex
-																if buffer[position] != rune('e') {
-																	goto l397
+																position915, tokenIndex915 := position, tokenIndex
+																if buffer[position] != rune('o') {
+																	goto l916
 																}
 																position++
-																goto l396
-															l397:
-																position, tokenIndex = position396, tokenIndex396
-																if buffer[position] != rune('E') {
-																	goto l303
+																goto l915
+															l916:
+																position, tokenIndex = position915, tokenIndex915
+																if buffer[position] != rune('O') {
+																	goto l912
 																}
 																position++
 															}
-														l396:
+														l915:
 															{
-																position398, tokenIndex398 := position, tokenIndex
+																position917, tokenIndex917 := position, tokenIndex
 																if buffer[position] != rune('t') {
-																	goto l399
+																	goto l918
 																}
 																position++
-																goto l398
-															l399:
-																position, tokenIndex = position398, tokenIndex398
+																goto l917
+															l918:
+																position, tokenIndex = position917, tokenIndex917
 																if buffer[position] != rune('T') {
-																	goto l303
-																}
-																position++
-															}
-														l398:
-															if !_rules[rulews]() {
-																goto l303
-															}
-															if !_rules[ruleoctaldigit]() {
-																goto l303
-															}
-															if !_rules[rulesep]() {
-																goto l303
-															}
-															if !_rules[ruleLoc8]() {
-																goto l303
-															}
-															{
-																position400, tokenIndex400 := position, tokenIndex
-																if !_rules[rulesep]() {
-																	goto l400
-																}
-																if !_rules[ruleCopy8]() {
-																	goto l400
-																}
-																goto l401
-															l400:
-																position, tokenIndex = position400, tokenIndex400
-															}
-														l401:
-															{
-																add(ruleAction61, position)
-															}
-															add(ruleSet, position393)
-														}
-														break
-													case 'R', 'r':
-														{
-															position403 := position
-															{
-																position404, tokenIndex404 := position, tokenIndex
-																if buffer[position] != rune('r') {
-																	goto l405
-																}
-																position++
-																goto l404
-															l405:
-																position, tokenIndex = position404, tokenIndex404
-																if buffer[position] != rune('R') {
-																	goto l303
-																}
-																position++
-															}
-														l404:
-															{
-																position406, tokenIndex406 := position, tokenIndex
-																if buffer[position] != rune('e') {
-																	goto l407
-																}
-																position++
-																goto l406
-															l407:
-																position, tokenIndex = position406, tokenIndex406
-																if buffer[position] != rune('E') {
-																	goto l303
-																}
-																position++
-															}
-														l406:
-															{
-																position408, tokenIndex408 := position, tokenIndex
-																if buffer[position] != rune('s') {
-																	goto l409
-																}
-																position++
-																goto l408
-															l409:
-																position, tokenIndex = position408, tokenIndex408
-																if buffer[position] != rune('S') {
-																	goto l303
-																}
-																position++
-															}
-														l408:
-															if !_rules[rulews]() {
-																goto l303
-															}
-															if !_rules[ruleoctaldigit]() {
-																goto l303
-															}
-															if !_rules[rulesep]() {
-																goto l303
-															}
-															if !_rules[ruleLoc8]() {
-																goto l303
-															}
-															{
-																position410, tokenIndex410 := position, tokenIndex
-																if !_rules[rulesep]() {
-																	goto l410
-																}
-																if !_rules[ruleCopy8]() {
-																	goto l410
-																}
-																goto l411
-															l410:
-																position, tokenIndex = position410, tokenIndex410
-															}
-														l411:
-															{
-																add(ruleAction60, position)
-															}
-															add(ruleRes, position403)
-														}
-														break
-													default:
-														{
-															position413 := position
-															{
-																position414, tokenIndex414 := position, tokenIndex
-																if buffer[position] != rune('b') {
-																	goto l415
-																}
-																position++
-																goto l414
-															l415:
-																position, tokenIndex = position414, tokenIndex414
-																if buffer[position] != rune('B') {
-																	goto l303
+																	goto l912
 																}
 																position++
 															}
-														l414:
+														l917:
 															{
-																position416, tokenIndex416 := position, tokenIndex
+																position919, tokenIndex919 := position, tokenIndex
 																if buffer[position] != rune('i') {
-																	goto l417
+																	goto l920
 																}
 																position++
-																goto l416
-															l417:
-																position, tokenIndex = position416, tokenIndex416
+																goto l919
+															l920:
+																position, tokenIndex = position919, tokenIndex919
 																if buffer[position] != rune('I') {
-																	goto l303
-																}
-																position++
-															}
-														l416:
-															{
-																position418, tokenIndex418 := position, tokenIndex
-																if buffer[position] != rune('t') {
-																	goto l419
-																}
-																position++
-																goto l418
-															l419:
-																position, tokenIndex = position418, tokenIndex418
-																if buffer[position] != rune('T') {
-																	goto l303
+																	goto l912
 																}
-																position++
-															}
-														l418:
-															if !_rules[rulews]() {
-																goto l303
-															}
-															if !_rules[ruleoctaldigit]() {
-																goto l303
-															}
-															if !_rules[rulesep]() {
-																goto l303
-															}
-															if !_rules[ruleLoc8]() {
-																goto l303
-															}
-															{
-																add(ruleAction59, position)
-															}
-															add(ruleBit, position413)
-														}
-														break
-													}
-												}
-
-											}
-										l305:
-											add(ruleBitOp, position304)
-										}
-										goto l89
-									l303:
-										position, tokenIndex = position89, tokenIndex89
-										{
-											position422 := position
-											{
-												position423, tokenIndex423 := position, tokenIndex
-												{
-													position425 := position
-													{
-														position426 := position
-														{
-															position427, tokenIndex427 := position, tokenIndex
-															if buffer[position] != rune('r') {
-																goto l428
-															}
-															position++
-															goto l427
-														l428:
-															position, tokenIndex = position427, tokenIndex427
-															if buffer[position] != rune('R') {
-																goto l424
-															}
-															position++
-														}
-													l427:
-														{
-															position429, tokenIndex429 := position, tokenIndex
-															if buffer[position] != rune('e') {
-																goto l430
-															}
-															position++
-															goto l429
-														l430:
-															position, tokenIndex = position429, tokenIndex429
-															if buffer[position] != rune('E') {
-																goto l424
-															}
-															position++
-														}
-													l429:
-														{
-															position431, tokenIndex431 := position, tokenIndex
-															if buffer[position] != rune('t') {
-																goto l432
-															}
-															position++
-															goto l431
-														l432:
-															position, tokenIndex = position431, tokenIndex431
-															if buffer[position] != rune('T') {
-																goto l424
-															}
-															position++
-														}
-													l431:
-														{
-															position433, tokenIndex433 := position, tokenIndex
-															if buffer[position] != rune('n') {
-																goto l434
-															}
-															position++
-															goto l433
-														l434:
-															position, tokenIndex = position433, tokenIndex433
-															if buffer[position] != rune('N') {
-																goto l424
-															}
-															position++
-														}
-													l433:
-														add(rulePegText, position426)
-													}
-													{
-														add(ruleAction76, position)
-													}
-													add(ruleRetn, position425)
-												}
-												goto l423
-											l424:
-												position, tokenIndex = position423, tokenIndex423
-												{
-													position437 := position
-													{
-														position438 := position
-														{
-															position439, tokenIndex439 := position, tokenIndex
-															if buffer[position] != rune('r') {
-																goto l440
-															}
-															position++
-															goto l439
-														l440:
-															position, tokenIndex = position439, tokenIndex439
-															if buffer[position] != rune('R') {
-																goto l436
-															}
-															position++
-														}
-													l439:
-														{
-															position441, tokenIndex441 := position, tokenIndex
-															if buffer[position] != rune('e') {
-																goto l442
-															}
-															position++
-															goto l441
-														l442:
-															position, tokenIndex = position441, tokenIndex441
-															if buffer[position] != rune('E') {
-																goto l436
-															}
-															position++
-														}
-													l441:
-														{
-															position443, tokenIndex443 := position, tokenIndex
-															if buffer[position] != rune('t') {
-																goto l444
-															}
-															position++
-															goto l443
-														l444:
-															position, tokenIndex = position443, tokenIndex443
-															if buffer[position] != rune('T') {
-																goto l436
-															}
-															position++
-														}
-													l443:
-														{
-															position445, tokenIndex445 := position, tokenIndex
-															if buffer[position] != rune('i') {
-																goto l446
-															}
-															position++
-															goto l445
-														l446:
-															position, tokenIndex = position445, tokenIndex445
-															if buffer[position] != rune('I') {
-																goto l436
-															}
-															position++
-														}
-													l445:
-														add(rulePegText, position438)
-													}
-													{
-														add(ruleAction77, position)
-													}
-													add(ruleReti, position437)
-												}
-												goto l423
-											l436:
-												position, tokenIndex = position423, tokenIndex423
-												{
-													position449 := position
-													{
-														position450 := position
-														{
-															position451, tokenIndex451 := position, tokenIndex
-															if buffer[position] != rune('r') {
-																goto l452
-															}
-															position++
-															goto l451
-														l452:
-															position, tokenIndex = position451, tokenIndex451
-															if buffer[position] != rune('R') {
-																goto l448
-															}
-															position++
-														}
-													l451:
-														{
-															position453, tokenIndex453 := position, tokenIndex
-															if buffer[position] != rune('r') {
-																goto l454
+																position++
 															}
-															position++
-															goto l453
-														l454:
-															position, tokenIndex = position453, tokenIndex453
-															if buffer[position] != rune('R') {
-																goto l448
+														l919:
+															{
+																position921, tokenIndex921 := position, tokenIndex
+																if buffer[position] != rune('r') {
+																	goto l922
+																}
+																position++
+																goto l921
+															l922:
+																position, tokenIndex = position921, tokenIndex921
+																if buffer[position] != rune('R') {
+																	goto l912
+																}
+																position++
 															}
-															position++
+														l921:
+															add(rulePegText, position914)
 														}
-													l453:
 														{
-															position455, tokenIndex455 := position, tokenIndex
-															if buffer[position] != rune('d') {
-																goto l456
-															}
-															position++
-															goto l455
-														l456:
-															position, tokenIndex = position455, tokenIndex455
-															if buffer[position] != rune('D') {
-																goto l448
-															}
-															position++
+															add(ruleAction165, position)
 														}
-													l455:
-														add(rulePegText, position450)
+														add(ruleOtir, position913)
 													}
+													goto l889
+												l912:
+													position, tokenIndex = position889, tokenIndex889
 													{
-														add(ruleAction78, position)
-													}
-													add(ruleRrd, position449)
-												}
-												goto l423
-											l448:
-												position, tokenIndex = position423, tokenIndex423
-												{
-													position459 := position
-													{
-														position460 := position
+														position925 := position
 														{
-															position461, tokenIndex461 := position, tokenIndex
-															if buffer[position] != rune('i') {
-																goto l462
+															position926 := position
+															{
+																position927, tokenIndex927 := position, tokenIndex
+																if buffer[position] != rune('o') {
+																	goto l928
+																}
+																position++
+																goto l927
+															l928:
+																position, tokenIndex = position927, tokenIndex927
+																if buffer[position] != rune('O') {
+																	goto l924
+																}
+																position++
 															}
-															position++
-															goto l461
-														l462:
-															position, tokenIndex = position461, tokenIndex461
-															if buffer[position] != rune('I') {
-																goto l458
+														l927:
+															{
+																position929, tokenIndex929 := position, tokenIndex
+																if buffer[position] != rune('u') {
+																	goto l930
+																}
+																position++
+																goto l929
+															l930:
+																position, tokenIndex = position929, tokenIndex929
+																if buffer[position] != rune('U') {
+																	goto l924
+																}
+																position++
 															}
-															position++
-														}
-													l461:
-														{
-															position463, tokenIndex463 := position, tokenIndex
-															if buffer[position] != rune('m') {
-																goto l464
+														l929:
+															{
+																position931, tokenIndex931 := position, tokenIndex
+																if buffer[position] != rune('t') {
+																	goto l932
+																}
+																position++
+																goto l931
+															l932:
+																position, tokenIndex = position931, tokenIndex931
+																if buffer[position] != rune('T') {
+																	goto l924
+																}
+																position++
 															}
-															position++
-															goto l463
-														l464:
-															position, tokenIndex = position463, tokenIndex463
-															if buffer[position] != rune('M') {
-																goto l458
+														l931:
+															{
+																position933, tokenIndex933 := position, tokenIndex
+																if buffer[position] != rune('i') {
+																	goto l934
+																}
+																position++
+																goto l933
+															l934:
+																position, tokenIndex = position933, tokenIndex933
+																if buffer[position] != rune('I') {
+																	goto l924
+																}
+																position++
 															}
-															position++
-														}
-													l463:
-														if buffer[position] != rune(' ') {
-															goto l458
+														l933:
+															add(rulePegText, position926)
 														}
-														position++
-														if buffer[position] != rune('0') {
-															goto l458
+														{
+															add(ruleAction157, position)
 														}
-														position++
-														add(rulePegText, position460)
+														add(ruleOuti, position925)
 													}
+													goto l889
+												l924:
+													position, tokenIndex = position889, tokenIndex889
 													{
-														add(ruleAction80, position)
-													}
-													add(ruleIm0, position459)
-												}
-												goto l423
-											l458:
-												position, tokenIndex = position423, tokenIndex423
-												{
-													position467 := position
-													{
-														position468 := position
+														position937 := position
 														{
-															position469, tokenIndex469 := position, tokenIndex
-															if buffer[position] != rune('i') {
-																goto l470
+															position938 := position
+															{
+																position939, tokenIndex939 := position, tokenIndex
+																if buffer[position] != rune('i') {
+																	goto l940
+																}
+																position++
+																goto l939
+															l940:
+																position, tokenIndex = position939, tokenIndex939
+																if buffer[position] != rune('I') {
+																	goto l936
+																}
+																position++
 															}
-															position++
-															goto l469
-														l470:
-															position, tokenIndex = position469, tokenIndex469
-															if buffer[position] != rune('I') {
-																goto l466
+														l939:
+															{
+																position941, tokenIndex941 := position, tokenIndex
+																if buffer[position] != rune('n') {
+																	goto l942
+																}
+																position++
+																goto l941
+															l942:
+																position, tokenIndex = position941, tokenIndex941
+																if buffer[position] != rune('N') {
+																	goto l936
+																}
+																position++
 															}
-															position++
-														}
-													l469:
-														{
-															position471, tokenIndex471 := position, tokenIndex
-															if buffer[position] != rune('m') {
-																goto l472
+														l941:
+															{
+																position943, tokenIndex943 := position, tokenIndex
+																if buffer[position] != rune('d') {
+																	goto l944
+																}
+																position++
+																goto l943
+															l944:
+																position, tokenIndex = position943, tokenIndex943
+																if buffer[position] != rune('D') {
+																	goto l936
+																}
+																position++
 															}
-															position++
-															goto l471
-														l472:
-															position, tokenIndex = position471, tokenIndex471
-															if buffer[position] != rune('M') {
-																goto l466
+														l943:
+															{
+																position945, tokenIndex945 := position, tokenIndex
+																if buffer[position] != rune('r') {
+																	goto l946
+																}
+																position++
+																goto l945
+															l946:
+																position, tokenIndex = position945, tokenIndex945
+																if buffer[position] != rune('R') {
+																	goto l936
+																}
+																position++
 															}
-															position++
-														}
-													l471:
-														if buffer[position] != rune(' ') {
-															goto l466
+														l945:
+															add(rulePegText, position938)
 														}
-														position++
-														if buffer[position] != rune('1') {
-															goto l466
+														{
+															add(ruleAction168, position)
 														}
-														position++
-														add(rulePegText, position468)
-													}
-													{
-														add(ruleAction81, position)
+														add(ruleIndr, position937)
 													}
-													add(ruleIm1, position467)
-												}
-												goto l423
-											l466:
-												position, tokenIndex = position423, tokenIndex423
-												{
-													position475 := position
+													goto l889
+												l936:
+													position, tokenIndex = position889, tokenIndex889
 													{
-														position476 := position
+														position949 := position
 														{
-															position477, tokenIndex477 := position, tokenIndex
-															if buffer[position] != rune('i') {
-																goto l478
-															}
-															position++
-															goto l477
-														l478:
-															position, tokenIndex = position477, tokenIndex477
-															if buffer[position] != rune('I') {
-																goto l474
+															position950 := position
+															{
+																position951, tokenIndex951 := position, tokenIndex
+																if buffer[position] != rune('i') {
+																	goto l952
+																}
+																position++
+																goto l951
+															l952:
+																position, tokenIndex = position951, tokenIndex951
+																if buffer[position] != rune('I') {
+																	goto l948
+																}
+																position++
 															}
-															position++
-														}
-													l477:
-														{
-															position479, tokenIndex479 := position, tokenIndex
-															if buffer[position] != rune('m') {
-																goto l480
+														l951:
+															{
+																position953, tokenIndex953 := position, tokenIndex
+																if buffer[position] != rune('n') {
+																	goto l954
+																}
+																position++
+																goto l953
+															l954:
+																position, tokenIndex = position953, tokenIndex953
+																if buffer[position] != rune('N') {
+																	goto l948
+																}
+																position++
 															}
-															position++
-															goto l479
-														l480:
-															position, tokenIndex = position479, tokenIndex479
-															if buffer[position] != rune('M') {
-																goto l474
+														l953:
+															{
+																position955, tokenIndex955 := position, tokenIndex
+																if buffer[position] != rune('d') {
+																	goto l956
+																}
+																position++
+																goto l955
+															l956:
+																position, tokenIndex = position955, tokenIndex955
+																if buffer[position] != rune('D') {
+																	goto l948
+																}
+																position++
 															}
-															position++
+														l955:
+															add(rulePegText, position950)
 														}
-													l479:
-														if buffer[position] != rune(' ') {
-															goto l474
-														}
-														position++
-														if buffer[position] != rune('2') {
-															goto l474
+														{
+															add(ruleAction160, position)
 														}
-														position++
-														add(rulePegText, position476)
+														add(ruleInd, position949)
 													}
+													goto l889
+												l948:
+													position, tokenIndex = position889, tokenIndex889
 													{
-														add(ruleAction82, position)
-													}
-													add(ruleIm2, position475)
-												}
-												goto l423
-											l474:
-												position, tokenIndex = position423, tokenIndex423
-												{
-													switch buffer[position] {
-													case 'I', 'O', 'i', 'o':
+														position959 := position
 														{
-															position483 := position
+															position960 := position
 															{
-																position484, tokenIndex484 := position, tokenIndex
-																{
-																	position486 := position
-																	{
-																		position487 := position
-																		{
-																			position488, tokenIndex488 := position, tokenIndex
-																			if buffer[position] != rune('i') {
-																				goto l489
-																			}
-																			position++
-																			goto l488
-																		l489:
-																			position, tokenIndex = position488, tokenIndex488
-																			if buffer[position] != rune('I') {
-																				goto l485
-																			}
-																			position++
-																		}
-																	l488:
-																		{
-																			position490, tokenIndex490 := position, tokenIndex
-																			if buffer[position] != rune('n') {
-																				goto l491
-																			}
-																			position++
-																			goto l490
-																		l491:
-																			position, tokenIndex = position490, tokenIndex490
-																			if buffer[position] != rune('N') {
-																				goto l485
-																			}
-																			position++
-																		}
-																	l490:
-																		{
-																			position492, tokenIndex492 := position, tokenIndex
-																			if buffer[position] != rune('i') {
-																				goto l493
-																			}
-																			position++
-																			goto l492
-																		l493:
-																			position, tokenIndex = position492, tokenIndex492
-																			if buffer[position] != rune('I') {
-																				goto l485
-																			}
-																			position++
-																		}
-																	l492:
-																		{
-																			position494, tokenIndex494 := position, tokenIndex
-																			if buffer[position] != rune('r') {
-																				goto l495
-																			}
-																			position++
-																			goto l494
-																		l495:
-																			position, tokenIndex = position494, tokenIndex494
-																			if buffer[position] != rune('R') {
-																				goto l485
-																			}
-																			position++
-																		}
-																	l494:
-																		add(rulePegText, position487)
-																	}
-																	{
-																		add(ruleAction93, position)
-																	}
-																	add(ruleInir, position486)
-																}
-																goto l484
-															l485:
-																position, tokenIndex = position484, tokenIndex484
-																{
-																	position498 := position
-																	{
-																		position499 := position
-																		{
-																			position500, tokenIndex500 := position, tokenIndex
-																			if buffer[position] != rune('i') {
-																				goto l501
-																			}
-																			position++
-																			goto l500
-																		l501:
-																			position, tokenIndex = position500, tokenIndex500
-																			if buffer[position] != rune('I') {
-																				goto l497
-																			}
-																			position++
-																		}
-																	l500:
-																		{
-																			position502, tokenIndex502 := position, tokenIndex
-																			if buffer[position] != rune('n') {
-																				goto l503
-																			}
-																			position++
-																			goto l502
-																		l503:
-																			position, tokenIndex = position502, tokenIndex502
-																			if buffer[position] != rune('N') {
-																				goto l497
-																			}
-																			position++
-																		}
-																	l502:
-																		{
-																			position504, tokenIndex504 := position, tokenIndex
-																			if buffer[position] != rune('i') {
-																				goto l505
-																			}
-																			position++
-																			goto l504
-																		l505:
-																			position, tokenIndex = position504, tokenIndex504
-																			if buffer[position] != rune('I') {
-																				goto l497
-																			}
-																			position++
-																		}
-																	l504:
-																		add(rulePegText, position499)
-																	}
-																	{
-																		add(ruleAction85, position)
-																	}
-																	add(ruleIni, position498)
-																}
-																goto l484
-															l497:
-																position, tokenIndex = position484, tokenIndex484
-																{
-																	position508 := position
-																	{
-																		position509 := position
-																		{
-																			position510, tokenIndex510 := position, tokenIndex
-																			if buffer[position] != rune('o') {
-																				goto l511
-																			}
-																			position++
-																			goto l510
-																		l511:
-																			position, tokenIndex = position510, tokenIndex510
-																			if buffer[position] != rune('O') {
-																				goto l507
-																			}
-																			position++
-																		}
-																	l510:
-																		{
-																			position512, tokenIndex512 := position, tokenIndex
-																			if buffer[position] != rune('t') {
-																				goto l513
-																			}
-																			position++
-																			goto l512
-																		l513:
-																			position, tokenIndex = position512, tokenIndex512
-																			if buffer[position] != rune('T') {
-																				goto l507
-																			}
-																			position++
-																		}
-																	l512:
-																		{
-																			position514, tokenIndex514 := position, tokenIndex
-																			if buffer[position] != rune('i') {
-																				goto l515
-																			}
-																			position++
-																			goto l514
-																		l515:
-																			position, tokenIndex = position514, tokenIndex514
-																			if buffer[position] != rune('I') {
-																				goto l507
-																			}
-																			position++
-																		}
-																	l514:
-																		{
-																			position516, tokenIndex516 := position, tokenIndex
-																			if buffer[position] != rune('r') {
-																				goto l517
-																			}
-																			position++
-																			goto l516
-																		l517:
-																			position, tokenIndex = position516, tokenIndex516
-																			if buffer[position] != rune('R') {
-																				goto l507
-																			}
-																			position++
-																		}
-																	l516:
-																		add(rulePegText, position509)
-																	}
-																	{
-																		add(ruleAction94, position)
-																	}
-																	add(ruleOtir, position508)
-																}
-																goto l484
-															l507:
-																position, tokenIndex = position484, tokenIndex484
-																{
-																	position520 := position
-																	{
-																		position521 := position
-																		{
-																			position522, tokenIndex522 := position, tokenIndex
-																			if buffer[position] != rune('o') {
-																				goto l523
-																			}
-																			position++
-																			goto l522
-																		l523:
-																			position, tokenIndex = position522, tokenIndex522
-																			if buffer[position] != rune('O') {
-																				goto l519
-																			}
-																			position++
-																		}
-																	l522:
-																		{
-																			position524, tokenIndex524 := position, tokenIndex
-																			if buffer[position] != rune('u') {
-																				goto l525
-																			}
-																			position++
-																			goto l524
-																		l525:
-																			position, tokenIndex = position524, tokenIndex524
-																			if buffer[position] != rune('U') {
-																				goto l519
-																			}
-																			position++
-																		}
-																	l524:
-																		{
-																			position526, tokenIndex526 := position, tokenIndex
-																			if buffer[position] != rune('t') {
-																				goto l527
-																			}
-																			position++
-																			goto l526
-																		l527:
-																			position, tokenIndex = position526, tokenIndex526
-																			if buffer[position] != rune('T') {
-																				goto l519
-																			}
-																			position++
-																		}
-																	l526:
-																		{
-																			position528, tokenIndex528 := position, tokenIndex
-																			if buffer[position] != rune('i') {
-																				goto l529
-																			}
-																			position++
-																			goto l528
-																		l529:
-																			position, tokenIndex = position528, tokenIndex528
-																			if buffer[position] != rune('I') {
-																				goto l519
-																			}
-																			position++
-																		}
-																	l528:
-																		add(rulePegText, position521)
-																	}
-																	{
-																		add(ruleAction86, position)
-																	}
-																	add(ruleOuti, position520)
-																}
-																goto l484
-															l519:
-																position, tokenIndex = position484, tokenIndex484
-																{
-																	position532 := position
-																	{
-																		position533 := position
-																		{
-																			position534, tokenIndex534 := position, tokenIndex
-																			if buffer[position] != rune('i') {
-																				goto l535
-																			}
-																			position++
-																			goto l534
-																		l535:
-																			position, tokenIndex = position534, tokenIndex534
-																			if buffer[position] != rune('I') {
-																				goto l531
-																			}
-																			position++
-																		}
-																	l534:
-																		{
-																			position536, tokenIndex536 := position, tokenIndex
-																			if buffer[position] != rune('n') {
-																				goto l537
-																			}
-																			position++
-																			goto l536
-																		l537:
-																			position, tokenIndex = position536, tokenIndex536
-																			if buffer[position] != rune('N') {
-																				goto l531
-																			}
-																			position++
-																		}
-																	l536:
-																		{
-																			position538, tokenIndex538 := position, tokenIndex
-																			if buffer[position] != rune('d') {
-																				goto l539
-																			}
-																			position++
-																			goto l538
-																		l539:
-																			position, tokenIndex = position538, tokenIndex538
-																			if buffer[position] != rune('D') {
-																				goto l531
-																			}
-																			position++
-																		}
-																	l538:
-																		{
-																			position540, tokenIndex540 := position, tokenIndex
-																			if buffer[position] != rune('r') {
-																				goto l541
-																			}
-																			position++
-																			goto l540
-																		l541:
-																			position, tokenIndex = position540, tokenIndex540
-																			if buffer[position] != rune('R') {
-																				goto l531
-																			}
-																			position++
-																		}
-																	l540:
-																		add(rulePegText, position533)
-																	}
-																	{
-																		add(ruleAction97, position)
-																	}
-																	add(ruleIndr, position532)
-																}
-																goto l484
-															l531:
-																position, tokenIndex = position484, tokenIndex484
-																{
-																	position544 := position
-																	{
-																		position545 := position
-																		{
-																			position546, tokenIndex546 := position, tokenIndex
-																			if buffer[position] != rune('i') {
-																				goto l547
-																			}
-																			position++
-																			goto l546
-																		l547:
-																			position, tokenIndex = position546, tokenIndex546
-																			if buffer[position] != rune('I') {
-																				goto l543
-																			}
-																			position++
-																		}
-																	l546:
-																		{
-																			position548, tokenIndex548 := position, tokenIndex
-																			if buffer[position] != rune('n') {
-																				goto l549
-																			}
-																			position++
-																			goto l548
-																		l549:
-																			position, tokenIndex = position548, tokenIndex548
-																			if buffer[position] != rune('N') {
-																				goto l543
-																			}
-																			position++
-																		}
-																	l548:
-																		{
-																			position550, tokenIndex550 := position, tokenIndex
-																			if buffer[position] != rune('d') {
-																				goto l551
-																			}
-																			position++
-																			goto l550
-																		l551:
-																			position, tokenIndex = position550, tokenIndex550
-																			if buffer[position] != rune('D') {
-																				goto l543
-																			}
-																			position++
-																		}
-																	l550:
-																		add(rulePegText, position545)
-																	}
-																	{
-																		add(ruleAction89, position)
-																	}
-																	add(ruleInd, position544)
-																}
-																goto l484
-															l543:
-																position, tokenIndex = position484, tokenIndex484
-																{
-																	position554 := position
-																	{
-																		position555 := position
-																		{
-																			position556, tokenIndex556 := position, tokenIndex
-																			if buffer[position] != rune('o') {
-																				goto l557
-																			}
-																			position++
-																			goto l556
-																		l557:
-																			position, tokenIndex = position556, tokenIndex556
-																			if buffer[position] != rune('O') {
-																				goto l553
-																			}
-																			position++
-																		}
-																	l556:
-																		{
-																			position558, tokenIndex558 := position, tokenIndex
-																			if buffer[position] != rune('t') {
-																				goto l559
-																			}
-																			position++
-																			goto l558
-																		l559:
-																			position, tokenIndex = position558, tokenIndex558
-																			if buffer[position] != rune('T') {
-																				goto l553
-																			}
-																			position++
-																		}
-																	l558:
-																		{
-																			position560, tokenIndex560 := position, tokenIndex
-																			if buffer[position] != rune('d') {
-																				goto l561
-																			}
-																			position++
-																			goto l560
-																		l561:
-																			position, tokenIndex = position560, tokenIndex560
-																			if buffer[position] != rune('D') {
-																				goto l553
-																			}
-																			position++
-																		}
-																	l560:
-																		{
-																			position562, tokenIndex562 := position, tokenIndex
-																			if buffer[position] != rune('r') {
-																				goto l563
-																			}
-																			position++
-																			goto l562
-																		l563:
-																			position, tokenIndex = position562, tokenIndex562
-																			if buffer[position] != rune('R') {
-																				goto l553
-																			}
-																			position++
-																		}
-																	l562:
-																		add(rulePegText, position555)
-																	}
-																	{
-																		add(ruleAction98, position)
-																	}
-																	add(ruleOtdr, position554)
-																}
-																goto l484
-															l553:
-																position, tokenIndex = position484, tokenIndex484
-																{
-																	position565 := position
-																	{
-																		position566 := position
-																		{
-																			position567, tokenIndex567 := position, tokenIndex
-																			if buffer[position] != rune('o') {
-																				goto l568
-																			}
-																			position++
-																			goto l567
-																		l568:
-																			position, tokenIndex = position567, tokenIndex567
-																			if buffer[position] != rune('O') {
-																				goto l421
-																			}
-																			position++
-																		}
-																	l567:
-																		{
-																			position569, tokenIndex569 := position, tokenIndex
-																			if buffer[position] != rune('u') {
-																				goto l570
-																			}
-																			position++
-																			goto l569
-																		l570:
-																			position, tokenIndex = position569, tokenIndex569
-																			if buffer[position] != rune('U') {
-																				goto l421
-																			}
-																			position++
-																		}
-																	l569:
-																		{
-																			position571, tokenIndex571 := position, tokenIndex
-																			if buffer[position] != rune('t') {
-																				goto l572
-																			}
-																			position++
-																			goto l571
-																		l572:
-																			position, tokenIndex = position571, tokenIndex571
-																			if buffer[position] != rune('T') {
-																				goto l421
-																			}
-																			position++
-																		}
-																	l571:
-																		{
-																			position573, tokenIndex573 := position, tokenIndex
-																			if buffer[position] != rune('d') {
-																				goto l574
-																			}
-																			position++
-																			goto l573
-																		l574:
-																			position, tokenIndex = position573, tokenIndex573
-																			if buffer[position] != rune('D') {
-																				goto l421
-																			}
-																			position++
-																		}
-																	l573:
-																		add(rulePegText, position566)
-																	}
-																	{
-																		add(ruleAction90, position)
-																	}
-																	add(ruleOutd, position565)
+																position961, tokenIndex961 := position, tokenIndex
+																if buffer[position] != rune('o') {
+																	goto l962
+																}
+																position++
+																goto l961
+															l962:
+																position, tokenIndex = position961, tokenIndex961
+																if buffer[position] != rune('O') {
+																	goto l958
 																}
+																position++
 															}
-														l484:
-															add(ruleBlitIO, position483)
-														}
-														break
-													case 'R', 'r':
-														{
-															position576 := position
+														l961:
+															{
+																position963, tokenIndex963 := position, tokenIndex
+																if buffer[position] != rune('t') {
+																	goto l964
+																}
+																position++
+																goto l963
+															l964:
+																position, tokenIndex = position963, tokenIndex963
+																if buffer[position] != rune('T') {
+																	goto l958
+																}
+																position++
+															}
+														l963:
 															{
-																position577 := position
-																{
-																	position578, tokenIndex578 := position, tokenIndex
-																	if buffer[position] != rune('r') {
-																		goto l579
-																	}
-																	position++
-																	goto l578
-																l579:
-																	position, tokenIndex = position578, tokenIndex578
-																	if buffer[position] != rune('R') {
-																		goto l421
-																	}
-																	position++
-																}
-															l578:
-																{
-																	position580, tokenIndex580 := position, tokenIndex
-																	if buffer[position] != rune('l') {
-																		goto l581
-																	}
-																	position++
-																	goto l580
-																l581:
-																	position, tokenIndex = position580, tokenIndex580
-																	if buffer[position] != rune('L') {
-																		goto l421
-																	}
-																	position++
-																}
-															l580:
-																{
-																	position582, tokenIndex582 := position, tokenIndex
-																	if buffer[position] != rune('d') {
-																		goto l583
-																	}
-																	position++
-																	goto l582
-																l583:
-																	position, tokenIndex = position582, tokenIndex582
-																	if buffer[position] != rune('D') {
-																		goto l421
-																	}
-																	position++
-																}
-															l582:
-																add(rulePegText, position577)
+																position965, tokenIndex965 := position, tokenIndex
+																if buffer[position] != rune('d') {
+																	goto l966
+																}
+																position++
+																goto l965
+															l966:
+																position, tokenIndex = position965, tokenIndex965
+																if buffer[position] != rune('D') {
+																	goto l958
+																}
+																position++
 															}
+														l965:
 															{
-																add(ruleAction79, position)
+																position967, tokenIndex967 := position, tokenIndex
+																if buffer[position] != rune('r') {
+																	goto l968
+																}
+																position++
+																goto l967
+															l968:
+																position, tokenIndex = position967, tokenIndex967
+																if buffer[position] != rune('R') {
+																	goto l958
+																}
+																position++
 															}
-															add(ruleRld, position576)
+														l967:
+															add(rulePegText, position960)
 														}
-														break
-													case 'N', 'n':
 														{
-															position585 := position
+															add(ruleAction169, position)
+														}
+														add(ruleOtdr, position959)
+													}
+													goto l889
+												l958:
+													position, tokenIndex = position889, tokenIndex889
+													{
+														position970 := position
+														{
+															position971 := position
+															{
+																position972, tokenIndex972 := position, tokenIndex
+																if buffer[position] != rune('o') {
+																	goto l973
+																}
+																position++
+																goto l972
+															l973:
+																position, tokenIndex = position972, tokenIndex972
+																if buffer[position] != rune('O') {
+																	goto l826
+																}
+																position++
+															}
+														l972:
 															{
-																position586 := position
-																{
-																	position587, tokenIndex587 := position, tokenIndex
-																	if buffer[position] != rune('n') {
-																		goto l588
-																	}
-																	position++
-																	goto l587
-																l588:
-																	position, tokenIndex = position587, tokenIndex587
-																	if buffer[position] != rune('N') {
-																		goto l421
-																	}
-																	position++
-																}
-															l587:
-																{
-																	position589, tokenIndex589 := position, tokenIndex
-																	if buffer[position] != rune('e') {
-																		goto l590
-																	}
-																	position++
-																	goto l589
-																l590:
-																	position, tokenIndex = position589, tokenIndex589
-																	if buffer[position] != rune('E') {
-																		goto l421
-																	}
-																	position++
-																}
-															l589:
-																{
-																	position591, tokenIndex591 := position, tokenIndex
-																	if buffer[position] != rune('g') {
-																		goto l592
-																	}
-																	position++
-																	goto l591
-																l592:
-																	position, tokenIndex = position591, tokenIndex591
-																	if buffer[position] != rune('G') {
-																		goto l421
-																	}
-																	position++
-																}
-															l591:
-																add(rulePegText, position586)
+																position974, tokenIndex974 := position, tokenIndex
+																if buffer[position] != rune('u') {
+																	goto l975
+																}
+																position++
+																goto l974
+															l975:
+																position, tokenIndex = position974, tokenIndex974
+																if buffer[position] != rune('U') {
+																	goto l826
+																}
+																position++
 															}
+														l974:
 															{
-																add(ruleAction75, position)
+																position976, tokenIndex976 := position, tokenIndex
+																if buffer[position] != rune('t') {
+																	goto l977
+																}
+																position++
+																goto l976
+															l977:
+																position, tokenIndex = position976, tokenIndex976
+																if buffer[position] != rune('T') {
+																	goto l826
+																}
+																position++
 															}
-															add(ruleNeg, position585)
-														}
-														break
-													default:
-														{
-															position594 := position
+														l976:
 															{
-																position595, tokenIndex595 := position, tokenIndex
-																{
-																	position597 := position
-																	{
-																		position598 := position
-																		{
-																			position599, tokenIndex599 := position, tokenIndex
-																			if buffer[position] != rune('l') {
-																				goto l600
-																			}
-																			position++
-																			goto l599
-																		l600:
-																			position, tokenIndex = position599, tokenIndex599
-																			if buffer[position] != rune('L') {
-																				goto l596
-																			}
-																			position++
-																		}
-																	l599:
-																		{
-																			position601, tokenIndex601 := position, tokenIndex
-																			if buffer[position] != rune('d') {
-																				goto l602
-																			}
-																			position++
-																			goto l601
-																		l602:
-																			position, tokenIndex = position601, tokenIndex601
-																			if buffer[position] != rune('D') {
-																				goto l596
-																			}
-																			position++
-																		}
-																	l601:
-																		{
-																			position603, tokenIndex603 := position, tokenIndex
-																			if buffer[position] != rune('i') {
-																				goto l604
-																			}
-																			position++
-																			goto l603
-																		l604:
-																			position, tokenIndex = position603, tokenIndex603
-																			if buffer[position] != rune('I') {
-																				goto l596
-																			}
-																			position++
-																		}
-																	l603:
-																		{
-																			position605, tokenIndex605 := position, tokenIndex
-																			if buffer[position] != rune('r') {
-																				goto l606
-																			}
-																			position++
-																			goto l605
-																		l606:
-																			position, tokenIndex = position605, tokenIndex605
-																			if buffer[position] != rune('R') {
-																				goto l596
-																			}
-																			position++
-																		}
-																	l605:
-																		add(rulePegText, position598)
-																	}
-																	{
-																		add(ruleAction91, position)
-																	}
-																	add(ruleLdir, position597)
-																}
-																goto l595
-															l596:
-																position, tokenIndex = position595, tokenIndex595
-																{
-																	position609 := position
-																	{
-																		position610 := position
-																		{
-																			position611, tokenIndex611 := position, tokenIndex
-																			if buffer[position] != rune('l') {
-																				goto l612
-																			}
-																			position++
-																			goto l611
-																		l612:
-																			position, tokenIndex = position611, tokenIndex611
-																			if buffer[position] != rune('L') {
-																				goto l608
-																			}
-																			position++
-																		}
-																	l611:
-																		{
-																			position613, tokenIndex613 := position, tokenIndex
-																			if buffer[position] != rune('d') {
-																				goto l614
-																			}
-																			position++
-																			goto l613
-																		l614:
-																			position, tokenIndex = position613, tokenIndex613
-																			if buffer[position] != rune('D') {
-																				goto l608
-																			}
-																			position++
-																		}
-																	l613:
-																		{
-																			position615, tokenIndex615 := position, tokenIndex
-																			if buffer[position] != rune('i') {
-																				goto l616
-																			}
-																			position++
-																			goto l615
-																		l616:
-																			position, tokenIndex = position615, tokenIndex615
-																			if buffer[position] != rune('I') {
-																				goto l608
-																			}
-																			position++
-																		}
-																	l615:
-																		add(rulePegText, position610)
-																	}
-																	{
-																		add(ruleAction83, position)
-																	}
-																	add(ruleLdi, position609)
-																}
-																goto l595
-															l608:
-																position, tokenIndex = position595, tokenIndex595
-																{
-																	position619 := position
-																	{
-																		position620 := position
-																		{
-																			position621, tokenIndex621 := position, tokenIndex
-																			if buffer[position] != rune('c') {
-																				goto l622
-																			}
-																			position++
-																			goto l621
-																		l622:
-																			position, tokenIndex = position621, tokenIndex621
-																			if buffer[position] != rune('C') {
-																				goto l618
-																			}
-																			position++
-																		}
-																	l621:
-																		{
-																			position623, tokenIndex623 := position, tokenIndex
-																			if buffer[position] != rune('p') {
-																				goto l624
-																			}
-																			position++
-																			goto l623
-																		l624:
-																			position, tokenIndex = position623, tokenIndex623
-																			if buffer[position] != rune('P') {
-																				goto l618
-																			}
-																			position++
-																		}
-																	l623:
-																		{
-																			position625, tokenIndex625 := position, tokenIndex
-																			if buffer[position] != rune('i') {
-																				goto l626
-																			}
-																			position++
-																			goto l625
-																		l626:
-																			position, tokenIndex = position625, tokenIndex625
-																			if buffer[position] != rune('I') {
-																				goto l618
-																			}
-																			position++
-																		}
-																	l625:
-																		{
-																			position627, tokenIndex627 := position, tokenIndex
-																			if buffer[position] != rune('r') {
-																				goto l628
-																			}
-																			position++
-																			goto l627
-																		l628:
-																			position, tokenIndex = position627, tokenIndex627
-																			if buffer[position] != rune('R') {
-																				goto l618
-																			}
-																			position++
-																		}
-																	l627:
-																		add(rulePegText, position620)
-																	}
-																	{
-																		add(ruleAction92, position)
-																	}
-																	add(ruleCpir, position619)
-																}
-																goto l595
-															l618:
-																position, tokenIndex = position595, tokenIndex595
-																{
-																	position631 := position
-																	{
-																		position632 := position
-																		{
-																			position633, tokenIndex633 := position, tokenIndex
-																			if buffer[position] != rune('c') {
-																				goto l634
-																			}
-																			position++
-																			goto l633
-																		l634:
-																			position, tokenIndex = position633, tokenIndex633
-																			if buffer[position] != rune('C') {
-																				goto l630
-																			}
-																			position++
-																		}
-																	l633:
-																		{
-																			position635, tokenIndex635 := position, tokenIndex
-																			if buffer[position] != rune('p') {
-																				goto l636
-																			}
-																			position++
-																			goto l635
-																		l636:
-																			position, tokenIndex = position635, tokenIndex635
-																			if buffer[position] != rune('P') {
-																				goto l630
-																			}
-																			position++
-																		}
-																	l635:
-																		{
-																			position637, tokenIndex637 := position, tokenIndex
-																			if buffer[position] != rune('i') {
-																				goto l638
-																			}
-																			position++
-																			goto l637
-																		l638:
-																			position, tokenIndex = position637, tokenIndex637
-																			if buffer[position] != rune('I') {
-																				goto l630
-																			}
-																			position++
-																		}
-																	l637:
-																		add(rulePegText, position632)
-																	}
-																	{
-																		add(ruleAction84, position)
-																	}
-																	add(ruleCpi, position631)
-																}
-																goto l595
-															l630:
-																position, tokenIndex = position595, tokenIndex595
-																{
-																	position641 := position
-																	{
-																		position642 := position
-																		{
-																			position643, tokenIndex643 := position, tokenIndex
-																			if buffer[position] != rune('l') {
-																				goto l644
-																			}
-																			position++
-																			goto l643
-																		l644:
-																			position, tokenIndex = position643, tokenIndex643
-																			if buffer[position] != rune('L') {
-																				goto l640
-																			}
-																			position++
-																		}
-																	l643:
-																		{
-																			position645, tokenIndex645 := position, tokenIndex
-																			if buffer[position] != rune('d') {
-																				goto l646
-																			}
-																			position++
-																			goto l645
-																		l646:
-																			position, tokenIndex = position645, tokenIndex645
-																			if buffer[position] != rune('D') {
-																				goto l640
-																			}
-																			position++
-																		}
-																	l645:
-																		{
-																			position647, tokenIndex647 := position, tokenIndex
-																			if buffer[position] != rune('d') {
-																				goto l648
-																			}
-																			position++
-																			goto l647
-																		l648:
-																			position, tokenIndex = position647, tokenIndex647
-																			if buffer[position] != rune('D') {
-																				goto l640
-																			}
-																			position++
-																		}
-																	l647:
-																		{
-																			position649, tokenIndex649 := position, tokenIndex
-																			if buffer[position] != rune('r') {
-																				goto l650
-																			}
-																			position++
-																			goto l649
-																		l650:
-																			position, tokenIndex = position649, tokenIndex649
-																			if buffer[position] != rune('R') {
-																				goto l640
-																			}
-																			position++
-																		}
-																	l649:
-																		add(rulePegText, position642)
-																	}
-																	{
-																		add(ruleAction95, position)
-																	}
-																	add(ruleLddr, position641)
-																}
-																goto l595
-															l640:
-																position, tokenIndex = position595, tokenIndex595
-																{
-																	position653 := position
-																	{
-																		position654 := position
-																		{
-																			position655, tokenIndex655 := position, tokenIndex
-																			if buffer[position] != rune('l') {
-																				goto l656
-																			}
-																			position++
-																			goto l655
-																		l656:
-																			position, tokenIndex = position655, tokenIndex655
-																			if buffer[position] != rune('L') {
-																				goto l652
-																			}
-																			position++
-																		}
-																	l655:
-																		{
-																			position657, tokenIndex657 := position, tokenIndex
-																			if buffer[position] != rune('d') {
-																				goto l658
-																			}
-																			position++
-																			goto l657
-																		l658:
-																			position, tokenIndex = position657, tokenIndex657
-																			if buffer[position] != rune('D') {
-																				goto l652
-																			}
-																			position++
-																		}
-																	l657:
-																		{
-																			position659, tokenIndex659 := position, tokenIndex
-																			if buffer[position] != rune('d') {
-																				goto l660
-																			}
-																			position++
-																			goto l659
-																		l660:
-																			position, tokenIndex = position659, tokenIndex659
-																			if buffer[position] != rune('D') {
-																				goto l652
-																			}
-																			position++
-																		}
-																	l659:
-																		add(rulePegText, position654)
-																	}
-																	{
-																		add(ruleAction87, position)
-																	}
-																	add(ruleLdd, position653)
-																}
-																goto l595
-															l652:
-																position, tokenIndex = position595, tokenIndex595
-																{
-																	position663 := position
-																	{
-																		position664 := position
-																		{
-																			position665, tokenIndex665 := position, tokenIndex
-																			if buffer[position] != rune('c') {
-																				goto l666
-																			}
-																			position++
-																			goto l665
-																		l666:
-																			position, tokenIndex = position665, tokenIndex665
-																			if buffer[position] != rune('C') {
-																				goto l662
-																			}
-																			position++
-																		}
-																	l665:
-																		{
-																			position667, tokenIndex667 := position, tokenIndex
-																			if buffer[position] != rune('p') {
-																				goto l668
-																			}
-																			position++
-																			goto l667
-																		l668:
-																			position, tokenIndex = position667, tokenIndex667
-																			if buffer[position] != rune('P') {
-																				goto l662
-																			}
-																			position++
-																		}
-																	l667:
-																		{
-																			position669, tokenIndex669 := position, tokenIndex
-																			if buffer[position] != rune('d') {
-																				goto l670
-																			}
-																			position++
-																			goto l669
-																		l670:
-																			position, tokenIndex = position669, tokenIndex669
-																			if buffer[position] != rune('D') {
-																				goto l662
-																			}
-																			position++
-																		}
-																	l669:
-																		{
-																			position671, tokenIndex671 := position, tokenIndex
-																			if buffer[position] != rune('r') {
-																				goto l672
-																			}
-																			position++
-																			goto l671
-																		l672:
-																			position, tokenIndex = position671, tokenIndex671
-																			if buffer[position] != rune('R') {
-																				goto l662
-																			}
-																			position++
-																		}
-																	l671:
-																		add(rulePegText, position664)
-																	}
-																	{
-																		add(ruleAction96, position)
-																	}
-																	add(ruleCpdr, position663)
-																}
-																goto l595
-															l662:
-																position, tokenIndex = position595, tokenIndex595
-																{
-																	position674 := position
-																	{
-																		position675 := position
-																		{
-																			position676, tokenIndex676 := position, tokenIndex
-																			if buffer[position] != rune('c') {
-																				goto l677
-																			}
-																			position++
-																			goto l676
-																		l677:
-																			position, tokenIndex = position676, tokenIndex676
-																			if buffer[position] != rune('C') {
-																				goto l421
-																			}
-																			position++
-																		}
-																	l676:
-																		{
-																			position678, tokenIndex678 := position, tokenIndex
-																			if buffer[position] != rune('p') {
-																				goto l679
-																			}
-																			position++
-																			goto l678
-																		l679:
-																			position, tokenIndex = position678, tokenIndex678
-																			if buffer[position] != rune('P') {
-																				goto l421
-																			}
-																			position++
-																		}
-																	l678:
-																		{
-																			position680, tokenIndex680 := position, tokenIndex
-																			if buffer[position] != rune('d') {
-																				goto l681
-																			}
-																			position++
-																			goto l680
-																		l681:
-																			position, tokenIndex = position680, tokenIndex680
-																			if buffer[position] != rune('D') {
-																				goto l421
-																			}
-																			position++
-																		}
-																	l680:
-																		add(rulePegText, position675)
-																	}
-																	{
-																		add(ruleAction88, position)
-																	}
-																	add(ruleCpd, position674)
+																position978, tokenIndex978 := position, tokenIndex
+																if buffer[position] != rune('d') {
+																	goto l979
+																}
+																position++
+																goto l978
+															l979:
+																position, tokenIndex = position978, tokenIndex978
+																if buffer[position] != rune('D') {
+																	goto l826
 																}
+																position++
 															}
-														l595:
-															add(ruleBlit, position594)
+														l978:
+															add(rulePegText, position971)
 														}
-														break
+														{
+															add(ruleAction161, position)
+														}
+														add(ruleOutd, position970)
 													}
 												}
-
+											l889:
+												add(ruleBlitIO, position888)
 											}
-										l423:
-											add(ruleEDSimple, position422)
-										}
-										goto l89
-									l421:
-										position, tokenIndex = position89, tokenIndex89
-										{
-											position684 := position
+											break
+										case 'R', 'r':
 											{
-												position685, tokenIndex685 := position, tokenIndex
+												position981 := position
 												{
-													position687 := position
+													position982 := position
 													{
-														position688 := position
-														{
-															position689, tokenIndex689 := position, tokenIndex
-															if buffer[position] != rune('r') {
-																goto l690
-															}
-															position++
-															goto l689
-														l690:
-															position, tokenIndex = position689, tokenIndex689
-															if buffer[position] != rune('R') {
-																goto l686
-															}
-															position++
+														position983, tokenIndex983 := position, tokenIndex
+														if buffer[position] != rune('r') {
+															goto l984
 														}
-													l689:
-														{
-															position691, tokenIndex691 := position, tokenIndex
-															if buffer[position] != rune('l') {
-																goto l692
-															}
-															position++
-															goto l691
-														l692:
-															position, tokenIndex = position691, tokenIndex691
-															if buffer[position] != rune('L') {
-																goto l686
-															}
-															position++
+														position++
+														goto l983
+													l984:
+														position, tokenIndex = position983, tokenIndex983
+														if buffer[position] != rune('R') {
+															goto l826
 														}
-													l691:
-														{
-															position693, tokenIndex693 := position, tokenIndex
-															if buffer[position] != rune('c') {
-																goto l694
-															}
-															position++
-															goto l693
-														l694:
-															position, tokenIndex = position693, tokenIndex693
-															if buffer[position] != rune('C') {
-																goto l686
-															}
-															position++
+														position++
+													}
+												l983:
+													{
+														position985, tokenIndex985 := position, tokenIndex
+														if buffer[position] != rune('l') {
+															goto l986
 														}
-													l693:
-														{
-															position695, tokenIndex695 := position, tokenIndex
-															if buffer[position] != rune('a') {
-																goto l696
-															}
-															position++
-															goto l695
-														l696:
-															position, tokenIndex = position695, tokenIndex695
-															if buffer[position] != rune('A') {
-																goto l686
-															}
-															position++
+														position++
+														goto l985
+													l986:
+														position, tokenIndex = position985, tokenIndex985
+														if buffer[position] != rune('L') {
+															goto l826
 														}
-													l695:
-														add(rulePegText, position688)
+														position++
 													}
+												l985:
 													{
-														add(ruleAction64, position)
+														position987, tokenIndex987 := position, tokenIndex
+														if buffer[position] != rune('d') {
+															goto l988
+														}
+														position++
+														goto l987
+													l988:
+														position, tokenIndex = position987, tokenIndex987
+														if buffer[position] != rune('D') {
+															goto l826
+														}
+														position++
 													}
-													add(ruleRlca, position687)
+												l987:
+													add(rulePegText, position982)
+												}
+												{
+													add(ruleAction150, position)
 												}
-												goto l685
-											l686:
-												position, tokenIndex = position685, tokenIndex685
+												add(ruleRld, position981)
+											}
+											break
+										case 'N', 'n':
+											{
+												position990 := position
 												{
-													position699 := position
+													position991 := position
 													{
-														position700 := position
-														{
-															position701, tokenIndex701 := position, tokenIndex
-															if buffer[position] != rune('r') {
-																goto l702
-															}
-															position++
-															goto l701
-														l702:
-															position, tokenIndex = position701, tokenIndex701
-															if buffer[position] != rune('R') {
-																goto l698
-															}
-															position++
+														position992, tokenIndex992 := position, tokenIndex
+														if buffer[position] != rune('n') {
+															goto l993
 														}
-													l701:
-														{
-															position703, tokenIndex703 := position, tokenIndex
-															if buffer[position] != rune('r') {
-																goto l704
-															}
-															position++
-															goto l703
-														l704:
-															position, tokenIndex = position703, tokenIndex703
-															if buffer[position] != rune('R') {
-																goto l698
-															}
-															position++
+														position++
+														goto l992
+													l993:
+														position, tokenIndex = position992, tokenIndex992
+														if buffer[position] != rune('N') {
+															goto l826
 														}
-													l703:
-														{
-															position705, tokenIndex705 := position, tokenIndex
-															if buffer[position] != rune('c') {
-																goto l706
-															}
-															position++
-															goto l705
-														l706:
-															position, tokenIndex = position705, tokenIndex705
-															if buffer[position] != rune('C') {
-																goto l698
-															}
-															position++
+														position++
+													}
+												l992:
+													{
+														position994, tokenIndex994 := position, tokenIndex
+														if buffer[position] != rune('e') {
+															goto l995
 														}
-													l705:
-														{
-															position707, tokenIndex707 := position, tokenIndex
-															if buffer[position] != rune('a') {
-																goto l708
-															}
-															position++
-															goto l707
-														l708:
-															position, tokenIndex = position707, tokenIndex707
-															if buffer[position] != rune('A') {
-																goto l698
-															}
-															position++
+														position++
+														goto l994
+													l995:
+														position, tokenIndex = position994, tokenIndex994
+														if buffer[position] != rune('E') {
+															goto l826
 														}
-													l707:
-														add(rulePegText, position700)
+														position++
 													}
+												l994:
 													{
-														add(ruleAction65, position)
+														position996, tokenIndex996 := position, tokenIndex
+														if buffer[position] != rune('g') {
+															goto l997
+														}
+														position++
+														goto l996
+													l997:
+														position, tokenIndex = position996, tokenIndex996
+														if buffer[position] != rune('G') {
+															goto l826
+														}
+														position++
 													}
-													add(ruleRrca, position699)
+												l996:
+													add(rulePegText, position991)
+												}
+												{
+													add(ruleAction146, position)
 												}
-												goto l685
-											l698:
-												position, tokenIndex = position685, tokenIndex685
+												add(ruleNeg, position990)
+											}
+											break
+										default:
+											{
+												position999 := position
 												{
-													position711 := position
+													position1000, tokenIndex1000 := position, tokenIndex
 													{
-														position712 := position
+														position1002 := position
 														{
-															position713, tokenIndex713 := position, tokenIndex
-															if buffer[position] != rune('r') {
-																goto l714
+															position1003 := position
+															{
+																position1004, tokenIndex1004 := position, tokenIndex
+																if buffer[position] != rune('l') {
+																	goto l1005
+																}
+																position++
+																goto l1004
+															l1005:
+																position, tokenIndex = position1004, tokenIndex1004
+																if buffer[position] != rune('L') {
+																	goto l1001
+																}
+																position++
 															}
-															position++
-															goto l713
-														l714:
-															position, tokenIndex = position713, tokenIndex713
-															if buffer[position] != rune('R') {
-																goto l710
+														l1004:
+															{
+																position1006, tokenIndex1006 := position, tokenIndex
+																if buffer[position] != rune('d') {
+																	goto l1007
+																}
+																position++
+																goto l1006
+															l1007:
+																position, tokenIndex = position1006, tokenIndex1006
+																if buffer[position] != rune('D') {
+																	goto l1001
+																}
+																position++
 															}
-															position++
-														}
-													l713:
-														{
-															position715, tokenIndex715 := position, tokenIndex
-															if buffer[position] != rune('l') {
-																goto l716
+														l1006:
+															{
+																position1008, tokenIndex1008 := position, tokenIndex
+																if buffer[position] != rune('i') {
+																	goto l1009
+																}
+																position++
+																goto l1008
+															l1009:
+																position, tokenIndex = position1008, tokenIndex1008
+																if buffer[position] != rune('I') {
+																	goto l1001
+																}
+																position++
 															}
-															position++
-															goto l715
-														l716:
-															position, tokenIndex = position715, tokenIndex715
-															if buffer[position] != rune('L') {
-																goto l710
+														l1008:
+															{
+																position1010, tokenIndex1010 := position, tokenIndex
+																if buffer[position] != rune('r') {
+																	goto l1011
+																}
+																position++
+																goto l1010
+															l1011:
+																position, tokenIndex = position1010, tokenIndex1010
+																if buffer[position] != rune('R') {
+																	goto l1001
+																}
+																position++
 															}
-															position++
+														l1010:
+															add(rulePegText, position1003)
 														}
-													l715:
 														{
-															position717, tokenIndex717 := position, tokenIndex
-															if buffer[position] != rune('a') {
-																goto l718
-															}
-															position++
-															goto l717
-														l718:
-															position, tokenIndex = position717, tokenIndex717
-															if buffer[position] != rune('A') {
-																goto l710
-															}
-															position++
+															add(ruleAction162, position)
 														}
-													l717:
-														add(rulePegText, position712)
-													}
-													{
-														add(ruleAction66, position)
+														add(ruleLdir, position1002)
 													}
-													add(ruleRla, position711)
-												}
-												goto l685
-											l710:
-												position, tokenIndex = position685, tokenIndex685
-												{
-													position721 := position
+													goto l1000
+												l1001:
+													position, tokenIndex = position1000, tokenIndex1000
 													{
-														position722 := position
+														position1014 := position
 														{
-															position723, tokenIndex723 := position, tokenIndex
-															if buffer[position] != rune('d') {
-																goto l724
-															}
-															position++
-															goto l723
-														l724:
-															position, tokenIndex = position723, tokenIndex723
-															if buffer[position] != rune('D') {
-																goto l720
+															position1015 := position
+															{
+																position1016, tokenIndex1016 := position, tokenIndex
+																if buffer[position] != rune('l') {
+																	goto l1017
+																}
+																position++
+																goto l1016
+															l1017:
+																position, tokenIndex = position1016, tokenIndex1016
+																if buffer[position] != rune('L') {
+																	goto l1013
+																}
+																position++
 															}
-															position++
-														}
-													l723:
-														{
-															position725, tokenIndex725 := position, tokenIndex
-															if buffer[position] != rune('a') {
-																goto l726
+														l1016:
+															{
+																position1018, tokenIndex1018 := position, tokenIndex
+																if buffer[position] != rune('d') {
+																	goto l1019
+																}
+																position++
+																goto l1018
+															l1019:
+																position, tokenIndex = position1018, tokenIndex1018
+																if buffer[position] != rune('D') {
+																	goto l1013
+																}
+																position++
 															}
-															position++
-															goto l725
-														l726:
-															position, tokenIndex = position725, tokenIndex725
-															if buffer[position] != rune('A') {
-																goto l720
+														l1018:
+															{
+																position1020, tokenIndex1020 := position, tokenIndex
+																if buffer[position] != rune('i') {
+																	goto l1021
+																}
+																position++
+																goto l1020
+															l1021:
+																position, tokenIndex = position1020, tokenIndex1020
+																if buffer[position] != rune('I') {
+																	goto l1013
+																}
+																position++
 															}
-															position++
+														l1020:
+															add(rulePegText, position1015)
 														}
-													l725:
 														{
-															position727, tokenIndex727 := position, tokenIndex
-															if buffer[position] != rune('a') {
-																goto l728
-															}
-															position++
-															goto l727
-														l728:
-															position, tokenIndex = position727, tokenIndex727
-															if buffer[position] != rune('A') {
-																goto l720
-															}
-															position++
+															add(ruleAction154, position)
 														}
-													l727:
-														add(rulePegText, position722)
-													}
-													{
-														add(ruleAction68, position)
+														add(ruleLdi, position1014)
 													}
-													add(ruleDaa, position721)
-												}
-												goto l685
-											l720:
-												position, tokenIndex = position685, tokenIndex685
-												{
-													position731 := position
+													goto l1000
+												l1013:
+													position, tokenIndex = position1000, tokenIndex1000
 													{
-														position732 := position
+														position1024 := position
 														{
-															position733, tokenIndex733 := position, tokenIndex
-															if buffer[position] != rune('c') {
-																goto l734
+															position1025 := position
+															{
+																position1026, tokenIndex1026 := position, tokenIndex
+																if buffer[position] != rune('c') {
+																	goto l1027
+																}
+																position++
+																goto l1026
+															l1027:
+																position, tokenIndex = position1026, tokenIndex1026
+																if buffer[position] != rune('C') {
+																	goto l1023
+																}
+																position++
 															}
-															position++
-															goto l733
-														l734:
-															position, tokenIndex = position733, tokenIndex733
-															if buffer[position] != rune('C') {
-																goto l730
+														l1026:
+															{
+																position1028, tokenIndex1028 := position, tokenIndex
+																if buffer[position] != rune('p') {
+																	goto l1029
+																}
+																position++
+																goto l1028
+															l1029:
+																position, tokenIndex = position1028, tokenIndex1028
+																if buffer[position] != rune('P') {
+																	goto l1023
+																}
+																position++
 															}
-															position++
-														}
-													l733:
-														{
-															position735, tokenIndex735 := position, tokenIndex
-															if buffer[position] != rune('p') {
-																goto l736
+														l1028:
+															{
+																position1030, tokenIndex1030 := position, tokenIndex
+																if buffer[position] != rune('i') {
+																	goto l1031
+																}
+																position++
+																goto l1030
+															l1031:
+																position, tokenIndex = position1030, tokenIndex1030
+																if buffer[position] != rune('I') {
+																	goto l1023
+																}
+																position++
 															}
-															position++
-															goto l735
-														l736:
-															position, tokenIndex = position735, tokenIndex735
-															if buffer[position] != rune('P') {
-																goto l730
+														l1030:
+															{
+																position1032, tokenIndex1032 := position, tokenIndex
+																if buffer[position] != rune('r') {
+																	goto l1033
+																}
+																position++
+																goto l1032
+															l1033:
+																position, tokenIndex = position1032, tokenIndex1032
+																if buffer[position] != rune('R') {
+																	goto l1023
+																}
+																position++
 															}
-															position++
+														l1032:
+															add(rulePegText, position1025)
 														}
-													l735:
 														{
-															position737, tokenIndex737 := position, tokenIndex
-															if buffer[position] != rune('l') {
-																goto l738
-															}
-															position++
-															goto l737
-														l738:
-															position, tokenIndex = position737, tokenIndex737
-															if buffer[position] != rune('L') {
-																goto l730
-															}
-															position++
+															add(ruleAction163, position)
 														}
-													l737:
-														add(rulePegText, position732)
-													}
-													{
-														add(ruleAction69, position)
+														add(ruleCpir, position1024)
 													}
-													add(ruleCpl, position731)
-												}
-												goto l685
-											l730:
-												position, tokenIndex = position685, tokenIndex685
-												{
-													position741 := position
+													goto l1000
+												l1023:
+													position, tokenIndex = position1000, tokenIndex1000
 													{
-														position742 := position
+														position1036 := position
 														{
-															position743, tokenIndex743 := position, tokenIndex
-															if buffer[position] != rune('e') {
-																goto l744
+															position1037 := position
+															{
+																position1038, tokenIndex1038 := position, tokenIndex
+																if buffer[position] != rune('c') {
+																	goto l1039
+																}
+																position++
+																goto l1038
+															l1039:
+																position, tokenIndex = position1038, tokenIndex1038
+																if buffer[position] != rune('C') {
+																	goto l1035
+																}
+																position++
 															}
-															position++
-															goto l743
-														l744:
-															position, tokenIndex = position743, tokenIndex743
-															if buffer[position] != rune('E') {
-																goto l740
+														l1038:
+															{
+																position1040, tokenIndex1040 := position, tokenIndex
+																if buffer[position] != rune('p') {
+																	goto l1041
+																}
+																position++
+																goto l1040
+															l1041:
+																position, tokenIndex = position1040, tokenIndex1040
+																if buffer[position] != rune('P') {
+																	goto l1035
+																}
+																position++
 															}
-															position++
+														l1040:
+															{
+																position1042, tokenIndex1042 := position, tokenIndex
+																if buffer[position] != rune('i') {
+																	goto l1043
+																}
+																position++
+																goto l1042
+															l1043:
+																position, tokenIndex = position1042, tokenIndex1042
+																if buffer[position] != rune('I') {
+																	goto l1035
+																}
+																position++
+															}
+														l1042:
+															add(rulePegText, position1037)
 														}
-													l743:
 														{
-															position745, tokenIndex745 := position, tokenIndex
-															if buffer[position] != rune('x') {
-																goto l746
-															}
-															position++
-															goto l745
-														l746:
-															position, tokenIndex = position745, tokenIndex745
-															if buffer[position] != rune('X') {
-																goto l740
-															}
-															position++
+															add(ruleAction155, position)
 														}
-													l745:
+														add(ruleCpi, position1036)
+													}
+													goto l1000
+												l1035:
+													position, tokenIndex = position1000, tokenIndex1000
+													{
+														position1046 := position
 														{
-															position747, tokenIndex747 := position, tokenIndex
-															if buffer[position] != rune('x') {
-																goto l748
+															position1047 := position
+															{
+																position1048, tokenIndex1048 := position, tokenIndex
+																if buffer[position] != rune('l') {
+																	goto l1049
+																}
+																position++
+																goto l1048
+															l1049:
+																position, tokenIndex = position1048, tokenIndex1048
+																if buffer[position] != rune('L') {
+																	goto l1045
+																}
+																position++
 															}
-															position++
-															goto l747
-														l748:
-															position, tokenIndex = position747, tokenIndex747
-															if buffer[position] != rune('X') {
-																goto l740
+														l1048:
+															{
+																position1050, tokenIndex1050 := position, tokenIndex
+																if buffer[position] != rune('d') {
+																	goto l1051
+																}
+																position++
+																goto l1050
+															l1051:
+																position, tokenIndex = position1050, tokenIndex1050
+																if buffer[position] != rune('D') {
+																	goto l1045
+																}
+																position++
 															}
-															position++
+														l1050:
+															{
+																position1052, tokenIndex1052 := position, tokenIndex
+																if buffer[position] != rune('d') {
+																	goto l1053
+																}
+																position++
+																goto l1052
+															l1053:
+																position, tokenIndex = position1052, tokenIndex1052
+																if buffer[position] != rune('D') {
+																	goto l1045
+																}
+																position++
+															}
+														l1052:
+															{
+																position1054, tokenIndex1054 := position, tokenIndex
+																if buffer[position] != rune('r') {
+																	goto l1055
+																}
+																position++
+																goto l1054
+															l1055:
+																position, tokenIndex = position1054, tokenIndex1054
+																if buffer[position] != rune('R') {
+																	goto l1045
+																}
+																position++
+															}
+														l1054:
+															add(rulePegText, position1047)
 														}
-													l747:
-														add(rulePegText, position742)
+														{
+															add(ruleAction166, position)
+														}
+														add(ruleLddr, position1046)
 													}
+													goto l1000
+												l1045:
+													position, tokenIndex = position1000, tokenIndex1000
 													{
-														add(ruleAction72, position)
-													}
-													add(ruleExx, position741)
-												}
-												goto l685
-											l740:
-												position, tokenIndex = position685, tokenIndex685
-												{
-													switch buffer[position] {
-													case 'E', 'e':
+														position1058 := position
 														{
-															position751 := position
-															{
-																position752 := position
-																{
-																	position753, tokenIndex753 := position, tokenIndex
-																	if buffer[position] != rune('e') {
-																		goto l754
-																	}
-																	position++
-																	goto l753
-																l754:
-																	position, tokenIndex = position753, tokenIndex753
-																	if buffer[position] != rune('E') {
-																		goto l683
-																	}
-																	position++
-																}
-															l753:
-																{
-																	position755, tokenIndex755 := position, tokenIndex
-																	if buffer[position] != rune('i') {
-																		goto l756
-																	}
-																	position++
-																	goto l755
-																l756:
-																	position, tokenIndex = position755, tokenIndex755
-																	if buffer[position] != rune('I') {
-																		goto l683
-																	}
-																	position++
-																}
-															l755:
-																add(rulePegText, position752)
-															}
+															position1059 := position
 															{
-																add(ruleAction74, position)
+																position1060, tokenIndex1060 := position, tokenIndex
+																if buffer[position] != rune('l') {
+																	goto l1061
+																}
+																position++
+																goto l1060
+															l1061:
+																position, tokenIndex = position1060, tokenIndex1060
+																if buffer[position] != rune('L') {
+																	goto l1057
+																}
+																position++
 															}
-															add(ruleEi, position751)
-														}
-														break
-													case 'D', 'd':
-														{
-															position758 := position
+														l1060:
 															{
-																position759 := position
-																{
-																	position760, tokenIndex760 := position, tokenIndex
-																	if buffer[position] != rune('d') {
-																		goto l761
-																	}
-																	position++
-																	goto l760
-																l761:
-																	position, tokenIndex = position760, tokenIndex760
-																	if buffer[position] != rune('D') {
-																		goto l683
-																	}
-																	position++
-																}
-															l760:
-																{
-																	position762, tokenIndex762 := position, tokenIndex
-																	if buffer[position] != rune('i') {
-																		goto l763
-																	}
-																	position++
-																	goto l762
-																l763:
-																	position, tokenIndex = position762, tokenIndex762
-																	if buffer[position] != rune('I') {
-																		goto l683
-																	}
-																	position++
-																}
-															l762:
-																add(rulePegText, position759)
+																position1062, tokenIndex1062 := position, tokenIndex
+																if buffer[position] != rune('d') {
+																	goto l1063
+																}
+																position++
+																goto l1062
+															l1063:
+																position, tokenIndex = position1062, tokenIndex1062
+																if buffer[position] != rune('D') {
+																	goto l1057
+																}
+																position++
 															}
+														l1062:
 															{
-																add(ruleAction73, position)
+																position1064, tokenIndex1064 := position, tokenIndex
+																if buffer[position] != rune('d') {
+																	goto l1065
+																}
+																position++
+																goto l1064
+															l1065:
+																position, tokenIndex = position1064, tokenIndex1064
+																if buffer[position] != rune('D') {
+																	goto l1057
+																}
+																position++
 															}
-															add(ruleDi, position758)
+														l1064:
+															add(rulePegText, position1059)
 														}
-														break
-													case 'C', 'c':
 														{
-															position765 := position
-															{
-																position766 := position
-																{
-																	position767, tokenIndex767 := position, tokenIndex
-																	if buffer[position] != rune('c') {
-																		goto l768
-																	}
-																	position++
-																	goto l767
-																l768:
-																	position, tokenIndex = position767, tokenIndex767
-																	if buffer[position] != rune('C') {
-																		goto l683
-																	}
-																	position++
-																}
-															l767:
-																{
-																	position769, tokenIndex769 := position, tokenIndex
-																	if buffer[position] != rune('c') {
-																		goto l770
-																	}
-																	position++
-																	goto l769
-																l770:
-																	position, tokenIndex = position769, tokenIndex769
-																	if buffer[position] != rune('C') {
-																		goto l683
-																	}
-																	position++
-																}
-															l769:
-																{
-																	position771, tokenIndex771 := position, tokenIndex
-																	if buffer[position] != rune('f') {
-																		goto l772
-																	}
-																	position++
-																	goto l771
-																l772:
-																	position, tokenIndex = position771, tokenIndex771
-																	if buffer[position] != rune('F') {
-																		goto l683
-																	}
-																	position++
-																}
-															l771:
-																add(rulePegText, position766)
-															}
-															{
-																add(ruleAction71, position)
-															}
-															add(ruleCcf, position765)
+															add(ruleAction158, position)
 														}
-														break
-													case 'S', 's':
+														add(ruleLdd, position1058)
+													}
+													goto l1000
+												l1057:
+													position, tokenIndex = position1000, tokenIndex1000
+													{
+														position1068 := position
 														{
-															position774 := position
+															position1069 := position
 															{
-																position775 := position
-																{
-																	position776, tokenIndex776 := position, tokenIndex
-																	if buffer[position] != rune('s') {
-																		goto l777
-																	}
-																	position++
-																	goto l776
-																l777:
-																	position, tokenIndex = position776, tokenIndex776
-																	if buffer[position] != rune('S') {
-																		goto l683
-																	}
-																	position++
-																}
-															l776:
-																{
-																	position778, tokenIndex778 := position, tokenIndex
-																	if buffer[position] != rune('c') {
-																		goto l779
-																	}
-																	position++
-																	goto l778
-																l779:
-																	position, tokenIndex = position778, tokenIndex778
-																	if buffer[position] != rune('C') {
-																		goto l683
-																	}
-																	position++
-																}
-															l778:
-																{
-																	position780, tokenIndex780 := position, tokenIndex
-																	if buffer[position] != rune('f') {
-																		goto l781
-																	}
-																	position++
-																	goto l780
-																l781:
-																	position, tokenIndex = position780, tokenIndex780
-																	if buffer[position] != rune('F') {
-																		goto l683
-																	}
-																	position++
-																}
-															l780:
-																add(rulePegText, position775)
+																position1070, tokenIndex1070 := position, tokenIndex
+																if buffer[position] != rune('c') {
+																	goto l1071
+																}
+																position++
+																goto l1070
+															l1071:
+																position, tokenIndex = position1070, tokenIndex1070
+																if buffer[position] != rune('C') {
+																	goto l1067
+																}
+																position++
 															}
+														l1070:
 															{
-																add(ruleAction70, position)
+																position1072, tokenIndex1072 := position, tokenIndex
+																if buffer[position] != rune('p') {
+																	goto l1073
+																}
+																position++
+																goto l1072
+															l1073:
+																position, tokenIndex = position1072, tokenIndex1072
+																if buffer[position] != rune('P') {
+																	goto l1067
+																}
+																position++
 															}
-															add(ruleScf, position774)
-														}
-														break
-													case 'R', 'r':
-														{
-															position783 := position
+														l1072:
 															{
-																position784 := position
-																{
-																	position785, tokenIndex785 := position, tokenIndex
-																	if buffer[position] != rune('r') {
-																		goto l786
-																	}
-																	position++
-																	goto l785
-																l786:
-																	position, tokenIndex = position785, tokenIndex785
-																	if buffer[position] != rune('R') {
-																		goto l683
-																	}
-																	position++
-																}
-															l785:
-																{
-																	position787, tokenIndex787 := position, tokenIndex
-																	if buffer[position] != rune('r') {
-																		goto l788
-																	}
-																	position++
-																	goto l787
-																l788:
-																	position, tokenIndex = position787, tokenIndex787
-																	if buffer[position] != rune('R') {
-																		goto l683
-																	}
-																	position++
-																}
-															l787:
-																{
-																	position789, tokenIndex789 := position, tokenIndex
-																	if buffer[position] != rune('a') {
-																		goto l790
-																	}
-																	position++
-																	goto l789
-																l790:
-																	position, tokenIndex = position789, tokenIndex789
-																	if buffer[position] != rune('A') {
-																		goto l683
-																	}
-																	position++
-																}
-															l789:
-																add(rulePegText, position784)
+																position1074, tokenIndex1074 := position, tokenIndex
+																if buffer[position] != rune('d') {
+																	goto l1075
+																}
+																position++
+																goto l1074
+															l1075:
+																position, tokenIndex = position1074, tokenIndex1074
+																if buffer[position] != rune('D') {
+																	goto l1067
+																}
+																position++
 															}
+														l1074:
 															{
-																add(ruleAction67, position)
+																position1076, tokenIndex1076 := position, tokenIndex
+																if buffer[position] != rune('r') {
+																	goto l1077
+																}
+																position++
+																goto l1076
+															l1077:
+																position, tokenIndex = position1076, tokenIndex1076
+																if buffer[position] != rune('R') {
+																	goto l1067
+																}
+																position++
 															}
-															add(ruleRra, position783)
+														l1076:
+															add(rulePegText, position1069)
 														}
-														break
-													case 'H', 'h':
 														{
-															position792 := position
-															{
-																position793 := position
-																{
-																	position794, tokenIndex794 := position, tokenIndex
-																	if buffer[position] != rune('h') {
-																		goto l795
-																	}
-																	position++
-																	goto l794
-																l795:
-																	position, tokenIndex = position794, tokenIndex794
-																	if buffer[position] != rune('H') {
-																		goto l683
-																	}
-																	position++
-																}
-															l794:
-																{
-																	position796, tokenIndex796 := position, tokenIndex
-																	if buffer[position] != rune('a') {
-																		goto l797
-																	}
-																	position++
-																	goto l796
-																l797:
-																	position, tokenIndex = position796, tokenIndex796
-																	if buffer[position] != rune('A') {
-																		goto l683
-																	}
-																	position++
-																}
-															l796:
-																{
-																	position798, tokenIndex798 := position, tokenIndex
-																	if buffer[position] != rune('l') {
-																		goto l799
-																	}
-																	position++
-																	goto l798
-																l799:
-																	position, tokenIndex = position798, tokenIndex798
-																	if buffer[position] != rune('L') {
-																		goto l683
-																	}
-																	position++
-																}
-															l798:
-																{
-																	position800, tokenIndex800 := position, tokenIndex
-																	if buffer[position] != rune('t') {
-																		goto l801
-																	}
-																	position++
-																	goto l800
-																l801:
-																	position, tokenIndex = position800, tokenIndex800
-																	if buffer[position] != rune('T') {
-																		goto l683
-																	}
-																	position++
-																}
-															l800:
-																add(rulePegText, position793)
-															}
-															{
-																add(ruleAction63, position)
-															}
-															add(ruleHalt, position792)
+															add(ruleAction167, position)
 														}
-														break
-													default:
+														add(ruleCpdr, position1068)
+													}
+													goto l1000
+												l1067:
+													position, tokenIndex = position1000, tokenIndex1000
+													{
+														position1079 := position
 														{
-															position803 := position
+															position1080 := position
+															{
+																position1081, tokenIndex1081 := position, tokenIndex
+																if buffer[position] != rune('c') {
+																	goto l1082
+																}
+																position++
+																goto l1081
+															l1082:
+																position, tokenIndex = position1081, tokenIndex1081
+																if buffer[position] != rune('C') {
+																	goto l826
+																}
+																position++
+															}
+														l1081:
 															{
-																position804 := position
-																{
-																	position805, tokenIndex805 := position, tokenIndex
-																	if buffer[position] != rune('n') {
-																		goto l806
-																	}
-																	position++
-																	goto l805
-																l806:
-																	position, tokenIndex = position805, tokenIndex805
-																	if buffer[position] != rune('N') {
-																		goto l683
-																	}
-																	position++
-																}
-															l805:
-																{
-																	position807, tokenIndex807 := position, tokenIndex
-																	if buffer[position] != rune('o') {
-																		goto l808
-																	}
-																	position++
-																	goto l807
-																l808:
-																	position, tokenIndex = position807, tokenIndex807
-																	if buffer[position] != rune('O') {
-																		goto l683
-																	}
-																	position++
-																}
-															l807:
-																{
-																	position809, tokenIndex809 := position, tokenIndex
-																	if buffer[position] != rune('p') {
-																		goto l810
-																	}
-																	position++
-																	goto l809
-																l810:
-																	position, tokenIndex = position809, tokenIndex809
-																	if buffer[position] != rune('P') {
-																		goto l683
-																	}
-																	position++
-																}
-															l809:
-																add(rulePegText, position804)
+																position1083, tokenIndex1083 := position, tokenIndex
+																if buffer[position] != rune('p') {
+																	goto l1084
+																}
+																position++
+																goto l1083
+															l1084:
+																position, tokenIndex = position1083, tokenIndex1083
+																if buffer[position] != rune('P') {
+																	goto l826
+																}
+																position++
 															}
+														l1083:
 															{
-																add(ruleAction62, position)
+																position1085, tokenIndex1085 := position, tokenIndex
+																if buffer[position] != rune('d') {
+																	goto l1086
+																}
+																position++
+																goto l1085
+															l1086:
+																position, tokenIndex = position1085, tokenIndex1085
+																if buffer[position] != rune('D') {
+																	goto l826
+																}
+																position++
 															}
-															add(ruleNop, position803)
+														l1085:
+															add(rulePegText, position1080)
+														}
+														{
+															add(ruleAction159, position)
+														}
+														add(ruleCpd, position1079)
+													}
+												}
+											l1000:
+												add(ruleBlit, position999)
+											}
+											break
+										}
+									}
+
+								}
+							l828:
+								add(ruleEDSimple, position827)
+							}
+							goto l468
+						l826:
+							position, tokenIndex = position468, tokenIndex468
+							{
+								position1089 := position
+								{
+									position1090, tokenIndex1090 := position, tokenIndex
+									{
+										position1092 := position
+										{
+											position1093 := position
+											{
+												position1094, tokenIndex1094 := position, tokenIndex
+												if buffer[position] != rune('r') {
+													goto l1095
+												}
+												position++
+												goto l1094
+											l1095:
+												position, tokenIndex = position1094, tokenIndex1094
+												if buffer[position] != rune('R') {
+													goto l1091
+												}
+												position++
+											}
+										l1094:
+											{
+												position1096, tokenIndex1096 := position, tokenIndex
+												if buffer[position] != rune('l') {
+													goto l1097
+												}
+												position++
+												goto l1096
+											l1097:
+												position, tokenIndex = position1096, tokenIndex1096
+												if buffer[position] != rune('L') {
+													goto l1091
+												}
+												position++
+											}
+										l1096:
+											{
+												position1098, tokenIndex1098 := position, tokenIndex
+												if buffer[position] != rune('c') {
+													goto l1099
+												}
+												position++
+												goto l1098
+											l1099:
+												position, tokenIndex = position1098, tokenIndex1098
+												if buffer[position] != rune('C') {
+													goto l1091
+												}
+												position++
+											}
+										l1098:
+											{
+												position1100, tokenIndex1100 := position, tokenIndex
+												if buffer[position] != rune('a') {
+													goto l1101
+												}
+												position++
+												goto l1100
+											l1101:
+												position, tokenIndex = position1100, tokenIndex1100
+												if buffer[position] != rune('A') {
+													goto l1091
+												}
+												position++
+											}
+										l1100:
+											add(rulePegText, position1093)
+										}
+										{
+											add(ruleAction135, position)
+										}
+										add(ruleRlca, position1092)
+									}
+									goto l1090
+								l1091:
+									position, tokenIndex = position1090, tokenIndex1090
+									{
+										position1104 := position
+										{
+											position1105 := position
+											{
+												position1106, tokenIndex1106 := position, tokenIndex
+												if buffer[position] != rune('r') {
+													goto l1107
+												}
+												position++
+												goto l1106
+											l1107:
+												position, tokenIndex = position1106, tokenIndex1106
+												if buffer[position] != rune('R') {
+													goto l1103
+												}
+												position++
+											}
+										l1106:
+											{
+												position1108, tokenIndex1108 := position, tokenIndex
+												if buffer[position] != rune('r') {
+													goto l1109
+												}
+												position++
+												goto l1108
+											l1109:
+												position, tokenIndex = position1108, tokenIndex1108
+												if buffer[position] != rune('R') {
+													goto l1103
+												}
+												position++
+											}
+										l1108:
+											{
+												position1110, tokenIndex1110 := position, tokenIndex
+												if buffer[position] != rune('c') {
+													goto l1111
+												}
+												position++
+												goto l1110
+											l1111:
+												position, tokenIndex = position1110, tokenIndex1110
+												if buffer[position] != rune('C') {
+													goto l1103
+												}
+												position++
+											}
+										l1110:
+											{
+												position1112, tokenIndex1112 := position, tokenIndex
+												if buffer[position] != rune('a') {
+													goto l1113
+												}
+												position++
+												goto l1112
+											l1113:
+												position, tokenIndex = position1112, tokenIndex1112
+												if buffer[position] != rune('A') {
+													goto l1103
+												}
+												position++
+											}
+										l1112:
+											add(rulePegText, position1105)
+										}
+										{
+											add(ruleAction136, position)
+										}
+										add(ruleRrca, position1104)
+									}
+									goto l1090
+								l1103:
+									position, tokenIndex = position1090, tokenIndex1090
+									{
+										position1116 := position
+										{
+											position1117 := position
+											{
+												position1118, tokenIndex1118 := position, tokenIndex
+												if buffer[position] != rune('r') {
+													goto l1119
+												}
+												position++
+												goto l1118
+											l1119:
+												position, tokenIndex = position1118, tokenIndex1118
+												if buffer[position] != rune('R') {
+													goto l1115
+												}
+												position++
+											}
+										l1118:
+											{
+												position1120, tokenIndex1120 := position, tokenIndex
+												if buffer[position] != rune('l') {
+													goto l1121
+												}
+												position++
+												goto l1120
+											l1121:
+												position, tokenIndex = position1120, tokenIndex1120
+												if buffer[position] != rune('L') {
+													goto l1115
+												}
+												position++
+											}
+										l1120:
+											{
+												position1122, tokenIndex1122 := position, tokenIndex
+												if buffer[position] != rune('a') {
+													goto l1123
+												}
+												position++
+												goto l1122
+											l1123:
+												position, tokenIndex = position1122, tokenIndex1122
+												if buffer[position] != rune('A') {
+													goto l1115
+												}
+												position++
+											}
+										l1122:
+											add(rulePegText, position1117)
+										}
+										{
+											add(ruleAction137, position)
+										}
+										add(ruleRla, position1116)
+									}
+									goto l1090
+								l1115:
+									position, tokenIndex = position1090, tokenIndex1090
+									{
+										position1126 := position
+										{
+											position1127 := position
+											{
+												position1128, tokenIndex1128 := position, tokenIndex
+												if buffer[position] != rune('d') {
+													goto l1129
+												}
+												position++
+												goto l1128
+											l1129:
+												position, tokenIndex = position1128, tokenIndex1128
+												if buffer[position] != rune('D') {
+													goto l1125
+												}
+												position++
+											}
+										l1128:
+											{
+												position1130, tokenIndex1130 := position, tokenIndex
+												if buffer[position] != rune('a') {
+													goto l1131
+												}
+												position++
+												goto l1130
+											l1131:
+												position, tokenIndex = position1130, tokenIndex1130
+												if buffer[position] != rune('A') {
+													goto l1125
+												}
+												position++
+											}
+										l1130:
+											{
+												position1132, tokenIndex1132 := position, tokenIndex
+												if buffer[position] != rune('a') {
+													goto l1133
+												}
+												position++
+												goto l1132
+											l1133:
+												position, tokenIndex = position1132, tokenIndex1132
+												if buffer[position] != rune('A') {
+													goto l1125
+												}
+												position++
+											}
+										l1132:
+											add(rulePegText, position1127)
+										}
+										{
+											add(ruleAction139, position)
+										}
+										add(ruleDaa, position1126)
+									}
+									goto l1090
+								l1125:
+									position, tokenIndex = position1090, tokenIndex1090
+									{
+										position1136 := position
+										{
+											position1137 := position
+											{
+												position1138, tokenIndex1138 := position, tokenIndex
+												if buffer[position] != rune('c') {
+													goto l1139
+												}
+												position++
+												goto l1138
+											l1139:
+												position, tokenIndex = position1138, tokenIndex1138
+												if buffer[position] != rune('C') {
+													goto l1135
+												}
+												position++
+											}
+										l1138:
+											{
+												position1140, tokenIndex1140 := position, tokenIndex
+												if buffer[position] != rune('p') {
+													goto l1141
+												}
+												position++
+												goto l1140
+											l1141:
+												position, tokenIndex = position1140, tokenIndex1140
+												if buffer[position] != rune('P') {
+													goto l1135
+												}
+												position++
+											}
+										l1140:
+											{
+												position1142, tokenIndex1142 := position, tokenIndex
+												if buffer[position] != rune('l') {
+													goto l1143
+												}
+												position++
+												goto l1142
+											l1143:
+												position, tokenIndex = position1142, tokenIndex1142
+												if buffer[position] != rune('L') {
+													goto l1135
+												}
+												position++
+											}
+										l1142:
+											add(rulePegText, position1137)
+										}
+										{
+											add(ruleAction140, position)
+										}
+										add(ruleCpl, position1136)
+									}
+									goto l1090
+								l1135:
+									position, tokenIndex = position1090, tokenIndex1090
+									{
+										position1146 := position
+										{
+											position1147 := position
+											{
+												position1148, tokenIndex1148 := position, tokenIndex
+												if buffer[position] != rune('e') {
+													goto l1149
+												}
+												position++
+												goto l1148
+											l1149:
+												position, tokenIndex = position1148, tokenIndex1148
+												if buffer[position] != rune('E') {
+													goto l1145
+												}
+												position++
+											}
+										l1148:
+											{
+												position1150, tokenIndex1150 := position, tokenIndex
+												if buffer[position] != rune('x') {
+													goto l1151
+												}
+												position++
+												goto l1150
+											l1151:
+												position, tokenIndex = position1150, tokenIndex1150
+												if buffer[position] != rune('X') {
+													goto l1145
+												}
+												position++
+											}
+										l1150:
+											{
+												position1152, tokenIndex1152 := position, tokenIndex
+												if buffer[position] != rune('x') {
+													goto l1153
+												}
+												position++
+												goto l1152
+											l1153:
+												position, tokenIndex = position1152, tokenIndex1152
+												if buffer[position] != rune('X') {
+													goto l1145
+												}
+												position++
+											}
+										l1152:
+											add(rulePegText, position1147)
+										}
+										{
+											add(ruleAction143, position)
+										}
+										add(ruleExx, position1146)
+									}
+									goto l1090
+								l1145:
+									position, tokenIndex = position1090, tokenIndex1090
+									{
+										switch buffer[position] {
+										case 'E', 'e':
+											{
+												position1156 := position
+												{
+													position1157 := position
+													{
+														position1158, tokenIndex1158 := position, tokenIndex
+														if buffer[position] != rune('e') {
+															goto l1159
+														}
+														position++
+														goto l1158
+													l1159:
+														position, tokenIndex = position1158, tokenIndex1158
+														if buffer[position] != rune('E') {
+															goto l1088
+														}
+														position++
+													}
+												l1158:
+													{
+														position1160, tokenIndex1160 := position, tokenIndex
+														if buffer[position] != rune('i') {
+															goto l1161
+														}
+														position++
+														goto l1160
+													l1161:
+														position, tokenIndex = position1160, tokenIndex1160
+														if buffer[position] != rune('I') {
+															goto l1088
 														}
-														break
+														position++
 													}
+												l1160:
+													add(rulePegText, position1157)
 												}
-
+												{
+													add(ruleAction145, position)
+												}
+												add(ruleEi, position1156)
 											}
-										l685:
-											add(ruleSimple, position684)
-										}
-										goto l89
-									l683:
-										position, tokenIndex = position89, tokenIndex89
-										{
-											position813 := position
+											break
+										case 'D', 'd':
 											{
-												position814, tokenIndex814 := position, tokenIndex
+												position1163 := position
 												{
-													position816 := position
+													position1164 := position
 													{
-														position817, tokenIndex817 := position, tokenIndex
-														if buffer[position] != rune('r') {
-															goto l818
+														position1165, tokenIndex1165 := position, tokenIndex
+														if buffer[position] != rune('d') {
+															goto l1166
 														}
 														position++
-														goto l817
-													l818:
-														position, tokenIndex = position817, tokenIndex817
-														if buffer[position] != rune('R') {
-															goto l815
+														goto l1165
+													l1166:
+														position, tokenIndex = position1165, tokenIndex1165
+														if buffer[position] != rune('D') {
+															goto l1088
 														}
 														position++
 													}
-												l817:
+												l1165:
 													{
-														position819, tokenIndex819 := position, tokenIndex
-														if buffer[position] != rune('s') {
-															goto l820
+														position1167, tokenIndex1167 := position, tokenIndex
+														if buffer[position] != rune('i') {
+															goto l1168
 														}
 														position++
-														goto l819
-													l820:
-														position, tokenIndex = position819, tokenIndex819
-														if buffer[position] != rune('S') {
-															goto l815
+														goto l1167
+													l1168:
+														position, tokenIndex = position1167, tokenIndex1167
+														if buffer[position] != rune('I') {
+															goto l1088
 														}
 														position++
 													}
-												l819:
+												l1167:
+													add(rulePegText, position1164)
+												}
+												{
+													add(ruleAction144, position)
+												}
+												add(ruleDi, position1163)
+											}
+											break
+										case 'C', 'c':
+											{
+												position1170 := position
+												{
+													position1171 := position
 													{
-														position821, tokenIndex821 := position, tokenIndex
-														if buffer[position] != rune('t') {
-															goto l822
+														position1172, tokenIndex1172 := position, tokenIndex
+														if buffer[position] != rune('c') {
+															goto l1173
 														}
 														position++
-														goto l821
-													l822:
-														position, tokenIndex = position821, tokenIndex821
-														if buffer[position] != rune('T') {
-															goto l815
+														goto l1172
+													l1173:
+														position, tokenIndex = position1172, tokenIndex1172
+														if buffer[position] != rune('C') {
+															goto l1088
 														}
 														position++
 													}
-												l821:
-													if !_rules[rulews]() {
-														goto l815
-													}
-													if !_rules[rulen]() {
-														goto l815
+												l1172:
+													{
+														position1174, tokenIndex1174 := position, tokenIndex
+														if buffer[position] != rune('c') {
+															goto l1175
+														}
+														position++
+														goto l1174
+													l1175:
+														position, tokenIndex = position1174, tokenIndex1174
+														if buffer[position] != rune('C') {
+															goto l1088
+														}
+														position++
 													}
+												l1174:
 													{
-														add(ruleAction99, position)
+														position1176, tokenIndex1176 := position, tokenIndex
+														if buffer[position] != rune('f') {
+															goto l1177
+														}
+														position++
+														goto l1176
+													l1177:
+														position, tokenIndex = position1176, tokenIndex1176
+														if buffer[position] != rune('F') {
+															goto l1088
+														}
+														position++
 													}
-													add(ruleRst, position816)
+												l1176:
+													add(rulePegText, position1171)
 												}
-												goto l814
-											l815:
-												position, tokenIndex = position814, tokenIndex814
 												{
-													position825 := position
+													add(ruleAction142, position)
+												}
+												add(ruleCcf, position1170)
+											}
+											break
+										case 'S', 's':
+											{
+												position1179 := position
+												{
+													position1180 := position
 													{
-														position826, tokenIndex826 := position, tokenIndex
-														if buffer[position] != rune('j') {
-															goto l827
+														position1181, tokenIndex1181 := position, tokenIndex
+														if buffer[position] != rune('s') {
+															goto l1182
 														}
 														position++
-														goto l826
-													l827:
-														position, tokenIndex = position826, tokenIndex826
-														if buffer[position] != rune('J') {
-															goto l824
+														goto l1181
+													l1182:
+														position, tokenIndex = position1181, tokenIndex1181
+														if buffer[position] != rune('S') {
+															goto l1088
 														}
 														position++
 													}
-												l826:
+												l1181:
 													{
-														position828, tokenIndex828 := position, tokenIndex
-														if buffer[position] != rune('p') {
-															goto l829
+														position1183, tokenIndex1183 := position, tokenIndex
+														if buffer[position] != rune('c') {
+															goto l1184
 														}
 														position++
-														goto l828
-													l829:
-														position, tokenIndex = position828, tokenIndex828
-														if buffer[position] != rune('P') {
-															goto l824
+														goto l1183
+													l1184:
+														position, tokenIndex = position1183, tokenIndex1183
+														if buffer[position] != rune('C') {
+															goto l1088
 														}
 														position++
 													}
-												l828:
-													if !_rules[rulews]() {
-														goto l824
-													}
+												l1183:
 													{
-														position830, tokenIndex830 := position, tokenIndex
-														if !_rules[rulecc]() {
-															goto l830
+														position1185, tokenIndex1185 := position, tokenIndex
+														if buffer[position] != rune('f') {
+															goto l1186
 														}
-														if !_rules[rulesep]() {
-															goto l830
+														position++
+														goto l1185
+													l1186:
+														position, tokenIndex = position1185, tokenIndex1185
+														if buffer[position] != rune('F') {
+															goto l1088
 														}
-														goto l831
-													l830:
-														position, tokenIndex = position830, tokenIndex830
-													}
-												l831:
-													if !_rules[ruleSrc16]() {
-														goto l824
-													}
-													{
-														add(ruleAction102, position)
+														position++
 													}
-													add(ruleJp, position825)
+												l1185:
+													add(rulePegText, position1180)
 												}
-												goto l814
-											l824:
-												position, tokenIndex = position814, tokenIndex814
 												{
-													switch buffer[position] {
-													case 'D', 'd':
-														{
-															position834 := position
-															{
-																position835, tokenIndex835 := position, tokenIndex
-																if buffer[position] != rune('d') {
-																	goto l836
-																}
-																position++
-																goto l835
-															l836:
-																position, tokenIndex = position835, tokenIndex835
-																if buffer[position] != rune('D') {
-																	goto l812
-																}
-																position++
-															}
-														l835:
-															{
-																position837, tokenIndex837 := position, tokenIndex
-																if buffer[position] != rune('j') {
-																	goto l838
-																}
-																position++
-																goto l837
-															l838:
-																position, tokenIndex = position837, tokenIndex837
-																if buffer[position] != rune('J') {
-																	goto l812
-																}
-																position++
-															}
-														l837:
-															{
-																position839, tokenIndex839 := position, tokenIndex
-																if buffer[position] != rune('n') {
-																	goto l840
-																}
-																position++
-																goto l839
-															l840:
-																position, tokenIndex = position839, tokenIndex839
-																if buffer[position] != rune('N') {
-																	goto l812
-																}
-																position++
-															}
-														l839:
-															{
-																position841, tokenIndex841 := position, tokenIndex
-																if buffer[position] != rune('z') {
-																	goto l842
-																}
-																position++
-																goto l841
-															l842:
-																position, tokenIndex = position841, tokenIndex841
-																if buffer[position] != rune('Z') {
-																	goto l812
-																}
-																position++
-															}
-														l841:
-															if !_rules[rulews]() {
-																goto l812
-															}
-															if !_rules[ruledisp]() {
-																goto l812
-															}
-															{
-																add(ruleAction104, position)
-															}
-															add(ruleDjnz, position834)
+													add(ruleAction141, position)
+												}
+												add(ruleScf, position1179)
+											}
+											break
+										case 'R', 'r':
+											{
+												position1188 := position
+												{
+													position1189 := position
+													{
+														position1190, tokenIndex1190 := position, tokenIndex
+														if buffer[position] != rune('r') {
+															goto l1191
 														}
-														break
-													case 'J', 'j':
-														{
-															position844 := position
-															{
-																position845, tokenIndex845 := position, tokenIndex
-																if buffer[position] != rune('j') {
-																	goto l846
-																}
-																position++
-																goto l845
-															l846:
-																position, tokenIndex = position845, tokenIndex845
-																if buffer[position] != rune('J') {
-																	goto l812
-																}
-																position++
-															}
-														l845:
-															{
-																position847, tokenIndex847 := position, tokenIndex
-																if buffer[position] != rune('r') {
-																	goto l848
-																}
-																position++
-																goto l847
-															l848:
-																position, tokenIndex = position847, tokenIndex847
-																if buffer[position] != rune('R') {
-																	goto l812
-																}
-																position++
-															}
-														l847:
-															if !_rules[rulews]() {
-																goto l812
-															}
-															{
-																position849, tokenIndex849 := position, tokenIndex
-																if !_rules[rulecc]() {
-																	goto l849
-																}
-																if !_rules[rulesep]() {
-																	goto l849
-																}
-																goto l850
-															l849:
-																position, tokenIndex = position849, tokenIndex849
-															}
-														l850:
-															if !_rules[ruledisp]() {
-																goto l812
-															}
-															{
-																add(ruleAction103, position)
-															}
-															add(ruleJr, position844)
+														position++
+														goto l1190
+													l1191:
+														position, tokenIndex = position1190, tokenIndex1190
+														if buffer[position] != rune('R') {
+															goto l1088
 														}
-														break
-													case 'R', 'r':
-														{
-															position852 := position
-															{
-																position853, tokenIndex853 := position, tokenIndex
-																if buffer[position] != rune('r') {
-																	goto l854
-																}
-																position++
-																goto l853
-															l854:
-																position, tokenIndex = position853, tokenIndex853
-																if buffer[position] != rune('R') {
-																	goto l812
-																}
-																position++
-															}
-														l853:
-															{
-																position855, tokenIndex855 := position, tokenIndex
-																if buffer[position] != rune('e') {
-																	goto l856
-																}
-																position++
-																goto l855
-															l856:
-																position, tokenIndex = position855, tokenIndex855
-																if buffer[position] != rune('E') {
-																	goto l812
-																}
-																position++
-															}
-														l855:
-															{
-																position857, tokenIndex857 := position, tokenIndex
-																if buffer[position] != rune('t') {
-																	goto l858
-																}
-																position++
-																goto l857
-															l858:
-																position, tokenIndex = position857, tokenIndex857
-																if buffer[position] != rune('T') {
-																	goto l812
-																}
-																position++
-															}
-														l857:
-															{
-																position859, tokenIndex859 := position, tokenIndex
-																if !_rules[rulews]() {
-																	goto l859
-																}
-																if !_rules[rulecc]() {
-																	goto l859
-																}
-																goto l860
-															l859:
-																position, tokenIndex = position859, tokenIndex859
-															}
-														l860:
-															{
-																add(ruleAction101, position)
-															}
-															add(ruleRet, position852)
+														position++
+													}
+												l1190:
+													{
+														position1192, tokenIndex1192 := position, tokenIndex
+														if buffer[position] != rune('r') {
+															goto l1193
 														}
-														break
-													default:
-														{
-															position862 := position
-															{
-																position863, tokenIndex863 := position, tokenIndex
-																if buffer[position] != rune('c') {
-																	goto l864
-																}
-																position++
-																goto l863
-															l864:
-																position, tokenIndex = position863, tokenIndex863
-																if buffer[position] != rune('C') {
-																	goto l812
-																}
-																position++
-															}
-														l863:
-															{
-																position865, tokenIndex865 := position, tokenIndex
-																if buffer[position] != rune('a') {
-																	goto l866
-																}
-																position++
-																goto l865
-															l866:
-																position, tokenIndex = position865, tokenIndex865
-																if buffer[position] != rune('A') {
-																	goto l812
-																}
-																position++
-															}
-														l865:
-															{
-																position867, tokenIndex867 := position, tokenIndex
-																if buffer[position] != rune('l') {
-																	goto l868
-																}
-																position++
-																goto l867
-															l868:
-																position, tokenIndex = position867, tokenIndex867
-																if buffer[position] != rune('L') {
-																	goto l812
-																}
-																position++
-															}
-														l867:
-															{
-																position869, tokenIndex869 := position, tokenIndex
-																if buffer[position] != rune('l') {
-																	goto l870
-																}
-																position++
-																goto l869
-															l870:
-																position, tokenIndex = position869, tokenIndex869
-																if buffer[position] != rune('L') {
-																	goto l812
-																}
-																position++
-															}
-														l869:
-															if !_rules[rulews]() {
-																goto l812
-															}
-															{
-																position871, tokenIndex871 := position, tokenIndex
-																if !_rules[rulecc]() {
-																	goto l871
-																}
-																if !_rules[rulesep]() {
-																	goto l871
-																}
-																goto l872
-															l871:
-																position, tokenIndex = position871, tokenIndex871
-															}
-														l872:
-															if !_rules[ruleSrc16]() {
-																goto l812
-															}
-															{
-																add(ruleAction100, position)
-															}
-															add(ruleCall, position862)
+														position++
+														goto l1192
+													l1193:
+														position, tokenIndex = position1192, tokenIndex1192
+														if buffer[position] != rune('R') {
+															goto l1088
 														}
-														break
+														position++
+													}
+												l1192:
+													{
+														position1194, tokenIndex1194 := position, tokenIndex
+														if buffer[position] != rune('a') {
+															goto l1195
+														}
+														position++
+														goto l1194
+													l1195:
+														position, tokenIndex = position1194, tokenIndex1194
+														if buffer[position] != rune('A') {
+															goto l1088
+														}
+														position++
 													}
+												l1194:
+													add(rulePegText, position1189)
 												}
-
+												{
+													add(ruleAction138, position)
+												}
+												add(ruleRra, position1188)
 											}
-										l814:
-											add(ruleJump, position813)
-										}
-										goto l89
-									l812:
-										position, tokenIndex = position89, tokenIndex89
-										{
-											position874 := position
+											break
+										case 'H', 'h':
 											{
-												position875, tokenIndex875 := position, tokenIndex
+												position1197 := position
 												{
-													position877 := position
+													position1198 := position
 													{
-														position878, tokenIndex878 := position, tokenIndex
-														if buffer[position] != rune('i') {
-															goto l879
+														position1199, tokenIndex1199 := position, tokenIndex
+														if buffer[position] != rune('h') {
+															goto l1200
 														}
 														position++
-														goto l878
-													l879:
-														position, tokenIndex = position878, tokenIndex878
-														if buffer[position] != rune('I') {
-															goto l876
+														goto l1199
+													l1200:
+														position, tokenIndex = position1199, tokenIndex1199
+														if buffer[position] != rune('H') {
+															goto l1088
+														}
+														position++
+													}
+												l1199:
+													{
+														position1201, tokenIndex1201 := position, tokenIndex
+														if buffer[position] != rune('a') {
+															goto l1202
+														}
+														position++
+														goto l1201
+													l1202:
+														position, tokenIndex = position1201, tokenIndex1201
+														if buffer[position] != rune('A') {
+															goto l1088
+														}
+														position++
+													}
+												l1201:
+													{
+														position1203, tokenIndex1203 := position, tokenIndex
+														if buffer[position] != rune('l') {
+															goto l1204
+														}
+														position++
+														goto l1203
+													l1204:
+														position, tokenIndex = position1203, tokenIndex1203
+														if buffer[position] != rune('L') {
+															goto l1088
 														}
 														position++
 													}
-												l878:
+												l1203:
 													{
-														position880, tokenIndex880 := position, tokenIndex
-														if buffer[position] != rune('n') {
-															goto l881
+														position1205, tokenIndex1205 := position, tokenIndex
+														if buffer[position] != rune('t') {
+															goto l1206
 														}
 														position++
-														goto l880
-													l881:
-														position, tokenIndex = position880, tokenIndex880
-														if buffer[position] != rune('N') {
-															goto l876
+														goto l1205
+													l1206:
+														position, tokenIndex = position1205, tokenIndex1205
+														if buffer[position] != rune('T') {
+															goto l1088
 														}
 														position++
 													}
-												l880:
-													if !_rules[rulews]() {
-														goto l876
-													}
-													if !_rules[ruleReg8]() {
-														goto l876
-													}
-													if !_rules[rulesep]() {
-														goto l876
-													}
-													if !_rules[rulePort]() {
-														goto l876
-													}
-													{
-														add(ruleAction105, position)
-													}
-													add(ruleIN, position877)
+												l1205:
+													add(rulePegText, position1198)
+												}
+												{
+													add(ruleAction134, position)
 												}
-												goto l875
-											l876:
-												position, tokenIndex = position875, tokenIndex875
+												add(ruleHalt, position1197)
+											}
+											break
+										default:
+											{
+												position1208 := position
 												{
-													position883 := position
+													position1209 := position
 													{
-														position884, tokenIndex884 := position, tokenIndex
-														if buffer[position] != rune('o') {
-															goto l885
+														position1210, tokenIndex1210 := position, tokenIndex
+														if buffer[position] != rune('n') {
+															goto l1211
 														}
 														position++
-														goto l884
-													l885:
-														position, tokenIndex = position884, tokenIndex884
-														if buffer[position] != rune('O') {
-															goto l15
+														goto l1210
+													l1211:
+														position, tokenIndex = position1210, tokenIndex1210
+														if buffer[position] != rune('N') {
+															goto l1088
 														}
 														position++
 													}
-												l884:
+												l1210:
 													{
-														position886, tokenIndex886 := position, tokenIndex
-														if buffer[position] != rune('u') {
-															goto l887
+														position1212, tokenIndex1212 := position, tokenIndex
+														if buffer[position] != rune('o') {
+															goto l1213
 														}
 														position++
-														goto l886
-													l887:
-														position, tokenIndex = position886, tokenIndex886
-														if buffer[position] != rune('U') {
-															goto l15
+														goto l1212
+													l1213:
+														position, tokenIndex = position1212, tokenIndex1212
+														if buffer[position] != rune('O') {
+															goto l1088
 														}
 														position++
 													}
-												l886:
+												l1212:
 													{
-														position888, tokenIndex888 := position, tokenIndex
-														if buffer[position] != rune('t') {
-															goto l889
+														position1214, tokenIndex1214 := position, tokenIndex
+														if buffer[position] != rune('p') {
+															goto l1215
 														}
 														position++
-														goto l888
-													l889:
-														position, tokenIndex = position888, tokenIndex888
-														if buffer[position] != rune('T') {
-															goto l15
+														goto l1214
+													l1215:
+														position, tokenIndex = position1214, tokenIndex1214
+														if buffer[position] != rune('P') {
+															goto l1088
 														}
 														position++
 													}
-												l888:
-													if !_rules[rulews]() {
-														goto l15
-													}
-													if !_rules[rulePort]() {
-														goto l15
-													}
-													if !_rules[rulesep]() {
-														goto l15
-													}
-													if !_rules[ruleReg8]() {
-														goto l15
-													}
-													{
-														add(ruleAction106, position)
-													}
-													add(ruleOUT, position883)
+												l1214:
+													add(rulePegText, position1209)
+												}
+												{
+													add(ruleAction133, position)
 												}
+												add(ruleNop, position1208)
 											}
-										l875:
-											add(ruleIO, position874)
+											break
 										}
 									}
-								l89:
-									add(ruleInstruction, position88)
-								}
-							}
-						l18:
-							add(ruleStatement, position17)
-						}
-						goto l16
-					l15:
-						position, tokenIndex = position15, tokenIndex15
-					}
-				l16:
-					{
-						position891, tokenIndex891 := position, tokenIndex
-						if !_rules[rulews]() {
-							goto l891
-						}
-						goto l892
-					l891:
-						position, tokenIndex = position891, tokenIndex891
-					}
-				l892:
-					{
-						position893, tokenIndex893 := position, tokenIndex
-						{
-							position895 := position
-							{
-								position896, tokenIndex896 := position, tokenIndex
-								if buffer[position] != rune(';') {
-									goto l897
-								}
-								position++
-								goto l896
-							l897:
-								position, tokenIndex = position896, tokenIndex896
-								if buffer[position] != rune('#') {
-									goto l893
-								}
-								position++
-							}
-						l896:
-						l898:
-							{
-								position899, tokenIndex899 := position, tokenIndex
-								{
-									position900, tokenIndex900 := position, tokenIndex
-									if buffer[position] != rune('\n') {
-										goto l900
-									}
-									position++
-									goto l899
-								l900:
-									position, tokenIndex = position900, tokenIndex900
-								}
-								if !matchDot() {
-									goto l899
+
 								}
-								goto l898
-							l899:
-								position, tokenIndex = position899, tokenIndex899
-							}
-							add(ruleComment, position895)
-						}
-						goto l894
-					l893:
-						position, tokenIndex = position893, tokenIndex893
-					}
-				l894:
-					{
-						position901, tokenIndex901 := position, tokenIndex
-						if !_rules[rulews]() {
-							goto l901
-						}
-						goto l902
-					l901:
-						position, tokenIndex = position901, tokenIndex901
-					}
-				l902:
-					{
-						position903, tokenIndex903 := position, tokenIndex
-						{
-							position905, tokenIndex905 := position, tokenIndex
-							if buffer[position] != rune('\r') {
-								goto l905
-							}
-							position++
-							goto l906
-						l905:
-							position, tokenIndex = position905, tokenIndex905
-						}
-					l906:
-						if buffer[position] != rune('\n') {
-							goto l904
-						}
-						position++
-						goto l903
-					l904:
-						position, tokenIndex = position903, tokenIndex903
-						if buffer[position] != rune(':') {
-							goto l0
-						}
-						position++
-					}
-				l903:
-					{
-						add(ruleAction0, position)
-					}
-					add(ruleLine, position4)
-				}
-			l2:
-				{
-					position3, tokenIndex3 := position, tokenIndex
-					{
-						position908 := position
-					l909:
-						{
-							position910, tokenIndex910 := position, tokenIndex
-							if !_rules[rulews]() {
-								goto l910
+							l1090:
+								add(ruleSimple, position1089)
 							}
-							goto l909
-						l910:
-							position, tokenIndex = position910, tokenIndex910
-						}
-						{
-							position911, tokenIndex911 := position, tokenIndex
+							goto l468
+						l1088:
+							position, tokenIndex = position468, tokenIndex468
 							{
-								position913 := position
-								if !_rules[ruleLabelText]() {
-									goto l911
-								}
-								if buffer[position] != rune(':') {
-									goto l911
-								}
-								position++
+								position1218 := position
 								{
-									position914, tokenIndex914 := position, tokenIndex
-									if !_rules[rulews]() {
-										goto l914
+									position1219, tokenIndex1219 := position, tokenIndex
+									{
+										position1221 := position
+										{
+											position1222, tokenIndex1222 := position, tokenIndex
+											if buffer[position] != rune('r') {
+												goto l1223
+											}
+											position++
+											goto l1222
+										l1223:
+											position, tokenIndex = position1222, tokenIndex1222
+											if buffer[position] != rune('R') {
+												goto l1220
+											}
+											position++
+										}
+									l1222:
+										{
+											position1224, tokenIndex1224 := position, tokenIndex
+											if buffer[position] != rune('s') {
+												goto l1225
+											}
+											position++
+											goto l1224
+										l1225:
+											position, tokenIndex = position1224, tokenIndex1224
+											if buffer[position] != rune('S') {
+												goto l1220
+											}
+											position++
+										}
+									l1224:
+										{
+											position1226, tokenIndex1226 := position, tokenIndex
+											if buffer[position] != rune('t') {
+												goto l1227
+											}
+											position++
+											goto l1226
+										l1227:
+											position, tokenIndex = position1226, tokenIndex1226
+											if buffer[position] != rune('T') {
+												goto l1220
+											}
+											position++
+										}
+									l1226:
+										if !_rules[rulews]() {
+											goto l1220
+										}
+										if !_rules[rulen]() {
+											goto l1220
+										}
+										{
+											add(ruleAction170, position)
+										}
+										add(ruleRst, position1221)
 									}
-									goto l915
-								l914:
-									position, tokenIndex = position914, tokenIndex914
-								}
-							l915:
-								{
-									add(ruleAction5, position)
-								}
-								add(ruleLabelDefn, position913)
-							}
-							goto l912
-						l911:
-							position, tokenIndex = position911, tokenIndex911
-						}
-					l912:
-					l917:
-						{
-							position918, tokenIndex918 := position, tokenIndex
-							if !_rules[rulews]() {
-								goto l918
-							}
-							goto l917
-						l918:
-							position, tokenIndex = position918, tokenIndex918
-						}
-						{
-							position919, tokenIndex919 := position, tokenIndex
-							{
-								position921 := position
-								{
-									position922, tokenIndex922 := position, tokenIndex
+									goto l1219
+								l1220:
+									position, tokenIndex = position1219, tokenIndex1219
 									{
-										position924 := position
+										position1230 := position
 										{
-											position925, tokenIndex925 := position, tokenIndex
+											position1231, tokenIndex1231 := position, tokenIndex
+											{
+												position1233, tokenIndex1233 := position, tokenIndex
+												if buffer[position] != rune('j') {
+													goto l1234
+												}
+												position++
+												goto l1233
+											l1234:
+												position, tokenIndex = position1233, tokenIndex1233
+												if buffer[position] != rune('J') {
+													goto l1232
+												}
+												position++
+											}
+										l1233:
+											{
+												position1235, tokenIndex1235 := position, tokenIndex
+												if buffer[position] != rune('p') {
+													goto l1236
+												}
+												position++
+												goto l1235
+											l1236:
+												position, tokenIndex = position1235, tokenIndex1235
+												if buffer[position] != rune('P') {
+													goto l1232
+												}
+												position++
+											}
+										l1235:
+											if !_rules[rulews]() {
+												goto l1232
+											}
 											{
-												position927 := position
+												position1237 := position
+												if buffer[position] != rune('(') {
+													goto l1232
+												}
+												position++
+												{
+													position1238, tokenIndex1238 := position, tokenIndex
+													if !_rules[rulews]() {
+														goto l1238
+													}
+													goto l1239
+												l1238:
+													position, tokenIndex = position1238, tokenIndex1238
+												}
+											l1239:
 												{
-													position928, tokenIndex928 := position, tokenIndex
+													position1240 := position
 													{
-														position930, tokenIndex930 := position, tokenIndex
-														if buffer[position] != rune('d') {
-															goto l931
-														}
-														position++
-														goto l930
-													l931:
-														position, tokenIndex = position930, tokenIndex930
-														if buffer[position] != rune('D') {
-															goto l929
+														position1241 := position
+														{
+															position1242, tokenIndex1242 := position, tokenIndex
+															if !_rules[ruleHL]() {
+																goto l1243
+															}
+															goto l1242
+														l1243:
+															position, tokenIndex = position1242, tokenIndex1242
+															if !_rules[ruleIX]() {
+																goto l1244
+															}
+															goto l1242
+														l1244:
+															position, tokenIndex = position1242, tokenIndex1242
+															if !_rules[ruleIY]() {
+																goto l1232
+															}
 														}
-														position++
+													l1242:
+														add(rulePegText, position1241)
 													}
-												l930:
 													{
-														position932, tokenIndex932 := position, tokenIndex
-														if buffer[position] != rune('e') {
-															goto l933
-														}
-														position++
-														goto l932
-													l933:
-														position, tokenIndex = position932, tokenIndex932
-														if buffer[position] != rune('E') {
-															goto l929
+														position1245, tokenIndex1245 := position, tokenIndex
+														if !_rules[rulealphaundnum]() {
+															goto l1245
 														}
-														position++
+														goto l1232
+													l1245:
+														position, tokenIndex = position1245, tokenIndex1245
 													}
-												l932:
 													{
-														position934, tokenIndex934 := position, tokenIndex
-														if buffer[position] != rune('f') {
-															goto l935
-														}
-														position++
-														goto l934
-													l935:
-														position, tokenIndex = position934, tokenIndex934
-														if buffer[position] != rune('F') {
-															goto l929
+														add(ruleAction178, position)
+													}
+													add(ruleJpReg, position1240)
+												}
+												{
+													position1247, tokenIndex1247 := position, tokenIndex
+													if !_rules[rulews]() {
+														goto l1247
+													}
+													goto l1248
+												l1247:
+													position, tokenIndex = position1247, tokenIndex1247
+												}
+											l1248:
+												if buffer[position] != rune(')') {
+													goto l1232
+												}
+												position++
+												{
+													add(ruleAction177, position)
+												}
+												add(ruleJpIndirect, position1237)
+											}
+											{
+												add(ruleAction173, position)
+											}
+											goto l1231
+										l1232:
+											position, tokenIndex = position1231, tokenIndex1231
+											{
+												position1251, tokenIndex1251 := position, tokenIndex
+												if buffer[position] != rune('j') {
+													goto l1252
+												}
+												position++
+												goto l1251
+											l1252:
+												position, tokenIndex = position1251, tokenIndex1251
+												if buffer[position] != rune('J') {
+													goto l1229
+												}
+												position++
+											}
+										l1251:
+											{
+												position1253, tokenIndex1253 := position, tokenIndex
+												if buffer[position] != rune('p') {
+													goto l1254
+												}
+												position++
+												goto l1253
+											l1254:
+												position, tokenIndex = position1253, tokenIndex1253
+												if buffer[position] != rune('P') {
+													goto l1229
+												}
+												position++
+											}
+										l1253:
+											if !_rules[rulews]() {
+												goto l1229
+											}
+											{
+												position1255, tokenIndex1255 := position, tokenIndex
+												if !_rules[rulecc]() {
+													goto l1255
+												}
+												if !_rules[rulesep]() {
+													goto l1255
+												}
+												goto l1256
+											l1255:
+												position, tokenIndex = position1255, tokenIndex1255
+											}
+										l1256:
+											if !_rules[ruleSrc16]() {
+												goto l1229
+											}
+											{
+												add(ruleAction174, position)
+											}
+										}
+									l1231:
+										add(ruleJp, position1230)
+									}
+									goto l1219
+								l1229:
+									position, tokenIndex = position1219, tokenIndex1219
+									{
+										switch buffer[position] {
+										case 'D', 'd':
+											{
+												position1259 := position
+												{
+													position1260, tokenIndex1260 := position, tokenIndex
+													if buffer[position] != rune('d') {
+														goto l1261
+													}
+													position++
+													goto l1260
+												l1261:
+													position, tokenIndex = position1260, tokenIndex1260
+													if buffer[position] != rune('D') {
+														goto l1217
+													}
+													position++
+												}
+											l1260:
+												{
+													position1262, tokenIndex1262 := position, tokenIndex
+													if buffer[position] != rune('j') {
+														goto l1263
+													}
+													position++
+													goto l1262
+												l1263:
+													position, tokenIndex = position1262, tokenIndex1262
+													if buffer[position] != rune('J') {
+														goto l1217
+													}
+													position++
+												}
+											l1262:
+												{
+													position1264, tokenIndex1264 := position, tokenIndex
+													if buffer[position] != rune('n') {
+														goto l1265
+													}
+													position++
+													goto l1264
+												l1265:
+													position, tokenIndex = position1264, tokenIndex1264
+													if buffer[position] != rune('N') {
+														goto l1217
+													}
+													position++
+												}
+											l1264:
+												{
+													position1266, tokenIndex1266 := position, tokenIndex
+													if buffer[position] != rune('z') {
+														goto l1267
+													}
+													position++
+													goto l1266
+												l1267:
+													position, tokenIndex = position1266, tokenIndex1266
+													if buffer[position] != rune('Z') {
+														goto l1217
+													}
+													position++
+												}
+											l1266:
+												if !_rules[rulews]() {
+													goto l1217
+												}
+												{
+													position1268, tokenIndex1268 := position, tokenIndex
+													if !_rules[ruledisp]() {
+														goto l1269
+													}
+													{
+														position1270, tokenIndex1270 := position, tokenIndex
+														if !_rules[ruleopEnd]() {
+															goto l1269
 														}
-														position++
+														position, tokenIndex = position1270, tokenIndex1270
+													}
+													goto l1268
+												l1269:
+													position, tokenIndex = position1268, tokenIndex1268
+													if !_rules[ruleJrTarget]() {
+														goto l1217
+													}
+												}
+											l1268:
+												{
+													add(ruleAction176, position)
+												}
+												add(ruleDjnz, position1259)
+											}
+											break
+										case 'J', 'j':
+											{
+												position1272 := position
+												{
+													position1273, tokenIndex1273 := position, tokenIndex
+													if buffer[position] != rune('j') {
+														goto l1274
+													}
+													position++
+													goto l1273
+												l1274:
+													position, tokenIndex = position1273, tokenIndex1273
+													if buffer[position] != rune('J') {
+														goto l1217
+													}
+													position++
+												}
+											l1273:
+												{
+													position1275, tokenIndex1275 := position, tokenIndex
+													if buffer[position] != rune('r') {
+														goto l1276
+													}
+													position++
+													goto l1275
+												l1276:
+													position, tokenIndex = position1275, tokenIndex1275
+													if buffer[position] != rune('R') {
+														goto l1217
+													}
+													position++
+												}
+											l1275:
+												if !_rules[rulews]() {
+													goto l1217
+												}
+												{
+													position1277, tokenIndex1277 := position, tokenIndex
+													if !_rules[rulecc]() {
+														goto l1277
 													}
-												l934:
-													{
-														position936, tokenIndex936 := position, tokenIndex
-														if buffer[position] != rune('b') {
-															goto l937
-														}
-														position++
-														goto l936
-													l937:
-														position, tokenIndex = position936, tokenIndex936
-														if buffer[position] != rune('B') {
-															goto l929
-														}
-														position++
+													if !_rules[rulesep]() {
+														goto l1277
 													}
-												l936:
-													goto l928
-												l929:
-													position, tokenIndex = position928, tokenIndex928
-													{
-														position938, tokenIndex938 := position, tokenIndex
-														if buffer[position] != rune('d') {
-															goto l939
-														}
-														position++
-														goto l938
-													l939:
-														position, tokenIndex = position938, tokenIndex938
-														if buffer[position] != rune('D') {
-															goto l926
-														}
-														position++
+													goto l1278
+												l1277:
+													position, tokenIndex = position1277, tokenIndex1277
+												}
+											l1278:
+												{
+													position1279, tokenIndex1279 := position, tokenIndex
+													if !_rules[ruledisp]() {
+														goto l1280
 													}
-												l938:
 													{
-														position940, tokenIndex940 := position, tokenIndex
-														if buffer[position] != rune('b') {
-															goto l941
+														position1281, tokenIndex1281 := position, tokenIndex
+														if !_rules[ruleopEnd]() {
+															goto l1280
 														}
-														position++
-														goto l940
-													l941:
-														position, tokenIndex = position940, tokenIndex940
-														if buffer[position] != rune('B') {
-															goto l926
-														}
-														position++
+														position, tokenIndex = position1281, tokenIndex1281
+													}
+													goto l1279
+												l1280:
+													position, tokenIndex = position1279, tokenIndex1279
+													if !_rules[ruleJrTarget]() {
+														goto l1217
 													}
-												l940:
-												}
-											l928:
-												if !_rules[rulews]() {
-													goto l926
-												}
-												if !_rules[rulen]() {
-													goto l926
 												}
+											l1279:
 												{
-													add(ruleAction2, position)
+													add(ruleAction175, position)
 												}
-												add(ruleDefb, position927)
+												add(ruleJr, position1272)
 											}
-											goto l925
-										l926:
-											position, tokenIndex = position925, tokenIndex925
+											break
+										case 'R', 'r':
 											{
-												position944 := position
+												position1283 := position
 												{
-													position945, tokenIndex945 := position, tokenIndex
-													{
-														position947, tokenIndex947 := position, tokenIndex
-														if buffer[position] != rune('d') {
-															goto l948
-														}
-														position++
-														goto l947
-													l948:
-														position, tokenIndex = position947, tokenIndex947
-														if buffer[position] != rune('D') {
-															goto l946
-														}
-														position++
+													position1284, tokenIndex1284 := position, tokenIndex
+													if buffer[position] != rune('r') {
+														goto l1285
 													}
-												l947:
-													{
-														position949, tokenIndex949 := position, tokenIndex
-														if buffer[position] != rune('e') {
-															goto l950
-														}
-														position++
-														goto l949
-													l950:
-														position, tokenIndex = position949, tokenIndex949
-														if buffer[position] != rune('E') {
-															goto l946
-														}
-														position++
+													position++
+													goto l1284
+												l1285:
+													position, tokenIndex = position1284, tokenIndex1284
+													if buffer[position] != rune('R') {
+														goto l1217
 													}
-												l949:
-													{
-														position951, tokenIndex951 := position, tokenIndex
-														if buffer[position] != rune('f') {
-															goto l952
-														}
-														position++
-														goto l951
-													l952:
-														position, tokenIndex = position951, tokenIndex951
-														if buffer[position] != rune('F') {
-															goto l946
-														}
-														position++
+													position++
+												}
+											l1284:
+												{
+													position1286, tokenIndex1286 := position, tokenIndex
+													if buffer[position] != rune('e') {
+														goto l1287
 													}
-												l951:
-													{
-														position953, tokenIndex953 := position, tokenIndex
-														if buffer[position] != rune('s') {
-															goto l954
-														}
-														position++
-														goto l953
-													l954:
-														position, tokenIndex = position953, tokenIndex953
-														if buffer[position] != rune('S') {
-															goto l946
-														}
-														position++
+													position++
+													goto l1286
+												l1287:
+													position, tokenIndex = position1286, tokenIndex1286
+													if buffer[position] != rune('E') {
+														goto l1217
 													}
-												l953:
-													goto l945
-												l946:
-													position, tokenIndex = position945, tokenIndex945
-													{
-														position955, tokenIndex955 := position, tokenIndex
-														if buffer[position] != rune('d') {
-															goto l956
-														}
-														position++
-														goto l955
-													l956:
-														position, tokenIndex = position955, tokenIndex955
-														if buffer[position] != rune('D') {
-															goto l943
-														}
-														position++
+													position++
+												}
+											l1286:
+												{
+													position1288, tokenIndex1288 := position, tokenIndex
+													if buffer[position] != rune('t') {
+														goto l1289
 													}
-												l955:
-													{
-														position957, tokenIndex957 := position, tokenIndex
-														if buffer[position] != rune('s') {
-															goto l958
-														}
-														position++
-														goto l957
-													l958:
-														position, tokenIndex = position957, tokenIndex957
-														if buffer[position] != rune('S') {
-															goto l943
-														}
-														position++
+													position++
+													goto l1288
+												l1289:
+													position, tokenIndex = position1288, tokenIndex1288
+													if buffer[position] != rune('T') {
+														goto l1217
 													}
-												l957:
-												}
-											l945:
-												if !_rules[rulews]() {
-													goto l943
+													position++
 												}
-												if !_rules[rulen]() {
-													goto l943
+											l1288:
+												{
+													position1290, tokenIndex1290 := position, tokenIndex
+													if !_rules[rulews]() {
+														goto l1290
+													}
+													if !_rules[rulecc]() {
+														goto l1290
+													}
+													goto l1291
+												l1290:
+													position, tokenIndex = position1290, tokenIndex1290
 												}
+											l1291:
 												{
-													add(ruleAction4, position)
+													add(ruleAction172, position)
 												}
-												add(ruleDefs, position944)
+												add(ruleRet, position1283)
 											}
-											goto l925
-										l943:
-											position, tokenIndex = position925, tokenIndex925
+											break
+										default:
 											{
-												switch buffer[position] {
-												case 'D', 'd':
-													{
-														position961 := position
-														{
-															position962, tokenIndex962 := position, tokenIndex
-															{
-																position964, tokenIndex964 := position, tokenIndex
-																if buffer[position] != rune('d') {
-																	goto l965
-																}
-																position++
-																goto l964
-															l965:
-																position, tokenIndex = position964, tokenIndex964
-																if buffer[position] != rune('D') {
-																	goto l963
-																}
-																position++
-															}
-														l964:
-															{
-																position966, tokenIndex966 := position, tokenIndex
-																if buffer[position] != rune('e') {
-																	goto l967
-																}
-																position++
-																goto l966
-															l967:
-																position, tokenIndex = position966, tokenIndex966
-																if buffer[position] != rune('E') {
-																	goto l963
-																}
-																position++
-															}
-														l966:
-															{
-																position968, tokenIndex968 := position, tokenIndex
-																if buffer[position] != rune('f') {
-																	goto l969
-																}
-																position++
-																goto l968
-															l969:
-																position, tokenIndex = position968, tokenIndex968
-																if buffer[position] != rune('F') {
-																	goto l963
-																}
-																position++
-															}
-														l968:
-															{
-																position970, tokenIndex970 := position, tokenIndex
-																if buffer[position] != rune('w') {
-																	goto l971
-																}
-																position++
-																goto l970
-															l971:
-																position, tokenIndex = position970, tokenIndex970
-																if buffer[position] != rune('W') {
-																	goto l963
-																}
-																position++
-															}
-														l970:
-															goto l962
-														l963:
-															position, tokenIndex = position962, tokenIndex962
-															{
-																position972, tokenIndex972 := position, tokenIndex
-																if buffer[position] != rune('d') {
-																	goto l973
-																}
-																position++
-																goto l972
-															l973:
-																position, tokenIndex = position972, tokenIndex972
-																if buffer[position] != rune('D') {
-																	goto l923
-																}
-																position++
-															}
-														l972:
-															{
-																position974, tokenIndex974 := position, tokenIndex
-																if buffer[position] != rune('w') {
-																	goto l975
-																}
-																position++
-																goto l974
-															l975:
-																position, tokenIndex = position974, tokenIndex974
-																if buffer[position] != rune('W') {
-																	goto l923
-																}
-																position++
-															}
-														l974:
-														}
-													l962:
-														if !_rules[rulews]() {
-															goto l923
-														}
-														if !_rules[rulenn]() {
-															goto l923
-														}
-														{
-															add(ruleAction3, position)
-														}
-														add(ruleDefw, position961)
+												position1293 := position
+												{
+													position1294, tokenIndex1294 := position, tokenIndex
+													if buffer[position] != rune('c') {
+														goto l1295
 													}
-													break
-												case 'O', 'o':
-													{
-														position977 := position
-														{
-															position978, tokenIndex978 := position, tokenIndex
-															if buffer[position] != rune('o') {
-																goto l979
-															}
-															position++
-															goto l978
-														l979:
-															position, tokenIndex = position978, tokenIndex978
-															if buffer[position] != rune('O') {
-																goto l923
-															}
-															position++
-														}
-													l978:
-														{
-															position980, tokenIndex980 := position, tokenIndex
-															if buffer[position] != rune('r') {
-																goto l981
-															}
-															position++
-															goto l980
-														l981:
-															position, tokenIndex = position980, tokenIndex980
-															if buffer[position] != rune('R') {
-																goto l923
-															}
-															position++
-														}
-													l980:
-														{
-															position982, tokenIndex982 := position, tokenIndex
-															if buffer[position] != rune('g') {
-																goto l983
-															}
-															position++
-															goto l982
-														l983:
-															position, tokenIndex = position982, tokenIndex982
-															if buffer[position] != rune('G') {
-																goto l923
-															}
-															position++
-														}
-													l982:
-														if !_rules[rulews]() {
-															goto l923
-														}
-														if !_rules[rulenn]() {
-															goto l923
-														}
-														{
-															add(ruleAction1, position)
-														}
-														add(ruleOrg, position977)
+													position++
+													goto l1294
+												l1295:
+													position, tokenIndex = position1294, tokenIndex1294
+													if buffer[position] != rune('C') {
+														goto l1217
 													}
-													break
-												case 'a':
-													{
-														position985 := position
-														if buffer[position] != rune('a') {
-															goto l923
-														}
-														position++
-														if buffer[position] != rune('s') {
-															goto l923
-														}
-														position++
-														if buffer[position] != rune('e') {
-															goto l923
-														}
-														position++
-														if buffer[position] != rune('g') {
-															goto l923
-														}
-														position++
-														add(ruleAseg, position985)
+													position++
+												}
+											l1294:
+												{
+													position1296, tokenIndex1296 := position, tokenIndex
+													if buffer[position] != rune('a') {
+														goto l1297
 													}
-													break
-												default:
-													{
-														position986 := position
-														{
-															position987, tokenIndex987 := position, tokenIndex
-															if buffer[position] != rune('.') {
-																goto l987
-															}
-															position++
-															goto l988
-														l987:
-															position, tokenIndex = position987, tokenIndex987
-														}
-													l988:
-														if buffer[position] != rune('t') {
-															goto l923
-														}
-														position++
-														if buffer[position] != rune('i') {
-															goto l923
-														}
-														position++
-														if buffer[position] != rune('t') {
-															goto l923
-														}
-														position++
-														if buffer[position] != rune('l') {
-															goto l923
-														}
-														position++
-														if buffer[position] != rune('e') {
-															goto l923
-														}
-														position++
-														if !_rules[rulews]() {
-															goto l923
-														}
-														if buffer[position] != rune('\'') {
-															goto l923
-														}
-														position++
-													l989:
-														{
-															position990, tokenIndex990 := position, tokenIndex
-															{
-																position991, tokenIndex991 := position, tokenIndex
-																if buffer[position] != rune('\'') {
-																	goto l991
-																}
-																position++
-																goto l990
-															l991:
-																position, tokenIndex = position991, tokenIndex991
-															}
-															if !matchDot() {
-																goto l990
-															}
-															goto l989
-														l990:
-															position, tokenIndex = position990, tokenIndex990
-														}
-														if buffer[position] != rune('\'') {
-															goto l923
-														}
-														position++
-														add(ruleTitle, position986)
+													position++
+													goto l1296
+												l1297:
+													position, tokenIndex = position1296, tokenIndex1296
+													if buffer[position] != rune('A') {
+														goto l1217
 													}
-													break
+													position++
+												}
+											l1296:
+												{
+													position1298, tokenIndex1298 := position, tokenIndex
+													if buffer[position] != rune('l') {
+														goto l1299
+													}
+													position++
+													goto l1298
+												l1299:
+													position, tokenIndex = position1298, tokenIndex1298
+													if buffer[position] != rune('L') {
+														goto l1217
+													}
+													position++
+												}
+											l1298:
+												{
+													position1300, tokenIndex1300 := position, tokenIndex
+													if buffer[position] != rune('l') {
+														goto l1301
+													}
+													position++
+													goto l1300
+												l1301:
+													position, tokenIndex = position1300, tokenIndex1300
+													if buffer[position] != rune('L') {
+														goto l1217
+													}
+													position++
+												}
+											l1300:
+												if !_rules[rulews]() {
+													goto l1217
+												}
+												{
+													position1302, tokenIndex1302 := position, tokenIndex
+													if !_rules[rulecc]() {
+														goto l1302
+													}
+													if !_rules[rulesep]() {
+														goto l1302
+													}
+													goto l1303
+												l1302:
+													position, tokenIndex = position1302, tokenIndex1302
+												}
+											l1303:
+												if !_rules[ruleSrc16]() {
+													goto l1217
+												}
+												{
+													add(ruleAction171, position)
 												}
+												add(ruleCall, position1293)
+											}
+											break
+										}
+									}
+
+								}
+							l1219:
+								add(ruleJump, position1218)
+							}
+							goto l468
+						l1217:
+							position, tokenIndex = position468, tokenIndex468
+							{
+								position1305 := position
+								{
+									position1306, tokenIndex1306 := position, tokenIndex
+									{
+										position1308 := position
+										{
+											position1309, tokenIndex1309 := position, tokenIndex
+											if buffer[position] != rune('i') {
+												goto l1310
+											}
+											position++
+											goto l1309
+										l1310:
+											position, tokenIndex = position1309, tokenIndex1309
+											if buffer[position] != rune('I') {
+												goto l1307
+											}
+											position++
+										}
+									l1309:
+										{
+											position1311, tokenIndex1311 := position, tokenIndex
+											if buffer[position] != rune('n') {
+												goto l1312
+											}
+											position++
+											goto l1311
+										l1312:
+											position, tokenIndex = position1311, tokenIndex1311
+											if buffer[position] != rune('N') {
+												goto l1307
+											}
+											position++
+										}
+									l1311:
+										if !_rules[rulews]() {
+											goto l1307
+										}
+										if !_rules[ruleReg8]() {
+											goto l1307
+										}
+										if !_rules[rulesep]() {
+											goto l1307
+										}
+										if !_rules[rulePort]() {
+											goto l1307
+										}
+										{
+											add(ruleAction180, position)
+										}
+										add(ruleIN, position1308)
+									}
+									goto l1306
+								l1307:
+									position, tokenIndex = position1306, tokenIndex1306
+									{
+										position1314 := position
+										{
+											position1315, tokenIndex1315 := position, tokenIndex
+											if buffer[position] != rune('o') {
+												goto l1316
+											}
+											position++
+											goto l1315
+										l1316:
+											position, tokenIndex = position1315, tokenIndex1315
+											if buffer[position] != rune('O') {
+												goto l157
+											}
+											position++
+										}
+									l1315:
+										{
+											position1317, tokenIndex1317 := position, tokenIndex
+											if buffer[position] != rune('u') {
+												goto l1318
+											}
+											position++
+											goto l1317
+										l1318:
+											position, tokenIndex = position1317, tokenIndex1317
+											if buffer[position] != rune('U') {
+												goto l157
 											}
+											position++
+										}
+									l1317:
+										{
+											position1319, tokenIndex1319 := position, tokenIndex
+											if buffer[position] != rune('t') {
+												goto l1320
+											}
+											position++
+											goto l1319
+										l1320:
+											position, tokenIndex = position1319, tokenIndex1319
+											if buffer[position] != rune('T') {
+												goto l157
+											}
+											position++
+										}
+									l1319:
+										if !_rules[rulews]() {
+											goto l157
+										}
+										if !_rules[rulePort]() {
+											goto l157
+										}
+										if !_rules[rulesep]() {
+											goto l157
+										}
+										if !_rules[ruleReg8]() {
+											goto l157
+										}
+										{
+											add(ruleAction181, position)
+										}
+										add(ruleOUT, position1314)
+									}
+								}
+							l1306:
+								add(ruleIO, position1305)
+							}
+						}
+					l468:
+						add(ruleInstruction, position467)
+					}
+				}
+			l159:
+				add(ruleStatement, position158)
+			}
+			return true
+		l157:
+			position, tokenIndex = position157, tokenIndex157
+			return false
+		},
+		/* 4 Directive <- <(Section / Public / Extern / Defl / Defb / Defz / Defc / Defs / ((&('A' | 'a') Align) | (&('D' | 'd') Defw) | (&('=' | 'E' | 'e') Equ) | (&('O' | 'o') Org) | (&('.' | 'T' | 't') Title)))> */
+		nil,
+		/* 5 Title <- <('.'? (('t' / 'T') ('i' / 'I') ('t' / 'T') ('l' / 'L') ('e' / 'E')) ws '\'' (!'\'' .)* '\'')> */
+		nil,
+		/* 6 Section <- <((&('A' | 'a') (('a' / 'A') ('s' / 'S') ('e' / 'E') ('g' / 'G') Action5)) | (&('D' | 'd') (('d' / 'D') ('s' / 'S') ('e' / 'E') ('g' / 'G') Action4)) | (&('C' | 'c') (('c' / 'C') ('s' / 'S') ('e' / 'E') ('g' / 'G') Action3)) | (&('S' | 's') (('s' / 'S') ('e' / 'E') ('c' / 'C') ('t' / 'T') ('i' / 'I') ('o' / 'O') ('n' / 'N') ws LabelText Action2)))> */
+		nil,
+		/* 7 Public <- <(((&('E' | 'e') (('e' / 'E') ('x' / 'X') ('p' / 'P') ('o' / 'O') ('r' / 'R') ('t' / 'T'))) | (&('X' | 'x') (('x' / 'X') ('d' / 'D') ('e' / 'E') ('f' / 'F'))) | (&('G' | 'g') (('g' / 'G') ('l' / 'L') ('o' / 'O') ('b' / 'B') ('a' / 'A') ('l' / 'L'))) | (&('P' | 'p') (('p' / 'P') ('u' / 'U') ('b' / 'B') ('l' / 'L') ('i' / 'I') ('c' / 'C')))) ws nameList Action6)> */
+		nil,
+		/* 8 Extern <- <(((('e' / 'E') ('x' / 'X') ('t' / 'T') ('e' / 'E') ('r' / 'R') ('n' / 'N')) / (('e' / 'E') ('x' / 'X') ('t' / 'T') ('r' / 'R') ('n' / 'N')) / (('x' / 'X') ('r' / 'R') ('e' / 'E') ('f' / 'F'))) ws nameList Action7)> */
+		nil,
+		/* 9 Org <- <(('o' / 'O') ('r' / 'R') ('g' / 'G') ws expr Action8)> */
+		nil,
+		/* 10 Equ <- <(((('e' / 'E') ('q' / 'Q') ('u' / 'U') ws) / ('=' ws?)) expr Action9)> */
+		nil,
+		/* 11 Defl <- <((((':' '=' ws?) / (('d' / 'D') ('e' / 'E') ('f' / 'F') ('l' / 'L') ws)) expr Action10) / (('s' / 'S') ('e' / 'E') ('t' / 'T') ws expr !(ws? ',') Action11))> */
+		nil,
+		/* 12 Defb <- <(((('d' / 'D') ('e' / 'E') ('f' / 'F') ('b' / 'B')) / (('d' / 'D') ('b' / 'B')) / (('d' / 'D') ('e' / 'E') ('f' / 'F') ('m' / 'M')) / (('d' / 'D') ('m' / 'M'))) ws dataList Action12)> */
+		nil,
+		/* 13 Defz <- <(((('d' / 'D') ('e' / 'E') ('f' / 'F') ('z' / 'Z')) / (('d' / 'D') ('z' / 'Z'))) ws dataList Action13)> */
+		nil,
+		/* 14 Defc <- <(('d' / 'D') ('c' / 'C') ws dataList Action14)> */
+		nil,
+		/* 15 Defw <- <(((('d' / 'D') ('e' / 'E') ('f' / 'F') ('w' / 'W')) / (('d' / 'D') ('w' / 'W'))) ws wordList Action15)> */
+		nil,
+		/* 16 Defs <- <(((('d' / 'D') ('e' / 'E') ('f' / 'F') ('s' / 'S')) / (('d' / 'D') ('s' / 'S'))) ws expr (sep expr Action16)? Action17)> */
+		nil,
+		/* 17 Align <- <(('a' / 'A') ('l' / 'L') ('i' / 'I') ('g' / 'G') ('n' / 'N') ws expr (sep expr Action18)? Action19)> */
+		nil,
+		/* 18 dataList <- <(dataItem (sep dataItem)*)> */
+		func() bool {
+			position1336, tokenIndex1336 := position, tokenIndex
+			{
+				position1337 := position
+				if !_rules[ruledataItem]() {
+					goto l1336
+				}
+			l1338:
+				{
+					position1339, tokenIndex1339 := position, tokenIndex
+					if !_rules[rulesep]() {
+						goto l1339
+					}
+					if !_rules[ruledataItem]() {
+						goto l1339
+					}
+					goto l1338
+				l1339:
+					position, tokenIndex = position1339, tokenIndex1339
+				}
+				add(ruledataList, position1337)
+			}
+			return true
+		l1336:
+			position, tokenIndex = position1336, tokenIndex1336
+			return false
+		},
+		/* 19 dataItem <- <(('"' <(!('"' / '\n') .)*> '"' &opEnd Action20) / ('\'' <(!('\'' / '\n') .)*> '\'' &opEnd Action21) / (expr Action22))> */
+		func() bool {
+			position1340, tokenIndex1340 := position, tokenIndex
+			{
+				position1341 := position
+				{
+					position1342, tokenIndex1342 := position, tokenIndex
+					if buffer[position] != rune('"') {
+						goto l1343
+					}
+					position++
+					{
+						position1344 := position
+					l1345:
+						{
+							position1346, tokenIndex1346 := position, tokenIndex
+							{
+								position1347, tokenIndex1347 := position, tokenIndex
+								{
+									position1348, tokenIndex1348 := position, tokenIndex
+									if buffer[position] != rune('"') {
+										goto l1349
+									}
+									position++
+									goto l1348
+								l1349:
+									position, tokenIndex = position1348, tokenIndex1348
+									if buffer[position] != rune('\n') {
+										goto l1347
+									}
+									position++
+								}
+							l1348:
+								goto l1346
+							l1347:
+								position, tokenIndex = position1347, tokenIndex1347
+							}
+							if !matchDot() {
+								goto l1346
+							}
+							goto l1345
+						l1346:
+							position, tokenIndex = position1346, tokenIndex1346
+						}
+						add(rulePegText, position1344)
+					}
+					if buffer[position] != rune('"') {
+						goto l1343
+					}
+					position++
+					{
+						position1350, tokenIndex1350 := position, tokenIndex
+						if !_rules[ruleopEnd]() {
+							goto l1343
+						}
+						position, tokenIndex = position1350, tokenIndex1350
+					}
+					{
+						add(ruleAction20, position)
+					}
+					goto l1342
+				l1343:
+					position, tokenIndex = position1342, tokenIndex1342
+					if buffer[position] != rune('\'') {
+						goto l1352
+					}
+					position++
+					{
+						position1353 := position
+					l1354:
+						{
+							position1355, tokenIndex1355 := position, tokenIndex
+							{
+								position1356, tokenIndex1356 := position, tokenIndex
+								{
+									position1357, tokenIndex1357 := position, tokenIndex
+									if buffer[position] != rune('\'') {
+										goto l1358
+									}
+									position++
+									goto l1357
+								l1358:
+									position, tokenIndex = position1357, tokenIndex1357
+									if buffer[position] != rune('\n') {
+										goto l1356
+									}
+									position++
+								}
+							l1357:
+								goto l1355
+							l1356:
+								position, tokenIndex = position1356, tokenIndex1356
+							}
+							if !matchDot() {
+								goto l1355
+							}
+							goto l1354
+						l1355:
+							position, tokenIndex = position1355, tokenIndex1355
+						}
+						add(rulePegText, position1353)
+					}
+					if buffer[position] != rune('\'') {
+						goto l1352
+					}
+					position++
+					{
+						position1359, tokenIndex1359 := position, tokenIndex
+						if !_rules[ruleopEnd]() {
+							goto l1352
+						}
+						position, tokenIndex = position1359, tokenIndex1359
+					}
+					{
+						add(ruleAction21, position)
+					}
+					goto l1342
+				l1352:
+					position, tokenIndex = position1342, tokenIndex1342
+					if !_rules[ruleexpr]() {
+						goto l1340
+					}
+					{
+						add(ruleAction22, position)
+					}
+				}
+			l1342:
+				add(ruledataItem, position1341)
+			}
+			return true
+		l1340:
+			position, tokenIndex = position1340, tokenIndex1340
+			return false
+		},
+		/* 20 wordList <- <(expr Action23 (sep expr Action24)*)> */
+		nil,
+		/* 21 nameList <- <(LabelText Action25 (sep LabelText Action26)*)> */
+		func() bool {
+			position1363, tokenIndex1363 := position, tokenIndex
+			{
+				position1364 := position
+				if !_rules[ruleLabelText]() {
+					goto l1363
+				}
+				{
+					add(ruleAction25, position)
+				}
+			l1366:
+				{
+					position1367, tokenIndex1367 := position, tokenIndex
+					if !_rules[rulesep]() {
+						goto l1367
+					}
+					if !_rules[ruleLabelText]() {
+						goto l1367
+					}
+					{
+						add(ruleAction26, position)
+					}
+					goto l1366
+				l1367:
+					position, tokenIndex = position1367, tokenIndex1367
+				}
+				add(rulenameList, position1364)
+			}
+			return true
+		l1363:
+			position, tokenIndex = position1363, tokenIndex1363
+			return false
+		},
+		/* 22 LabelDefn <- <((LabelText &(ws? (':' '=')) Action27) / (LabelText ':' ws? Action28) / (LabelText &(ws? ((&('S' | 's') (('s' / 'S') ('e' / 'E') ('t' / 'T') ws)) | (&('D' | 'd') (('d' / 'D') ('e' / 'E') ('f' / 'F') ('l' / 'L') ws)) | (&('=') '=') | (&('E' | 'e') (('e' / 'E') ('q' / 'Q') ('u' / 'U') ws)))) Action29))> */
+		nil,
+		/* 23 ColumnLabel <- <(!(Statement ws? Comment? ws? !(!((&('\n') '\n') | (&('\r') '\r') | (&(':') ':')) .)) LabelText !(!((&('\n') '\n') | (&('\r') '\r') | (&('\t') '\t') | (&(' ') ' ')) .) Action30)> */
+		nil,
+		/* 24 LabelText <- <<(((&('@') '@') | (&('?') '?') | (&('.') '.') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '_' | 'a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') alphaund)) labelchar*)>> */
+		func() bool {
+			position1371, tokenIndex1371 := position, tokenIndex
+			{
+				position1372 := position
+				{
+					position1373 := position
+					{
+						switch buffer[position] {
+						case '@':
+							if buffer[position] != rune('@') {
+								goto l1371
+							}
+							position++
+							break
+						case '?':
+							if buffer[position] != rune('?') {
+								goto l1371
+							}
+							position++
+							break
+						case '.':
+							if buffer[position] != rune('.') {
+								goto l1371
+							}
+							position++
+							break
+						default:
+							if !_rules[rulealphaund]() {
+								goto l1371
+							}
+							break
+						}
+					}
+
+				l1375:
+					{
+						position1376, tokenIndex1376 := position, tokenIndex
+						{
+							position1377 := position
+							{
+								switch buffer[position] {
+								case '@':
+									if buffer[position] != rune('@') {
+										goto l1376
+									}
+									position++
+									break
+								case '?':
+									if buffer[position] != rune('?') {
+										goto l1376
+									}
+									position++
+									break
+								case '.':
+									if buffer[position] != rune('.') {
+										goto l1376
+									}
+									position++
+									break
+								default:
+									if !_rules[rulealphaundnum]() {
+										goto l1376
+									}
+									break
+								}
+							}
+
+							add(rulelabelchar, position1377)
+						}
+						goto l1375
+					l1376:
+						position, tokenIndex = position1376, tokenIndex1376
+					}
+					add(rulePegText, position1373)
+				}
+				add(ruleLabelText, position1372)
+			}
+			return true
+		l1371:
+			position, tokenIndex = position1371, tokenIndex1371
+			return false
+		},
+		/* 25 labelchar <- <((&('@') '@') | (&('?') '?') | (&('.') '.') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9' | 'A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '_' | 'a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') alphaundnum))> */
+		nil,
+		/* 26 alphaundnum <- <(alphaund / num)> */
+		func() bool {
+			position1380, tokenIndex1380 := position, tokenIndex
+			{
+				position1381 := position
+				{
+					position1382, tokenIndex1382 := position, tokenIndex
+					if !_rules[rulealphaund]() {
+						goto l1383
+					}
+					goto l1382
+				l1383:
+					position, tokenIndex = position1382, tokenIndex1382
+					{
+						position1384 := position
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l1380
+						}
+						position++
+						add(rulenum, position1384)
+					}
+				}
+			l1382:
+				add(rulealphaundnum, position1381)
+			}
+			return true
+		l1380:
+			position, tokenIndex = position1380, tokenIndex1380
+			return false
+		},
+		/* 27 alphaund <- <((&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))> */
+		func() bool {
+			position1385, tokenIndex1385 := position, tokenIndex
+			{
+				position1386 := position
+				{
+					switch buffer[position] {
+					case '_':
+						if buffer[position] != rune('_') {
+							goto l1385
+						}
+						position++
+						break
+					case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+						if c := buffer[position]; c < rune('A') || c > rune('Z') {
+							goto l1385
+						}
+						position++
+						break
+					default:
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l1385
+						}
+						position++
+						break
+					}
+				}
+
+				add(rulealphaund, position1386)
+			}
+			return true
+		l1385:
+			position, tokenIndex = position1385, tokenIndex1385
+			return false
+		},
+		/* 28 num <- <[0-9]> */
+		nil,
+		/* 29 Comment <- <((';' / '#') (!'\n' .)*)> */
+		func() bool {
+			position1389, tokenIndex1389 := position, tokenIndex
+			{
+				position1390 := position
+				{
+					position1391, tokenIndex1391 := position, tokenIndex
+					if buffer[position] != rune(';') {
+						goto l1392
+					}
+					position++
+					goto l1391
+				l1392:
+					position, tokenIndex = position1391, tokenIndex1391
+					if buffer[position] != rune('#') {
+						goto l1389
+					}
+					position++
+				}
+			l1391:
+			l1393:
+				{
+					position1394, tokenIndex1394 := position, tokenIndex
+					{
+						position1395, tokenIndex1395 := position, tokenIndex
+						if buffer[position] != rune('\n') {
+							goto l1395
+						}
+						position++
+						goto l1394
+					l1395:
+						position, tokenIndex = position1395, tokenIndex1395
+					}
+					if !matchDot() {
+						goto l1394
+					}
+					goto l1393
+				l1394:
+					position, tokenIndex = position1394, tokenIndex1394
+				}
+				add(ruleComment, position1390)
+			}
+			return true
+		l1389:
+			position, tokenIndex = position1389, tokenIndex1389
+			return false
+		},
+		/* 30 Instruction <- <(Assignment / Inc / Dec / Alu16 / Alu / BitOp / EDSimple / Simple / Jump / IO)> */
+		nil,
+		/* 31 Assignment <- <(Push / ((&('E' | 'e') Ex) | (&('P' | 'p') Pop) | (&('L' | 'l') Load)))> */
+		nil,
+		/* 32 Load <- <(Load16 / Load8)> */
+		nil,
+		/* 33 Load8 <- <(('l' / 'L') ('d' / 'D') ws Dst8 sep Src8 Action31)> */
+		nil,
+		/* 34 Load16 <- <(('l' / 'L') ('d' / 'D') ws LdDst16 sep Src16 Action32)> */
+		nil,
+		/* 35 Push <- <(('p' / 'P') ('u' / 'U') ('s' / 'S') ('h' / 'H') ws Src16 Action33)> */
+		nil,
+		/* 36 Pop <- <(('p' / 'P') ('o' / 'O') ('p' / 'P') ws Dst16 Action34)> */
+		nil,
+		/* 37 Ex <- <(('e' / 'E') ('x' / 'X') ws Dst16 sep Src16 Action35)> */
+		nil,
+		/* 38 Inc <- <(Inc16Indexed8 / Inc16 / Inc8)> */
+		nil,
+		/* 39 Inc16Indexed8 <- <(('i' / 'I') ('n' / 'N') ('c' / 'C') ws ILoc8 Action36)> */
+		nil,
+		/* 40 Inc8 <- <(('i' / 'I') ('n' / 'N') ('c' / 'C') ws Loc8 Action37)> */
+		nil,
+		/* 41 Inc16 <- <(('i' / 'I') ('n' / 'N') ('c' / 'C') ws Loc16 Action38)> */
+		nil,
+		/* 42 Dec <- <(Dec16Indexed8 / Dec16 / Dec8)> */
+		nil,
+		/* 43 Dec16Indexed8 <- <(('d' / 'D') ('e' / 'E') ('c' / 'C') ws ILoc8 Action39)> */
+		nil,
+		/* 44 Dec8 <- <(('d' / 'D') ('e' / 'E') ('c' / 'C') ws Loc8 Action40)> */
+		nil,
+		/* 45 Dec16 <- <(('d' / 'D') ('e' / 'E') ('c' / 'C') ws Loc16 Action41)> */
+		nil,
+		/* 46 Alu16 <- <(Add16 / Adc16 / Sbc16)> */
+		nil,
+		/* 47 Add16 <- <(('a' / 'A') ('d' / 'D') ('d' / 'D') ws Dst16 sep Src16 Action42)> */
+		nil,
+		/* 48 Adc16 <- <(('a' / 'A') ('d' / 'D') ('c' / 'C') ws Dst16 sep Src16 Action43)> */
+		nil,
+		/* 49 Sbc16 <- <(('s' / 'S') ('b' / 'B') ('c' / 'C') ws Dst16 sep Src16 Action44)> */
+		nil,
+		/* 50 Dst8 <- <((Reg8 / Reg16Contents / nn_contents) Action45)> */
+		nil,
+		/* 51 Src8 <- <((Reg8 / Reg16Contents / nn_contents / n) Action46)> */
+		func() bool {
+			position1417, tokenIndex1417 := position, tokenIndex
+			{
+				position1418 := position
+				{
+					position1419, tokenIndex1419 := position, tokenIndex
+					if !_rules[ruleReg8]() {
+						goto l1420
+					}
+					goto l1419
+				l1420:
+					position, tokenIndex = position1419, tokenIndex1419
+					if !_rules[ruleReg16Contents]() {
+						goto l1421
+					}
+					goto l1419
+				l1421:
+					position, tokenIndex = position1419, tokenIndex1419
+					if !_rules[rulenn_contents]() {
+						goto l1422
+					}
+					goto l1419
+				l1422:
+					position, tokenIndex = position1419, tokenIndex1419
+					if !_rules[rulen]() {
+						goto l1417
+					}
+				}
+			l1419:
+				{
+					add(ruleAction46, position)
+				}
+				add(ruleSrc8, position1418)
+			}
+			return true
+		l1417:
+			position, tokenIndex = position1417, tokenIndex1417
+			return false
+		},
+		/* 52 Loc8 <- <((Reg8 / Reg16Contents) Action47)> */
+		func() bool {
+			position1424, tokenIndex1424 := position, tokenIndex
+			{
+				position1425 := position
+				{
+					position1426, tokenIndex1426 := position, tokenIndex
+					if !_rules[ruleReg8]() {
+						goto l1427
+					}
+					goto l1426
+				l1427:
+					position, tokenIndex = position1426, tokenIndex1426
+					if !_rules[ruleReg16Contents]() {
+						goto l1424
+					}
+				}
+			l1426:
+				{
+					add(ruleAction47, position)
+				}
+				add(ruleLoc8, position1425)
+			}
+			return true
+		l1424:
+			position, tokenIndex = position1424, tokenIndex1424
+			return false
+		},
+		/* 53 Copy8 <- <(Reg8 Action48)> */
+		func() bool {
+			position1429, tokenIndex1429 := position, tokenIndex
+			{
+				position1430 := position
+				if !_rules[ruleReg8]() {
+					goto l1429
+				}
+				{
+					add(ruleAction48, position)
+				}
+				add(ruleCopy8, position1430)
+			}
+			return true
+		l1429:
+			position, tokenIndex = position1429, tokenIndex1429
+			return false
+		},
+		/* 54 ILoc8 <- <(IReg8 Action49)> */
+		func() bool {
+			position1432, tokenIndex1432 := position, tokenIndex
+			{
+				position1433 := position
+				if !_rules[ruleIReg8]() {
+					goto l1432
+				}
+				{
+					add(ruleAction49, position)
+				}
+				add(ruleILoc8, position1433)
+			}
+			return true
+		l1432:
+			position, tokenIndex = position1432, tokenIndex1432
+			return false
+		},
+		/* 55 Reg8 <- <(<(IReg8 / ((&('R' | 'r') R) | (&('I' | 'i') I) | (&('L' | 'l') L) | (&('H' | 'h') H) | (&('E' | 'e') E) | (&('D' | 'd') D) | (&('C' | 'c') C) | (&('B' | 'b') B) | (&('F' | 'f') F) | (&('A' | 'a') A)))> !alphaundnum Action50)> */
+		func() bool {
+			position1435, tokenIndex1435 := position, tokenIndex
+			{
+				position1436 := position
+				{
+					position1437 := position
+					{
+						position1438, tokenIndex1438 := position, tokenIndex
+						if !_rules[ruleIReg8]() {
+							goto l1439
+						}
+						goto l1438
+					l1439:
+						position, tokenIndex = position1438, tokenIndex1438
+						{
+							switch buffer[position] {
+							case 'R', 'r':
+								if !_rules[ruleR]() {
+									goto l1435
+								}
+								break
+							case 'I', 'i':
+								if !_rules[ruleI]() {
+									goto l1435
+								}
+								break
+							case 'L', 'l':
+								if !_rules[ruleL]() {
+									goto l1435
+								}
+								break
+							case 'H', 'h':
+								if !_rules[ruleH]() {
+									goto l1435
+								}
+								break
+							case 'E', 'e':
+								if !_rules[ruleE]() {
+									goto l1435
+								}
+								break
+							case 'D', 'd':
+								if !_rules[ruleD]() {
+									goto l1435
+								}
+								break
+							case 'C', 'c':
+								if !_rules[ruleC]() {
+									goto l1435
+								}
+								break
+							case 'B', 'b':
+								if !_rules[ruleB]() {
+									goto l1435
+								}
+								break
+							case 'F', 'f':
+								if !_rules[ruleF]() {
+									goto l1435
+								}
+								break
+							default:
+								if !_rules[ruleA]() {
+									goto l1435
+								}
+								break
+							}
+						}
+
+					}
+				l1438:
+					add(rulePegText, position1437)
+				}
+				{
+					position1441, tokenIndex1441 := position, tokenIndex
+					if !_rules[rulealphaundnum]() {
+						goto l1441
+					}
+					goto l1435
+				l1441:
+					position, tokenIndex = position1441, tokenIndex1441
+				}
+				{
+					add(ruleAction50, position)
+				}
+				add(ruleReg8, position1436)
+			}
+			return true
+		l1435:
+			position, tokenIndex = position1435, tokenIndex1435
+			return false
+		},
+		/* 56 IReg8 <- <(<(IXH / IXL / IYH / IYL)> !alphaundnum Action51)> */
+		func() bool {
+			position1443, tokenIndex1443 := position, tokenIndex
+			{
+				position1444 := position
+				{
+					position1445 := position
+					{
+						position1446, tokenIndex1446 := position, tokenIndex
+						if !_rules[ruleIXH]() {
+							goto l1447
+						}
+						goto l1446
+					l1447:
+						position, tokenIndex = position1446, tokenIndex1446
+						if !_rules[ruleIXL]() {
+							goto l1448
+						}
+						goto l1446
+					l1448:
+						position, tokenIndex = position1446, tokenIndex1446
+						if !_rules[ruleIYH]() {
+							goto l1449
+						}
+						goto l1446
+					l1449:
+						position, tokenIndex = position1446, tokenIndex1446
+						if !_rules[ruleIYL]() {
+							goto l1443
+						}
+					}
+				l1446:
+					add(rulePegText, position1445)
+				}
+				{
+					position1450, tokenIndex1450 := position, tokenIndex
+					if !_rules[rulealphaundnum]() {
+						goto l1450
+					}
+					goto l1443
+				l1450:
+					position, tokenIndex = position1450, tokenIndex1450
+				}
+				{
+					add(ruleAction51, position)
+				}
+				add(ruleIReg8, position1444)
+			}
+			return true
+		l1443:
+			position, tokenIndex = position1443, tokenIndex1443
+			return false
+		},
+		/* 57 LdDst16 <- <((Reg16 / nn_contents) Action52)> */
+		nil,
+		/* 58 Dst16 <- <((Reg16 / nn_contents / Reg16Contents) Action53)> */
+		func() bool {
+			position1453, tokenIndex1453 := position, tokenIndex
+			{
+				position1454 := position
+				{
+					position1455, tokenIndex1455 := position, tokenIndex
+					if !_rules[ruleReg16]() {
+						goto l1456
+					}
+					goto l1455
+				l1456:
+					position, tokenIndex = position1455, tokenIndex1455
+					if !_rules[rulenn_contents]() {
+						goto l1457
+					}
+					goto l1455
+				l1457:
+					position, tokenIndex = position1455, tokenIndex1455
+					if !_rules[ruleReg16Contents]() {
+						goto l1453
+					}
+				}
+			l1455:
+				{
+					add(ruleAction53, position)
+				}
+				add(ruleDst16, position1454)
+			}
+			return true
+		l1453:
+			position, tokenIndex = position1453, tokenIndex1453
+			return false
+		},
+		/* 59 Src16 <- <((Reg16 / nn_contents / nn) Action54)> */
+		func() bool {
+			position1459, tokenIndex1459 := position, tokenIndex
+			{
+				position1460 := position
+				{
+					position1461, tokenIndex1461 := position, tokenIndex
+					if !_rules[ruleReg16]() {
+						goto l1462
+					}
+					goto l1461
+				l1462:
+					position, tokenIndex = position1461, tokenIndex1461
+					if !_rules[rulenn_contents]() {
+						goto l1463
+					}
+					goto l1461
+				l1463:
+					position, tokenIndex = position1461, tokenIndex1461
+					if !_rules[rulenn]() {
+						goto l1459
+					}
+				}
+			l1461:
+				{
+					add(ruleAction54, position)
+				}
+				add(ruleSrc16, position1460)
+			}
+			return true
+		l1459:
+			position, tokenIndex = position1459, tokenIndex1459
+			return false
+		},
+		/* 60 Loc16 <- <(Reg16 Action55)> */
+		func() bool {
+			position1465, tokenIndex1465 := position, tokenIndex
+			{
+				position1466 := position
+				if !_rules[ruleReg16]() {
+					goto l1465
+				}
+				{
+					add(ruleAction55, position)
+				}
+				add(ruleLoc16, position1466)
+			}
+			return true
+		l1465:
+			position, tokenIndex = position1465, tokenIndex1465
+			return false
+		},
+		/* 61 Reg16 <- <(<(AF_PRIME / ((&('I' | 'i') IReg16) | (&('S' | 's') SP) | (&('H' | 'h') HL) | (&('D' | 'd') DE) | (&('B' | 'b') BC) | (&('A' | 'a') AF)))> !alphaundnum Action56)> */
+		func() bool {
+			position1468, tokenIndex1468 := position, tokenIndex
+			{
+				position1469 := position
+				{
+					position1470 := position
+					{
+						position1471, tokenIndex1471 := position, tokenIndex
+						if !_rules[ruleAF_PRIME]() {
+							goto l1472
+						}
+						goto l1471
+					l1472:
+						position, tokenIndex = position1471, tokenIndex1471
+						{
+							switch buffer[position] {
+							case 'I', 'i':
+								if !_rules[ruleIReg16]() {
+									goto l1468
+								}
+								break
+							case 'S', 's':
+								if !_rules[ruleSP]() {
+									goto l1468
+								}
+								break
+							case 'H', 'h':
+								if !_rules[ruleHL]() {
+									goto l1468
+								}
+								break
+							case 'D', 'd':
+								if !_rules[ruleDE]() {
+									goto l1468
+								}
+								break
+							case 'B', 'b':
+								if !_rules[ruleBC]() {
+									goto l1468
+								}
+								break
+							default:
+								if !_rules[ruleAF]() {
+									goto l1468
+								}
+								break
+							}
+						}
 
+					}
+				l1471:
+					add(rulePegText, position1470)
+				}
+				{
+					position1474, tokenIndex1474 := position, tokenIndex
+					if !_rules[rulealphaundnum]() {
+						goto l1474
+					}
+					goto l1468
+				l1474:
+					position, tokenIndex = position1474, tokenIndex1474
+				}
+				{
+					add(ruleAction56, position)
+				}
+				add(ruleReg16, position1469)
+			}
+			return true
+		l1468:
+			position, tokenIndex = position1468, tokenIndex1468
+			return false
+		},
+		/* 62 IReg16 <- <(<(IX / IY)> !alphaundnum Action57)> */
+		func() bool {
+			position1476, tokenIndex1476 := position, tokenIndex
+			{
+				position1477 := position
+				{
+					position1478 := position
+					{
+						position1479, tokenIndex1479 := position, tokenIndex
+						if !_rules[ruleIX]() {
+							goto l1480
+						}
+						goto l1479
+					l1480:
+						position, tokenIndex = position1479, tokenIndex1479
+						if !_rules[ruleIY]() {
+							goto l1476
+						}
+					}
+				l1479:
+					add(rulePegText, position1478)
+				}
+				{
+					position1481, tokenIndex1481 := position, tokenIndex
+					if !_rules[rulealphaundnum]() {
+						goto l1481
+					}
+					goto l1476
+				l1481:
+					position, tokenIndex = position1481, tokenIndex1481
+				}
+				{
+					add(ruleAction57, position)
+				}
+				add(ruleIReg16, position1477)
+			}
+			return true
+		l1476:
+			position, tokenIndex = position1476, tokenIndex1476
+			return false
+		},
+		/* 63 RegName <- <((AF_PRIME / IXH / IXL / IYH / IYL / AF / BC / DE / HL / IX / IY / ((&('R' | 'r') R) | (&('I' | 'i') I) | (&('L' | 'l') L) | (&('H' | 'h') H) | (&('E' | 'e') E) | (&('D' | 'd') D) | (&('C' | 'c') C) | (&('B' | 'b') B) | (&('F' | 'f') F) | (&('A' | 'a') A) | (&('S' | 's') SP))) !alphaundnum)> */
+		nil,
+		/* 64 Reg16Contents <- <(IndexedR16C / PlainR16C)> */
+		func() bool {
+			position1484, tokenIndex1484 := position, tokenIndex
+			{
+				position1485 := position
+				{
+					position1486, tokenIndex1486 := position, tokenIndex
+					{
+						position1488 := position
+						if buffer[position] != rune('(') {
+							goto l1487
+						}
+						position++
+						if !_rules[ruleIReg16]() {
+							goto l1487
+						}
+						{
+							position1489, tokenIndex1489 := position, tokenIndex
+							if !_rules[rulews]() {
+								goto l1489
+							}
+							goto l1490
+						l1489:
+							position, tokenIndex = position1489, tokenIndex1489
+						}
+					l1490:
+						{
+							position1491, tokenIndex1491 := position, tokenIndex
+							{
+								position1493 := position
+								{
+									position1494, tokenIndex1494 := position, tokenIndex
+									if buffer[position] != rune('+') {
+										goto l1495
+									}
+									position++
+									{
+										position1496, tokenIndex1496 := position, tokenIndex
+										if !_rules[rulews]() {
+											goto l1496
 										}
-									l925:
-										add(ruleDirective, position924)
+										goto l1497
+									l1496:
+										position, tokenIndex = position1496, tokenIndex1496
+									}
+								l1497:
+									if !_rules[ruleexpr]() {
+										goto l1495
 									}
-									goto l922
-								l923:
-									position, tokenIndex = position922, tokenIndex922
 									{
-										position992 := position
-										{
-											position993, tokenIndex993 := position, tokenIndex
-											{
-												position995 := position
-												{
-													position996, tokenIndex996 := position, tokenIndex
-													{
-														position998 := position
-														{
-															position999, tokenIndex999 := position, tokenIndex
-															if buffer[position] != rune('p') {
-																goto l1000
-															}
-															position++
-															goto l999
-														l1000:
-															position, tokenIndex = position999, tokenIndex999
-															if buffer[position] != rune('P') {
-																goto l997
-															}
-															position++
-														}
-													l999:
-														{
-															position1001, tokenIndex1001 := position, tokenIndex
-															if buffer[position] != rune('u') {
-																goto l1002
-															}
-															position++
-															goto l1001
-														l1002:
-															position, tokenIndex = position1001, tokenIndex1001
-															if buffer[position] != rune('U') {
-																goto l997
-															}
-															position++
-														}
-													l1001:
-														{
-															position1003, tokenIndex1003 := position, tokenIndex
-															if buffer[position] != rune('s') {
-																goto l1004
-															}
-															position++
-															goto l1003
-														l1004:
-															position, tokenIndex = position1003, tokenIndex1003
-															if buffer[position] != rune('S') {
-																goto l997
-															}
-															position++
-														}
-													l1003:
-														{
-															position1005, tokenIndex1005 := position, tokenIndex
-															if buffer[position] != rune('h') {
-																goto l1006
-															}
-															position++
-															goto l1005
-														l1006:
-															position, tokenIndex = position1005, tokenIndex1005
-															if buffer[position] != rune('H') {
-																goto l997
-															}
-															position++
-														}
-													l1005:
-														if !_rules[rulews]() {
-															goto l997
-														}
-														if !_rules[ruleSrc16]() {
-															goto l997
-														}
-														{
-															add(ruleAction8, position)
-														}
-														add(rulePush, position998)
-													}
-													goto l996
-												l997:
-													position, tokenIndex = position996, tokenIndex996
-													{
-														switch buffer[position] {
-														case 'E', 'e':
-															{
-																position1009 := position
-																{
-																	position1010, tokenIndex1010 := position, tokenIndex
-																	if buffer[position] != rune('e') {
-																		goto l1011
-																	}
-																	position++
-																	goto l1010
-																l1011:
-																	position, tokenIndex = position1010, tokenIndex1010
-																	if buffer[position] != rune('E') {
-																		goto l994
-																	}
-																	position++
-																}
-															l1010:
-																{
-																	position1012, tokenIndex1012 := position, tokenIndex
-																	if buffer[position] != rune('x') {
-																		goto l1013
-																	}
-																	position++
-																	goto l1012
-																l1013:
-																	position, tokenIndex = position1012, tokenIndex1012
-																	if buffer[position] != rune('X') {
-																		goto l994
-																	}
-																	position++
-																}
-															l1012:
-																if !_rules[rulews]() {
-																	goto l994
-																}
-																if !_rules[ruleDst16]() {
-																	goto l994
-																}
-																if !_rules[rulesep]() {
-																	goto l994
-																}
-																if !_rules[ruleSrc16]() {
-																	goto l994
-																}
-																{
-																	add(ruleAction10, position)
-																}
-																add(ruleEx, position1009)
-															}
-															break
-														case 'P', 'p':
-															{
-																position1015 := position
-																{
-																	position1016, tokenIndex1016 := position, tokenIndex
-																	if buffer[position] != rune('p') {
-																		goto l1017
-																	}
-																	position++
-																	goto l1016
-																l1017:
-																	position, tokenIndex = position1016, tokenIndex1016
-																	if buffer[position] != rune('P') {
-																		goto l994
-																	}
-																	position++
-																}
-															l1016:
-																{
-																	position1018, tokenIndex1018 := position, tokenIndex
-																	if buffer[position] != rune('o') {
-																		goto l1019
-																	}
-																	position++
-																	goto l1018
-																l1019:
-																	position, tokenIndex = position1018, tokenIndex1018
-																	if buffer[position] != rune('O') {
-																		goto l994
-																	}
-																	position++
-																}
-															l1018:
-																{
-																	position1020, tokenIndex1020 := position, tokenIndex
-																	if buffer[position] != rune('p') {
-																		goto l1021
-																	}
-																	position++
-																	goto l1020
-																l1021:
-																	position, tokenIndex = position1020, tokenIndex1020
-																	if buffer[position] != rune('P') {
-																		goto l994
-																	}
-																	position++
-																}
-															l1020:
-																if !_rules[rulews]() {
-																	goto l994
-																}
-																if !_rules[ruleDst16]() {
-																	goto l994
-																}
-																{
-																	add(ruleAction9, position)
-																}
-																add(rulePop, position1015)
-															}
-															break
-														default:
-															{
-																position1023 := position
-																{
-																	position1024, tokenIndex1024 := position, tokenIndex
-																	{
-																		position1026 := position
-																		{
-																			position1027, tokenIndex1027 := position, tokenIndex
-																			if buffer[position] != rune('l') {
-																				goto l1028
-																			}
-																			position++
-																			goto l1027
-																		l1028:
-																			position, tokenIndex = position1027, tokenIndex1027
-																			if buffer[position] != rune('L') {
-																				goto l1025
-																			}
-																			position++
-																		}
-																	l1027:
-																		{
-																			position1029, tokenIndex1029 := position, tokenIndex
-																			if buffer[position] != rune('d') {
-																				goto l1030
-																			}
-																			position++
-																			goto l1029
-																		l1030:
-																			position, tokenIndex = position1029, tokenIndex1029
-																			if buffer[position] != rune('D') {
-																				goto l1025
-																			}
-																			position++
-																		}
-																	l1029:
-																		if !_rules[rulews]() {
-																			goto l1025
-																		}
-																		if !_rules[ruleDst16]() {
-																			goto l1025
-																		}
-																		if !_rules[rulesep]() {
-																			goto l1025
-																		}
-																		if !_rules[ruleSrc16]() {
-																			goto l1025
-																		}
-																		{
-																			add(ruleAction7, position)
-																		}
-																		add(ruleLoad16, position1026)
-																	}
-																	goto l1024
-																l1025:
-																	position, tokenIndex = position1024, tokenIndex1024
-																	{
-																		position1032 := position
-																		{
-																			position1033, tokenIndex1033 := position, tokenIndex
-																			if buffer[position] != rune('l') {
-																				goto l1034
-																			}
-																			position++
-																			goto l1033
-																		l1034:
-																			position, tokenIndex = position1033, tokenIndex1033
-																			if buffer[position] != rune('L') {
-																				goto l994
-																			}
-																			position++
-																		}
-																	l1033:
-																		{
-																			position1035, tokenIndex1035 := position, tokenIndex
-																			if buffer[position] != rune('d') {
-																				goto l1036
-																			}
-																			position++
-																			goto l1035
-																		l1036:
-																			position, tokenIndex = position1035, tokenIndex1035
-																			if buffer[position] != rune('D') {
-																				goto l994
-																			}
-																			position++
-																		}
-																	l1035:
-																		if !_rules[rulews]() {
-																			goto l994
-																		}
-																		{
-																			position1037 := position
-																			{
-																				position1038, tokenIndex1038 := position, tokenIndex
-																				if !_rules[ruleReg8]() {
-																					goto l1039
-																				}
-																				goto l1038
-																			l1039:
-																				position, tokenIndex = position1038, tokenIndex1038
-																				if !_rules[ruleReg16Contents]() {
-																					goto l1040
-																				}
-																				goto l1038
-																			l1040:
-																				position, tokenIndex = position1038, tokenIndex1038
-																				if !_rules[rulenn_contents]() {
-																					goto l994
-																				}
-																			}
-																		l1038:
-																			{
-																				add(ruleAction20, position)
-																			}
-																			add(ruleDst8, position1037)
-																		}
-																		if !_rules[rulesep]() {
-																			goto l994
-																		}
-																		if !_rules[ruleSrc8]() {
-																			goto l994
-																		}
-																		{
-																			add(ruleAction6, position)
-																		}
-																		add(ruleLoad8, position1032)
-																	}
-																}
-															l1024:
-																add(ruleLoad, position1023)
-															}
-															break
-														}
-													}
+										add(ruleAction60, position)
+									}
+									goto l1494
+								l1495:
+									position, tokenIndex = position1494, tokenIndex1494
+									if buffer[position] != rune('-') {
+										goto l1491
+									}
+									position++
+									{
+										position1499, tokenIndex1499 := position, tokenIndex
+										if !_rules[rulews]() {
+											goto l1499
+										}
+										goto l1500
+									l1499:
+										position, tokenIndex = position1499, tokenIndex1499
+									}
+								l1500:
+									if !_rules[ruleexpr]() {
+										goto l1491
+									}
+									{
+										add(ruleAction61, position)
+									}
+								}
+							l1494:
+								add(ruleIndexDisp, position1493)
+							}
+							goto l1492
+						l1491:
+							position, tokenIndex = position1491, tokenIndex1491
+						}
+					l1492:
+						{
+							position1502, tokenIndex1502 := position, tokenIndex
+							if !_rules[rulews]() {
+								goto l1502
+							}
+							goto l1503
+						l1502:
+							position, tokenIndex = position1502, tokenIndex1502
+						}
+					l1503:
+						if buffer[position] != rune(')') {
+							goto l1487
+						}
+						position++
+						{
+							add(ruleAction59, position)
+						}
+						add(ruleIndexedR16C, position1488)
+					}
+					goto l1486
+				l1487:
+					position, tokenIndex = position1486, tokenIndex1486
+					{
+						position1505 := position
+						if buffer[position] != rune('(') {
+							goto l1484
+						}
+						position++
+						if !_rules[ruleReg16]() {
+							goto l1484
+						}
+						if buffer[position] != rune(')') {
+							goto l1484
+						}
+						position++
+						{
+							add(ruleAction58, position)
+						}
+						add(rulePlainR16C, position1505)
+					}
+				}
+			l1486:
+				add(ruleReg16Contents, position1485)
+			}
+			return true
+		l1484:
+			position, tokenIndex = position1484, tokenIndex1484
+			return false
+		},
+		/* 65 PlainR16C <- <('(' Reg16 ')' Action58)> */
+		nil,
+		/* 66 IndexedR16C <- <('(' IReg16 ws? IndexDisp? ws? ')' Action59)> */
+		nil,
+		/* 67 IndexDisp <- <(('+' ws? expr Action60) / ('-' ws? expr Action61))> */
+		nil,
+		/* 68 n <- <(expr Action62)> */
+		func() bool {
+			position1510, tokenIndex1510 := position, tokenIndex
+			{
+				position1511 := position
+				if !_rules[ruleexpr]() {
+					goto l1510
+				}
+				{
+					add(ruleAction62, position)
+				}
+				add(rulen, position1511)
+			}
+			return true
+		l1510:
+			position, tokenIndex = position1510, tokenIndex1510
+			return false
+		},
+		/* 69 nn <- <(expr Action63)> */
+		func() bool {
+			position1513, tokenIndex1513 := position, tokenIndex
+			{
+				position1514 := position
+				if !_rules[ruleexpr]() {
+					goto l1513
+				}
+				{
+					add(ruleAction63, position)
+				}
+				add(rulenn, position1514)
+			}
+			return true
+		l1513:
+			position, tokenIndex = position1513, tokenIndex1513
+			return false
+		},
+		/* 70 disp <- <(signedHexByteH / signedHexByte0x / signedDecimalByte)> */
+		func() bool {
+			position1516, tokenIndex1516 := position, tokenIndex
+			{
+				position1517 := position
+				{
+					position1518, tokenIndex1518 := position, tokenIndex
+					{
+						position1520 := position
+						{
+							position1521 := position
+							{
+								position1522, tokenIndex1522 := position, tokenIndex
+								{
+									position1524, tokenIndex1524 := position, tokenIndex
+									if buffer[position] != rune('-') {
+										goto l1525
+									}
+									position++
+									goto l1524
+								l1525:
+									position, tokenIndex = position1524, tokenIndex1524
+									if buffer[position] != rune('+') {
+										goto l1522
+									}
+									position++
+								}
+							l1524:
+								goto l1523
+							l1522:
+								position, tokenIndex = position1522, tokenIndex1522
+							}
+						l1523:
+							{
+								switch buffer[position] {
+								case 'A', 'B', 'C', 'D', 'E', 'F':
+									if c := buffer[position]; c < rune('A') || c > rune('F') {
+										goto l1519
+									}
+									position++
+									break
+								case 'a', 'b', 'c', 'd', 'e', 'f':
+									if c := buffer[position]; c < rune('a') || c > rune('f') {
+										goto l1519
+									}
+									position++
+									break
+								default:
+									if c := buffer[position]; c < rune('0') || c > rune('9') {
+										goto l1519
+									}
+									position++
+									break
+								}
+							}
+
+						l1526:
+							{
+								position1527, tokenIndex1527 := position, tokenIndex
+								{
+									switch buffer[position] {
+									case 'A', 'B', 'C', 'D', 'E', 'F':
+										if c := buffer[position]; c < rune('A') || c > rune('F') {
+											goto l1527
+										}
+										position++
+										break
+									case 'a', 'b', 'c', 'd', 'e', 'f':
+										if c := buffer[position]; c < rune('a') || c > rune('f') {
+											goto l1527
+										}
+										position++
+										break
+									default:
+										if c := buffer[position]; c < rune('0') || c > rune('9') {
+											goto l1527
+										}
+										position++
+										break
+									}
+								}
+
+								goto l1526
+							l1527:
+								position, tokenIndex = position1527, tokenIndex1527
+							}
+							add(rulePegText, position1521)
+						}
+						{
+							position1530, tokenIndex1530 := position, tokenIndex
+							if buffer[position] != rune('h') {
+								goto l1531
+							}
+							position++
+							goto l1530
+						l1531:
+							position, tokenIndex = position1530, tokenIndex1530
+							if buffer[position] != rune('H') {
+								goto l1519
+							}
+							position++
+						}
+					l1530:
+						{
+							add(ruleAction65, position)
+						}
+						add(rulesignedHexByteH, position1520)
+					}
+					goto l1518
+				l1519:
+					position, tokenIndex = position1518, tokenIndex1518
+					{
+						position1534 := position
+						{
+							position1535 := position
+							{
+								position1536, tokenIndex1536 := position, tokenIndex
+								{
+									position1538, tokenIndex1538 := position, tokenIndex
+									if buffer[position] != rune('-') {
+										goto l1539
+									}
+									position++
+									goto l1538
+								l1539:
+									position, tokenIndex = position1538, tokenIndex1538
+									if buffer[position] != rune('+') {
+										goto l1536
+									}
+									position++
+								}
+							l1538:
+								goto l1537
+							l1536:
+								position, tokenIndex = position1536, tokenIndex1536
+							}
+						l1537:
+							if buffer[position] != rune('0') {
+								goto l1533
+							}
+							position++
+							{
+								position1540, tokenIndex1540 := position, tokenIndex
+								if buffer[position] != rune('x') {
+									goto l1541
+								}
+								position++
+								goto l1540
+							l1541:
+								position, tokenIndex = position1540, tokenIndex1540
+								if buffer[position] != rune('X') {
+									goto l1533
+								}
+								position++
+							}
+						l1540:
+							{
+								switch buffer[position] {
+								case 'A', 'B', 'C', 'D', 'E', 'F':
+									if c := buffer[position]; c < rune('A') || c > rune('F') {
+										goto l1533
+									}
+									position++
+									break
+								case 'a', 'b', 'c', 'd', 'e', 'f':
+									if c := buffer[position]; c < rune('a') || c > rune('f') {
+										goto l1533
+									}
+									position++
+									break
+								default:
+									if c := buffer[position]; c < rune('0') || c > rune('9') {
+										goto l1533
+									}
+									position++
+									break
+								}
+							}
+
+						l1542:
+							{
+								position1543, tokenIndex1543 := position, tokenIndex
+								{
+									switch buffer[position] {
+									case 'A', 'B', 'C', 'D', 'E', 'F':
+										if c := buffer[position]; c < rune('A') || c > rune('F') {
+											goto l1543
+										}
+										position++
+										break
+									case 'a', 'b', 'c', 'd', 'e', 'f':
+										if c := buffer[position]; c < rune('a') || c > rune('f') {
+											goto l1543
+										}
+										position++
+										break
+									default:
+										if c := buffer[position]; c < rune('0') || c > rune('9') {
+											goto l1543
+										}
+										position++
+										break
+									}
+								}
 
+								goto l1542
+							l1543:
+								position, tokenIndex = position1543, tokenIndex1543
+							}
+							add(rulePegText, position1535)
+						}
+						{
+							add(ruleAction66, position)
+						}
+						add(rulesignedHexByte0x, position1534)
+					}
+					goto l1518
+				l1533:
+					position, tokenIndex = position1518, tokenIndex1518
+					{
+						position1547 := position
+						{
+							position1548 := position
+							{
+								position1549, tokenIndex1549 := position, tokenIndex
+								{
+									position1551, tokenIndex1551 := position, tokenIndex
+									if buffer[position] != rune('-') {
+										goto l1552
+									}
+									position++
+									goto l1551
+								l1552:
+									position, tokenIndex = position1551, tokenIndex1551
+									if buffer[position] != rune('+') {
+										goto l1549
+									}
+									position++
+								}
+							l1551:
+								goto l1550
+							l1549:
+								position, tokenIndex = position1549, tokenIndex1549
+							}
+						l1550:
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l1516
+							}
+							position++
+						l1553:
+							{
+								position1554, tokenIndex1554 := position, tokenIndex
+								if c := buffer[position]; c < rune('0') || c > rune('9') {
+									goto l1554
+								}
+								position++
+								goto l1553
+							l1554:
+								position, tokenIndex = position1554, tokenIndex1554
+							}
+							add(rulePegText, position1548)
+						}
+						{
+							add(ruleAction64, position)
+						}
+						add(rulesignedDecimalByte, position1547)
+					}
+				}
+			l1518:
+				add(ruledisp, position1517)
+			}
+			return true
+		l1516:
+			position, tokenIndex = position1516, tokenIndex1516
+			return false
+		},
+		/* 71 signedDecimalByte <- <(<(('-' / '+')? [0-9]+)> Action64)> */
+		nil,
+		/* 72 signedHexByteH <- <(<(('-' / '+')? ((&('A' | 'B' | 'C' | 'D' | 'E' | 'F') [A-F]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f') [a-f]) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]))+)> ('h' / 'H') Action65)> */
+		nil,
+		/* 73 signedHexByte0x <- <(<(('-' / '+')? ('0' ('x' / 'X')) ((&('A' | 'B' | 'C' | 'D' | 'E' | 'F') [A-F]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f') [a-f]) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]))+)> Action66)> */
+		nil,
+		/* 74 expr <- <cmpExpr> */
+		func() bool {
+			position1559, tokenIndex1559 := position, tokenIndex
+			{
+				position1560 := position
+				{
+					position1561 := position
+					if !_rules[ruleorExpr]() {
+						goto l1559
+					}
+				l1562:
+					{
+						position1563, tokenIndex1563 := position, tokenIndex
+						{
+							position1564, tokenIndex1564 := position, tokenIndex
+							if !_rules[rulews]() {
+								goto l1564
+							}
+							goto l1565
+						l1564:
+							position, tokenIndex = position1564, tokenIndex1564
+						}
+					l1565:
+						{
+							position1566, tokenIndex1566 := position, tokenIndex
+							{
+								position1568, tokenIndex1568 := position, tokenIndex
+								if buffer[position] != rune('!') {
+									goto l1569
+								}
+								position++
+								if buffer[position] != rune('=') {
+									goto l1569
+								}
+								position++
+								goto l1568
+							l1569:
+								position, tokenIndex = position1568, tokenIndex1568
+								if buffer[position] != rune('<') {
+									goto l1567
+								}
+								position++
+								if buffer[position] != rune('>') {
+									goto l1567
+								}
+								position++
+							}
+						l1568:
+							{
+								position1570, tokenIndex1570 := position, tokenIndex
+								if !_rules[rulews]() {
+									goto l1570
+								}
+								goto l1571
+							l1570:
+								position, tokenIndex = position1570, tokenIndex1570
+							}
+						l1571:
+							if !_rules[ruleorExpr]() {
+								goto l1567
+							}
+							{
+								add(ruleAction68, position)
+							}
+							goto l1566
+						l1567:
+							position, tokenIndex = position1566, tokenIndex1566
+							if buffer[position] != rune('<') {
+								goto l1573
+							}
+							position++
+							if buffer[position] != rune('=') {
+								goto l1573
+							}
+							position++
+							{
+								position1574, tokenIndex1574 := position, tokenIndex
+								if !_rules[rulews]() {
+									goto l1574
+								}
+								goto l1575
+							l1574:
+								position, tokenIndex = position1574, tokenIndex1574
+							}
+						l1575:
+							if !_rules[ruleorExpr]() {
+								goto l1573
+							}
+							{
+								add(ruleAction69, position)
+							}
+							goto l1566
+						l1573:
+							position, tokenIndex = position1566, tokenIndex1566
+							if buffer[position] != rune('>') {
+								goto l1577
+							}
+							position++
+							if buffer[position] != rune('=') {
+								goto l1577
+							}
+							position++
+							{
+								position1578, tokenIndex1578 := position, tokenIndex
+								if !_rules[rulews]() {
+									goto l1578
+								}
+								goto l1579
+							l1578:
+								position, tokenIndex = position1578, tokenIndex1578
+							}
+						l1579:
+							if !_rules[ruleorExpr]() {
+								goto l1577
+							}
+							{
+								add(ruleAction70, position)
+							}
+							goto l1566
+						l1577:
+							position, tokenIndex = position1566, tokenIndex1566
+							{
+								switch buffer[position] {
+								case '>':
+									if buffer[position] != rune('>') {
+										goto l1563
+									}
+									position++
+									{
+										position1582, tokenIndex1582 := position, tokenIndex
+										if buffer[position] != rune('>') {
+											goto l1582
+										}
+										position++
+										goto l1563
+									l1582:
+										position, tokenIndex = position1582, tokenIndex1582
+									}
+									{
+										position1583, tokenIndex1583 := position, tokenIndex
+										if !_rules[rulews]() {
+											goto l1583
+										}
+										goto l1584
+									l1583:
+										position, tokenIndex = position1583, tokenIndex1583
+									}
+								l1584:
+									if !_rules[ruleorExpr]() {
+										goto l1563
+									}
+									{
+										add(ruleAction72, position)
+									}
+									break
+								case '<':
+									if buffer[position] != rune('<') {
+										goto l1563
+									}
+									position++
+									{
+										position1586, tokenIndex1586 := position, tokenIndex
+										if buffer[position] != rune('<') {
+											goto l1586
+										}
+										position++
+										goto l1563
+									l1586:
+										position, tokenIndex = position1586, tokenIndex1586
+									}
+									{
+										position1587, tokenIndex1587 := position, tokenIndex
+										if !_rules[rulews]() {
+											goto l1587
+										}
+										goto l1588
+									l1587:
+										position, tokenIndex = position1587, tokenIndex1587
+									}
+								l1588:
+									if !_rules[ruleorExpr]() {
+										goto l1563
+									}
+									{
+										add(ruleAction71, position)
+									}
+									break
+								case '=':
+									if buffer[position] != rune('=') {
+										goto l1563
+									}
+									position++
+									if buffer[position] != rune('=') {
+										goto l1563
+									}
+									position++
+									{
+										position1590, tokenIndex1590 := position, tokenIndex
+										if !_rules[rulews]() {
+											goto l1590
+										}
+										goto l1591
+									l1590:
+										position, tokenIndex = position1590, tokenIndex1590
+									}
+								l1591:
+									if !_rules[ruleorExpr]() {
+										goto l1563
+									}
+									{
+										add(ruleAction67, position)
+									}
+									break
+								default:
+									{
+										position1593 := position
+										{
+											position1594, tokenIndex1594 := position, tokenIndex
+											{
+												position1596, tokenIndex1596 := position, tokenIndex
+												if buffer[position] != rune('l') {
+													goto l1597
+												}
+												position++
+												goto l1596
+											l1597:
+												position, tokenIndex = position1596, tokenIndex1596
+												if buffer[position] != rune('L') {
+													goto l1595
 												}
-											l996:
-												add(ruleAssignment, position995)
-											}
-											goto l993
-										l994:
-											position, tokenIndex = position993, tokenIndex993
-											{
-												position1044 := position
-												{
-													position1045, tokenIndex1045 := position, tokenIndex
-													{
-														position1047 := position
-														{
-															position1048, tokenIndex1048 := position, tokenIndex
-															if buffer[position] != rune('i') {
-																goto l1049
-															}
-															position++
-															goto l1048
-														l1049:
-															position, tokenIndex = position1048, tokenIndex1048
-															if buffer[position] != rune('I') {
-																goto l1046
-															}
-															position++
-														}
-													l1048:
-														{
-															position1050, tokenIndex1050 := position, tokenIndex
-															if buffer[position] != rune('n') {
-																goto l1051
-															}
-															position++
-															goto l1050
-														l1051:
-															position, tokenIndex = position1050, tokenIndex1050
-															if buffer[position] != rune('N') {
-																goto l1046
-															}
-															position++
-														}
-													l1050:
-														{
-															position1052, tokenIndex1052 := position, tokenIndex
-															if buffer[position] != rune('c') {
-																goto l1053
-															}
-															position++
-															goto l1052
-														l1053:
-															position, tokenIndex = position1052, tokenIndex1052
-															if buffer[position] != rune('C') {
-																goto l1046
-															}
-															position++
-														}
-													l1052:
-														if !_rules[rulews]() {
-															goto l1046
-														}
-														if !_rules[ruleILoc8]() {
-															goto l1046
-														}
-														{
-															add(ruleAction11, position)
-														}
-														add(ruleInc16Indexed8, position1047)
-													}
-													goto l1045
-												l1046:
-													position, tokenIndex = position1045, tokenIndex1045
-													{
-														position1056 := position
-														{
-															position1057, tokenIndex1057 := position, tokenIndex
-															if buffer[position] != rune('i') {
-																goto l1058
-															}
-															position++
-															goto l1057
-														l1058:
-															position, tokenIndex = position1057, tokenIndex1057
-															if buffer[position] != rune('I') {
-																goto l1055
-															}
-															position++
-														}
-													l1057:
-														{
-															position1059, tokenIndex1059 := position, tokenIndex
-															if buffer[position] != rune('n') {
-																goto l1060
-															}
-															position++
-															goto l1059
-														l1060:
-															position, tokenIndex = position1059, tokenIndex1059
-															if buffer[position] != rune('N') {
-																goto l1055
-															}
-															position++
-														}
-													l1059:
-														{
-															position1061, tokenIndex1061 := position, tokenIndex
-															if buffer[position] != rune('c') {
-																goto l1062
-															}
-															position++
-															goto l1061
-														l1062:
-															position, tokenIndex = position1061, tokenIndex1061
-															if buffer[position] != rune('C') {
-																goto l1055
-															}
-															position++
-														}
-													l1061:
-														if !_rules[rulews]() {
-															goto l1055
-														}
-														if !_rules[ruleLoc16]() {
-															goto l1055
-														}
-														{
-															add(ruleAction13, position)
-														}
-														add(ruleInc16, position1056)
-													}
-													goto l1045
-												l1055:
-													position, tokenIndex = position1045, tokenIndex1045
-													{
-														position1064 := position
-														{
-															position1065, tokenIndex1065 := position, tokenIndex
-															if buffer[position] != rune('i') {
-																goto l1066
-															}
-															position++
-															goto l1065
-														l1066:
-															position, tokenIndex = position1065, tokenIndex1065
-															if buffer[position] != rune('I') {
-																goto l1043
-															}
-															position++
-														}
-													l1065:
-														{
-															position1067, tokenIndex1067 := position, tokenIndex
-															if buffer[position] != rune('n') {
-																goto l1068
-															}
-															position++
-															goto l1067
-														l1068:
-															position, tokenIndex = position1067, tokenIndex1067
-															if buffer[position] != rune('N') {
-																goto l1043
-															}
-															position++
-														}
-													l1067:
-														{
-															position1069, tokenIndex1069 := position, tokenIndex
-															if buffer[position] != rune('c') {
-																goto l1070
-															}
-															position++
-															goto l1069
-														l1070:
-															position, tokenIndex = position1069, tokenIndex1069
-															if buffer[position] != rune('C') {
-																goto l1043
-															}
-															position++
-														}
-													l1069:
-														if !_rules[rulews]() {
-															goto l1043
-														}
-														if !_rules[ruleLoc8]() {
-															goto l1043
-														}
-														{
-															add(ruleAction12, position)
-														}
-														add(ruleInc8, position1064)
-													}
-												}
-											l1045:
-												add(ruleInc, position1044)
-											}
-											goto l993
-										l1043:
-											position, tokenIndex = position993, tokenIndex993
-											{
-												position1073 := position
-												{
-													position1074, tokenIndex1074 := position, tokenIndex
-													{
-														position1076 := position
-														{
-															position1077, tokenIndex1077 := position, tokenIndex
-															if buffer[position] != rune('d') {
-																goto l1078
-															}
-															position++
-															goto l1077
-														l1078:
-															position, tokenIndex = position1077, tokenIndex1077
-															if buffer[position] != rune('D') {
-																goto l1075
-															}
-															position++
-														}
-													l1077:
-														{
-															position1079, tokenIndex1079 := position, tokenIndex
-															if buffer[position] != rune('e') {
-																goto l1080
-															}
-															position++
-															goto l1079
-														l1080:
-															position, tokenIndex = position1079, tokenIndex1079
-															if buffer[position] != rune('E') {
-																goto l1075
-															}
-															position++
-														}
-													l1079:
-														{
-															position1081, tokenIndex1081 := position, tokenIndex
-															if buffer[position] != rune('c') {
-																goto l1082
-															}
-															position++
-															goto l1081
-														l1082:
-															position, tokenIndex = position1081, tokenIndex1081
-															if buffer[position] != rune('C') {
-																goto l1075
-															}
-															position++
-														}
-													l1081:
-														if !_rules[rulews]() {
-															goto l1075
-														}
-														if !_rules[ruleILoc8]() {
-															goto l1075
-														}
-														{
-															add(ruleAction14, position)
-														}
-														add(ruleDec16Indexed8, position1076)
-													}
-													goto l1074
-												l1075:
-													position, tokenIndex = position1074, tokenIndex1074
-													{
-														position1085 := position
-														{
-															position1086, tokenIndex1086 := position, tokenIndex
-															if buffer[position] != rune('d') {
-																goto l1087
-															}
-															position++
-															goto l1086
-														l1087:
-															position, tokenIndex = position1086, tokenIndex1086
-															if buffer[position] != rune('D') {
-																goto l1084
-															}
-															position++
-														}
-													l1086:
-														{
-															position1088, tokenIndex1088 := position, tokenIndex
-															if buffer[position] != rune('e') {
-																goto l1089
-															}
-															position++
-															goto l1088
-														l1089:
-															position, tokenIndex = position1088, tokenIndex1088
-															if buffer[position] != rune('E') {
-																goto l1084
-															}
-															position++
-														}
-													l1088:
-														{
-															position1090, tokenIndex1090 := position, tokenIndex
-															if buffer[position] != rune('c') {
-																goto l1091
-															}
-															position++
-															goto l1090
-														l1091:
-															position, tokenIndex = position1090, tokenIndex1090
-															if buffer[position] != rune('C') {
-																goto l1084
-															}
-															position++
-														}
-													l1090:
-														if !_rules[rulews]() {
-															goto l1084
-														}
-														if !_rules[ruleLoc16]() {
-															goto l1084
-														}
-														{
-															add(ruleAction16, position)
-														}
-														add(ruleDec16, position1085)
-													}
-													goto l1074
-												l1084:
-													position, tokenIndex = position1074, tokenIndex1074
-													{
-														position1093 := position
-														{
-															position1094, tokenIndex1094 := position, tokenIndex
-															if buffer[position] != rune('d') {
-																goto l1095
-															}
-															position++
-															goto l1094
-														l1095:
-															position, tokenIndex = position1094, tokenIndex1094
-															if buffer[position] != rune('D') {
-																goto l1072
-															}
-															position++
-														}
-													l1094:
-														{
-															position1096, tokenIndex1096 := position, tokenIndex
-															if buffer[position] != rune('e') {
-																goto l1097
-															}
-															position++
-															goto l1096
-														l1097:
-															position, tokenIndex = position1096, tokenIndex1096
-															if buffer[position] != rune('E') {
-																goto l1072
-															}
-															position++
-														}
-													l1096:
-														{
-															position1098, tokenIndex1098 := position, tokenIndex
-															if buffer[position] != rune('c') {
-																goto l1099
-															}
-															position++
-															goto l1098
-														l1099:
-															position, tokenIndex = position1098, tokenIndex1098
-															if buffer[position] != rune('C') {
-																goto l1072
-															}
-															position++
-														}
-													l1098:
-														if !_rules[rulews]() {
-															goto l1072
-														}
-														if !_rules[ruleLoc8]() {
-															goto l1072
-														}
-														{
-															add(ruleAction15, position)
-														}
-														add(ruleDec8, position1093)
-													}
-												}
-											l1074:
-												add(ruleDec, position1073)
+												position++
 											}
-											goto l993
-										l1072:
-											position, tokenIndex = position993, tokenIndex993
+										l1596:
 											{
-												position1102 := position
-												{
-													position1103, tokenIndex1103 := position, tokenIndex
+												position1598, tokenIndex1598 := position, tokenIndex
+												if buffer[position] != rune('e') {
+													goto l1599
+												}
+												position++
+												goto l1598
+											l1599:
+												position, tokenIndex = position1598, tokenIndex1598
+												if buffer[position] != rune('E') {
+													goto l1595
+												}
+												position++
+											}
+										l1598:
+											if !_rules[rulews]() {
+												goto l1595
+											}
+											if !_rules[ruleorExpr]() {
+												goto l1595
+											}
+											{
+												add(ruleAction75, position)
+											}
+											goto l1594
+										l1595:
+											position, tokenIndex = position1594, tokenIndex1594
+											{
+												position1602, tokenIndex1602 := position, tokenIndex
+												if buffer[position] != rune('g') {
+													goto l1603
+												}
+												position++
+												goto l1602
+											l1603:
+												position, tokenIndex = position1602, tokenIndex1602
+												if buffer[position] != rune('G') {
+													goto l1601
+												}
+												position++
+											}
+										l1602:
+											{
+												position1604, tokenIndex1604 := position, tokenIndex
+												if buffer[position] != rune('e') {
+													goto l1605
+												}
+												position++
+												goto l1604
+											l1605:
+												position, tokenIndex = position1604, tokenIndex1604
+												if buffer[position] != rune('E') {
+													goto l1601
+												}
+												position++
+											}
+										l1604:
+											if !_rules[rulews]() {
+												goto l1601
+											}
+											if !_rules[ruleorExpr]() {
+												goto l1601
+											}
+											{
+												add(ruleAction76, position)
+											}
+											goto l1594
+										l1601:
+											position, tokenIndex = position1594, tokenIndex1594
+											{
+												switch buffer[position] {
+												case 'G', 'g':
 													{
-														position1105 := position
-														{
-															position1106, tokenIndex1106 := position, tokenIndex
-															if buffer[position] != rune('a') {
-																goto l1107
-															}
-															position++
-															goto l1106
-														l1107:
-															position, tokenIndex = position1106, tokenIndex1106
-															if buffer[position] != rune('A') {
-																goto l1104
-															}
-															position++
-														}
-													l1106:
-														{
-															position1108, tokenIndex1108 := position, tokenIndex
-															if buffer[position] != rune('d') {
-																goto l1109
-															}
-															position++
-															goto l1108
-														l1109:
-															position, tokenIndex = position1108, tokenIndex1108
-															if buffer[position] != rune('D') {
-																goto l1104
-															}
-															position++
-														}
-													l1108:
-														{
-															position1110, tokenIndex1110 := position, tokenIndex
-															if buffer[position] != rune('d') {
-																goto l1111
-															}
-															position++
-															goto l1110
-														l1111:
-															position, tokenIndex = position1110, tokenIndex1110
-															if buffer[position] != rune('D') {
-																goto l1104
-															}
-															position++
-														}
-													l1110:
-														if !_rules[rulews]() {
-															goto l1104
-														}
-														if !_rules[ruleDst16]() {
-															goto l1104
-														}
-														if !_rules[rulesep]() {
-															goto l1104
-														}
-														if !_rules[ruleSrc16]() {
-															goto l1104
+														position1608, tokenIndex1608 := position, tokenIndex
+														if buffer[position] != rune('g') {
+															goto l1609
 														}
-														{
-															add(ruleAction17, position)
+														position++
+														goto l1608
+													l1609:
+														position, tokenIndex = position1608, tokenIndex1608
+														if buffer[position] != rune('G') {
+															goto l1563
 														}
-														add(ruleAdd16, position1105)
+														position++
 													}
-													goto l1103
-												l1104:
-													position, tokenIndex = position1103, tokenIndex1103
+												l1608:
 													{
-														position1114 := position
-														{
-															position1115, tokenIndex1115 := position, tokenIndex
-															if buffer[position] != rune('a') {
-																goto l1116
-															}
-															position++
-															goto l1115
-														l1116:
-															position, tokenIndex = position1115, tokenIndex1115
-															if buffer[position] != rune('A') {
-																goto l1113
-															}
-															position++
-														}
-													l1115:
-														{
-															position1117, tokenIndex1117 := position, tokenIndex
-															if buffer[position] != rune('d') {
-																goto l1118
-															}
-															position++
-															goto l1117
-														l1118:
-															position, tokenIndex = position1117, tokenIndex1117
-															if buffer[position] != rune('D') {
-																goto l1113
-															}
-															position++
-														}
-													l1117:
-														{
-															position1119, tokenIndex1119 := position, tokenIndex
-															if buffer[position] != rune('c') {
-																goto l1120
-															}
-															position++
-															goto l1119
-														l1120:
-															position, tokenIndex = position1119, tokenIndex1119
-															if buffer[position] != rune('C') {
-																goto l1113
-															}
-															position++
-														}
-													l1119:
-														if !_rules[rulews]() {
-															goto l1113
-														}
-														if !_rules[ruleDst16]() {
-															goto l1113
-														}
-														if !_rules[rulesep]() {
-															goto l1113
-														}
-														if !_rules[ruleSrc16]() {
-															goto l1113
+														position1610, tokenIndex1610 := position, tokenIndex
+														if buffer[position] != rune('t') {
+															goto l1611
 														}
-														{
-															add(ruleAction18, position)
+														position++
+														goto l1610
+													l1611:
+														position, tokenIndex = position1610, tokenIndex1610
+														if buffer[position] != rune('T') {
+															goto l1563
 														}
-														add(ruleAdc16, position1114)
+														position++
+													}
+												l1610:
+													if !_rules[rulews]() {
+														goto l1563
+													}
+													if !_rules[ruleorExpr]() {
+														goto l1563
 													}
-													goto l1103
-												l1113:
-													position, tokenIndex = position1103, tokenIndex1103
 													{
-														position1122 := position
-														{
-															position1123, tokenIndex1123 := position, tokenIndex
-															if buffer[position] != rune('s') {
-																goto l1124
-															}
-															position++
-															goto l1123
-														l1124:
-															position, tokenIndex = position1123, tokenIndex1123
-															if buffer[position] != rune('S') {
-																goto l1101
-															}
-															position++
-														}
-													l1123:
-														{
-															position1125, tokenIndex1125 := position, tokenIndex
-															if buffer[position] != rune('b') {
-																goto l1126
-															}
-															position++
-															goto l1125
-														l1126:
-															position, tokenIndex = position1125, tokenIndex1125
-															if buffer[position] != rune('B') {
-																goto l1101
-															}
-															position++
-														}
-													l1125:
-														{
-															position1127, tokenIndex1127 := position, tokenIndex
-															if buffer[position] != rune('c') {
-																goto l1128
-															}
-															position++
-															goto l1127
-														l1128:
-															position, tokenIndex = position1127, tokenIndex1127
-															if buffer[position] != rune('C') {
-																goto l1101
-															}
-															position++
-														}
-													l1127:
-														if !_rules[rulews]() {
-															goto l1101
-														}
-														if !_rules[ruleDst16]() {
-															goto l1101
-														}
-														if !_rules[rulesep]() {
-															goto l1101
-														}
-														if !_rules[ruleSrc16]() {
-															goto l1101
-														}
-														{
-															add(ruleAction19, position)
-														}
-														add(ruleSbc16, position1122)
+														add(ruleAction78, position)
 													}
-												}
-											l1103:
-												add(ruleAlu16, position1102)
-											}
-											goto l993
-										l1101:
-											position, tokenIndex = position993, tokenIndex993
-											{
-												position1131 := position
-												{
-													position1132, tokenIndex1132 := position, tokenIndex
+													break
+												case 'L', 'l':
 													{
-														position1134 := position
-														{
-															position1135, tokenIndex1135 := position, tokenIndex
-															if buffer[position] != rune('a') {
-																goto l1136
-															}
-															position++
-															goto l1135
-														l1136:
-															position, tokenIndex = position1135, tokenIndex1135
-															if buffer[position] != rune('A') {
-																goto l1133
-															}
-															position++
-														}
-													l1135:
-														{
-															position1137, tokenIndex1137 := position, tokenIndex
-															if buffer[position] != rune('d') {
-																goto l1138
-															}
-															position++
-															goto l1137
-														l1138:
-															position, tokenIndex = position1137, tokenIndex1137
-															if buffer[position] != rune('D') {
-																goto l1133
-															}
-															position++
-														}
-													l1137:
-														{
-															position1139, tokenIndex1139 := position, tokenIndex
-															if buffer[position] != rune('d') {
-																goto l1140
-															}
-															position++
-															goto l1139
-														l1140:
-															position, tokenIndex = position1139, tokenIndex1139
-															if buffer[position] != rune('D') {
-																goto l1133
-															}
-															position++
-														}
-													l1139:
-														if !_rules[rulews]() {
-															goto l1133
-														}
-														{
-															position1141, tokenIndex1141 := position, tokenIndex
-															if buffer[position] != rune('a') {
-																goto l1142
-															}
-															position++
-															goto l1141
-														l1142:
-															position, tokenIndex = position1141, tokenIndex1141
-															if buffer[position] != rune('A') {
-																goto l1133
-															}
-															position++
-														}
-													l1141:
-														if !_rules[rulesep]() {
-															goto l1133
-														}
-														if !_rules[ruleSrc8]() {
-															goto l1133
+														position1613, tokenIndex1613 := position, tokenIndex
+														if buffer[position] != rune('l') {
+															goto l1614
 														}
-														{
-															add(ruleAction43, position)
+														position++
+														goto l1613
+													l1614:
+														position, tokenIndex = position1613, tokenIndex1613
+														if buffer[position] != rune('L') {
+															goto l1563
 														}
-														add(ruleAdd, position1134)
+														position++
 													}
-													goto l1132
-												l1133:
-													position, tokenIndex = position1132, tokenIndex1132
+												l1613:
 													{
-														position1145 := position
-														{
-															position1146, tokenIndex1146 := position, tokenIndex
-															if buffer[position] != rune('a') {
-																goto l1147
-															}
-															position++
-															goto l1146
-														l1147:
-															position, tokenIndex = position1146, tokenIndex1146
-															if buffer[position] != rune('A') {
-																goto l1144
-															}
-															position++
-														}
-													l1146:
-														{
-															position1148, tokenIndex1148 := position, tokenIndex
-															if buffer[position] != rune('d') {
-																goto l1149
-															}
-															position++
-															goto l1148
-														l1149:
-															position, tokenIndex = position1148, tokenIndex1148
-															if buffer[position] != rune('D') {
-																goto l1144
-															}
-															position++
-														}
-													l1148:
-														{
-															position1150, tokenIndex1150 := position, tokenIndex
-															if buffer[position] != rune('c') {
-																goto l1151
-															}
-															position++
-															goto l1150
-														l1151:
-															position, tokenIndex = position1150, tokenIndex1150
-															if buffer[position] != rune('C') {
-																goto l1144
-															}
-															position++
-														}
-													l1150:
-														if !_rules[rulews]() {
-															goto l1144
-														}
-														{
-															position1152, tokenIndex1152 := position, tokenIndex
-															if buffer[position] != rune('a') {
-																goto l1153
-															}
-															position++
-															goto l1152
-														l1153:
-															position, tokenIndex = position1152, tokenIndex1152
-															if buffer[position] != rune('A') {
-																goto l1144
-															}
-															position++
+														position1615, tokenIndex1615 := position, tokenIndex
+														if buffer[position] != rune('t') {
+															goto l1616
 														}
-													l1152:
-														if !_rules[rulesep]() {
-															goto l1144
+														position++
+														goto l1615
+													l1616:
+														position, tokenIndex = position1615, tokenIndex1615
+														if buffer[position] != rune('T') {
+															goto l1563
 														}
-														if !_rules[ruleSrc8]() {
-															goto l1144
+														position++
+													}
+												l1615:
+													if !_rules[rulews]() {
+														goto l1563
+													}
+													if !_rules[ruleorExpr]() {
+														goto l1563
+													}
+													{
+														add(ruleAction77, position)
+													}
+													break
+												case 'N', 'n':
+													{
+														position1618, tokenIndex1618 := position, tokenIndex
+														if buffer[position] != rune('n') {
+															goto l1619
 														}
-														{
-															add(ruleAction44, position)
+														position++
+														goto l1618
+													l1619:
+														position, tokenIndex = position1618, tokenIndex1618
+														if buffer[position] != rune('N') {
+															goto l1563
 														}
-														add(ruleAdc, position1145)
+														position++
 													}
-													goto l1132
-												l1144:
-													position, tokenIndex = position1132, tokenIndex1132
+												l1618:
 													{
-														position1156 := position
-														{
-															position1157, tokenIndex1157 := position, tokenIndex
-															if buffer[position] != rune('s') {
-																goto l1158
-															}
-															position++
-															goto l1157
-														l1158:
-															position, tokenIndex = position1157, tokenIndex1157
-															if buffer[position] != rune('S') {
-																goto l1155
-															}
-															position++
+														position1620, tokenIndex1620 := position, tokenIndex
+														if buffer[position] != rune('e') {
+															goto l1621
 														}
-													l1157:
-														{
-															position1159, tokenIndex1159 := position, tokenIndex
-															if buffer[position] != rune('u') {
-																goto l1160
-															}
-															position++
-															goto l1159
-														l1160:
-															position, tokenIndex = position1159, tokenIndex1159
-															if buffer[position] != rune('U') {
-																goto l1155
-															}
-															position++
+														position++
+														goto l1620
+													l1621:
+														position, tokenIndex = position1620, tokenIndex1620
+														if buffer[position] != rune('E') {
+															goto l1563
 														}
-													l1159:
-														{
-															position1161, tokenIndex1161 := position, tokenIndex
-															if buffer[position] != rune('b') {
-																goto l1162
-															}
-															position++
-															goto l1161
-														l1162:
-															position, tokenIndex = position1161, tokenIndex1161
-															if buffer[position] != rune('B') {
-																goto l1155
-															}
-															position++
+														position++
+													}
+												l1620:
+													if !_rules[rulews]() {
+														goto l1563
+													}
+													if !_rules[ruleorExpr]() {
+														goto l1563
+													}
+													{
+														add(ruleAction74, position)
+													}
+													break
+												default:
+													{
+														position1623, tokenIndex1623 := position, tokenIndex
+														if buffer[position] != rune('e') {
+															goto l1624
 														}
-													l1161:
-														if !_rules[rulews]() {
-															goto l1155
+														position++
+														goto l1623
+													l1624:
+														position, tokenIndex = position1623, tokenIndex1623
+														if buffer[position] != rune('E') {
+															goto l1563
 														}
-														if !_rules[ruleSrc8]() {
-															goto l1155
+														position++
+													}
+												l1623:
+													{
+														position1625, tokenIndex1625 := position, tokenIndex
+														if buffer[position] != rune('q') {
+															goto l1626
 														}
-														{
-															add(ruleAction45, position)
+														position++
+														goto l1625
+													l1626:
+														position, tokenIndex = position1625, tokenIndex1625
+														if buffer[position] != rune('Q') {
+															goto l1563
 														}
-														add(ruleSub, position1156)
+														position++
+													}
+												l1625:
+													if !_rules[rulews]() {
+														goto l1563
+													}
+													if !_rules[ruleorExpr]() {
+														goto l1563
 													}
-													goto l1132
-												l1155:
-													position, tokenIndex = position1132, tokenIndex1132
 													{
-														switch buffer[position] {
-														case 'C', 'c':
-															{
-																position1165 := position
-																{
-																	position1166, tokenIndex1166 := position, tokenIndex
-																	if buffer[position] != rune('c') {
-																		goto l1167
-																	}
-																	position++
-																	goto l1166
-																l1167:
-																	position, tokenIndex = position1166, tokenIndex1166
-																	if buffer[position] != rune('C') {
-																		goto l1130
-																	}
-																	position++
-																}
-															l1166:
-																{
-																	position1168, tokenIndex1168 := position, tokenIndex
-																	if buffer[position] != rune('p') {
-																		goto l1169
-																	}
-																	position++
-																	goto l1168
-																l1169:
-																	position, tokenIndex = position1168, tokenIndex1168
-																	if buffer[position] != rune('P') {
-																		goto l1130
-																	}
-																	position++
-																}
-															l1168:
-																if !_rules[rulews]() {
-																	goto l1130
-																}
-																if !_rules[ruleSrc8]() {
-																	goto l1130
-																}
-																{
-																	add(ruleAction50, position)
-																}
-																add(ruleCp, position1165)
-															}
-															break
-														case 'O', 'o':
-															{
-																position1171 := position
-																{
-																	position1172, tokenIndex1172 := position, tokenIndex
-																	if buffer[position] != rune('o') {
-																		goto l1173
-																	}
-																	position++
-																	goto l1172
-																l1173:
-																	position, tokenIndex = position1172, tokenIndex1172
-																	if buffer[position] != rune('O') {
-																		goto l1130
-																	}
-																	position++
-																}
-															l1172:
-																{
-																	position1174, tokenIndex1174 := position, tokenIndex
-																	if buffer[position] != rune('r') {
-																		goto l1175
-																	}
-																	position++
-																	goto l1174
-																l1175:
-																	position, tokenIndex = position1174, tokenIndex1174
-																	if buffer[position] != rune('R') {
-																		goto l1130
-																	}
-																	position++
-																}
-															l1174:
-																if !_rules[rulews]() {
-																	goto l1130
-																}
-																if !_rules[ruleSrc8]() {
-																	goto l1130
-																}
-																{
-																	add(ruleAction49, position)
-																}
-																add(ruleOr, position1171)
-															}
-															break
-														case 'X', 'x':
-															{
-																position1177 := position
-																{
-																	position1178, tokenIndex1178 := position, tokenIndex
-																	if buffer[position] != rune('x') {
-																		goto l1179
-																	}
-																	position++
-																	goto l1178
-																l1179:
-																	position, tokenIndex = position1178, tokenIndex1178
-																	if buffer[position] != rune('X') {
-																		goto l1130
-																	}
-																	position++
-																}
-															l1178:
-																{
-																	position1180, tokenIndex1180 := position, tokenIndex
-																	if buffer[position] != rune('o') {
-																		goto l1181
-																	}
-																	position++
-																	goto l1180
-																l1181:
-																	position, tokenIndex = position1180, tokenIndex1180
-																	if buffer[position] != rune('O') {
-																		goto l1130
-																	}
-																	position++
-																}
-															l1180:
-																{
-																	position1182, tokenIndex1182 := position, tokenIndex
-																	if buffer[position] != rune('r') {
-																		goto l1183
-																	}
-																	position++
-																	goto l1182
-																l1183:
-																	position, tokenIndex = position1182, tokenIndex1182
-																	if buffer[position] != rune('R') {
-																		goto l1130
-																	}
-																	position++
-																}
-															l1182:
-																if !_rules[rulews]() {
-																	goto l1130
-																}
-																if !_rules[ruleSrc8]() {
-																	goto l1130
-																}
-																{
-																	add(ruleAction48, position)
-																}
-																add(ruleXor, position1177)
-															}
-															break
-														case 'A', 'a':
-															{
-																position1185 := position
-																{
-																	position1186, tokenIndex1186 := position, tokenIndex
-																	if buffer[position] != rune('a') {
-																		goto l1187
-																	}
-																	position++
-																	goto l1186
-																l1187:
-																	position, tokenIndex = position1186, tokenIndex1186
-																	if buffer[position] != rune('A') {
-																		goto l1130
-																	}
-																	position++
-																}
-															l1186:
-																{
-																	position1188, tokenIndex1188 := position, tokenIndex
-																	if buffer[position] != rune('n') {
-																		goto l1189
-																	}
-																	position++
-																	goto l1188
-																l1189:
-																	position, tokenIndex = position1188, tokenIndex1188
-																	if buffer[position] != rune('N') {
-																		goto l1130
-																	}
-																	position++
-																}
-															l1188:
-																{
-																	position1190, tokenIndex1190 := position, tokenIndex
-																	if buffer[position] != rune('d') {
-																		goto l1191
-																	}
-																	position++
-																	goto l1190
-																l1191:
-																	position, tokenIndex = position1190, tokenIndex1190
-																	if buffer[position] != rune('D') {
-																		goto l1130
-																	}
-																	position++
-																}
-															l1190:
-																if !_rules[rulews]() {
-																	goto l1130
-																}
-																{
-																	position1192, tokenIndex1192 := position, tokenIndex
-																	{
-																		position1194, tokenIndex1194 := position, tokenIndex
-																		if buffer[position] != rune('a') {
-																			goto l1195
-																		}
-																		position++
-																		goto l1194
-																	l1195:
-																		position, tokenIndex = position1194, tokenIndex1194
-																		if buffer[position] != rune('A') {
-																			goto l1192
-																		}
-																		position++
-																	}
-																l1194:
-																	if !_rules[rulesep]() {
-																		goto l1192
-																	}
-																	goto l1193
-																l1192:
-																	position, tokenIndex = position1192, tokenIndex1192
-																}
-															l1193:
-																if !_rules[ruleSrc8]() {
-																	goto l1130
-																}
-																{
-																	add(ruleAction47, position)
-																}
-																add(ruleAnd, position1185)
-															}
-															break
-														default:
-															{
-																position1197 := position
-																{
-																	position1198, tokenIndex1198 := position, tokenIndex
-																	if buffer[position] != rune('s') {
-																		goto l1199
-																	}
-																	position++
-																	goto l1198
-																l1199:
-																	position, tokenIndex = position1198, tokenIndex1198
-																	if buffer[position] != rune('S') {
-																		goto l1130
-																	}
-																	position++
-																}
-															l1198:
-																{
-																	position1200, tokenIndex1200 := position, tokenIndex
-																	if buffer[position] != rune('b') {
-																		goto l1201
-																	}
-																	position++
-																	goto l1200
-																l1201:
-																	position, tokenIndex = position1200, tokenIndex1200
-																	if buffer[position] != rune('B') {
-																		goto l1130
-																	}
-																	position++
-																}
-															l1200:
-																{
-																	position1202, tokenIndex1202 := position, tokenIndex
-																	if buffer[position] != rune('c') {
-																		goto l1203
-																	}
-																	position++
-																	goto l1202
-																l1203:
-																	position, tokenIndex = position1202, tokenIndex1202
-																	if buffer[position] != rune('C') {
-																		goto l1130
-																	}
-																	position++
-																}
-															l1202:
-																if !_rules[rulews]() {
-																	goto l1130
-																}
-																{
-																	position1204, tokenIndex1204 := position, tokenIndex
-																	if buffer[position] != rune('a') {
-																		goto l1205
-																	}
-																	position++
-																	goto l1204
-																l1205:
-																	position, tokenIndex = position1204, tokenIndex1204
-																	if buffer[position] != rune('A') {
-																		goto l1130
-																	}
-																	position++
-																}
-															l1204:
-																if !_rules[rulesep]() {
-																	goto l1130
-																}
-																if !_rules[ruleSrc8]() {
-																	goto l1130
-																}
-																{
-																	add(ruleAction46, position)
-																}
-																add(ruleSbc, position1197)
-															}
-															break
-														}
+														add(ruleAction73, position)
 													}
+													break
+												}
+											}
+
+										}
+									l1594:
+										add(rulecmpWord, position1593)
+									}
+									break
+								}
+							}
+
+						}
+					l1566:
+						goto l1562
+					l1563:
+						position, tokenIndex = position1563, tokenIndex1563
+					}
+					add(rulecmpExpr, position1561)
+				}
+				add(ruleexpr, position1560)
+			}
+			return true
+		l1559:
+			position, tokenIndex = position1559, tokenIndex1559
+			return false
+		},
+		/* 75 cmpExpr <- <(orExpr (ws? (((('!' '=') / ('<' '>')) ws? orExpr Action68) / ('<' '=' ws? orExpr Action69) / ('>' '=' ws? orExpr Action70) / ((&('>') ('>' !'>' ws? orExpr Action72)) | (&('<') ('<' !'<' ws? orExpr Action71)) | (&('=') ('=' '=' ws? orExpr Action67)) | (&('E' | 'G' | 'L' | 'N' | 'e' | 'g' | 'l' | 'n') cmpWord))))*)> */
+		nil,
+		/* 76 cmpWord <- <((('l' / 'L') ('e' / 'E') ws orExpr Action75) / (('g' / 'G') ('e' / 'E') ws orExpr Action76) / ((&('G' | 'g') (('g' / 'G') ('t' / 'T') ws orExpr Action78)) | (&('L' | 'l') (('l' / 'L') ('t' / 'T') ws orExpr Action77)) | (&('N' | 'n') (('n' / 'N') ('e' / 'E') ws orExpr Action74)) | (&('E' | 'e') (('e' / 'E') ('q' / 'Q') ws orExpr Action73))))> */
+		nil,
+		/* 77 orExpr <- <(xorExpr ((ws? '|' ws? xorExpr Action79) / (ws (('o' / 'O') ('r' / 'R')) ws xorExpr Action80))*)> */
+		func() bool {
+			position1630, tokenIndex1630 := position, tokenIndex
+			{
+				position1631 := position
+				if !_rules[rulexorExpr]() {
+					goto l1630
+				}
+			l1632:
+				{
+					position1633, tokenIndex1633 := position, tokenIndex
+					{
+						position1634, tokenIndex1634 := position, tokenIndex
+						{
+							position1636, tokenIndex1636 := position, tokenIndex
+							if !_rules[rulews]() {
+								goto l1636
+							}
+							goto l1637
+						l1636:
+							position, tokenIndex = position1636, tokenIndex1636
+						}
+					l1637:
+						if buffer[position] != rune('|') {
+							goto l1635
+						}
+						position++
+						{
+							position1638, tokenIndex1638 := position, tokenIndex
+							if !_rules[rulews]() {
+								goto l1638
+							}
+							goto l1639
+						l1638:
+							position, tokenIndex = position1638, tokenIndex1638
+						}
+					l1639:
+						if !_rules[rulexorExpr]() {
+							goto l1635
+						}
+						{
+							add(ruleAction79, position)
+						}
+						goto l1634
+					l1635:
+						position, tokenIndex = position1634, tokenIndex1634
+						if !_rules[rulews]() {
+							goto l1633
+						}
+						{
+							position1641, tokenIndex1641 := position, tokenIndex
+							if buffer[position] != rune('o') {
+								goto l1642
+							}
+							position++
+							goto l1641
+						l1642:
+							position, tokenIndex = position1641, tokenIndex1641
+							if buffer[position] != rune('O') {
+								goto l1633
+							}
+							position++
+						}
+					l1641:
+						{
+							position1643, tokenIndex1643 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l1644
+							}
+							position++
+							goto l1643
+						l1644:
+							position, tokenIndex = position1643, tokenIndex1643
+							if buffer[position] != rune('R') {
+								goto l1633
+							}
+							position++
+						}
+					l1643:
+						if !_rules[rulews]() {
+							goto l1633
+						}
+						if !_rules[rulexorExpr]() {
+							goto l1633
+						}
+						{
+							add(ruleAction80, position)
+						}
+					}
+				l1634:
+					goto l1632
+				l1633:
+					position, tokenIndex = position1633, tokenIndex1633
+				}
+				add(ruleorExpr, position1631)
+			}
+			return true
+		l1630:
+			position, tokenIndex = position1630, tokenIndex1630
+			return false
+		},
+		/* 78 xorExpr <- <(andExpr ((ws? '^' ws? andExpr Action81) / (ws (('x' / 'X') ('o' / 'O') ('r' / 'R')) ws andExpr Action82))*)> */
+		func() bool {
+			position1646, tokenIndex1646 := position, tokenIndex
+			{
+				position1647 := position
+				if !_rules[ruleandExpr]() {
+					goto l1646
+				}
+			l1648:
+				{
+					position1649, tokenIndex1649 := position, tokenIndex
+					{
+						position1650, tokenIndex1650 := position, tokenIndex
+						{
+							position1652, tokenIndex1652 := position, tokenIndex
+							if !_rules[rulews]() {
+								goto l1652
+							}
+							goto l1653
+						l1652:
+							position, tokenIndex = position1652, tokenIndex1652
+						}
+					l1653:
+						if buffer[position] != rune('^') {
+							goto l1651
+						}
+						position++
+						{
+							position1654, tokenIndex1654 := position, tokenIndex
+							if !_rules[rulews]() {
+								goto l1654
+							}
+							goto l1655
+						l1654:
+							position, tokenIndex = position1654, tokenIndex1654
+						}
+					l1655:
+						if !_rules[ruleandExpr]() {
+							goto l1651
+						}
+						{
+							add(ruleAction81, position)
+						}
+						goto l1650
+					l1651:
+						position, tokenIndex = position1650, tokenIndex1650
+						if !_rules[rulews]() {
+							goto l1649
+						}
+						{
+							position1657, tokenIndex1657 := position, tokenIndex
+							if buffer[position] != rune('x') {
+								goto l1658
+							}
+							position++
+							goto l1657
+						l1658:
+							position, tokenIndex = position1657, tokenIndex1657
+							if buffer[position] != rune('X') {
+								goto l1649
+							}
+							position++
+						}
+					l1657:
+						{
+							position1659, tokenIndex1659 := position, tokenIndex
+							if buffer[position] != rune('o') {
+								goto l1660
+							}
+							position++
+							goto l1659
+						l1660:
+							position, tokenIndex = position1659, tokenIndex1659
+							if buffer[position] != rune('O') {
+								goto l1649
+							}
+							position++
+						}
+					l1659:
+						{
+							position1661, tokenIndex1661 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l1662
+							}
+							position++
+							goto l1661
+						l1662:
+							position, tokenIndex = position1661, tokenIndex1661
+							if buffer[position] != rune('R') {
+								goto l1649
+							}
+							position++
+						}
+					l1661:
+						if !_rules[rulews]() {
+							goto l1649
+						}
+						if !_rules[ruleandExpr]() {
+							goto l1649
+						}
+						{
+							add(ruleAction82, position)
+						}
+					}
+				l1650:
+					goto l1648
+				l1649:
+					position, tokenIndex = position1649, tokenIndex1649
+				}
+				add(rulexorExpr, position1647)
+			}
+			return true
+		l1646:
+			position, tokenIndex = position1646, tokenIndex1646
+			return false
+		},
+		/* 79 andExpr <- <(shiftExpr ((ws? '&' ws? shiftExpr Action83) / (ws (('a' / 'A') ('n' / 'N') ('d' / 'D')) ws shiftExpr Action84))*)> */
+		func() bool {
+			position1664, tokenIndex1664 := position, tokenIndex
+			{
+				position1665 := position
+				if !_rules[ruleshiftExpr]() {
+					goto l1664
+				}
+			l1666:
+				{
+					position1667, tokenIndex1667 := position, tokenIndex
+					{
+						position1668, tokenIndex1668 := position, tokenIndex
+						{
+							position1670, tokenIndex1670 := position, tokenIndex
+							if !_rules[rulews]() {
+								goto l1670
+							}
+							goto l1671
+						l1670:
+							position, tokenIndex = position1670, tokenIndex1670
+						}
+					l1671:
+						if buffer[position] != rune('&') {
+							goto l1669
+						}
+						position++
+						{
+							position1672, tokenIndex1672 := position, tokenIndex
+							if !_rules[rulews]() {
+								goto l1672
+							}
+							goto l1673
+						l1672:
+							position, tokenIndex = position1672, tokenIndex1672
+						}
+					l1673:
+						if !_rules[ruleshiftExpr]() {
+							goto l1669
+						}
+						{
+							add(ruleAction83, position)
+						}
+						goto l1668
+					l1669:
+						position, tokenIndex = position1668, tokenIndex1668
+						if !_rules[rulews]() {
+							goto l1667
+						}
+						{
+							position1675, tokenIndex1675 := position, tokenIndex
+							if buffer[position] != rune('a') {
+								goto l1676
+							}
+							position++
+							goto l1675
+						l1676:
+							position, tokenIndex = position1675, tokenIndex1675
+							if buffer[position] != rune('A') {
+								goto l1667
+							}
+							position++
+						}
+					l1675:
+						{
+							position1677, tokenIndex1677 := position, tokenIndex
+							if buffer[position] != rune('n') {
+								goto l1678
+							}
+							position++
+							goto l1677
+						l1678:
+							position, tokenIndex = position1677, tokenIndex1677
+							if buffer[position] != rune('N') {
+								goto l1667
+							}
+							position++
+						}
+					l1677:
+						{
+							position1679, tokenIndex1679 := position, tokenIndex
+							if buffer[position] != rune('d') {
+								goto l1680
+							}
+							position++
+							goto l1679
+						l1680:
+							position, tokenIndex = position1679, tokenIndex1679
+							if buffer[position] != rune('D') {
+								goto l1667
+							}
+							position++
+						}
+					l1679:
+						if !_rules[rulews]() {
+							goto l1667
+						}
+						if !_rules[ruleshiftExpr]() {
+							goto l1667
+						}
+						{
+							add(ruleAction84, position)
+						}
+					}
+				l1668:
+					goto l1666
+				l1667:
+					position, tokenIndex = position1667, tokenIndex1667
+				}
+				add(ruleandExpr, position1665)
+			}
+			return true
+		l1664:
+			position, tokenIndex = position1664, tokenIndex1664
+			return false
+		},
+		/* 80 shiftExpr <- <(addExpr ((ws? ('<' '<') ws? addExpr Action85) / (ws? ('>' '>') ws? addExpr Action86) / (ws (('s' / 'S') ('h' / 'H') ('l' / 'L')) ws addExpr Action87) / (ws (('s' / 'S') ('h' / 'H') ('r' / 'R')) ws addExpr Action88))*)> */
+		func() bool {
+			position1682, tokenIndex1682 := position, tokenIndex
+			{
+				position1683 := position
+				if !_rules[ruleaddExpr]() {
+					goto l1682
+				}
+			l1684:
+				{
+					position1685, tokenIndex1685 := position, tokenIndex
+					{
+						position1686, tokenIndex1686 := position, tokenIndex
+						{
+							position1688, tokenIndex1688 := position, tokenIndex
+							if !_rules[rulews]() {
+								goto l1688
+							}
+							goto l1689
+						l1688:
+							position, tokenIndex = position1688, tokenIndex1688
+						}
+					l1689:
+						if buffer[position] != rune('<') {
+							goto l1687
+						}
+						position++
+						if buffer[position] != rune('<') {
+							goto l1687
+						}
+						position++
+						{
+							position1690, tokenIndex1690 := position, tokenIndex
+							if !_rules[rulews]() {
+								goto l1690
+							}
+							goto l1691
+						l1690:
+							position, tokenIndex = position1690, tokenIndex1690
+						}
+					l1691:
+						if !_rules[ruleaddExpr]() {
+							goto l1687
+						}
+						{
+							add(ruleAction85, position)
+						}
+						goto l1686
+					l1687:
+						position, tokenIndex = position1686, tokenIndex1686
+						{
+							position1694, tokenIndex1694 := position, tokenIndex
+							if !_rules[rulews]() {
+								goto l1694
+							}
+							goto l1695
+						l1694:
+							position, tokenIndex = position1694, tokenIndex1694
+						}
+					l1695:
+						if buffer[position] != rune('>') {
+							goto l1693
+						}
+						position++
+						if buffer[position] != rune('>') {
+							goto l1693
+						}
+						position++
+						{
+							position1696, tokenIndex1696 := position, tokenIndex
+							if !_rules[rulews]() {
+								goto l1696
+							}
+							goto l1697
+						l1696:
+							position, tokenIndex = position1696, tokenIndex1696
+						}
+					l1697:
+						if !_rules[ruleaddExpr]() {
+							goto l1693
+						}
+						{
+							add(ruleAction86, position)
+						}
+						goto l1686
+					l1693:
+						position, tokenIndex = position1686, tokenIndex1686
+						if !_rules[rulews]() {
+							goto l1699
+						}
+						{
+							position1700, tokenIndex1700 := position, tokenIndex
+							if buffer[position] != rune('s') {
+								goto l1701
+							}
+							position++
+							goto l1700
+						l1701:
+							position, tokenIndex = position1700, tokenIndex1700
+							if buffer[position] != rune('S') {
+								goto l1699
+							}
+							position++
+						}
+					l1700:
+						{
+							position1702, tokenIndex1702 := position, tokenIndex
+							if buffer[position] != rune('h') {
+								goto l1703
+							}
+							position++
+							goto l1702
+						l1703:
+							position, tokenIndex = position1702, tokenIndex1702
+							if buffer[position] != rune('H') {
+								goto l1699
+							}
+							position++
+						}
+					l1702:
+						{
+							position1704, tokenIndex1704 := position, tokenIndex
+							if buffer[position] != rune('l') {
+								goto l1705
+							}
+							position++
+							goto l1704
+						l1705:
+							position, tokenIndex = position1704, tokenIndex1704
+							if buffer[position] != rune('L') {
+								goto l1699
+							}
+							position++
+						}
+					l1704:
+						if !_rules[rulews]() {
+							goto l1699
+						}
+						if !_rules[ruleaddExpr]() {
+							goto l1699
+						}
+						{
+							add(ruleAction87, position)
+						}
+						goto l1686
+					l1699:
+						position, tokenIndex = position1686, tokenIndex1686
+						if !_rules[rulews]() {
+							goto l1685
+						}
+						{
+							position1707, tokenIndex1707 := position, tokenIndex
+							if buffer[position] != rune('s') {
+								goto l1708
+							}
+							position++
+							goto l1707
+						l1708:
+							position, tokenIndex = position1707, tokenIndex1707
+							if buffer[position] != rune('S') {
+								goto l1685
+							}
+							position++
+						}
+					l1707:
+						{
+							position1709, tokenIndex1709 := position, tokenIndex
+							if buffer[position] != rune('h') {
+								goto l1710
+							}
+							position++
+							goto l1709
+						l1710:
+							position, tokenIndex = position1709, tokenIndex1709
+							if buffer[position] != rune('H') {
+								goto l1685
+							}
+							position++
+						}
+					l1709:
+						{
+							position1711, tokenIndex1711 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l1712
+							}
+							position++
+							goto l1711
+						l1712:
+							position, tokenIndex = position1711, tokenIndex1711
+							if buffer[position] != rune('R') {
+								goto l1685
+							}
+							position++
+						}
+					l1711:
+						if !_rules[rulews]() {
+							goto l1685
+						}
+						if !_rules[ruleaddExpr]() {
+							goto l1685
+						}
+						{
+							add(ruleAction88, position)
+						}
+					}
+				l1686:
+					goto l1684
+				l1685:
+					position, tokenIndex = position1685, tokenIndex1685
+				}
+				add(ruleshiftExpr, position1683)
+			}
+			return true
+		l1682:
+			position, tokenIndex = position1682, tokenIndex1682
+			return false
+		},
+		/* 81 addExpr <- <(mulExpr (ws? (('+' ws? mulExpr Action89) / ('-' ws? mulExpr Action90)))*)> */
+		func() bool {
+			position1714, tokenIndex1714 := position, tokenIndex
+			{
+				position1715 := position
+				if !_rules[rulemulExpr]() {
+					goto l1714
+				}
+			l1716:
+				{
+					position1717, tokenIndex1717 := position, tokenIndex
+					{
+						position1718, tokenIndex1718 := position, tokenIndex
+						if !_rules[rulews]() {
+							goto l1718
+						}
+						goto l1719
+					l1718:
+						position, tokenIndex = position1718, tokenIndex1718
+					}
+				l1719:
+					{
+						position1720, tokenIndex1720 := position, tokenIndex
+						if buffer[position] != rune('+') {
+							goto l1721
+						}
+						position++
+						{
+							position1722, tokenIndex1722 := position, tokenIndex
+							if !_rules[rulews]() {
+								goto l1722
+							}
+							goto l1723
+						l1722:
+							position, tokenIndex = position1722, tokenIndex1722
+						}
+					l1723:
+						if !_rules[rulemulExpr]() {
+							goto l1721
+						}
+						{
+							add(ruleAction89, position)
+						}
+						goto l1720
+					l1721:
+						position, tokenIndex = position1720, tokenIndex1720
+						if buffer[position] != rune('-') {
+							goto l1717
+						}
+						position++
+						{
+							position1725, tokenIndex1725 := position, tokenIndex
+							if !_rules[rulews]() {
+								goto l1725
+							}
+							goto l1726
+						l1725:
+							position, tokenIndex = position1725, tokenIndex1725
+						}
+					l1726:
+						if !_rules[rulemulExpr]() {
+							goto l1717
+						}
+						{
+							add(ruleAction90, position)
+						}
+					}
+				l1720:
+					goto l1716
+				l1717:
+					position, tokenIndex = position1717, tokenIndex1717
+				}
+				add(ruleaddExpr, position1715)
+			}
+			return true
+		l1714:
+			position, tokenIndex = position1714, tokenIndex1714
+			return false
+		},
+		/* 82 mulExpr <- <(unaryExpr ((ws? ((&('%') ('%' ws? unaryExpr Action93)) | (&('/') ('/' ws? unaryExpr Action92)) | (&('*') ('*' ws? unaryExpr Action91)))) / (ws (('m' / 'M') ('o' / 'O') ('d' / 'D')) ws unaryExpr Action94))*)> */
+		func() bool {
+			position1728, tokenIndex1728 := position, tokenIndex
+			{
+				position1729 := position
+				if !_rules[ruleunaryExpr]() {
+					goto l1728
+				}
+			l1730:
+				{
+					position1731, tokenIndex1731 := position, tokenIndex
+					{
+						position1732, tokenIndex1732 := position, tokenIndex
+						{
+							position1734, tokenIndex1734 := position, tokenIndex
+							if !_rules[rulews]() {
+								goto l1734
+							}
+							goto l1735
+						l1734:
+							position, tokenIndex = position1734, tokenIndex1734
+						}
+					l1735:
+						{
+							switch buffer[position] {
+							case '%':
+								if buffer[position] != rune('%') {
+									goto l1733
+								}
+								position++
+								{
+									position1737, tokenIndex1737 := position, tokenIndex
+									if !_rules[rulews]() {
+										goto l1737
+									}
+									goto l1738
+								l1737:
+									position, tokenIndex = position1737, tokenIndex1737
+								}
+							l1738:
+								if !_rules[ruleunaryExpr]() {
+									goto l1733
+								}
+								{
+									add(ruleAction93, position)
+								}
+								break
+							case '/':
+								if buffer[position] != rune('/') {
+									goto l1733
+								}
+								position++
+								{
+									position1740, tokenIndex1740 := position, tokenIndex
+									if !_rules[rulews]() {
+										goto l1740
+									}
+									goto l1741
+								l1740:
+									position, tokenIndex = position1740, tokenIndex1740
+								}
+							l1741:
+								if !_rules[ruleunaryExpr]() {
+									goto l1733
+								}
+								{
+									add(ruleAction92, position)
+								}
+								break
+							default:
+								if buffer[position] != rune('*') {
+									goto l1733
+								}
+								position++
+								{
+									position1743, tokenIndex1743 := position, tokenIndex
+									if !_rules[rulews]() {
+										goto l1743
+									}
+									goto l1744
+								l1743:
+									position, tokenIndex = position1743, tokenIndex1743
+								}
+							l1744:
+								if !_rules[ruleunaryExpr]() {
+									goto l1733
+								}
+								{
+									add(ruleAction91, position)
+								}
+								break
+							}
+						}
 
+						goto l1732
+					l1733:
+						position, tokenIndex = position1732, tokenIndex1732
+						if !_rules[rulews]() {
+							goto l1731
+						}
+						{
+							position1746, tokenIndex1746 := position, tokenIndex
+							if buffer[position] != rune('m') {
+								goto l1747
+							}
+							position++
+							goto l1746
+						l1747:
+							position, tokenIndex = position1746, tokenIndex1746
+							if buffer[position] != rune('M') {
+								goto l1731
+							}
+							position++
+						}
+					l1746:
+						{
+							position1748, tokenIndex1748 := position, tokenIndex
+							if buffer[position] != rune('o') {
+								goto l1749
+							}
+							position++
+							goto l1748
+						l1749:
+							position, tokenIndex = position1748, tokenIndex1748
+							if buffer[position] != rune('O') {
+								goto l1731
+							}
+							position++
+						}
+					l1748:
+						{
+							position1750, tokenIndex1750 := position, tokenIndex
+							if buffer[position] != rune('d') {
+								goto l1751
+							}
+							position++
+							goto l1750
+						l1751:
+							position, tokenIndex = position1750, tokenIndex1750
+							if buffer[position] != rune('D') {
+								goto l1731
+							}
+							position++
+						}
+					l1750:
+						if !_rules[rulews]() {
+							goto l1731
+						}
+						if !_rules[ruleunaryExpr]() {
+							goto l1731
+						}
+						{
+							add(ruleAction94, position)
+						}
+					}
+				l1732:
+					goto l1730
+				l1731:
+					position, tokenIndex = position1731, tokenIndex1731
+				}
+				add(rulemulExpr, position1729)
+			}
+			return true
+		l1728:
+			position, tokenIndex = position1728, tokenIndex1728
+			return false
+		},
+		/* 83 unaryExpr <- <((('n' / 'N') ('o' / 'O') ('t' / 'T') ws unaryExpr Action98) / (('l' / 'L') ('o' / 'O') ('w' / 'W') !alphaundnum ws? unaryExpr Action99) / (('h' / 'H') ('i' / 'I') ('g' / 'G') ('h' / 'H') !alphaundnum ws? unaryExpr Action100) / ((&('!') ('!' ws? unaryExpr Action97)) | (&('~') ('~' ws? unaryExpr Action96)) | (&('+') ('+' ws? unaryExpr)) | (&('-') ('-' ws? unaryExpr Action95)) | (&('"' | '$' | '%' | '\'' | '(' | '.' | '0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9' | '?' | '@' | 'A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '_' | 'a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') primary)))> */
+		func() bool {
+			position1753, tokenIndex1753 := position, tokenIndex
+			{
+				position1754 := position
+				{
+					position1755, tokenIndex1755 := position, tokenIndex
+					{
+						position1757, tokenIndex1757 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l1758
+						}
+						position++
+						goto l1757
+					l1758:
+						position, tokenIndex = position1757, tokenIndex1757
+						if buffer[position] != rune('N') {
+							goto l1756
+						}
+						position++
+					}
+				l1757:
+					{
+						position1759, tokenIndex1759 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l1760
+						}
+						position++
+						goto l1759
+					l1760:
+						position, tokenIndex = position1759, tokenIndex1759
+						if buffer[position] != rune('O') {
+							goto l1756
+						}
+						position++
+					}
+				l1759:
+					{
+						position1761, tokenIndex1761 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l1762
+						}
+						position++
+						goto l1761
+					l1762:
+						position, tokenIndex = position1761, tokenIndex1761
+						if buffer[position] != rune('T') {
+							goto l1756
+						}
+						position++
+					}
+				l1761:
+					if !_rules[rulews]() {
+						goto l1756
+					}
+					if !_rules[ruleunaryExpr]() {
+						goto l1756
+					}
+					{
+						add(ruleAction98, position)
+					}
+					goto l1755
+				l1756:
+					position, tokenIndex = position1755, tokenIndex1755
+					{
+						position1765, tokenIndex1765 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l1766
+						}
+						position++
+						goto l1765
+					l1766:
+						position, tokenIndex = position1765, tokenIndex1765
+						if buffer[position] != rune('L') {
+							goto l1764
+						}
+						position++
+					}
+				l1765:
+					{
+						position1767, tokenIndex1767 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l1768
+						}
+						position++
+						goto l1767
+					l1768:
+						position, tokenIndex = position1767, tokenIndex1767
+						if buffer[position] != rune('O') {
+							goto l1764
+						}
+						position++
+					}
+				l1767:
+					{
+						position1769, tokenIndex1769 := position, tokenIndex
+						if buffer[position] != rune('w') {
+							goto l1770
+						}
+						position++
+						goto l1769
+					l1770:
+						position, tokenIndex = position1769, tokenIndex1769
+						if buffer[position] != rune('W') {
+							goto l1764
+						}
+						position++
+					}
+				l1769:
+					{
+						position1771, tokenIndex1771 := position, tokenIndex
+						if !_rules[rulealphaundnum]() {
+							goto l1771
+						}
+						goto l1764
+					l1771:
+						position, tokenIndex = position1771, tokenIndex1771
+					}
+					{
+						position1772, tokenIndex1772 := position, tokenIndex
+						if !_rules[rulews]() {
+							goto l1772
+						}
+						goto l1773
+					l1772:
+						position, tokenIndex = position1772, tokenIndex1772
+					}
+				l1773:
+					if !_rules[ruleunaryExpr]() {
+						goto l1764
+					}
+					{
+						add(ruleAction99, position)
+					}
+					goto l1755
+				l1764:
+					position, tokenIndex = position1755, tokenIndex1755
+					{
+						position1776, tokenIndex1776 := position, tokenIndex
+						if buffer[position] != rune('h') {
+							goto l1777
+						}
+						position++
+						goto l1776
+					l1777:
+						position, tokenIndex = position1776, tokenIndex1776
+						if buffer[position] != rune('H') {
+							goto l1775
+						}
+						position++
+					}
+				l1776:
+					{
+						position1778, tokenIndex1778 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l1779
+						}
+						position++
+						goto l1778
+					l1779:
+						position, tokenIndex = position1778, tokenIndex1778
+						if buffer[position] != rune('I') {
+							goto l1775
+						}
+						position++
+					}
+				l1778:
+					{
+						position1780, tokenIndex1780 := position, tokenIndex
+						if buffer[position] != rune('g') {
+							goto l1781
+						}
+						position++
+						goto l1780
+					l1781:
+						position, tokenIndex = position1780, tokenIndex1780
+						if buffer[position] != rune('G') {
+							goto l1775
+						}
+						position++
+					}
+				l1780:
+					{
+						position1782, tokenIndex1782 := position, tokenIndex
+						if buffer[position] != rune('h') {
+							goto l1783
+						}
+						position++
+						goto l1782
+					l1783:
+						position, tokenIndex = position1782, tokenIndex1782
+						if buffer[position] != rune('H') {
+							goto l1775
+						}
+						position++
+					}
+				l1782:
+					{
+						position1784, tokenIndex1784 := position, tokenIndex
+						if !_rules[rulealphaundnum]() {
+							goto l1784
+						}
+						goto l1775
+					l1784:
+						position, tokenIndex = position1784, tokenIndex1784
+					}
+					{
+						position1785, tokenIndex1785 := position, tokenIndex
+						if !_rules[rulews]() {
+							goto l1785
+						}
+						goto l1786
+					l1785:
+						position, tokenIndex = position1785, tokenIndex1785
+					}
+				l1786:
+					if !_rules[ruleunaryExpr]() {
+						goto l1775
+					}
+					{
+						add(ruleAction100, position)
+					}
+					goto l1755
+				l1775:
+					position, tokenIndex = position1755, tokenIndex1755
+					{
+						switch buffer[position] {
+						case '!':
+							if buffer[position] != rune('!') {
+								goto l1753
+							}
+							position++
+							{
+								position1789, tokenIndex1789 := position, tokenIndex
+								if !_rules[rulews]() {
+									goto l1789
+								}
+								goto l1790
+							l1789:
+								position, tokenIndex = position1789, tokenIndex1789
+							}
+						l1790:
+							if !_rules[ruleunaryExpr]() {
+								goto l1753
+							}
+							{
+								add(ruleAction97, position)
+							}
+							break
+						case '~':
+							if buffer[position] != rune('~') {
+								goto l1753
+							}
+							position++
+							{
+								position1792, tokenIndex1792 := position, tokenIndex
+								if !_rules[rulews]() {
+									goto l1792
+								}
+								goto l1793
+							l1792:
+								position, tokenIndex = position1792, tokenIndex1792
+							}
+						l1793:
+							if !_rules[ruleunaryExpr]() {
+								goto l1753
+							}
+							{
+								add(ruleAction96, position)
+							}
+							break
+						case '+':
+							if buffer[position] != rune('+') {
+								goto l1753
+							}
+							position++
+							{
+								position1795, tokenIndex1795 := position, tokenIndex
+								if !_rules[rulews]() {
+									goto l1795
+								}
+								goto l1796
+							l1795:
+								position, tokenIndex = position1795, tokenIndex1795
+							}
+						l1796:
+							if !_rules[ruleunaryExpr]() {
+								goto l1753
+							}
+							break
+						case '-':
+							if buffer[position] != rune('-') {
+								goto l1753
+							}
+							position++
+							{
+								position1797, tokenIndex1797 := position, tokenIndex
+								if !_rules[rulews]() {
+									goto l1797
+								}
+								goto l1798
+							l1797:
+								position, tokenIndex = position1797, tokenIndex1797
+							}
+						l1798:
+							if !_rules[ruleunaryExpr]() {
+								goto l1753
+							}
+							{
+								add(ruleAction95, position)
+							}
+							break
+						default:
+							{
+								position1800 := position
+								{
+									position1801, tokenIndex1801 := position, tokenIndex
+									{
+										position1803 := position
+										{
+											position1804, tokenIndex1804 := position, tokenIndex
+											if buffer[position] != rune('0') {
+												goto l1805
+											}
+											position++
+											{
+												position1806, tokenIndex1806 := position, tokenIndex
+												if buffer[position] != rune('x') {
+													goto l1807
+												}
+												position++
+												goto l1806
+											l1807:
+												position, tokenIndex = position1806, tokenIndex1806
+												if buffer[position] != rune('X') {
+													goto l1805
 												}
-											l1132:
-												add(ruleAlu, position1131)
+												position++
 											}
-											goto l993
-										l1130:
-											position, tokenIndex = position993, tokenIndex993
+										l1806:
 											{
-												position1208 := position
+												position1808 := position
+												if !_rules[rulehexdigit]() {
+													goto l1805
+												}
+											l1809:
 												{
-													position1209, tokenIndex1209 := position, tokenIndex
-													{
-														position1211 := position
-														{
-															position1212, tokenIndex1212 := position, tokenIndex
-															{
-																position1214 := position
-																{
-																	position1215, tokenIndex1215 := position, tokenIndex
-																	if buffer[position] != rune('r') {
-																		goto l1216
-																	}
-																	position++
-																	goto l1215
-																l1216:
-																	position, tokenIndex = position1215, tokenIndex1215
-																	if buffer[position] != rune('R') {
-																		goto l1213
-																	}
-																	position++
-																}
-															l1215:
-																{
-																	position1217, tokenIndex1217 := position, tokenIndex
-																	if buffer[position] != rune('l') {
-																		goto l1218
-																	}
-																	position++
-																	goto l1217
-																l1218:
-																	position, tokenIndex = position1217, tokenIndex1217
-																	if buffer[position] != rune('L') {
-																		goto l1213
-																	}
-																	position++
-																}
-															l1217:
-																{
-																	position1219, tokenIndex1219 := position, tokenIndex
-																	if buffer[position] != rune('c') {
-																		goto l1220
-																	}
-																	position++
-																	goto l1219
-																l1220:
-																	position, tokenIndex = position1219, tokenIndex1219
-																	if buffer[position] != rune('C') {
-																		goto l1213
-																	}
-																	position++
-																}
-															l1219:
-																if !_rules[rulews]() {
-																	goto l1213
-																}
-																if !_rules[ruleLoc8]() {
-																	goto l1213
-																}
-																{
-																	position1221, tokenIndex1221 := position, tokenIndex
-																	if !_rules[rulesep]() {
-																		goto l1221
-																	}
-																	if !_rules[ruleCopy8]() {
-																		goto l1221
-																	}
-																	goto l1222
-																l1221:
-																	position, tokenIndex = position1221, tokenIndex1221
-																}
-															l1222:
-																{
-																	add(ruleAction51, position)
-																}
-																add(ruleRlc, position1214)
-															}
-															goto l1212
-														l1213:
-															position, tokenIndex = position1212, tokenIndex1212
-															{
-																position1225 := position
-																{
-																	position1226, tokenIndex1226 := position, tokenIndex
-																	if buffer[position] != rune('r') {
-																		goto l1227
-																	}
-																	position++
-																	goto l1226
-																l1227:
-																	position, tokenIndex = position1226, tokenIndex1226
-																	if buffer[position] != rune('R') {
-																		goto l1224
-																	}
-																	position++
-																}
-															l1226:
-																{
-																	position1228, tokenIndex1228 := position, tokenIndex
-																	if buffer[position] != rune('r') {
-																		goto l1229
-																	}
-																	position++
-																	goto l1228
-																l1229:
-																	position, tokenIndex = position1228, tokenIndex1228
-																	if buffer[position] != rune('R') {
-																		goto l1224
-																	}
-																	position++
-																}
-															l1228:
-																{
-																	position1230, tokenIndex1230 := position, tokenIndex
-																	if buffer[position] != rune('c') {
-																		goto l1231
-																	}
-																	position++
-																	goto l1230
-																l1231:
-																	position, tokenIndex = position1230, tokenIndex1230
-																	if buffer[position] != rune('C') {
-																		goto l1224
-																	}
-																	position++
-																}
-															l1230:
-																if !_rules[rulews]() {
-																	goto l1224
-																}
-																if !_rules[ruleLoc8]() {
-																	goto l1224
-																}
-																{
-																	position1232, tokenIndex1232 := position, tokenIndex
-																	if !_rules[rulesep]() {
-																		goto l1232
-																	}
-																	if !_rules[ruleCopy8]() {
-																		goto l1232
-																	}
-																	goto l1233
-																l1232:
-																	position, tokenIndex = position1232, tokenIndex1232
-																}
-															l1233:
-																{
-																	add(ruleAction52, position)
-																}
-																add(ruleRrc, position1225)
-															}
-															goto l1212
-														l1224:
-															position, tokenIndex = position1212, tokenIndex1212
-															{
-																position1236 := position
-																{
-																	position1237, tokenIndex1237 := position, tokenIndex
-																	if buffer[position] != rune('r') {
-																		goto l1238
-																	}
-																	position++
-																	goto l1237
-																l1238:
-																	position, tokenIndex = position1237, tokenIndex1237
-																	if buffer[position] != rune('R') {
-																		goto l1235
-																	}
-																	position++
-																}
-															l1237:
-																{
-																	position1239, tokenIndex1239 := position, tokenIndex
-																	if buffer[position] != rune('l') {
-																		goto l1240
-																	}
-																	position++
-																	goto l1239
-																l1240:
-																	position, tokenIndex = position1239, tokenIndex1239
-																	if buffer[position] != rune('L') {
-																		goto l1235
-																	}
-																	position++
-																}
-															l1239:
-																if !_rules[rulews]() {
-																	goto l1235
-																}
-																if !_rules[ruleLoc8]() {
-																	goto l1235
-																}
-																{
-																	position1241, tokenIndex1241 := position, tokenIndex
-																	if !_rules[rulesep]() {
-																		goto l1241
-																	}
-																	if !_rules[ruleCopy8]() {
-																		goto l1241
-																	}
-																	goto l1242
-																l1241:
-																	position, tokenIndex = position1241, tokenIndex1241
-																}
-															l1242:
-																{
-																	add(ruleAction53, position)
-																}
-																add(ruleRl, position1236)
-															}
-															goto l1212
-														l1235:
-															position, tokenIndex = position1212, tokenIndex1212
-															{
-																position1245 := position
-																{
-																	position1246, tokenIndex1246 := position, tokenIndex
-																	if buffer[position] != rune('r') {
-																		goto l1247
-																	}
-																	position++
-																	goto l1246
-																l1247:
-																	position, tokenIndex = position1246, tokenIndex1246
-																	if buffer[position] != rune('R') {
-																		goto l1244
-																	}
-																	position++
-																}
-															l1246:
-																{
-																	position1248, tokenIndex1248 := position, tokenIndex
-																	if buffer[position] != rune('r') {
-																		goto l1249
-																	}
-																	position++
-																	goto l1248
-																l1249:
-																	position, tokenIndex = position1248, tokenIndex1248
-																	if buffer[position] != rune('R') {
-																		goto l1244
-																	}
-																	position++
-																}
-															l1248:
-																if !_rules[rulews]() {
-																	goto l1244
-																}
-																if !_rules[ruleLoc8]() {
-																	goto l1244
-																}
-																{
-																	position1250, tokenIndex1250 := position, tokenIndex
-																	if !_rules[rulesep]() {
-																		goto l1250
-																	}
-																	if !_rules[ruleCopy8]() {
-																		goto l1250
-																	}
-																	goto l1251
-																l1250:
-																	position, tokenIndex = position1250, tokenIndex1250
-																}
-															l1251:
-																{
-																	add(ruleAction54, position)
-																}
-																add(ruleRr, position1245)
-															}
-															goto l1212
-														l1244:
-															position, tokenIndex = position1212, tokenIndex1212
-															{
-																position1254 := position
-																{
-																	position1255, tokenIndex1255 := position, tokenIndex
-																	if buffer[position] != rune('s') {
-																		goto l1256
-																	}
-																	position++
-																	goto l1255
-																l1256:
-																	position, tokenIndex = position1255, tokenIndex1255
-																	if buffer[position] != rune('S') {
-																		goto l1253
-																	}
-																	position++
-																}
-															l1255:
-																{
-																	position1257, tokenIndex1257 := position, tokenIndex
-																	if buffer[position] != rune('l') {
-																		goto l1258
-																	}
-																	position++
-																	goto l1257
-																l1258:
-																	position, tokenIndex = position1257, tokenIndex1257
-																	if buffer[position] != rune('L') {
-																		goto l1253
-																	}
-																	position++
-																}
-															l1257:
-																{
-																	position1259, tokenIndex1259 := position, tokenIndex
-																	if buffer[position] != rune('a') {
-																		goto l1260
-																	}
-																	position++
-																	goto l1259
-																l1260:
-																	position, tokenIndex = position1259, tokenIndex1259
-																	if buffer[position] != rune('A') {
-																		goto l1253
-																	}
-																	position++
-																}
-															l1259:
-																if !_rules[rulews]() {
-																	goto l1253
-																}
-																if !_rules[ruleLoc8]() {
-																	goto l1253
-																}
-																{
-																	position1261, tokenIndex1261 := position, tokenIndex
-																	if !_rules[rulesep]() {
-																		goto l1261
-																	}
-																	if !_rules[ruleCopy8]() {
-																		goto l1261
-																	}
-																	goto l1262
-																l1261:
-																	position, tokenIndex = position1261, tokenIndex1261
-																}
-															l1262:
-																{
-																	add(ruleAction55, position)
-																}
-																add(ruleSla, position1254)
-															}
-															goto l1212
-														l1253:
-															position, tokenIndex = position1212, tokenIndex1212
-															{
-																position1265 := position
-																{
-																	position1266, tokenIndex1266 := position, tokenIndex
-																	if buffer[position] != rune('s') {
-																		goto l1267
-																	}
-																	position++
-																	goto l1266
-																l1267:
-																	position, tokenIndex = position1266, tokenIndex1266
-																	if buffer[position] != rune('S') {
-																		goto l1264
-																	}
-																	position++
-																}
-															l1266:
-																{
-																	position1268, tokenIndex1268 := position, tokenIndex
-																	if buffer[position] != rune('r') {
-																		goto l1269
-																	}
-																	position++
-																	goto l1268
-																l1269:
-																	position, tokenIndex = position1268, tokenIndex1268
-																	if buffer[position] != rune('R') {
-																		goto l1264
-																	}
-																	position++
-																}
-															l1268:
-																{
-																	position1270, tokenIndex1270 := position, tokenIndex
-																	if buffer[position] != rune('a') {
-																		goto l1271
-																	}
-																	position++
-																	goto l1270
-																l1271:
-																	position, tokenIndex = position1270, tokenIndex1270
-																	if buffer[position] != rune('A') {
-																		goto l1264
-																	}
-																	position++
-																}
-															l1270:
-																if !_rules[rulews]() {
-																	goto l1264
-																}
-																if !_rules[ruleLoc8]() {
-																	goto l1264
-																}
-																{
-																	position1272, tokenIndex1272 := position, tokenIndex
-																	if !_rules[rulesep]() {
-																		goto l1272
-																	}
-																	if !_rules[ruleCopy8]() {
-																		goto l1272
-																	}
-																	goto l1273
-																l1272:
-																	position, tokenIndex = position1272, tokenIndex1272
-																}
-															l1273:
-																{
-																	add(ruleAction56, position)
-																}
-																add(ruleSra, position1265)
-															}
-															goto l1212
-														l1264:
-															position, tokenIndex = position1212, tokenIndex1212
-															{
-																position1276 := position
-																{
-																	position1277, tokenIndex1277 := position, tokenIndex
-																	if buffer[position] != rune('s') {
-																		goto l1278
-																	}
-																	position++
-																	goto l1277
-																l1278:
-																	position, tokenIndex = position1277, tokenIndex1277
-																	if buffer[position] != rune('S') {
-																		goto l1275
-																	}
-																	position++
-																}
-															l1277:
-																{
-																	position1279, tokenIndex1279 := position, tokenIndex
-																	if buffer[position] != rune('l') {
-																		goto l1280
-																	}
-																	position++
-																	goto l1279
-																l1280:
-																	position, tokenIndex = position1279, tokenIndex1279
-																	if buffer[position] != rune('L') {
-																		goto l1275
-																	}
-																	position++
-																}
-															l1279:
-																{
-																	position1281, tokenIndex1281 := position, tokenIndex
-																	if buffer[position] != rune('l') {
-																		goto l1282
-																	}
-																	position++
-																	goto l1281
-																l1282:
-																	position, tokenIndex = position1281, tokenIndex1281
-																	if buffer[position] != rune('L') {
-																		goto l1275
-																	}
-																	position++
-																}
-															l1281:
-																if !_rules[rulews]() {
-																	goto l1275
-																}
-																if !_rules[ruleLoc8]() {
-																	goto l1275
-																}
-																{
-																	position1283, tokenIndex1283 := position, tokenIndex
-																	if !_rules[rulesep]() {
-																		goto l1283
-																	}
-																	if !_rules[ruleCopy8]() {
-																		goto l1283
-																	}
-																	goto l1284
-																l1283:
-																	position, tokenIndex = position1283, tokenIndex1283
-																}
-															l1284:
-																{
-																	add(ruleAction57, position)
-																}
-																add(ruleSll, position1276)
-															}
-															goto l1212
-														l1275:
-															position, tokenIndex = position1212, tokenIndex1212
-															{
-																position1286 := position
-																{
-																	position1287, tokenIndex1287 := position, tokenIndex
-																	if buffer[position] != rune('s') {
-																		goto l1288
-																	}
-																	position++
-																	goto l1287
-																l1288:
-																	position, tokenIndex = position1287, tokenIndex1287
-																	if buffer[position] != rune('S') {
-																		goto l1210
-																	}
-																	position++
-																}
-															l1287:
-																{
-																	position1289, tokenIndex1289 := position, tokenIndex
-																	if buffer[position] != rune('r') {
-																		goto l1290
-																	}
-																	position++
-																	goto l1289
-																l1290:
-																	position, tokenIndex = position1289, tokenIndex1289
-																	if buffer[position] != rune('R') {
-																		goto l1210
-																	}
-																	position++
-																}
-															l1289:
-																{
-																	position1291, tokenIndex1291 := position, tokenIndex
-																	if buffer[position] != rune('l') {
-																		goto l1292
-																	}
-																	position++
-																	goto l1291
-																l1292:
-																	position, tokenIndex = position1291, tokenIndex1291
-																	if buffer[position] != rune('L') {
-																		goto l1210
-																	}
-																	position++
-																}
-															l1291:
-																if !_rules[rulews]() {
-																	goto l1210
-																}
-																if !_rules[ruleLoc8]() {
-																	goto l1210
-																}
-																{
-																	position1293, tokenIndex1293 := position, tokenIndex
-																	if !_rules[rulesep]() {
-																		goto l1293
-																	}
-																	if !_rules[ruleCopy8]() {
-																		goto l1293
-																	}
-																	goto l1294
-																l1293:
-																	position, tokenIndex = position1293, tokenIndex1293
-																}
-															l1294:
-																{
-																	add(ruleAction58, position)
-																}
-																add(ruleSrl, position1286)
-															}
-														}
-													l1212:
-														add(ruleRot, position1211)
-													}
-													goto l1209
-												l1210:
-													position, tokenIndex = position1209, tokenIndex1209
-													{
-														switch buffer[position] {
-														case 'S', 's':
-															{
-																position1297 := position
-																{
-																	position1298, tokenIndex1298 := position, tokenIndex
-																	if buffer[position] != rune('s') {
-																		goto l1299
-																	}
-																	position++
-																	goto l1298
-																l1299:
-																	position, tokenIndex = position1298, tokenIndex1298
-																	if buffer[position] != rune('S') {
-																		goto l1207
-																	}
-																	position++
-																}
-															l1298:
-																{
-																	position1300, tokenIndex1300 := position, tokenIndex
-																	if buffer[position] != rune('e') {
-																		goto l1301
-																	}
-																	position++
-																	goto l1300
-																l1301:
-																	position, tokenIndex = position1300, tokenIndex1300
-																	if buffer[position] != rune('E') {
-																		goto l1207
-																	}
-																	position++
-																}
-															l1300:
-																{
-																	position1302, tokenIndex1302 := position, tokenIndex
-																	if buffer[position] != rune('t') {
-																		goto l1303
-																	}
-																	position++
-																	goto l1302
-																l1303:
-																	position, tokenIndex = position1302, tokenIndex1302
-																	if buffer[position] != rune('T') {
-																		goto l1207
-																	}
-																	position++
-																}
-															l1302:
-																if !_rules[rulews]() {
-																	goto l1207
-																}
-																if !_rules[ruleoctaldigit]() {
-																	goto l1207
-																}
-																if !_rules[rulesep]() {
-																	goto l1207
-																}
-																if !_rules[ruleLoc8]() {
-																	goto l1207
-																}
-																{
-																	position1304, tokenIndex1304 := position, tokenIndex
-																	if !_rules[rulesep]() {
-																		goto l1304
-																	}
-																	if !_rules[ruleCopy8]() {
-																		goto l1304
-																	}
-																	goto l1305
-																l1304:
-																	position, tokenIndex = position1304, tokenIndex1304
-																}
-															l1305:
-																{
-																	add(ruleAction61, position)
-																}
-																add(ruleSet, position1297)
-															}
-															break
-														case 'R', 'r':
-															{
-																position1307 := position
-																{
-																	position1308, tokenIndex1308 := position, tokenIndex
-																	if buffer[position] != rune('r') {
-																		goto l1309
-																	}
-																	position++
-																	goto l1308
-																l1309:
-																	position, tokenIndex = position1308, tokenIndex1308
-																	if buffer[position] != rune('R') {
-																		goto l1207
-																	}
-																	position++
-																}
-															l1308:
-																{
-																	position1310, tokenIndex1310 := position, tokenIndex
-																	if buffer[position] != rune('e') {
-																		goto l1311
-																	}
-																	position++
-																	goto l1310
-																l1311:
-																	position, tokenIndex = position1310, tokenIndex1310
-																	if buffer[position] != rune('E') {
-																		goto l1207
-																	}
-																	position++
-																}
-															l1310:
-																{
-																	position1312, tokenIndex1312 := position, tokenIndex
-																	if buffer[position] != rune('s') {
-																		goto l1313
-																	}
-																	position++
-																	goto l1312
-																l1313:
-																	position, tokenIndex = position1312, tokenIndex1312
-																	if buffer[position] != rune('S') {
-																		goto l1207
-																	}
-																	position++
-																}
-															l1312:
-																if !_rules[rulews]() {
-																	goto l1207
-																}
-																if !_rules[ruleoctaldigit]() {
-																	goto l1207
-																}
-																if !_rules[rulesep]() {
-																	goto l1207
-																}
-																if !_rules[ruleLoc8]() {
-																	goto l1207
-																}
-																{
-																	position1314, tokenIndex1314 := position, tokenIndex
-																	if !_rules[rulesep]() {
-																		goto l1314
-																	}
-																	if !_rules[ruleCopy8]() {
-																		goto l1314
-																	}
-																	goto l1315
-																l1314:
-																	position, tokenIndex = position1314, tokenIndex1314
-																}
-															l1315:
-																{
-																	add(ruleAction60, position)
-																}
-																add(ruleRes, position1307)
-															}
-															break
-														default:
-															{
-																position1317 := position
-																{
-																	position1318, tokenIndex1318 := position, tokenIndex
-																	if buffer[position] != rune('b') {
-																		goto l1319
-																	}
-																	position++
-																	goto l1318
-																l1319:
-																	position, tokenIndex = position1318, tokenIndex1318
-																	if buffer[position] != rune('B') {
-																		goto l1207
-																	}
-																	position++
-																}
-															l1318:
-																{
-																	position1320, tokenIndex1320 := position, tokenIndex
-																	if buffer[position] != rune('i') {
-																		goto l1321
-																	}
-																	position++
-																	goto l1320
-																l1321:
-																	position, tokenIndex = position1320, tokenIndex1320
-																	if buffer[position] != rune('I') {
-																		goto l1207
-																	}
-																	position++
-																}
-															l1320:
-																{
-																	position1322, tokenIndex1322 := position, tokenIndex
-																	if buffer[position] != rune('t') {
-																		goto l1323
-																	}
-																	position++
-																	goto l1322
-																l1323:
-																	position, tokenIndex = position1322, tokenIndex1322
-																	if buffer[position] != rune('T') {
-																		goto l1207
-																	}
-																	position++
-																}
-															l1322:
-																if !_rules[rulews]() {
-																	goto l1207
-																}
-																if !_rules[ruleoctaldigit]() {
-																	goto l1207
-																}
-																if !_rules[rulesep]() {
-																	goto l1207
-																}
-																if !_rules[ruleLoc8]() {
-																	goto l1207
-																}
-																{
-																	add(ruleAction59, position)
-																}
-																add(ruleBit, position1317)
-															}
-															break
-														}
+													position1810, tokenIndex1810 := position, tokenIndex
+													if !_rules[rulehexdigit]() {
+														goto l1810
 													}
-
+													goto l1809
+												l1810:
+													position, tokenIndex = position1810, tokenIndex1810
+												}
+												add(rulePegText, position1808)
+											}
+											{
+												add(ruleAction103, position)
+											}
+											goto l1804
+										l1805:
+											position, tokenIndex = position1804, tokenIndex1804
+											if buffer[position] != rune('0') {
+												goto l1812
+											}
+											position++
+											{
+												position1813, tokenIndex1813 := position, tokenIndex
+												if buffer[position] != rune('b') {
+													goto l1814
+												}
+												position++
+												goto l1813
+											l1814:
+												position, tokenIndex = position1813, tokenIndex1813
+												if buffer[position] != rune('B') {
+													goto l1812
 												}
-											l1209:
-												add(ruleBitOp, position1208)
+												position++
 											}
-											goto l993
-										l1207:
-											position, tokenIndex = position993, tokenIndex993
+										l1813:
 											{
-												position1326 := position
+												position1815 := position
 												{
-													position1327, tokenIndex1327 := position, tokenIndex
-													{
-														position1329 := position
-														{
-															position1330 := position
-															{
-																position1331, tokenIndex1331 := position, tokenIndex
-																if buffer[position] != rune('r') {
-																	goto l1332
-																}
-																position++
-																goto l1331
-															l1332:
-																position, tokenIndex = position1331, tokenIndex1331
-																if buffer[position] != rune('R') {
-																	goto l1328
-																}
-																position++
-															}
-														l1331:
-															{
-																position1333, tokenIndex1333 := position, tokenIndex
-																if buffer[position] != rune('e') {
-																	goto l1334
-																}
-																position++
-																goto l1333
-															l1334:
-																position, tokenIndex = position1333, tokenIndex1333
-																if buffer[position] != rune('E') {
-																	goto l1328
-																}
-																position++
-															}
-														l1333:
-															{
-																position1335, tokenIndex1335 := position, tokenIndex
-																if buffer[position] != rune('t') {
-																	goto l1336
-																}
-																position++
-																goto l1335
-															l1336:
-																position, tokenIndex = position1335, tokenIndex1335
-																if buffer[position] != rune('T') {
-																	goto l1328
-																}
-																position++
-															}
-														l1335:
-															{
-																position1337, tokenIndex1337 := position, tokenIndex
-																if buffer[position] != rune('n') {
-																	goto l1338
-																}
-																position++
-																goto l1337
-															l1338:
-																position, tokenIndex = position1337, tokenIndex1337
-																if buffer[position] != rune('N') {
-																	goto l1328
-																}
-																position++
-															}
-														l1337:
-															add(rulePegText, position1330)
-														}
-														{
-															add(ruleAction76, position)
-														}
-														add(ruleRetn, position1329)
-													}
-													goto l1327
-												l1328:
-													position, tokenIndex = position1327, tokenIndex1327
-													{
-														position1341 := position
-														{
-															position1342 := position
-															{
-																position1343, tokenIndex1343 := position, tokenIndex
-																if buffer[position] != rune('r') {
-																	goto l1344
-																}
-																position++
-																goto l1343
-															l1344:
-																position, tokenIndex = position1343, tokenIndex1343
-																if buffer[position] != rune('R') {
-																	goto l1340
-																}
-																position++
-															}
-														l1343:
-															{
-																position1345, tokenIndex1345 := position, tokenIndex
-																if buffer[position] != rune('e') {
-																	goto l1346
-																}
-																position++
-																goto l1345
-															l1346:
-																position, tokenIndex = position1345, tokenIndex1345
-																if buffer[position] != rune('E') {
-																	goto l1340
-																}
-																position++
-															}
-														l1345:
-															{
-																position1347, tokenIndex1347 := position, tokenIndex
-																if buffer[position] != rune('t') {
-																	goto l1348
-																}
-																position++
-																goto l1347
-															l1348:
-																position, tokenIndex = position1347, tokenIndex1347
-																if buffer[position] != rune('T') {
-																	goto l1340
-																}
-																position++
-															}
-														l1347:
-															{
-																position1349, tokenIndex1349 := position, tokenIndex
-																if buffer[position] != rune('i') {
-																	goto l1350
-																}
-																position++
-																goto l1349
-															l1350:
-																position, tokenIndex = position1349, tokenIndex1349
-																if buffer[position] != rune('I') {
-																	goto l1340
-																}
-																position++
-															}
-														l1349:
-															add(rulePegText, position1342)
-														}
-														{
-															add(ruleAction77, position)
-														}
-														add(ruleReti, position1341)
-													}
-													goto l1327
-												l1340:
-													position, tokenIndex = position1327, tokenIndex1327
-													{
-														position1353 := position
-														{
-															position1354 := position
-															{
-																position1355, tokenIndex1355 := position, tokenIndex
-																if buffer[position] != rune('r') {
-																	goto l1356
-																}
-																position++
-																goto l1355
-															l1356:
-																position, tokenIndex = position1355, tokenIndex1355
-																if buffer[position] != rune('R') {
-																	goto l1352
-																}
-																position++
-															}
-														l1355:
-															{
-																position1357, tokenIndex1357 := position, tokenIndex
-																if buffer[position] != rune('r') {
-																	goto l1358
-																}
-																position++
-																goto l1357
-															l1358:
-																position, tokenIndex = position1357, tokenIndex1357
-																if buffer[position] != rune('R') {
-																	goto l1352
-																}
-																position++
-															}
-														l1357:
-															{
-																position1359, tokenIndex1359 := position, tokenIndex
-																if buffer[position] != rune('d') {
-																	goto l1360
-																}
-																position++
-																goto l1359
-															l1360:
-																position, tokenIndex = position1359, tokenIndex1359
-																if buffer[position] != rune('D') {
-																	goto l1352
-																}
-																position++
-															}
-														l1359:
-															add(rulePegText, position1354)
-														}
-														{
-															add(ruleAction78, position)
-														}
-														add(ruleRrd, position1353)
-													}
-													goto l1327
-												l1352:
-													position, tokenIndex = position1327, tokenIndex1327
-													{
-														position1363 := position
-														{
-															position1364 := position
-															{
-																position1365, tokenIndex1365 := position, tokenIndex
-																if buffer[position] != rune('i') {
-																	goto l1366
-																}
-																position++
-																goto l1365
-															l1366:
-																position, tokenIndex = position1365, tokenIndex1365
-																if buffer[position] != rune('I') {
-																	goto l1362
-																}
-																position++
-															}
-														l1365:
-															{
-																position1367, tokenIndex1367 := position, tokenIndex
-																if buffer[position] != rune('m') {
-																	goto l1368
-																}
-																position++
-																goto l1367
-															l1368:
-																position, tokenIndex = position1367, tokenIndex1367
-																if buffer[position] != rune('M') {
-																	goto l1362
-																}
-																position++
-															}
-														l1367:
-															if buffer[position] != rune(' ') {
-																goto l1362
-															}
-															position++
-															if buffer[position] != rune('0') {
-																goto l1362
-															}
-															position++
-															add(rulePegText, position1364)
-														}
-														{
-															add(ruleAction80, position)
-														}
-														add(ruleIm0, position1363)
+													position1818, tokenIndex1818 := position, tokenIndex
+													if buffer[position] != rune('0') {
+														goto l1819
 													}
-													goto l1327
-												l1362:
-													position, tokenIndex = position1327, tokenIndex1327
-													{
-														position1371 := position
-														{
-															position1372 := position
-															{
-																position1373, tokenIndex1373 := position, tokenIndex
-																if buffer[position] != rune('i') {
-																	goto l1374
-																}
-																position++
-																goto l1373
-															l1374:
-																position, tokenIndex = position1373, tokenIndex1373
-																if buffer[position] != rune('I') {
-																	goto l1370
-																}
-																position++
-															}
-														l1373:
-															{
-																position1375, tokenIndex1375 := position, tokenIndex
-																if buffer[position] != rune('m') {
-																	goto l1376
-																}
-																position++
-																goto l1375
-															l1376:
-																position, tokenIndex = position1375, tokenIndex1375
-																if buffer[position] != rune('M') {
-																	goto l1370
-																}
-																position++
-															}
-														l1375:
-															if buffer[position] != rune(' ') {
-																goto l1370
-															}
-															position++
-															if buffer[position] != rune('1') {
-																goto l1370
-															}
-															position++
-															add(rulePegText, position1372)
-														}
-														{
-															add(ruleAction81, position)
-														}
-														add(ruleIm1, position1371)
+													position++
+													goto l1818
+												l1819:
+													position, tokenIndex = position1818, tokenIndex1818
+													if buffer[position] != rune('1') {
+														goto l1812
 													}
-													goto l1327
-												l1370:
-													position, tokenIndex = position1327, tokenIndex1327
+													position++
+												}
+											l1818:
+											l1816:
+												{
+													position1817, tokenIndex1817 := position, tokenIndex
 													{
-														position1379 := position
-														{
-															position1380 := position
-															{
-																position1381, tokenIndex1381 := position, tokenIndex
-																if buffer[position] != rune('i') {
-																	goto l1382
-																}
-																position++
-																goto l1381
-															l1382:
-																position, tokenIndex = position1381, tokenIndex1381
-																if buffer[position] != rune('I') {
-																	goto l1378
-																}
-																position++
-															}
-														l1381:
-															{
-																position1383, tokenIndex1383 := position, tokenIndex
-																if buffer[position] != rune('m') {
-																	goto l1384
-																}
-																position++
-																goto l1383
-															l1384:
-																position, tokenIndex = position1383, tokenIndex1383
-																if buffer[position] != rune('M') {
-																	goto l1378
-																}
-																position++
-															}
-														l1383:
-															if buffer[position] != rune(' ') {
-																goto l1378
-															}
-															position++
-															if buffer[position] != rune('2') {
-																goto l1378
-															}
-															position++
-															add(rulePegText, position1380)
+														position1820, tokenIndex1820 := position, tokenIndex
+														if buffer[position] != rune('0') {
+															goto l1821
 														}
-														{
-															add(ruleAction82, position)
+														position++
+														goto l1820
+													l1821:
+														position, tokenIndex = position1820, tokenIndex1820
+														if buffer[position] != rune('1') {
+															goto l1817
 														}
-														add(ruleIm2, position1379)
+														position++
 													}
-													goto l1327
-												l1378:
-													position, tokenIndex = position1327, tokenIndex1327
-													{
-														switch buffer[position] {
-														case 'I', 'O', 'i', 'o':
-															{
-																position1387 := position
-																{
-																	position1388, tokenIndex1388 := position, tokenIndex
-																	{
-																		position1390 := position
-																		{
-																			position1391 := position
-																			{
-																				position1392, tokenIndex1392 := position, tokenIndex
-																				if buffer[position] != rune('i') {
-																					goto l1393
-																				}
-																				position++
-																				goto l1392
-																			l1393:
-																				position, tokenIndex = position1392, tokenIndex1392
-																				if buffer[position] != rune('I') {
-																					goto l1389
-																				}
-																				position++
-																			}
-																		l1392:
-																			{
-																				position1394, tokenIndex1394 := position, tokenIndex
-																				if buffer[position] != rune('n') {
-																					goto l1395
-																				}
-																				position++
-																				goto l1394
-																			l1395:
-																				position, tokenIndex = position1394, tokenIndex1394
-																				if buffer[position] != rune('N') {
-																					goto l1389
-																				}
-																				position++
-																			}
-																		l1394:
-																			{
-																				position1396, tokenIndex1396 := position, tokenIndex
-																				if buffer[position] != rune('i') {
-																					goto l1397
-																				}
-																				position++
-																				goto l1396
-																			l1397:
-																				position, tokenIndex = position1396, tokenIndex1396
-																				if buffer[position] != rune('I') {
-																					goto l1389
-																				}
-																				position++
-																			}
-																		l1396:
-																			{
-																				position1398, tokenIndex1398 := position, tokenIndex
-																				if buffer[position] != rune('r') {
-																					goto l1399
-																				}
-																				position++
-																				goto l1398
-																			l1399:
-																				position, tokenIndex = position1398, tokenIndex1398
-																				if buffer[position] != rune('R') {
-																					goto l1389
-																				}
-																				position++
-																			}
-																		l1398:
-																			add(rulePegText, position1391)
-																		}
-																		{
-																			add(ruleAction93, position)
-																		}
-																		add(ruleInir, position1390)
-																	}
-																	goto l1388
-																l1389:
-																	position, tokenIndex = position1388, tokenIndex1388
-																	{
-																		position1402 := position
-																		{
-																			position1403 := position
-																			{
-																				position1404, tokenIndex1404 := position, tokenIndex
-																				if buffer[position] != rune('i') {
-																					goto l1405
-																				}
-																				position++
-																				goto l1404
-																			l1405:
-																				position, tokenIndex = position1404, tokenIndex1404
-																				if buffer[position] != rune('I') {
-																					goto l1401
-																				}
-																				position++
-																			}
-																		l1404:
-																			{
-																				position1406, tokenIndex1406 := position, tokenIndex
-																				if buffer[position] != rune('n') {
-																					goto l1407
-																				}
-																				position++
-																				goto l1406
-																			l1407:
-																				position, tokenIndex = position1406, tokenIndex1406
-																				if buffer[position] != rune('N') {
-																					goto l1401
-																				}
-																				position++
-																			}
-																		l1406:
-																			{
-																				position1408, tokenIndex1408 := position, tokenIndex
-																				if buffer[position] != rune('i') {
-																					goto l1409
-																				}
-																				position++
-																				goto l1408
-																			l1409:
-																				position, tokenIndex = position1408, tokenIndex1408
-																				if buffer[position] != rune('I') {
-																					goto l1401
-																				}
-																				position++
-																			}
-																		l1408:
-																			add(rulePegText, position1403)
-																		}
-																		{
-																			add(ruleAction85, position)
-																		}
-																		add(ruleIni, position1402)
-																	}
-																	goto l1388
-																l1401:
-																	position, tokenIndex = position1388, tokenIndex1388
-																	{
-																		position1412 := position
-																		{
-																			position1413 := position
-																			{
-																				position1414, tokenIndex1414 := position, tokenIndex
-																				if buffer[position] != rune('o') {
-																					goto l1415
-																				}
-																				position++
-																				goto l1414
-																			l1415:
-																				position, tokenIndex = position1414, tokenIndex1414
-																				if buffer[position] != rune('O') {
-																					goto l1411
-																				}
-																				position++
-																			}
-																		l1414:
-																			{
-																				position1416, tokenIndex1416 := position, tokenIndex
-																				if buffer[position] != rune('t') {
-																					goto l1417
-																				}
-																				position++
-																				goto l1416
-																			l1417:
-																				position, tokenIndex = position1416, tokenIndex1416
-																				if buffer[position] != rune('T') {
-																					goto l1411
-																				}
-																				position++
-																			}
-																		l1416:
-																			{
-																				position1418, tokenIndex1418 := position, tokenIndex
-																				if buffer[position] != rune('i') {
-																					goto l1419
-																				}
-																				position++
-																				goto l1418
-																			l1419:
-																				position, tokenIndex = position1418, tokenIndex1418
-																				if buffer[position] != rune('I') {
-																					goto l1411
-																				}
-																				position++
-																			}
-																		l1418:
-																			{
-																				position1420, tokenIndex1420 := position, tokenIndex
-																				if buffer[position] != rune('r') {
-																					goto l1421
-																				}
-																				position++
-																				goto l1420
-																			l1421:
-																				position, tokenIndex = position1420, tokenIndex1420
-																				if buffer[position] != rune('R') {
-																					goto l1411
-																				}
-																				position++
-																			}
-																		l1420:
-																			add(rulePegText, position1413)
-																		}
-																		{
-																			add(ruleAction94, position)
-																		}
-																		add(ruleOtir, position1412)
-																	}
-																	goto l1388
-																l1411:
-																	position, tokenIndex = position1388, tokenIndex1388
-																	{
-																		position1424 := position
-																		{
-																			position1425 := position
-																			{
-																				position1426, tokenIndex1426 := position, tokenIndex
-																				if buffer[position] != rune('o') {
-																					goto l1427
-																				}
-																				position++
-																				goto l1426
-																			l1427:
-																				position, tokenIndex = position1426, tokenIndex1426
-																				if buffer[position] != rune('O') {
-																					goto l1423
-																				}
-																				position++
-																			}
-																		l1426:
-																			{
-																				position1428, tokenIndex1428 := position, tokenIndex
-																				if buffer[position] != rune('u') {
-																					goto l1429
-																				}
-																				position++
-																				goto l1428
-																			l1429:
-																				position, tokenIndex = position1428, tokenIndex1428
-																				if buffer[position] != rune('U') {
-																					goto l1423
-																				}
-																				position++
-																			}
-																		l1428:
-																			{
-																				position1430, tokenIndex1430 := position, tokenIndex
-																				if buffer[position] != rune('t') {
-																					goto l1431
-																				}
-																				position++
-																				goto l1430
-																			l1431:
-																				position, tokenIndex = position1430, tokenIndex1430
-																				if buffer[position] != rune('T') {
-																					goto l1423
-																				}
-																				position++
-																			}
-																		l1430:
-																			{
-																				position1432, tokenIndex1432 := position, tokenIndex
-																				if buffer[position] != rune('i') {
-																					goto l1433
-																				}
-																				position++
-																				goto l1432
-																			l1433:
-																				position, tokenIndex = position1432, tokenIndex1432
-																				if buffer[position] != rune('I') {
-																					goto l1423
-																				}
-																				position++
-																			}
-																		l1432:
-																			add(rulePegText, position1425)
-																		}
-																		{
-																			add(ruleAction86, position)
-																		}
-																		add(ruleOuti, position1424)
-																	}
-																	goto l1388
-																l1423:
-																	position, tokenIndex = position1388, tokenIndex1388
-																	{
-																		position1436 := position
-																		{
-																			position1437 := position
-																			{
-																				position1438, tokenIndex1438 := position, tokenIndex
-																				if buffer[position] != rune('i') {
-																					goto l1439
-																				}
-																				position++
-																				goto l1438
-																			l1439:
-																				position, tokenIndex = position1438, tokenIndex1438
-																				if buffer[position] != rune('I') {
-																					goto l1435
-																				}
-																				position++
-																			}
-																		l1438:
-																			{
-																				position1440, tokenIndex1440 := position, tokenIndex
-																				if buffer[position] != rune('n') {
-																					goto l1441
-																				}
-																				position++
-																				goto l1440
-																			l1441:
-																				position, tokenIndex = position1440, tokenIndex1440
-																				if buffer[position] != rune('N') {
-																					goto l1435
-																				}
-																				position++
-																			}
-																		l1440:
-																			{
-																				position1442, tokenIndex1442 := position, tokenIndex
-																				if buffer[position] != rune('d') {
-																					goto l1443
-																				}
-																				position++
-																				goto l1442
-																			l1443:
-																				position, tokenIndex = position1442, tokenIndex1442
-																				if buffer[position] != rune('D') {
-																					goto l1435
-																				}
-																				position++
-																			}
-																		l1442:
-																			{
-																				position1444, tokenIndex1444 := position, tokenIndex
-																				if buffer[position] != rune('r') {
-																					goto l1445
-																				}
-																				position++
-																				goto l1444
-																			l1445:
-																				position, tokenIndex = position1444, tokenIndex1444
-																				if buffer[position] != rune('R') {
-																					goto l1435
-																				}
-																				position++
-																			}
-																		l1444:
-																			add(rulePegText, position1437)
-																		}
-																		{
-																			add(ruleAction97, position)
-																		}
-																		add(ruleIndr, position1436)
-																	}
-																	goto l1388
-																l1435:
-																	position, tokenIndex = position1388, tokenIndex1388
-																	{
-																		position1448 := position
-																		{
-																			position1449 := position
-																			{
-																				position1450, tokenIndex1450 := position, tokenIndex
-																				if buffer[position] != rune('i') {
-																					goto l1451
-																				}
-																				position++
-																				goto l1450
-																			l1451:
-																				position, tokenIndex = position1450, tokenIndex1450
-																				if buffer[position] != rune('I') {
-																					goto l1447
-																				}
-																				position++
-																			}
-																		l1450:
-																			{
-																				position1452, tokenIndex1452 := position, tokenIndex
-																				if buffer[position] != rune('n') {
-																					goto l1453
-																				}
-																				position++
-																				goto l1452
-																			l1453:
-																				position, tokenIndex = position1452, tokenIndex1452
-																				if buffer[position] != rune('N') {
-																					goto l1447
-																				}
-																				position++
-																			}
-																		l1452:
-																			{
-																				position1454, tokenIndex1454 := position, tokenIndex
-																				if buffer[position] != rune('d') {
-																					goto l1455
-																				}
-																				position++
-																				goto l1454
-																			l1455:
-																				position, tokenIndex = position1454, tokenIndex1454
-																				if buffer[position] != rune('D') {
-																					goto l1447
-																				}
-																				position++
-																			}
-																		l1454:
-																			add(rulePegText, position1449)
-																		}
-																		{
-																			add(ruleAction89, position)
-																		}
-																		add(ruleInd, position1448)
-																	}
-																	goto l1388
-																l1447:
-																	position, tokenIndex = position1388, tokenIndex1388
-																	{
-																		position1458 := position
-																		{
-																			position1459 := position
-																			{
-																				position1460, tokenIndex1460 := position, tokenIndex
-																				if buffer[position] != rune('o') {
-																					goto l1461
-																				}
-																				position++
-																				goto l1460
-																			l1461:
-																				position, tokenIndex = position1460, tokenIndex1460
-																				if buffer[position] != rune('O') {
-																					goto l1457
-																				}
-																				position++
-																			}
-																		l1460:
-																			{
-																				position1462, tokenIndex1462 := position, tokenIndex
-																				if buffer[position] != rune('t') {
-																					goto l1463
-																				}
-																				position++
-																				goto l1462
-																			l1463:
-																				position, tokenIndex = position1462, tokenIndex1462
-																				if buffer[position] != rune('T') {
-																					goto l1457
-																				}
-																				position++
-																			}
-																		l1462:
-																			{
-																				position1464, tokenIndex1464 := position, tokenIndex
-																				if buffer[position] != rune('d') {
-																					goto l1465
-																				}
-																				position++
-																				goto l1464
-																			l1465:
-																				position, tokenIndex = position1464, tokenIndex1464
-																				if buffer[position] != rune('D') {
-																					goto l1457
-																				}
-																				position++
-																			}
-																		l1464:
-																			{
-																				position1466, tokenIndex1466 := position, tokenIndex
-																				if buffer[position] != rune('r') {
-																					goto l1467
-																				}
-																				position++
-																				goto l1466
-																			l1467:
-																				position, tokenIndex = position1466, tokenIndex1466
-																				if buffer[position] != rune('R') {
-																					goto l1457
-																				}
-																				position++
-																			}
-																		l1466:
-																			add(rulePegText, position1459)
-																		}
-																		{
-																			add(ruleAction98, position)
-																		}
-																		add(ruleOtdr, position1458)
-																	}
-																	goto l1388
-																l1457:
-																	position, tokenIndex = position1388, tokenIndex1388
-																	{
-																		position1469 := position
-																		{
-																			position1470 := position
-																			{
-																				position1471, tokenIndex1471 := position, tokenIndex
-																				if buffer[position] != rune('o') {
-																					goto l1472
-																				}
-																				position++
-																				goto l1471
-																			l1472:
-																				position, tokenIndex = position1471, tokenIndex1471
-																				if buffer[position] != rune('O') {
-																					goto l1325
-																				}
-																				position++
-																			}
-																		l1471:
-																			{
-																				position1473, tokenIndex1473 := position, tokenIndex
-																				if buffer[position] != rune('u') {
-																					goto l1474
-																				}
-																				position++
-																				goto l1473
-																			l1474:
-																				position, tokenIndex = position1473, tokenIndex1473
-																				if buffer[position] != rune('U') {
-																					goto l1325
-																				}
-																				position++
-																			}
-																		l1473:
-																			{
-																				position1475, tokenIndex1475 := position, tokenIndex
-																				if buffer[position] != rune('t') {
-																					goto l1476
-																				}
-																				position++
-																				goto l1475
-																			l1476:
-																				position, tokenIndex = position1475, tokenIndex1475
-																				if buffer[position] != rune('T') {
-																					goto l1325
-																				}
-																				position++
-																			}
-																		l1475:
-																			{
-																				position1477, tokenIndex1477 := position, tokenIndex
-																				if buffer[position] != rune('d') {
-																					goto l1478
-																				}
-																				position++
-																				goto l1477
-																			l1478:
-																				position, tokenIndex = position1477, tokenIndex1477
-																				if buffer[position] != rune('D') {
-																					goto l1325
-																				}
-																				position++
-																			}
-																		l1477:
-																			add(rulePegText, position1470)
-																		}
-																		{
-																			add(ruleAction90, position)
-																		}
-																		add(ruleOutd, position1469)
-																	}
-																}
-															l1388:
-																add(ruleBlitIO, position1387)
-															}
-															break
-														case 'R', 'r':
-															{
-																position1480 := position
-																{
-																	position1481 := position
-																	{
-																		position1482, tokenIndex1482 := position, tokenIndex
-																		if buffer[position] != rune('r') {
-																			goto l1483
-																		}
-																		position++
-																		goto l1482
-																	l1483:
-																		position, tokenIndex = position1482, tokenIndex1482
-																		if buffer[position] != rune('R') {
-																			goto l1325
-																		}
-																		position++
-																	}
-																l1482:
-																	{
-																		position1484, tokenIndex1484 := position, tokenIndex
-																		if buffer[position] != rune('l') {
-																			goto l1485
-																		}
-																		position++
-																		goto l1484
-																	l1485:
-																		position, tokenIndex = position1484, tokenIndex1484
-																		if buffer[position] != rune('L') {
-																			goto l1325
-																		}
-																		position++
-																	}
-																l1484:
-																	{
-																		position1486, tokenIndex1486 := position, tokenIndex
-																		if buffer[position] != rune('d') {
-																			goto l1487
-																		}
-																		position++
-																		goto l1486
-																	l1487:
-																		position, tokenIndex = position1486, tokenIndex1486
-																		if buffer[position] != rune('D') {
-																			goto l1325
-																		}
-																		position++
-																	}
-																l1486:
-																	add(rulePegText, position1481)
-																}
-																{
-																	add(ruleAction79, position)
-																}
-																add(ruleRld, position1480)
-															}
-															break
-														case 'N', 'n':
-															{
-																position1489 := position
-																{
-																	position1490 := position
-																	{
-																		position1491, tokenIndex1491 := position, tokenIndex
-																		if buffer[position] != rune('n') {
-																			goto l1492
-																		}
-																		position++
-																		goto l1491
-																	l1492:
-																		position, tokenIndex = position1491, tokenIndex1491
-																		if buffer[position] != rune('N') {
-																			goto l1325
-																		}
-																		position++
-																	}
-																l1491:
-																	{
-																		position1493, tokenIndex1493 := position, tokenIndex
-																		if buffer[position] != rune('e') {
-																			goto l1494
-																		}
-																		position++
-																		goto l1493
-																	l1494:
-																		position, tokenIndex = position1493, tokenIndex1493
-																		if buffer[position] != rune('E') {
-																			goto l1325
-																		}
-																		position++
-																	}
-																l1493:
-																	{
-																		position1495, tokenIndex1495 := position, tokenIndex
-																		if buffer[position] != rune('g') {
-																			goto l1496
-																		}
-																		position++
-																		goto l1495
-																	l1496:
-																		position, tokenIndex = position1495, tokenIndex1495
-																		if buffer[position] != rune('G') {
-																			goto l1325
-																		}
-																		position++
-																	}
-																l1495:
-																	add(rulePegText, position1490)
-																}
-																{
-																	add(ruleAction75, position)
-																}
-																add(ruleNeg, position1489)
-															}
-															break
-														default:
-															{
-																position1498 := position
-																{
-																	position1499, tokenIndex1499 := position, tokenIndex
-																	{
-																		position1501 := position
-																		{
-																			position1502 := position
-																			{
-																				position1503, tokenIndex1503 := position, tokenIndex
-																				if buffer[position] != rune('l') {
-																					goto l1504
-																				}
-																				position++
-																				goto l1503
-																			l1504:
-																				position, tokenIndex = position1503, tokenIndex1503
-																				if buffer[position] != rune('L') {
-																					goto l1500
-																				}
-																				position++
-																			}
-																		l1503:
-																			{
-																				position1505, tokenIndex1505 := position, tokenIndex
-																				if buffer[position] != rune('d') {
-																					goto l1506
-																				}
-																				position++
-																				goto l1505
-																			l1506:
-																				position, tokenIndex = position1505, tokenIndex1505
-																				if buffer[position] != rune('D') {
-																					goto l1500
-																				}
-																				position++
-																			}
-																		l1505:
-																			{
-																				position1507, tokenIndex1507 := position, tokenIndex
-																				if buffer[position] != rune('i') {
-																					goto l1508
-																				}
-																				position++
-																				goto l1507
-																			l1508:
-																				position, tokenIndex = position1507, tokenIndex1507
-																				if buffer[position] != rune('I') {
-																					goto l1500
-																				}
-																				position++
-																			}
-																		l1507:
-																			{
-																				position1509, tokenIndex1509 := position, tokenIndex
-																				if buffer[position] != rune('r') {
-																					goto l1510
-																				}
-																				position++
-																				goto l1509
-																			l1510:
-																				position, tokenIndex = position1509, tokenIndex1509
-																				if buffer[position] != rune('R') {
-																					goto l1500
-																				}
-																				position++
-																			}
-																		l1509:
-																			add(rulePegText, position1502)
-																		}
-																		{
-																			add(ruleAction91, position)
-																		}
-																		add(ruleLdir, position1501)
-																	}
-																	goto l1499
-																l1500:
-																	position, tokenIndex = position1499, tokenIndex1499
-																	{
-																		position1513 := position
-																		{
-																			position1514 := position
-																			{
-																				position1515, tokenIndex1515 := position, tokenIndex
-																				if buffer[position] != rune('l') {
-																					goto l1516
-																				}
-																				position++
-																				goto l1515
-																			l1516:
-																				position, tokenIndex = position1515, tokenIndex1515
-																				if buffer[position] != rune('L') {
-																					goto l1512
-																				}
-																				position++
-																			}
-																		l1515:
-																			{
-																				position1517, tokenIndex1517 := position, tokenIndex
-																				if buffer[position] != rune('d') {
-																					goto l1518
-																				}
-																				position++
-																				goto l1517
-																			l1518:
-																				position, tokenIndex = position1517, tokenIndex1517
-																				if buffer[position] != rune('D') {
-																					goto l1512
-																				}
-																				position++
-																			}
-																		l1517:
-																			{
-																				position1519, tokenIndex1519 := position, tokenIndex
-																				if buffer[position] != rune('i') {
-																					goto l1520
-																				}
-																				position++
-																				goto l1519
-																			l1520:
-																				position, tokenIndex = position1519, tokenIndex1519
-																				if buffer[position] != rune('I') {
-																					goto l1512
-																				}
-																				position++
-																			}
-																		l1519:
-																			add(rulePegText, position1514)
-																		}
-																		{
-																			add(ruleAction83, position)
-																		}
-																		add(ruleLdi, position1513)
-																	}
-																	goto l1499
-																l1512:
-																	position, tokenIndex = position1499, tokenIndex1499
-																	{
-																		position1523 := position
-																		{
-																			position1524 := position
-																			{
-																				position1525, tokenIndex1525 := position, tokenIndex
-																				if buffer[position] != rune('c') {
-																					goto l1526
-																				}
-																				position++
-																				goto l1525
-																			l1526:
-																				position, tokenIndex = position1525, tokenIndex1525
-																				if buffer[position] != rune('C') {
-																					goto l1522
-																				}
-																				position++
-																			}
-																		l1525:
-																			{
-																				position1527, tokenIndex1527 := position, tokenIndex
-																				if buffer[position] != rune('p') {
-																					goto l1528
-																				}
-																				position++
-																				goto l1527
-																			l1528:
-																				position, tokenIndex = position1527, tokenIndex1527
-																				if buffer[position] != rune('P') {
-																					goto l1522
-																				}
-																				position++
-																			}
-																		l1527:
-																			{
-																				position1529, tokenIndex1529 := position, tokenIndex
-																				if buffer[position] != rune('i') {
-																					goto l1530
-																				}
-																				position++
-																				goto l1529
-																			l1530:
-																				position, tokenIndex = position1529, tokenIndex1529
-																				if buffer[position] != rune('I') {
-																					goto l1522
-																				}
-																				position++
-																			}
-																		l1529:
-																			{
-																				position1531, tokenIndex1531 := position, tokenIndex
-																				if buffer[position] != rune('r') {
-																					goto l1532
-																				}
-																				position++
-																				goto l1531
-																			l1532:
-																				position, tokenIndex = position1531, tokenIndex1531
-																				if buffer[position] != rune('R') {
-																					goto l1522
-																				}
-																				position++
-																			}
-																		l1531:
-																			add(rulePegText, position1524)
-																		}
-																		{
-																			add(ruleAction92, position)
-																		}
-																		add(ruleCpir, position1523)
-																	}
-																	goto l1499
-																l1522:
-																	position, tokenIndex = position1499, tokenIndex1499
-																	{
-																		position1535 := position
-																		{
-																			position1536 := position
-																			{
-																				position1537, tokenIndex1537 := position, tokenIndex
-																				if buffer[position] != rune('c') {
-																					goto l1538
-																				}
-																				position++
-																				goto l1537
-																			l1538:
-																				position, tokenIndex = position1537, tokenIndex1537
-																				if buffer[position] != rune('C') {
-																					goto l1534
-																				}
-																				position++
-																			}
-																		l1537:
-																			{
-																				position1539, tokenIndex1539 := position, tokenIndex
-																				if buffer[position] != rune('p') {
-																					goto l1540
-																				}
-																				position++
-																				goto l1539
-																			l1540:
-																				position, tokenIndex = position1539, tokenIndex1539
-																				if buffer[position] != rune('P') {
-																					goto l1534
-																				}
-																				position++
-																			}
-																		l1539:
-																			{
-																				position1541, tokenIndex1541 := position, tokenIndex
-																				if buffer[position] != rune('i') {
-																					goto l1542
-																				}
-																				position++
-																				goto l1541
-																			l1542:
-																				position, tokenIndex = position1541, tokenIndex1541
-																				if buffer[position] != rune('I') {
-																					goto l1534
-																				}
-																				position++
-																			}
-																		l1541:
-																			add(rulePegText, position1536)
-																		}
-																		{
-																			add(ruleAction84, position)
-																		}
-																		add(ruleCpi, position1535)
-																	}
-																	goto l1499
-																l1534:
-																	position, tokenIndex = position1499, tokenIndex1499
-																	{
-																		position1545 := position
-																		{
-																			position1546 := position
-																			{
-																				position1547, tokenIndex1547 := position, tokenIndex
-																				if buffer[position] != rune('l') {
-																					goto l1548
-																				}
-																				position++
-																				goto l1547
-																			l1548:
-																				position, tokenIndex = position1547, tokenIndex1547
-																				if buffer[position] != rune('L') {
-																					goto l1544
-																				}
-																				position++
-																			}
-																		l1547:
-																			{
-																				position1549, tokenIndex1549 := position, tokenIndex
-																				if buffer[position] != rune('d') {
-																					goto l1550
-																				}
-																				position++
-																				goto l1549
-																			l1550:
-																				position, tokenIndex = position1549, tokenIndex1549
-																				if buffer[position] != rune('D') {
-																					goto l1544
-																				}
-																				position++
-																			}
-																		l1549:
-																			{
-																				position1551, tokenIndex1551 := position, tokenIndex
-																				if buffer[position] != rune('d') {
-																					goto l1552
-																				}
-																				position++
-																				goto l1551
-																			l1552:
-																				position, tokenIndex = position1551, tokenIndex1551
-																				if buffer[position] != rune('D') {
-																					goto l1544
-																				}
-																				position++
-																			}
-																		l1551:
-																			{
-																				position1553, tokenIndex1553 := position, tokenIndex
-																				if buffer[position] != rune('r') {
-																					goto l1554
-																				}
-																				position++
-																				goto l1553
-																			l1554:
-																				position, tokenIndex = position1553, tokenIndex1553
-																				if buffer[position] != rune('R') {
-																					goto l1544
-																				}
-																				position++
-																			}
-																		l1553:
-																			add(rulePegText, position1546)
-																		}
-																		{
-																			add(ruleAction95, position)
-																		}
-																		add(ruleLddr, position1545)
-																	}
-																	goto l1499
-																l1544:
-																	position, tokenIndex = position1499, tokenIndex1499
-																	{
-																		position1557 := position
-																		{
-																			position1558 := position
-																			{
-																				position1559, tokenIndex1559 := position, tokenIndex
-																				if buffer[position] != rune('l') {
-																					goto l1560
-																				}
-																				position++
-																				goto l1559
-																			l1560:
-																				position, tokenIndex = position1559, tokenIndex1559
-																				if buffer[position] != rune('L') {
-																					goto l1556
-																				}
-																				position++
-																			}
-																		l1559:
-																			{
-																				position1561, tokenIndex1561 := position, tokenIndex
-																				if buffer[position] != rune('d') {
-																					goto l1562
-																				}
-																				position++
-																				goto l1561
-																			l1562:
-																				position, tokenIndex = position1561, tokenIndex1561
-																				if buffer[position] != rune('D') {
-																					goto l1556
-																				}
-																				position++
-																			}
-																		l1561:
-																			{
-																				position1563, tokenIndex1563 := position, tokenIndex
-																				if buffer[position] != rune('d') {
-																					goto l1564
-																				}
-																				position++
-																				goto l1563
-																			l1564:
-																				position, tokenIndex = position1563, tokenIndex1563
-																				if buffer[position] != rune('D') {
-																					goto l1556
-																				}
-																				position++
-																			}
-																		l1563:
-																			add(rulePegText, position1558)
-																		}
-																		{
-																			add(ruleAction87, position)
-																		}
-																		add(ruleLdd, position1557)
-																	}
-																	goto l1499
-																l1556:
-																	position, tokenIndex = position1499, tokenIndex1499
-																	{
-																		position1567 := position
-																		{
-																			position1568 := position
-																			{
-																				position1569, tokenIndex1569 := position, tokenIndex
-																				if buffer[position] != rune('c') {
-																					goto l1570
-																				}
-																				position++
-																				goto l1569
-																			l1570:
-																				position, tokenIndex = position1569, tokenIndex1569
-																				if buffer[position] != rune('C') {
-																					goto l1566
-																				}
-																				position++
-																			}
-																		l1569:
-																			{
-																				position1571, tokenIndex1571 := position, tokenIndex
-																				if buffer[position] != rune('p') {
-																					goto l1572
-																				}
-																				position++
-																				goto l1571
-																			l1572:
-																				position, tokenIndex = position1571, tokenIndex1571
-																				if buffer[position] != rune('P') {
-																					goto l1566
-																				}
-																				position++
-																			}
-																		l1571:
-																			{
-																				position1573, tokenIndex1573 := position, tokenIndex
-																				if buffer[position] != rune('d') {
-																					goto l1574
-																				}
-																				position++
-																				goto l1573
-																			l1574:
-																				position, tokenIndex = position1573, tokenIndex1573
-																				if buffer[position] != rune('D') {
-																					goto l1566
-																				}
-																				position++
-																			}
-																		l1573:
-																			{
-																				position1575, tokenIndex1575 := position, tokenIndex
-																				if buffer[position] != rune('r') {
-																					goto l1576
-																				}
-																				position++
-																				goto l1575
-																			l1576:
-																				position, tokenIndex = position1575, tokenIndex1575
-																				if buffer[position] != rune('R') {
-																					goto l1566
-																				}
-																				position++
-																			}
-																		l1575:
-																			add(rulePegText, position1568)
-																		}
-																		{
-																			add(ruleAction96, position)
-																		}
-																		add(ruleCpdr, position1567)
-																	}
-																	goto l1499
-																l1566:
-																	position, tokenIndex = position1499, tokenIndex1499
-																	{
-																		position1578 := position
-																		{
-																			position1579 := position
-																			{
-																				position1580, tokenIndex1580 := position, tokenIndex
-																				if buffer[position] != rune('c') {
-																					goto l1581
-																				}
-																				position++
-																				goto l1580
-																			l1581:
-																				position, tokenIndex = position1580, tokenIndex1580
-																				if buffer[position] != rune('C') {
-																					goto l1325
-																				}
-																				position++
-																			}
-																		l1580:
-																			{
-																				position1582, tokenIndex1582 := position, tokenIndex
-																				if buffer[position] != rune('p') {
-																					goto l1583
-																				}
-																				position++
-																				goto l1582
-																			l1583:
-																				position, tokenIndex = position1582, tokenIndex1582
-																				if buffer[position] != rune('P') {
-																					goto l1325
-																				}
-																				position++
-																			}
-																		l1582:
-																			{
-																				position1584, tokenIndex1584 := position, tokenIndex
-																				if buffer[position] != rune('d') {
-																					goto l1585
-																				}
-																				position++
-																				goto l1584
-																			l1585:
-																				position, tokenIndex = position1584, tokenIndex1584
-																				if buffer[position] != rune('D') {
-																					goto l1325
-																				}
-																				position++
-																			}
-																		l1584:
-																			add(rulePegText, position1579)
-																		}
-																		{
-																			add(ruleAction88, position)
-																		}
-																		add(ruleCpd, position1578)
-																	}
-																}
-															l1499:
-																add(ruleBlit, position1498)
-															}
-															break
-														}
+												l1820:
+													goto l1816
+												l1817:
+													position, tokenIndex = position1817, tokenIndex1817
+												}
+												add(rulePegText, position1815)
+											}
+											{
+												position1822, tokenIndex1822 := position, tokenIndex
+												if !_rules[rulealphaundnum]() {
+													goto l1822
+												}
+												goto l1812
+											l1822:
+												position, tokenIndex = position1822, tokenIndex1822
+											}
+											{
+												add(ruleAction104, position)
+											}
+											goto l1804
+										l1812:
+											position, tokenIndex = position1804, tokenIndex1804
+											{
+												position1825 := position
+												if c := buffer[position]; c < rune('0') || c > rune('9') {
+													goto l1824
+												}
+												position++
+											l1826:
+												{
+													position1827, tokenIndex1827 := position, tokenIndex
+													if !_rules[rulehexdigit]() {
+														goto l1827
 													}
-
+													goto l1826
+												l1827:
+													position, tokenIndex = position1827, tokenIndex1827
+												}
+												add(rulePegText, position1825)
+											}
+											{
+												position1828, tokenIndex1828 := position, tokenIndex
+												if buffer[position] != rune('h') {
+													goto l1829
+												}
+												position++
+												goto l1828
+											l1829:
+												position, tokenIndex = position1828, tokenIndex1828
+												if buffer[position] != rune('H') {
+													goto l1824
+												}
+												position++
+											}
+										l1828:
+											{
+												position1830, tokenIndex1830 := position, tokenIndex
+												if !_rules[rulealphaundnum]() {
+													goto l1830
+												}
+												goto l1824
+											l1830:
+												position, tokenIndex = position1830, tokenIndex1830
+											}
+											{
+												add(ruleAction105, position)
+											}
+											goto l1804
+										l1824:
+											position, tokenIndex = position1804, tokenIndex1804
+											{
+												position1833 := position
+												if !_rules[rulehexdigit]() {
+													goto l1832
+												}
+												if !_rules[rulehexdigit]() {
+													goto l1832
 												}
-											l1327:
-												add(ruleEDSimple, position1326)
+												{
+													position1834, tokenIndex1834 := position, tokenIndex
+													if buffer[position] != rune('h') {
+														goto l1835
+													}
+													position++
+													goto l1834
+												l1835:
+													position, tokenIndex = position1834, tokenIndex1834
+													if buffer[position] != rune('H') {
+														goto l1832
+													}
+													position++
+												}
+											l1834:
+												add(rulePegText, position1833)
+											}
+											{
+												position1836, tokenIndex1836 := position, tokenIndex
+												if !_rules[rulealphaundnum]() {
+													goto l1836
+												}
+												goto l1832
+											l1836:
+												position, tokenIndex = position1836, tokenIndex1836
+											}
+											{
+												add(ruleAction106, position)
 											}
-											goto l993
-										l1325:
-											position, tokenIndex = position993, tokenIndex993
+											goto l1804
+										l1832:
+											position, tokenIndex = position1804, tokenIndex1804
 											{
-												position1588 := position
+												position1839 := position
+												{
+													position1842, tokenIndex1842 := position, tokenIndex
+													if buffer[position] != rune('0') {
+														goto l1843
+													}
+													position++
+													goto l1842
+												l1843:
+													position, tokenIndex = position1842, tokenIndex1842
+													if buffer[position] != rune('1') {
+														goto l1838
+													}
+													position++
+												}
+											l1842:
+											l1840:
 												{
-													position1589, tokenIndex1589 := position, tokenIndex
+													position1841, tokenIndex1841 := position, tokenIndex
 													{
-														position1591 := position
-														{
-															position1592 := position
-															{
-																position1593, tokenIndex1593 := position, tokenIndex
-																if buffer[position] != rune('r') {
-																	goto l1594
-																}
-																position++
-																goto l1593
-															l1594:
-																position, tokenIndex = position1593, tokenIndex1593
-																if buffer[position] != rune('R') {
-																	goto l1590
-																}
-																position++
-															}
-														l1593:
-															{
-																position1595, tokenIndex1595 := position, tokenIndex
-																if buffer[position] != rune('l') {
-																	goto l1596
-																}
-																position++
-																goto l1595
-															l1596:
-																position, tokenIndex = position1595, tokenIndex1595
-																if buffer[position] != rune('L') {
-																	goto l1590
-																}
-																position++
-															}
-														l1595:
-															{
-																position1597, tokenIndex1597 := position, tokenIndex
-																if buffer[position] != rune('c') {
-																	goto l1598
-																}
-																position++
-																goto l1597
-															l1598:
-																position, tokenIndex = position1597, tokenIndex1597
-																if buffer[position] != rune('C') {
-																	goto l1590
-																}
-																position++
-															}
-														l1597:
-															{
-																position1599, tokenIndex1599 := position, tokenIndex
-																if buffer[position] != rune('a') {
-																	goto l1600
-																}
-																position++
-																goto l1599
-															l1600:
-																position, tokenIndex = position1599, tokenIndex1599
-																if buffer[position] != rune('A') {
-																	goto l1590
-																}
-																position++
-															}
-														l1599:
-															add(rulePegText, position1592)
+														position1844, tokenIndex1844 := position, tokenIndex
+														if buffer[position] != rune('0') {
+															goto l1845
 														}
-														{
-															add(ruleAction64, position)
+														position++
+														goto l1844
+													l1845:
+														position, tokenIndex = position1844, tokenIndex1844
+														if buffer[position] != rune('1') {
+															goto l1841
 														}
-														add(ruleRlca, position1591)
+														position++
+													}
+												l1844:
+													goto l1840
+												l1841:
+													position, tokenIndex = position1841, tokenIndex1841
+												}
+												add(rulePegText, position1839)
+											}
+											{
+												position1846, tokenIndex1846 := position, tokenIndex
+												if buffer[position] != rune('b') {
+													goto l1847
+												}
+												position++
+												goto l1846
+											l1847:
+												position, tokenIndex = position1846, tokenIndex1846
+												if buffer[position] != rune('B') {
+													goto l1838
+												}
+												position++
+											}
+										l1846:
+											{
+												position1848, tokenIndex1848 := position, tokenIndex
+												if !_rules[rulealphaundnum]() {
+													goto l1848
+												}
+												goto l1838
+											l1848:
+												position, tokenIndex = position1848, tokenIndex1848
+											}
+											{
+												add(ruleAction107, position)
+											}
+											goto l1804
+										l1838:
+											position, tokenIndex = position1804, tokenIndex1804
+											if buffer[position] != rune('%') {
+												goto l1850
+											}
+											position++
+											{
+												position1851 := position
+												{
+													position1854, tokenIndex1854 := position, tokenIndex
+													if buffer[position] != rune('0') {
+														goto l1855
+													}
+													position++
+													goto l1854
+												l1855:
+													position, tokenIndex = position1854, tokenIndex1854
+													if buffer[position] != rune('1') {
+														goto l1850
 													}
-													goto l1589
-												l1590:
-													position, tokenIndex = position1589, tokenIndex1589
+													position++
+												}
+											l1854:
+											l1852:
+												{
+													position1853, tokenIndex1853 := position, tokenIndex
 													{
-														position1603 := position
-														{
-															position1604 := position
-															{
-																position1605, tokenIndex1605 := position, tokenIndex
-																if buffer[position] != rune('r') {
-																	goto l1606
-																}
-																position++
-																goto l1605
-															l1606:
-																position, tokenIndex = position1605, tokenIndex1605
-																if buffer[position] != rune('R') {
-																	goto l1602
-																}
-																position++
-															}
-														l1605:
-															{
-																position1607, tokenIndex1607 := position, tokenIndex
-																if buffer[position] != rune('r') {
-																	goto l1608
-																}
-																position++
-																goto l1607
-															l1608:
-																position, tokenIndex = position1607, tokenIndex1607
-																if buffer[position] != rune('R') {
-																	goto l1602
-																}
-																position++
-															}
-														l1607:
-															{
-																position1609, tokenIndex1609 := position, tokenIndex
-																if buffer[position] != rune('c') {
-																	goto l1610
-																}
-																position++
-																goto l1609
-															l1610:
-																position, tokenIndex = position1609, tokenIndex1609
-																if buffer[position] != rune('C') {
-																	goto l1602
-																}
-																position++
-															}
-														l1609:
-															{
-																position1611, tokenIndex1611 := position, tokenIndex
-																if buffer[position] != rune('a') {
-																	goto l1612
-																}
-																position++
-																goto l1611
-															l1612:
-																position, tokenIndex = position1611, tokenIndex1611
-																if buffer[position] != rune('A') {
-																	goto l1602
-																}
-																position++
-															}
-														l1611:
-															add(rulePegText, position1604)
+														position1856, tokenIndex1856 := position, tokenIndex
+														if buffer[position] != rune('0') {
+															goto l1857
 														}
-														{
-															add(ruleAction65, position)
+														position++
+														goto l1856
+													l1857:
+														position, tokenIndex = position1856, tokenIndex1856
+														if buffer[position] != rune('1') {
+															goto l1853
 														}
-														add(ruleRrca, position1603)
+														position++
+													}
+												l1856:
+													goto l1852
+												l1853:
+													position, tokenIndex = position1853, tokenIndex1853
+												}
+												add(rulePegText, position1851)
+											}
+											{
+												add(ruleAction108, position)
+											}
+											goto l1804
+										l1850:
+											position, tokenIndex = position1804, tokenIndex1804
+											{
+												position1860 := position
+												if c := buffer[position]; c < rune('0') || c > rune('7') {
+													goto l1859
+												}
+												position++
+											l1861:
+												{
+													position1862, tokenIndex1862 := position, tokenIndex
+													if c := buffer[position]; c < rune('0') || c > rune('7') {
+														goto l1862
+													}
+													position++
+													goto l1861
+												l1862:
+													position, tokenIndex = position1862, tokenIndex1862
+												}
+												add(rulePegText, position1860)
+											}
+											{
+												switch buffer[position] {
+												case 'O':
+													if buffer[position] != rune('O') {
+														goto l1859
+													}
+													position++
+													break
+												case 'o':
+													if buffer[position] != rune('o') {
+														goto l1859
 													}
-													goto l1589
-												l1602:
-													position, tokenIndex = position1589, tokenIndex1589
+													position++
+													break
+												default:
 													{
-														position1615 := position
-														{
-															position1616 := position
-															{
-																position1617, tokenIndex1617 := position, tokenIndex
-																if buffer[position] != rune('r') {
-																	goto l1618
-																}
-																position++
-																goto l1617
-															l1618:
-																position, tokenIndex = position1617, tokenIndex1617
-																if buffer[position] != rune('R') {
-																	goto l1614
-																}
-																position++
-															}
-														l1617:
-															{
-																position1619, tokenIndex1619 := position, tokenIndex
-																if buffer[position] != rune('l') {
-																	goto l1620
-																}
-																position++
-																goto l1619
-															l1620:
-																position, tokenIndex = position1619, tokenIndex1619
-																if buffer[position] != rune('L') {
-																	goto l1614
-																}
-																position++
-															}
-														l1619:
-															{
-																position1621, tokenIndex1621 := position, tokenIndex
-																if buffer[position] != rune('a') {
-																	goto l1622
-																}
-																position++
-																goto l1621
-															l1622:
-																position, tokenIndex = position1621, tokenIndex1621
-																if buffer[position] != rune('A') {
-																	goto l1614
-																}
-																position++
-															}
-														l1621:
-															add(rulePegText, position1616)
+														position1864, tokenIndex1864 := position, tokenIndex
+														if buffer[position] != rune('q') {
+															goto l1865
 														}
-														{
-															add(ruleAction66, position)
+														position++
+														goto l1864
+													l1865:
+														position, tokenIndex = position1864, tokenIndex1864
+														if buffer[position] != rune('Q') {
+															goto l1859
 														}
-														add(ruleRla, position1615)
+														position++
+													}
+												l1864:
+													break
+												}
+											}
+
+											{
+												position1866, tokenIndex1866 := position, tokenIndex
+												if !_rules[rulealphaundnum]() {
+													goto l1866
+												}
+												goto l1859
+											l1866:
+												position, tokenIndex = position1866, tokenIndex1866
+											}
+											{
+												add(ruleAction109, position)
+											}
+											goto l1804
+										l1859:
+											position, tokenIndex = position1804, tokenIndex1804
+											{
+												position1868 := position
+												if c := buffer[position]; c < rune('0') || c > rune('9') {
+													goto l1802
+												}
+												position++
+											l1869:
+												{
+													position1870, tokenIndex1870 := position, tokenIndex
+													if c := buffer[position]; c < rune('0') || c > rune('9') {
+														goto l1870
+													}
+													position++
+													goto l1869
+												l1870:
+													position, tokenIndex = position1870, tokenIndex1870
+												}
+												add(rulePegText, position1868)
+											}
+											{
+												position1871, tokenIndex1871 := position, tokenIndex
+												{
+													position1873, tokenIndex1873 := position, tokenIndex
+													if buffer[position] != rune('d') {
+														goto l1874
+													}
+													position++
+													goto l1873
+												l1874:
+													position, tokenIndex = position1873, tokenIndex1873
+													if buffer[position] != rune('D') {
+														goto l1871
+													}
+													position++
+												}
+											l1873:
+												goto l1872
+											l1871:
+												position, tokenIndex = position1871, tokenIndex1871
+											}
+										l1872:
+											{
+												position1875, tokenIndex1875 := position, tokenIndex
+												if !_rules[rulealphaundnum]() {
+													goto l1875
+												}
+												goto l1802
+											l1875:
+												position, tokenIndex = position1875, tokenIndex1875
+											}
+											{
+												add(ruleAction110, position)
+											}
+										}
+									l1804:
+										add(rulenumber, position1803)
+									}
+									goto l1801
+								l1802:
+									position, tokenIndex = position1801, tokenIndex1801
+									{
+										switch buffer[position] {
+										case '$':
+											if buffer[position] != rune('$') {
+												goto l1753
+											}
+											position++
+											{
+												position1878, tokenIndex1878 := position, tokenIndex
+												if !_rules[rulealphaundnum]() {
+													goto l1878
+												}
+												goto l1753
+											l1878:
+												position, tokenIndex = position1878, tokenIndex1878
+											}
+											{
+												add(ruleAction101, position)
+											}
+											break
+										case '(':
+											if buffer[position] != rune('(') {
+												goto l1753
+											}
+											position++
+											{
+												position1880, tokenIndex1880 := position, tokenIndex
+												if !_rules[rulews]() {
+													goto l1880
+												}
+												goto l1881
+											l1880:
+												position, tokenIndex = position1880, tokenIndex1880
+											}
+										l1881:
+											if !_rules[ruleexpr]() {
+												goto l1753
+											}
+											{
+												position1882, tokenIndex1882 := position, tokenIndex
+												if !_rules[rulews]() {
+													goto l1882
+												}
+												goto l1883
+											l1882:
+												position, tokenIndex = position1882, tokenIndex1882
+											}
+										l1883:
+											if buffer[position] != rune(')') {
+												goto l1753
+											}
+											position++
+											break
+										case '"', '\'':
+											{
+												position1884 := position
+												{
+													position1885, tokenIndex1885 := position, tokenIndex
+													if buffer[position] != rune('\'') {
+														goto l1886
 													}
-													goto l1589
-												l1614:
-													position, tokenIndex = position1589, tokenIndex1589
+													position++
 													{
-														position1625 := position
+														position1887 := position
 														{
-															position1626 := position
-															{
-																position1627, tokenIndex1627 := position, tokenIndex
-																if buffer[position] != rune('d') {
-																	goto l1628
-																}
-																position++
-																goto l1627
-															l1628:
-																position, tokenIndex = position1627, tokenIndex1627
-																if buffer[position] != rune('D') {
-																	goto l1624
-																}
-																position++
-															}
-														l1627:
+															position1888, tokenIndex1888 := position, tokenIndex
 															{
-																position1629, tokenIndex1629 := position, tokenIndex
-																if buffer[position] != rune('a') {
-																	goto l1630
-																}
-																position++
-																goto l1629
-															l1630:
-																position, tokenIndex = position1629, tokenIndex1629
-																if buffer[position] != rune('A') {
-																	goto l1624
-																}
-																position++
-															}
-														l1629:
-															{
-																position1631, tokenIndex1631 := position, tokenIndex
-																if buffer[position] != rune('a') {
-																	goto l1632
+																position1889, tokenIndex1889 := position, tokenIndex
+																if buffer[position] != rune('\'') {
+																	goto l1890
 																}
 																position++
-																goto l1631
-															l1632:
-																position, tokenIndex = position1631, tokenIndex1631
-																if buffer[position] != rune('A') {
-																	goto l1624
+																goto l1889
+															l1890:
+																position, tokenIndex = position1889, tokenIndex1889
+																if buffer[position] != rune('\n') {
+																	goto l1888
 																}
 																position++
 															}
-														l1631:
-															add(rulePegText, position1626)
+														l1889:
+															goto l1886
+														l1888:
+															position, tokenIndex = position1888, tokenIndex1888
 														}
-														{
-															add(ruleAction68, position)
+														if !matchDot() {
+															goto l1886
 														}
-														add(ruleDaa, position1625)
+														add(rulePegText, position1887)
+													}
+													if buffer[position] != rune('\'') {
+														goto l1886
 													}
-													goto l1589
-												l1624:
-													position, tokenIndex = position1589, tokenIndex1589
+													position++
 													{
-														position1635 := position
-														{
-															position1636 := position
-															{
-																position1637, tokenIndex1637 := position, tokenIndex
-																if buffer[position] != rune('c') {
-																	goto l1638
-																}
-																position++
-																goto l1637
-															l1638:
-																position, tokenIndex = position1637, tokenIndex1637
-																if buffer[position] != rune('C') {
-																	goto l1634
-																}
-																position++
-															}
-														l1637:
-															{
-																position1639, tokenIndex1639 := position, tokenIndex
-																if buffer[position] != rune('p') {
-																	goto l1640
-																}
-																position++
-																goto l1639
-															l1640:
-																position, tokenIndex = position1639, tokenIndex1639
-																if buffer[position] != rune('P') {
-																	goto l1634
-																}
-																position++
-															}
-														l1639:
-															{
-																position1641, tokenIndex1641 := position, tokenIndex
-																if buffer[position] != rune('l') {
-																	goto l1642
-																}
-																position++
-																goto l1641
-															l1642:
-																position, tokenIndex = position1641, tokenIndex1641
-																if buffer[position] != rune('L') {
-																	goto l1634
-																}
-																position++
-															}
-														l1641:
-															add(rulePegText, position1636)
-														}
-														{
-															add(ruleAction69, position)
-														}
-														add(ruleCpl, position1635)
+														add(ruleAction111, position)
 													}
-													goto l1589
-												l1634:
-													position, tokenIndex = position1589, tokenIndex1589
+													goto l1885
+												l1886:
+													position, tokenIndex = position1885, tokenIndex1885
+													if buffer[position] != rune('"') {
+														goto l1753
+													}
+													position++
 													{
-														position1645 := position
+														position1892 := position
 														{
-															position1646 := position
-															{
-																position1647, tokenIndex1647 := position, tokenIndex
-																if buffer[position] != rune('e') {
-																	goto l1648
-																}
-																position++
-																goto l1647
-															l1648:
-																position, tokenIndex = position1647, tokenIndex1647
-																if buffer[position] != rune('E') {
-																	goto l1644
-																}
-																position++
-															}
-														l1647:
+															position1893, tokenIndex1893 := position, tokenIndex
 															{
-																position1649, tokenIndex1649 := position, tokenIndex
-																if buffer[position] != rune('x') {
-																	goto l1650
+																position1894, tokenIndex1894 := position, tokenIndex
+																if buffer[position] != rune('"') {
+																	goto l1895
 																}
 																position++
-																goto l1649
-															l1650:
-																position, tokenIndex = position1649, tokenIndex1649
-																if buffer[position] != rune('X') {
-																	goto l1644
+																goto l1894
+															l1895:
+																position, tokenIndex = position1894, tokenIndex1894
+																if buffer[position] != rune('\n') {
+																	goto l1893
 																}
 																position++
 															}
-														l1649:
-															{
-																position1651, tokenIndex1651 := position, tokenIndex
-																if buffer[position] != rune('x') {
-																	goto l1652
-																}
-																position++
-																goto l1651
-															l1652:
-																position, tokenIndex = position1651, tokenIndex1651
-																if buffer[position] != rune('X') {
-																	goto l1644
-																}
-																position++
-															}
-														l1651:
-															add(rulePegText, position1646)
+														l1894:
+															goto l1753
+														l1893:
+															position, tokenIndex = position1893, tokenIndex1893
 														}
-														{
-															add(ruleAction72, position)
+														if !matchDot() {
+															goto l1753
 														}
-														add(ruleExx, position1645)
+														add(rulePegText, position1892)
 													}
-													goto l1589
-												l1644:
-													position, tokenIndex = position1589, tokenIndex1589
+													if buffer[position] != rune('"') {
+														goto l1753
+													}
+													position++
 													{
-														switch buffer[position] {
-														case 'E', 'e':
-															{
-																position1655 := position
-																{
-																	position1656 := position
-																	{
-																		position1657, tokenIndex1657 := position, tokenIndex
-																		if buffer[position] != rune('e') {
-																			goto l1658
-																		}
-																		position++
-																		goto l1657
-																	l1658:
-																		position, tokenIndex = position1657, tokenIndex1657
-																		if buffer[position] != rune('E') {
-																			goto l1587
-																		}
-																		position++
-																	}
-																l1657:
-																	{
-																		position1659, tokenIndex1659 := position, tokenIndex
-																		if buffer[position] != rune('i') {
-																			goto l1660
-																		}
-																		position++
-																		goto l1659
-																	l1660:
-																		position, tokenIndex = position1659, tokenIndex1659
-																		if buffer[position] != rune('I') {
-																			goto l1587
-																		}
-																		position++
-																	}
-																l1659:
-																	add(rulePegText, position1656)
-																}
-																{
-																	add(ruleAction74, position)
-																}
-																add(ruleEi, position1655)
-															}
-															break
-														case 'D', 'd':
-															{
-																position1662 := position
-																{
-																	position1663 := position
-																	{
-																		position1664, tokenIndex1664 := position, tokenIndex
-																		if buffer[position] != rune('d') {
-																			goto l1665
-																		}
-																		position++
-																		goto l1664
-																	l1665:
-																		position, tokenIndex = position1664, tokenIndex1664
-																		if buffer[position] != rune('D') {
-																			goto l1587
-																		}
-																		position++
-																	}
-																l1664:
-																	{
-																		position1666, tokenIndex1666 := position, tokenIndex
-																		if buffer[position] != rune('i') {
-																			goto l1667
-																		}
-																		position++
-																		goto l1666
-																	l1667:
-																		position, tokenIndex = position1666, tokenIndex1666
-																		if buffer[position] != rune('I') {
-																			goto l1587
-																		}
-																		position++
-																	}
-																l1666:
-																	add(rulePegText, position1663)
-																}
-																{
-																	add(ruleAction73, position)
-																}
-																add(ruleDi, position1662)
-															}
-															break
-														case 'C', 'c':
-															{
-																position1669 := position
-																{
-																	position1670 := position
-																	{
-																		position1671, tokenIndex1671 := position, tokenIndex
-																		if buffer[position] != rune('c') {
-																			goto l1672
-																		}
-																		position++
-																		goto l1671
-																	l1672:
-																		position, tokenIndex = position1671, tokenIndex1671
-																		if buffer[position] != rune('C') {
-																			goto l1587
-																		}
-																		position++
-																	}
-																l1671:
-																	{
-																		position1673, tokenIndex1673 := position, tokenIndex
-																		if buffer[position] != rune('c') {
-																			goto l1674
-																		}
-																		position++
-																		goto l1673
-																	l1674:
-																		position, tokenIndex = position1673, tokenIndex1673
-																		if buffer[position] != rune('C') {
-																			goto l1587
-																		}
-																		position++
-																	}
-																l1673:
-																	{
-																		position1675, tokenIndex1675 := position, tokenIndex
-																		if buffer[position] != rune('f') {
-																			goto l1676
-																		}
-																		position++
-																		goto l1675
-																	l1676:
-																		position, tokenIndex = position1675, tokenIndex1675
-																		if buffer[position] != rune('F') {
-																			goto l1587
-																		}
-																		position++
-																	}
-																l1675:
-																	add(rulePegText, position1670)
-																}
-																{
-																	add(ruleAction71, position)
-																}
-																add(ruleCcf, position1669)
-															}
-															break
-														case 'S', 's':
-															{
-																position1678 := position
-																{
-																	position1679 := position
-																	{
-																		position1680, tokenIndex1680 := position, tokenIndex
-																		if buffer[position] != rune('s') {
-																			goto l1681
-																		}
-																		position++
-																		goto l1680
-																	l1681:
-																		position, tokenIndex = position1680, tokenIndex1680
-																		if buffer[position] != rune('S') {
-																			goto l1587
-																		}
-																		position++
-																	}
-																l1680:
-																	{
-																		position1682, tokenIndex1682 := position, tokenIndex
-																		if buffer[position] != rune('c') {
-																			goto l1683
-																		}
-																		position++
-																		goto l1682
-																	l1683:
-																		position, tokenIndex = position1682, tokenIndex1682
-																		if buffer[position] != rune('C') {
-																			goto l1587
-																		}
-																		position++
-																	}
-																l1682:
-																	{
-																		position1684, tokenIndex1684 := position, tokenIndex
-																		if buffer[position] != rune('f') {
-																			goto l1685
-																		}
-																		position++
-																		goto l1684
-																	l1685:
-																		position, tokenIndex = position1684, tokenIndex1684
-																		if buffer[position] != rune('F') {
-																			goto l1587
-																		}
-																		position++
-																	}
-																l1684:
-																	add(rulePegText, position1679)
-																}
-																{
-																	add(ruleAction70, position)
-																}
-																add(ruleScf, position1678)
-															}
-															break
-														case 'R', 'r':
-															{
-																position1687 := position
-																{
-																	position1688 := position
-																	{
-																		position1689, tokenIndex1689 := position, tokenIndex
-																		if buffer[position] != rune('r') {
-																			goto l1690
-																		}
-																		position++
-																		goto l1689
-																	l1690:
-																		position, tokenIndex = position1689, tokenIndex1689
-																		if buffer[position] != rune('R') {
-																			goto l1587
-																		}
-																		position++
-																	}
-																l1689:
-																	{
-																		position1691, tokenIndex1691 := position, tokenIndex
-																		if buffer[position] != rune('r') {
-																			goto l1692
-																		}
-																		position++
-																		goto l1691
-																	l1692:
-																		position, tokenIndex = position1691, tokenIndex1691
-																		if buffer[position] != rune('R') {
-																			goto l1587
-																		}
-																		position++
-																	}
-																l1691:
-																	{
-																		position1693, tokenIndex1693 := position, tokenIndex
-																		if buffer[position] != rune('a') {
-																			goto l1694
-																		}
-																		position++
-																		goto l1693
-																	l1694:
-																		position, tokenIndex = position1693, tokenIndex1693
-																		if buffer[position] != rune('A') {
-																			goto l1587
-																		}
-																		position++
-																	}
-																l1693:
-																	add(rulePegText, position1688)
-																}
-																{
-																	add(ruleAction67, position)
-																}
-																add(ruleRra, position1687)
-															}
-															break
-														case 'H', 'h':
-															{
-																position1696 := position
-																{
-																	position1697 := position
-																	{
-																		position1698, tokenIndex1698 := position, tokenIndex
-																		if buffer[position] != rune('h') {
-																			goto l1699
-																		}
-																		position++
-																		goto l1698
-																	l1699:
-																		position, tokenIndex = position1698, tokenIndex1698
-																		if buffer[position] != rune('H') {
-																			goto l1587
-																		}
-																		position++
-																	}
-																l1698:
-																	{
-																		position1700, tokenIndex1700 := position, tokenIndex
-																		if buffer[position] != rune('a') {
-																			goto l1701
-																		}
-																		position++
-																		goto l1700
-																	l1701:
-																		position, tokenIndex = position1700, tokenIndex1700
-																		if buffer[position] != rune('A') {
-																			goto l1587
-																		}
-																		position++
-																	}
-																l1700:
-																	{
-																		position1702, tokenIndex1702 := position, tokenIndex
-																		if buffer[position] != rune('l') {
-																			goto l1703
-																		}
-																		position++
-																		goto l1702
-																	l1703:
-																		position, tokenIndex = position1702, tokenIndex1702
-																		if buffer[position] != rune('L') {
-																			goto l1587
-																		}
-																		position++
-																	}
-																l1702:
-																	{
-																		position1704, tokenIndex1704 := position, tokenIndex
-																		if buffer[position] != rune('t') {
-																			goto l1705
-																		}
-																		position++
-																		goto l1704
-																	l1705:
-																		position, tokenIndex = position1704, tokenIndex1704
-																		if buffer[position] != rune('T') {
-																			goto l1587
-																		}
-																		position++
-																	}
-																l1704:
-																	add(rulePegText, position1697)
-																}
-																{
-																	add(ruleAction63, position)
-																}
-																add(ruleHalt, position1696)
-															}
-															break
-														default:
-															{
-																position1707 := position
-																{
-																	position1708 := position
-																	{
-																		position1709, tokenIndex1709 := position, tokenIndex
-																		if buffer[position] != rune('n') {
-																			goto l1710
-																		}
-																		position++
-																		goto l1709
-																	l1710:
-																		position, tokenIndex = position1709, tokenIndex1709
-																		if buffer[position] != rune('N') {
-																			goto l1587
-																		}
-																		position++
-																	}
-																l1709:
-																	{
-																		position1711, tokenIndex1711 := position, tokenIndex
-																		if buffer[position] != rune('o') {
-																			goto l1712
-																		}
-																		position++
-																		goto l1711
-																	l1712:
-																		position, tokenIndex = position1711, tokenIndex1711
-																		if buffer[position] != rune('O') {
-																			goto l1587
-																		}
-																		position++
-																	}
-																l1711:
-																	{
-																		position1713, tokenIndex1713 := position, tokenIndex
-																		if buffer[position] != rune('p') {
-																			goto l1714
-																		}
-																		position++
-																		goto l1713
-																	l1714:
-																		position, tokenIndex = position1713, tokenIndex1713
-																		if buffer[position] != rune('P') {
-																			goto l1587
-																		}
-																		position++
-																	}
-																l1713:
-																	add(rulePegText, position1708)
-																}
-																{
-																	add(ruleAction62, position)
-																}
-																add(ruleNop, position1707)
-															}
-															break
-														}
+														add(ruleAction112, position)
 													}
-
 												}
-											l1589:
-												add(ruleSimple, position1588)
+											l1885:
+												add(rulecharLit, position1884)
 											}
-											goto l993
-										l1587:
-											position, tokenIndex = position993, tokenIndex993
+											break
+										default:
 											{
-												position1717 := position
+												position1897, tokenIndex1897 := position, tokenIndex
 												{
-													position1718, tokenIndex1718 := position, tokenIndex
-													{
-														position1720 := position
-														{
-															position1721, tokenIndex1721 := position, tokenIndex
-															if buffer[position] != rune('r') {
-																goto l1722
-															}
-															position++
-															goto l1721
-														l1722:
-															position, tokenIndex = position1721, tokenIndex1721
-															if buffer[position] != rune('R') {
-																goto l1719
-															}
-															position++
-														}
-													l1721:
-														{
-															position1723, tokenIndex1723 := position, tokenIndex
-															if buffer[position] != rune('s') {
-																goto l1724
-															}
-															position++
-															goto l1723
-														l1724:
-															position, tokenIndex = position1723, tokenIndex1723
-															if buffer[position] != rune('S') {
-																goto l1719
-															}
-															position++
-														}
-													l1723:
-														{
-															position1725, tokenIndex1725 := position, tokenIndex
-															if buffer[position] != rune('t') {
-																goto l1726
-															}
-															position++
-															goto l1725
-														l1726:
-															position, tokenIndex = position1725, tokenIndex1725
-															if buffer[position] != rune('T') {
-																goto l1719
-															}
-															position++
-														}
-													l1725:
-														if !_rules[rulews]() {
-															goto l1719
-														}
-														if !_rules[rulen]() {
-															goto l1719
-														}
-														{
-															add(ruleAction99, position)
-														}
-														add(ruleRst, position1720)
-													}
-													goto l1718
-												l1719:
-													position, tokenIndex = position1718, tokenIndex1718
-													{
-														position1729 := position
-														{
-															position1730, tokenIndex1730 := position, tokenIndex
-															if buffer[position] != rune('j') {
-																goto l1731
-															}
-															position++
-															goto l1730
-														l1731:
-															position, tokenIndex = position1730, tokenIndex1730
-															if buffer[position] != rune('J') {
-																goto l1728
-															}
-															position++
-														}
-													l1730:
-														{
-															position1732, tokenIndex1732 := position, tokenIndex
-															if buffer[position] != rune('p') {
-																goto l1733
-															}
-															position++
-															goto l1732
-														l1733:
-															position, tokenIndex = position1732, tokenIndex1732
-															if buffer[position] != rune('P') {
-																goto l1728
-															}
-															position++
-														}
-													l1732:
-														if !_rules[rulews]() {
-															goto l1728
-														}
-														{
-															position1734, tokenIndex1734 := position, tokenIndex
-															if !_rules[rulecc]() {
-																goto l1734
-															}
-															if !_rules[rulesep]() {
-																goto l1734
+													position1898 := position
+													{
+														position1899, tokenIndex1899 := position, tokenIndex
+														if !_rules[ruleAF_PRIME]() {
+															goto l1900
+														}
+														goto l1899
+													l1900:
+														position, tokenIndex = position1899, tokenIndex1899
+														if !_rules[ruleIXH]() {
+															goto l1901
+														}
+														goto l1899
+													l1901:
+														position, tokenIndex = position1899, tokenIndex1899
+														if !_rules[ruleIXL]() {
+															goto l1902
+														}
+														goto l1899
+													l1902:
+														position, tokenIndex = position1899, tokenIndex1899
+														if !_rules[ruleIYH]() {
+															goto l1903
+														}
+														goto l1899
+													l1903:
+														position, tokenIndex = position1899, tokenIndex1899
+														if !_rules[ruleIYL]() {
+															goto l1904
+														}
+														goto l1899
+													l1904:
+														position, tokenIndex = position1899, tokenIndex1899
+														if !_rules[ruleAF]() {
+															goto l1905
+														}
+														goto l1899
+													l1905:
+														position, tokenIndex = position1899, tokenIndex1899
+														if !_rules[ruleBC]() {
+															goto l1906
+														}
+														goto l1899
+													l1906:
+														position, tokenIndex = position1899, tokenIndex1899
+														if !_rules[ruleDE]() {
+															goto l1907
+														}
+														goto l1899
+													l1907:
+														position, tokenIndex = position1899, tokenIndex1899
+														if !_rules[ruleHL]() {
+															goto l1908
+														}
+														goto l1899
+													l1908:
+														position, tokenIndex = position1899, tokenIndex1899
+														if !_rules[ruleIX]() {
+															goto l1909
+														}
+														goto l1899
+													l1909:
+														position, tokenIndex = position1899, tokenIndex1899
+														if !_rules[ruleIY]() {
+															goto l1910
+														}
+														goto l1899
+													l1910:
+														position, tokenIndex = position1899, tokenIndex1899
+														{
+															switch buffer[position] {
+															case 'R', 'r':
+																if !_rules[ruleR]() {
+																	goto l1897
+																}
+																break
+															case 'I', 'i':
+																if !_rules[ruleI]() {
+																	goto l1897
+																}
+																break
+															case 'L', 'l':
+																if !_rules[ruleL]() {
+																	goto l1897
+																}
+																break
+															case 'H', 'h':
+																if !_rules[ruleH]() {
+																	goto l1897
+																}
+																break
+															case 'E', 'e':
+																if !_rules[ruleE]() {
+																	goto l1897
+																}
+																break
+															case 'D', 'd':
+																if !_rules[ruleD]() {
+																	goto l1897
+																}
+																break
+															case 'C', 'c':
+																if !_rules[ruleC]() {
+																	goto l1897
+																}
+																break
+															case 'B', 'b':
+																if !_rules[ruleB]() {
+																	goto l1897
+																}
+																break
+															case 'F', 'f':
+																if !_rules[ruleF]() {
+																	goto l1897
+																}
+																break
+															case 'A', 'a':
+																if !_rules[ruleA]() {
+																	goto l1897
+																}
+																break
+															default:
+																if !_rules[ruleSP]() {
+																	goto l1897
+																}
+																break
 															}
-															goto l1735
-														l1734:
-															position, tokenIndex = position1734, tokenIndex1734
-														}
-													l1735:
-														if !_rules[ruleSrc16]() {
-															goto l1728
-														}
-														{
-															add(ruleAction102, position)
 														}
-														add(ruleJp, position1729)
+
 													}
-													goto l1718
-												l1728:
-													position, tokenIndex = position1718, tokenIndex1718
+												l1899:
 													{
-														switch buffer[position] {
-														case 'D', 'd':
-															{
-																position1738 := position
-																{
-																	position1739, tokenIndex1739 := position, tokenIndex
-																	if buffer[position] != rune('d') {
-																		goto l1740
-																	}
-																	position++
-																	goto l1739
-																l1740:
-																	position, tokenIndex = position1739, tokenIndex1739
-																	if buffer[position] != rune('D') {
-																		goto l1716
-																	}
-																	position++
-																}
-															l1739:
-																{
-																	position1741, tokenIndex1741 := position, tokenIndex
-																	if buffer[position] != rune('j') {
-																		goto l1742
-																	}
-																	position++
-																	goto l1741
-																l1742:
-																	position, tokenIndex = position1741, tokenIndex1741
-																	if buffer[position] != rune('J') {
-																		goto l1716
-																	}
-																	position++
-																}
-															l1741:
-																{
-																	position1743, tokenIndex1743 := position, tokenIndex
-																	if buffer[position] != rune('n') {
-																		goto l1744
-																	}
-																	position++
-																	goto l1743
-																l1744:
-																	position, tokenIndex = position1743, tokenIndex1743
-																	if buffer[position] != rune('N') {
-																		goto l1716
-																	}
-																	position++
-																}
-															l1743:
-																{
-																	position1745, tokenIndex1745 := position, tokenIndex
-																	if buffer[position] != rune('z') {
-																		goto l1746
-																	}
-																	position++
-																	goto l1745
-																l1746:
-																	position, tokenIndex = position1745, tokenIndex1745
-																	if buffer[position] != rune('Z') {
-																		goto l1716
-																	}
-																	position++
-																}
-															l1745:
-																if !_rules[rulews]() {
-																	goto l1716
-																}
-																if !_rules[ruledisp]() {
-																	goto l1716
-																}
-																{
-																	add(ruleAction104, position)
-																}
-																add(ruleDjnz, position1738)
-															}
-															break
-														case 'J', 'j':
-															{
-																position1748 := position
-																{
-																	position1749, tokenIndex1749 := position, tokenIndex
-																	if buffer[position] != rune('j') {
-																		goto l1750
-																	}
-																	position++
-																	goto l1749
-																l1750:
-																	position, tokenIndex = position1749, tokenIndex1749
-																	if buffer[position] != rune('J') {
-																		goto l1716
-																	}
-																	position++
-																}
-															l1749:
-																{
-																	position1751, tokenIndex1751 := position, tokenIndex
-																	if buffer[position] != rune('r') {
-																		goto l1752
-																	}
-																	position++
-																	goto l1751
-																l1752:
-																	position, tokenIndex = position1751, tokenIndex1751
-																	if buffer[position] != rune('R') {
-																		goto l1716
-																	}
-																	position++
-																}
-															l1751:
-																if !_rules[rulews]() {
-																	goto l1716
-																}
-																{
-																	position1753, tokenIndex1753 := position, tokenIndex
-																	if !_rules[rulecc]() {
-																		goto l1753
-																	}
-																	if !_rules[rulesep]() {
-																		goto l1753
-																	}
-																	goto l1754
-																l1753:
-																	position, tokenIndex = position1753, tokenIndex1753
-																}
-															l1754:
-																if !_rules[ruledisp]() {
-																	goto l1716
-																}
-																{
-																	add(ruleAction103, position)
-																}
-																add(ruleJr, position1748)
-															}
-															break
-														case 'R', 'r':
-															{
-																position1756 := position
-																{
-																	position1757, tokenIndex1757 := position, tokenIndex
-																	if buffer[position] != rune('r') {
-																		goto l1758
-																	}
-																	position++
-																	goto l1757
-																l1758:
-																	position, tokenIndex = position1757, tokenIndex1757
-																	if buffer[position] != rune('R') {
-																		goto l1716
-																	}
-																	position++
-																}
-															l1757:
-																{
-																	position1759, tokenIndex1759 := position, tokenIndex
-																	if buffer[position] != rune('e') {
-																		goto l1760
-																	}
-																	position++
-																	goto l1759
-																l1760:
-																	position, tokenIndex = position1759, tokenIndex1759
-																	if buffer[position] != rune('E') {
-																		goto l1716
-																	}
-																	position++
-																}
-															l1759:
-																{
-																	position1761, tokenIndex1761 := position, tokenIndex
-																	if buffer[position] != rune('t') {
-																		goto l1762
-																	}
-																	position++
-																	goto l1761
-																l1762:
-																	position, tokenIndex = position1761, tokenIndex1761
-																	if buffer[position] != rune('T') {
-																		goto l1716
-																	}
-																	position++
-																}
-															l1761:
-																{
-																	position1763, tokenIndex1763 := position, tokenIndex
-																	if !_rules[rulews]() {
-																		goto l1763
-																	}
-																	if !_rules[rulecc]() {
-																		goto l1763
-																	}
-																	goto l1764
-																l1763:
-																	position, tokenIndex = position1763, tokenIndex1763
-																}
-															l1764:
-																{
-																	add(ruleAction101, position)
-																}
-																add(ruleRet, position1756)
-															}
-															break
-														default:
-															{
-																position1766 := position
-																{
-																	position1767, tokenIndex1767 := position, tokenIndex
-																	if buffer[position] != rune('c') {
-																		goto l1768
-																	}
-																	position++
-																	goto l1767
-																l1768:
-																	position, tokenIndex = position1767, tokenIndex1767
-																	if buffer[position] != rune('C') {
-																		goto l1716
-																	}
-																	position++
-																}
-															l1767:
-																{
-																	position1769, tokenIndex1769 := position, tokenIndex
-																	if buffer[position] != rune('a') {
-																		goto l1770
-																	}
-																	position++
-																	goto l1769
-																l1770:
-																	position, tokenIndex = position1769, tokenIndex1769
-																	if buffer[position] != rune('A') {
-																		goto l1716
-																	}
-																	position++
-																}
-															l1769:
-																{
-																	position1771, tokenIndex1771 := position, tokenIndex
-																	if buffer[position] != rune('l') {
-																		goto l1772
-																	}
-																	position++
-																	goto l1771
-																l1772:
-																	position, tokenIndex = position1771, tokenIndex1771
-																	if buffer[position] != rune('L') {
-																		goto l1716
-																	}
-																	position++
-																}
-															l1771:
-																{
-																	position1773, tokenIndex1773 := position, tokenIndex
-																	if buffer[position] != rune('l') {
-																		goto l1774
-																	}
-																	position++
-																	goto l1773
-																l1774:
-																	position, tokenIndex = position1773, tokenIndex1773
-																	if buffer[position] != rune('L') {
-																		goto l1716
-																	}
-																	position++
-																}
-															l1773:
-																if !_rules[rulews]() {
-																	goto l1716
-																}
-																{
-																	position1775, tokenIndex1775 := position, tokenIndex
-																	if !_rules[rulecc]() {
-																		goto l1775
-																	}
-																	if !_rules[rulesep]() {
-																		goto l1775
-																	}
-																	goto l1776
-																l1775:
-																	position, tokenIndex = position1775, tokenIndex1775
-																}
-															l1776:
-																if !_rules[ruleSrc16]() {
-																	goto l1716
-																}
-																{
-																	add(ruleAction100, position)
-																}
-																add(ruleCall, position1766)
-															}
-															break
+														position1912, tokenIndex1912 := position, tokenIndex
+														if !_rules[rulealphaundnum]() {
+															goto l1912
 														}
+														goto l1897
+													l1912:
+														position, tokenIndex = position1912, tokenIndex1912
 													}
-
+													add(ruleRegName, position1898)
 												}
-											l1718:
-												add(ruleJump, position1717)
+												goto l1753
+											l1897:
+												position, tokenIndex = position1897, tokenIndex1897
 											}
-											goto l993
-										l1716:
-											position, tokenIndex = position993, tokenIndex993
 											{
-												position1778 := position
-												{
-													position1779, tokenIndex1779 := position, tokenIndex
-													{
-														position1781 := position
-														{
-															position1782, tokenIndex1782 := position, tokenIndex
-															if buffer[position] != rune('i') {
-																goto l1783
-															}
-															position++
-															goto l1782
-														l1783:
-															position, tokenIndex = position1782, tokenIndex1782
-															if buffer[position] != rune('I') {
-																goto l1780
-															}
-															position++
-														}
-													l1782:
-														{
-															position1784, tokenIndex1784 := position, tokenIndex
-															if buffer[position] != rune('n') {
-																goto l1785
-															}
-															position++
-															goto l1784
-														l1785:
-															position, tokenIndex = position1784, tokenIndex1784
-															if buffer[position] != rune('N') {
-																goto l1780
-															}
-															position++
-														}
-													l1784:
-														if !_rules[rulews]() {
-															goto l1780
-														}
-														if !_rules[ruleReg8]() {
-															goto l1780
-														}
-														if !_rules[rulesep]() {
-															goto l1780
-														}
-														if !_rules[rulePort]() {
-															goto l1780
-														}
-														{
-															add(ruleAction105, position)
-														}
-														add(ruleIN, position1781)
-													}
-													goto l1779
-												l1780:
-													position, tokenIndex = position1779, tokenIndex1779
-													{
-														position1787 := position
-														{
-															position1788, tokenIndex1788 := position, tokenIndex
-															if buffer[position] != rune('o') {
-																goto l1789
-															}
-															position++
-															goto l1788
-														l1789:
-															position, tokenIndex = position1788, tokenIndex1788
-															if buffer[position] != rune('O') {
-																goto l919
-															}
-															position++
-														}
-													l1788:
-														{
-															position1790, tokenIndex1790 := position, tokenIndex
-															if buffer[position] != rune('u') {
-																goto l1791
-															}
-															position++
-															goto l1790
-														l1791:
-															position, tokenIndex = position1790, tokenIndex1790
-															if buffer[position] != rune('U') {
-																goto l919
-															}
-															position++
-														}
-													l1790:
-														{
-															position1792, tokenIndex1792 := position, tokenIndex
-															if buffer[position] != rune('t') {
-																goto l1793
-															}
-															position++
-															goto l1792
-														l1793:
-															position, tokenIndex = position1792, tokenIndex1792
-															if buffer[position] != rune('T') {
-																goto l919
-															}
-															position++
-														}
-													l1792:
-														if !_rules[rulews]() {
-															goto l919
-														}
-														if !_rules[rulePort]() {
-															goto l919
-														}
-														if !_rules[rulesep]() {
-															goto l919
-														}
-														if !_rules[ruleReg8]() {
-															goto l919
-														}
-														{
-															add(ruleAction106, position)
-														}
-														add(ruleOUT, position1787)
-													}
+												position1913 := position
+												if !_rules[ruleLabelText]() {
+													goto l1753
 												}
-											l1779:
-												add(ruleIO, position1778)
+												add(rulePegText, position1913)
 											}
+											{
+												add(ruleAction102, position)
+											}
+											break
 										}
-									l993:
-										add(ruleInstruction, position992)
 									}
+
 								}
-							l922:
-								add(ruleStatement, position921)
-							}
-							goto l920
-						l919:
-							position, tokenIndex = position919, tokenIndex919
-						}
-					l920:
-						{
-							position1795, tokenIndex1795 := position, tokenIndex
-							if !_rules[rulews]() {
-								goto l1795
+							l1801:
+								add(ruleprimary, position1800)
 							}
-							goto l1796
-						l1795:
-							position, tokenIndex = position1795, tokenIndex1795
+							break
 						}
-					l1796:
+					}
+
+				}
+			l1755:
+				add(ruleunaryExpr, position1754)
+			}
+			return true
+		l1753:
+			position, tokenIndex = position1753, tokenIndex1753
+			return false
+		},
+		/* 84 primary <- <(number / ((&('$') ('$' !alphaundnum Action101)) | (&('(') ('(' ws? expr ws? ')')) | (&('"' | '\'') charLit) | (&('.' | '?' | '@' | 'A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '_' | 'a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') (!RegName <LabelText> Action102))))> */
+		nil,
+		/* 85 number <- <(('0' ('x' / 'X') <hexdigit+> Action103) / ('0' ('b' / 'B') <('0' / '1')+> !alphaundnum Action104) / (<([0-9] hexdigit*)> ('h' / 'H') !alphaundnum Action105) / (<(hexdigit hexdigit ('h' / 'H'))> !alphaundnum Action106) / (<('0' / '1')+> ('b' / 'B') !alphaundnum Action107) / ('%' <('0' / '1')+> Action108) / (<[0-7]+> ((&('O') 'O') | (&('o') 'o') | (&('Q' | 'q') ('q' / 'Q'))) !alphaundnum Action109) / (<[0-9]+> ('d' / 'D')? !alphaundnum Action110))> */
+		nil,
+		/* 86 charLit <- <(('\'' <(!('\'' / '\n') .)> '\'' Action111) / ('"' <(!('"' / '\n') .)> '"' Action112))> */
+		nil,
+		/* 87 nn_contents <- <('(' ws? nn ws? ')' &opEnd Action113)> */
+		func() bool {
+			position1918, tokenIndex1918 := position, tokenIndex
+			{
+				position1919 := position
+				if buffer[position] != rune('(') {
+					goto l1918
+				}
+				position++
+				{
+					position1920, tokenIndex1920 := position, tokenIndex
+					if !_rules[rulews]() {
+						goto l1920
+					}
+					goto l1921
+				l1920:
+					position, tokenIndex = position1920, tokenIndex1920
+				}
+			l1921:
+				if !_rules[rulenn]() {
+					goto l1918
+				}
+				{
+					position1922, tokenIndex1922 := position, tokenIndex
+					if !_rules[rulews]() {
+						goto l1922
+					}
+					goto l1923
+				l1922:
+					position, tokenIndex = position1922, tokenIndex1922
+				}
+			l1923:
+				if buffer[position] != rune(')') {
+					goto l1918
+				}
+				position++
+				{
+					position1924, tokenIndex1924 := position, tokenIndex
+					if !_rules[ruleopEnd]() {
+						goto l1918
+					}
+					position, tokenIndex = position1924, tokenIndex1924
+				}
+				{
+					add(ruleAction113, position)
+				}
+				add(rulenn_contents, position1919)
+			}
+			return true
+		l1918:
+			position, tokenIndex = position1918, tokenIndex1918
+			return false
+		},
+		/* 88 opEnd <- <(ws? !(!((&('\n') '\n') | (&('\r') '\r') | (&(':') ':') | (&('#') '#') | (&(';') ';') | (&(',') ',')) .))> */
+		func() bool {
+			position1926, tokenIndex1926 := position, tokenIndex
+			{
+				position1927 := position
+				{
+					position1928, tokenIndex1928 := position, tokenIndex
+					if !_rules[rulews]() {
+						goto l1928
+					}
+					goto l1929
+				l1928:
+					position, tokenIndex = position1928, tokenIndex1928
+				}
+			l1929:
+				{
+					position1930, tokenIndex1930 := position, tokenIndex
+					{
+						position1931, tokenIndex1931 := position, tokenIndex
 						{
-							position1797, tokenIndex1797 := position, tokenIndex
-							{
-								position1799 := position
-								{
-									position1800, tokenIndex1800 := position, tokenIndex
-									if buffer[position] != rune(';') {
-										goto l1801
-									}
-									position++
-									goto l1800
-								l1801:
-									position, tokenIndex = position1800, tokenIndex1800
-									if buffer[position] != rune('#') {
-										goto l1797
-									}
-									position++
-								}
-							l1800:
-							l1802:
-								{
-									position1803, tokenIndex1803 := position, tokenIndex
-									{
-										position1804, tokenIndex1804 := position, tokenIndex
-										if buffer[position] != rune('\n') {
-											goto l1804
-										}
-										position++
-										goto l1803
-									l1804:
-										position, tokenIndex = position1804, tokenIndex1804
-									}
-									if !matchDot() {
-										goto l1803
-									}
-									goto l1802
-								l1803:
-									position, tokenIndex = position1803, tokenIndex1803
+							switch buffer[position] {
+							case '\n':
+								if buffer[position] != rune('\n') {
+									goto l1931
 								}
-								add(ruleComment, position1799)
-							}
-							goto l1798
-						l1797:
-							position, tokenIndex = position1797, tokenIndex1797
-						}
-					l1798:
-						{
-							position1805, tokenIndex1805 := position, tokenIndex
-							if !_rules[rulews]() {
-								goto l1805
-							}
-							goto l1806
-						l1805:
-							position, tokenIndex = position1805, tokenIndex1805
-						}
-					l1806:
-						{
-							position1807, tokenIndex1807 := position, tokenIndex
-							{
-								position1809, tokenIndex1809 := position, tokenIndex
+								position++
+								break
+							case '\r':
 								if buffer[position] != rune('\r') {
-									goto l1809
+									goto l1931
 								}
 								position++
-								goto l1810
-							l1809:
-								position, tokenIndex = position1809, tokenIndex1809
-							}
-						l1810:
-							if buffer[position] != rune('\n') {
-								goto l1808
-							}
-							position++
-							goto l1807
-						l1808:
-							position, tokenIndex = position1807, tokenIndex1807
-							if buffer[position] != rune(':') {
-								goto l3
+								break
+							case ':':
+								if buffer[position] != rune(':') {
+									goto l1931
+								}
+								position++
+								break
+							case '#':
+								if buffer[position] != rune('#') {
+									goto l1931
+								}
+								position++
+								break
+							case ';':
+								if buffer[position] != rune(';') {
+									goto l1931
+								}
+								position++
+								break
+							default:
+								if buffer[position] != rune(',') {
+									goto l1931
+								}
+								position++
+								break
 							}
-							position++
-						}
-					l1807:
-						{
-							add(ruleAction0, position)
 						}
-						add(ruleLine, position908)
+
+						goto l1930
+					l1931:
+						position, tokenIndex = position1931, tokenIndex1931
 					}
-					goto l2
-				l3:
-					position, tokenIndex = position3, tokenIndex3
-				}
-				{
-					position1812, tokenIndex1812 := position, tokenIndex
 					if !matchDot() {
-						goto l1812
+						goto l1930
 					}
-					goto l0
-				l1812:
-					position, tokenIndex = position1812, tokenIndex1812
+					goto l1926
+				l1930:
+					position, tokenIndex = position1930, tokenIndex1930
 				}
-				add(ruleProgram, position1)
+				add(ruleopEnd, position1927)
 			}
 			return true
-		l0:
-			position, tokenIndex = position0, tokenIndex0
+		l1926:
+			position, tokenIndex = position1926, tokenIndex1926
 			return false
 		},
-		/* 1 Line <- <(ws* LabelDefn? ws* Statement? ws? Comment? ws? (('\r'? '\n') / ':') Action0)> */
+		/* 89 Alu <- <(Add / Adc / Sub / ((&('C' | 'c') Cp) | (&('O' | 'o') Or) | (&('X' | 'x') Xor) | (&('A' | 'a') And) | (&('S' | 's') Sbc)))> */
+		nil,
+		/* 90 Add <- <(('a' / 'A') ('d' / 'D') ('d' / 'D') ws (('a' / 'A') sep)? Src8 Action114)> */
+		nil,
+		/* 91 Adc <- <(('a' / 'A') ('d' / 'D') ('c' / 'C') ws (('a' / 'A') sep)? Src8 Action115)> */
+		nil,
+		/* 92 Sub <- <(('s' / 'S') ('u' / 'U') ('b' / 'B') ws (('a' / 'A') sep)? Src8 Action116)> */
+		nil,
+		/* 93 Sbc <- <(('s' / 'S') ('b' / 'B') ('c' / 'C') ws (('a' / 'A') sep)? Src8 Action117)> */
+		nil,
+		/* 94 And <- <(('a' / 'A') ('n' / 'N') ('d' / 'D') ws (('a' / 'A') sep)? Src8 Action118)> */
+		nil,
+		/* 95 Xor <- <(('x' / 'X') ('o' / 'O') ('r' / 'R') ws (('a' / 'A') sep)? Src8 Action119)> */
+		nil,
+		/* 96 Or <- <(('o' / 'O') ('r' / 'R') ws (('a' / 'A') sep)? Src8 Action120)> */
+		nil,
+		/* 97 Cp <- <(('c' / 'C') ('p' / 'P') ws (('a' / 'A') sep)? Src8 Action121)> */
+		nil,
+		/* 98 BitOp <- <(Rot / ((&('S' | 's') Set) | (&('R' | 'r') Res) | (&('B' | 'b') Bit)))> */
+		nil,
+		/* 99 Rot <- <(Rlc / Rrc / Rl / Rr / Sla / Sra / Sll / Srl)> */
+		nil,
+		/* 100 Rlc <- <(('r' / 'R') ('l' / 'L') ('c' / 'C') ws Loc8 (sep Copy8)? Action122)> */
+		nil,
+		/* 101 Rrc <- <(('r' / 'R') ('r' / 'R') ('c' / 'C') ws Loc8 (sep Copy8)? Action123)> */
+		nil,
+		/* 102 Rl <- <(('r' / 'R') ('l' / 'L') ws Loc8 (sep Copy8)? Action124)> */
+		nil,
+		/* 103 Rr <- <(('r' / 'R') ('r' / 'R') ws Loc8 (sep Copy8)? Action125)> */
+		nil,
+		/* 104 Sla <- <(('s' / 'S') ('l' / 'L') ('a' / 'A') ws Loc8 (sep Copy8)? Action126)> */
+		nil,
+		/* 105 Sra <- <(('s' / 'S') ('r' / 'R') ('a' / 'A') ws Loc8 (sep Copy8)? Action127)> */
+		nil,
+		/* 106 Sll <- <(('s' / 'S') ('l' / 'L') ('l' / 'L') ws Loc8 (sep Copy8)? Action128)> */
+		nil,
+		/* 107 Srl <- <(('s' / 'S') ('r' / 'R') ('l' / 'L') ws Loc8 (sep Copy8)? Action129)> */
+		nil,
+		/* 108 Bit <- <(('b' / 'B') ('i' / 'I') ('t' / 'T') ws octaldigit sep Loc8 Action130)> */
+		nil,
+		/* 109 Res <- <(('r' / 'R') ('e' / 'E') ('s' / 'S') ws octaldigit sep Loc8 (sep Copy8)? Action131)> */
+		nil,
+		/* 110 Set <- <(('s' / 'S') ('e' / 'E') ('t' / 'T') ws octaldigit sep Loc8 (sep Copy8)? Action132)> */
+		nil,
+		/* 111 Simple <- <(Rlca / Rrca / Rla / Daa / Cpl / Exx / ((&('E' | 'e') Ei) | (&('D' | 'd') Di) | (&('C' | 'c') Ccf) | (&('S' | 's') Scf) | (&('R' | 'r') Rra) | (&('H' | 'h') Halt) | (&('N' | 'n') Nop)))> */
+		nil,
+		/* 112 Nop <- <(<(('n' / 'N') ('o' / 'O') ('p' / 'P'))> Action133)> */
+		nil,
+		/* 113 Halt <- <(<(('h' / 'H') ('a' / 'A') ('l' / 'L') ('t' / 'T'))> Action134)> */
+		nil,
+		/* 114 Rlca <- <(<(('r' / 'R') ('l' / 'L') ('c' / 'C') ('a' / 'A'))> Action135)> */
+		nil,
+		/* 115 Rrca <- <(<(('r' / 'R') ('r' / 'R') ('c' / 'C') ('a' / 'A'))> Action136)> */
+		nil,
+		/* 116 Rla <- <(<(('r' / 'R') ('l' / 'L') ('a' / 'A'))> Action137)> */
+		nil,
+		/* 117 Rra <- <(<(('r' / 'R') ('r' / 'R') ('a' / 'A'))> Action138)> */
+		nil,
+		/* 118 Daa <- <(<(('d' / 'D') ('a' / 'A') ('a' / 'A'))> Action139)> */
+		nil,
+		/* 119 Cpl <- <(<(('c' / 'C') ('p' / 'P') ('l' / 'L'))> Action140)> */
+		nil,
+		/* 120 Scf <- <(<(('s' / 'S') ('c' / 'C') ('f' / 'F'))> Action141)> */
+		nil,
+		/* 121 Ccf <- <(<(('c' / 'C') ('c' / 'C') ('f' / 'F'))> Action142)> */
+		nil,
+		/* 122 Exx <- <(<(('e' / 'E') ('x' / 'X') ('x' / 'X'))> Action143)> */
+		nil,
+		/* 123 Di <- <(<(('d' / 'D') ('i' / 'I'))> Action144)> */
+		nil,
+		/* 124 Ei <- <(<(('e' / 'E') ('i' / 'I'))> Action145)> */
+		nil,
+		/* 125 EDSimple <- <(Retn / Reti / Rrd / Im0 / Im1 / Im2 / ((&('I' | 'O' | 'i' | 'o') BlitIO) | (&('R' | 'r') Rld) | (&('N' | 'n') Neg) | (&('C' | 'L' | 'c' | 'l') Blit)))> */
+		nil,
+		/* 126 Neg <- <(<(('n' / 'N') ('e' / 'E') ('g' / 'G'))> Action146)> */
+		nil,
+		/* 127 Retn <- <(<(('r' / 'R') ('e' / 'E') ('t' / 'T') ('n' / 'N'))> Action147)> */
+		nil,
+		/* 128 Reti <- <(<(('r' / 'R') ('e' / 'E') ('t' / 'T') ('i' / 'I'))> Action148)> */
+		nil,
+		/* 129 Rrd <- <(<(('r' / 'R') ('r' / 'R') ('d' / 'D'))> Action149)> */
+		nil,
+		/* 130 Rld <- <(<(('r' / 'R') ('l' / 'L') ('d' / 'D'))> Action150)> */
+		nil,
+		/* 131 Im0 <- <(<(('i' / 'I') ('m' / 'M') ' ' '0')> Action151)> */
+		nil,
+		/* 132 Im1 <- <(<(('i' / 'I') ('m' / 'M') ' ' '1')> Action152)> */
+		nil,
+		/* 133 Im2 <- <(<(('i' / 'I') ('m' / 'M') ' ' '2')> Action153)> */
+		nil,
+		/* 134 Blit <- <(Ldir / Ldi / Cpir / Cpi / Lddr / Ldd / Cpdr / Cpd)> */
+		nil,
+		/* 135 BlitIO <- <(Inir / Ini / Otir / Outi / Indr / Ind / Otdr / Outd)> */
 		nil,
-		/* 2 Statement <- <(Directive / Instruction)> */
+		/* 136 Ldi <- <(<(('l' / 'L') ('d' / 'D') ('i' / 'I'))> Action154)> */
 		nil,
-		/* 3 Directive <- <(Defb / Defs / ((&('D' | 'd') Defw) | (&('O' | 'o') Org) | (&('a') Aseg) | (&('.' | 't') Title)))> */
+		/* 137 Cpi <- <(<(('c' / 'C') ('p' / 'P') ('i' / 'I'))> Action155)> */
 		nil,
-		/* 4 Title <- <('.'? ('t' 'i' 't' 'l' 'e') ws '\'' (!'\'' .)* '\'')> */
+		/* 138 Ini <- <(<(('i' / 'I') ('n' / 'N') ('i' / 'I'))> Action156)> */
 		nil,
-		/* 5 Aseg <- <('a' 's' 'e' 'g')> */
+		/* 139 Outi <- <(<(('o' / 'O') ('u' / 'U') ('t' / 'T') ('i' / 'I'))> Action157)> */
 		nil,
-		/* 6 Org <- <(('o' / 'O') ('r' / 'R') ('g' / 'G') ws nn Action1)> */
+		/* 140 Ldd <- <(<(('l' / 'L') ('d' / 'D') ('d' / 'D'))> Action158)> */
 		nil,
-		/* 7 Defb <- <(((('d' / 'D') ('e' / 'E') ('f' / 'F') ('b' / 'B')) / (('d' / 'D') ('b' / 'B'))) ws n Action2)> */
+		/* 141 Cpd <- <(<(('c' / 'C') ('p' / 'P') ('d' / 'D'))> Action159)> */
 		nil,
-		/* 8 Defw <- <(((('d' / 'D') ('e' / 'E') ('f' / 'F') ('w' / 'W')) / (('d' / 'D') ('w' / 'W'))) ws nn Action3)> */
+		/* 142 Ind <- <(<(('i' / 'I') ('n' / 'N') ('d' / 'D'))> Action160)> */
 		nil,
-		/* 9 Defs <- <(((('d' / 'D') ('e' / 'E') ('f' / 'F') ('s' / 'S')) / (('d' / 'D') ('s' / 'S'))) ws n Action4)> */
+		/* 143 Outd <- <(<(('o' / 'O') ('u' / 'U') ('t' / 'T') ('d' / 'D'))> Action161)> */
 		nil,
-		/* 10 LabelDefn <- <(LabelText ':' ws? Action5)> */
+		/* 144 Ldir <- <(<(('l' / 'L') ('d' / 'D') ('i' / 'I') ('r' / 'R'))> Action162)> */
 		nil,
-		/* 11 LabelText <- <<(alphaund alphaundnum alphaundnum+)>> */
+		/* 145 Cpir <- <(<(('c' / 'C') ('p' / 'P') ('i' / 'I') ('r' / 'R'))> Action163)> */
+		nil,
+		/* 146 Inir <- <(<(('i' / 'I') ('n' / 'N') ('i' / 'I') ('r' / 'R'))> Action164)> */
+		nil,
+		/* 147 Otir <- <(<(('o' / 'O') ('t' / 'T') ('i' / 'I') ('r' / 'R'))> Action165)> */
+		nil,
+		/* 148 Lddr <- <(<(('l' / 'L') ('d' / 'D') ('d' / 'D') ('r' / 'R'))> Action166)> */
+		nil,
+		/* 149 Cpdr <- <(<(('c' / 'C') ('p' / 'P') ('d' / 'D') ('r' / 'R'))> Action167)> */
+		nil,
+		/* 150 Indr <- <(<(('i' / 'I') ('n' / 'N') ('d' / 'D') ('r' / 'R'))> Action168)> */
+		nil,
+		/* 151 Otdr <- <(<(('o' / 'O') ('t' / 'T') ('d' / 'D') ('r' / 'R'))> Action169)> */
+		nil,
+		/* 152 Jump <- <(Rst / Jp / ((&('D' | 'd') Djnz) | (&('J' | 'j') Jr) | (&('R' | 'r') Ret) | (&('C' | 'c') Call)))> */
+		nil,
+		/* 153 Rst <- <(('r' / 'R') ('s' / 'S') ('t' / 'T') ws n Action170)> */
+		nil,
+		/* 154 Call <- <(('c' / 'C') ('a' / 'A') ('l' / 'L') ('l' / 'L') ws (cc sep)? Src16 Action171)> */
+		nil,
+		/* 155 Ret <- <(('r' / 'R') ('e' / 'E') ('t' / 'T') (ws cc)? Action172)> */
+		nil,
+		/* 156 Jp <- <((('j' / 'J') ('p' / 'P') ws JpIndirect Action173) / (('j' / 'J') ('p' / 'P') ws (cc sep)? Src16 Action174))> */
+		nil,
+		/* 157 Jr <- <(('j' / 'J') ('r' / 'R') ws (cc sep)? ((disp &opEnd) / JrTarget) Action175)> */
+		nil,
+		/* 158 Djnz <- <(('d' / 'D') ('j' / 'J') ('n' / 'N') ('z' / 'Z') ws ((disp &opEnd) / JrTarget) Action176)> */
+		nil,
+		/* 159 JpIndirect <- <('(' ws? JpReg ws? ')' Action177)> */
+		nil,
+		/* 160 JpReg <- <(<(HL / IX / IY)> !alphaundnum Action178)> */
+		nil,
+		/* 161 JrTarget <- <(expr Action179)> */
 		func() bool {
-			position1823, tokenIndex1823 := position, tokenIndex
+			position2005, tokenIndex2005 := position, tokenIndex
 			{
-				position1824 := position
+				position2006 := position
+				if !_rules[ruleexpr]() {
+					goto l2005
+				}
 				{
-					position1825 := position
-					if !_rules[rulealphaund]() {
-						goto l1823
-					}
-					if !_rules[rulealphaundnum]() {
-						goto l1823
-					}
-					if !_rules[rulealphaundnum]() {
-						goto l1823
+					add(ruleAction179, position)
+				}
+				add(ruleJrTarget, position2006)
+			}
+			return true
+		l2005:
+			position, tokenIndex = position2005, tokenIndex2005
+			return false
+		},
+		/* 162 IO <- <(IN / OUT)> */
+		nil,
+		/* 163 IN <- <(('i' / 'I') ('n' / 'N') ws Reg8 sep Port Action180)> */
+		nil,
+		/* 164 OUT <- <(('o' / 'O') ('u' / 'U') ('t' / 'T') ws Port sep Reg8 Action181)> */
+		nil,
+		/* 165 Port <- <(('(' ('c' / 'C') ')') / ('(' n ')'))> */
+		func() bool {
+			position2011, tokenIndex2011 := position, tokenIndex
+			{
+				position2012 := position
+				{
+					position2013, tokenIndex2013 := position, tokenIndex
+					if buffer[position] != rune('(') {
+						goto l2014
 					}
-				l1826:
+					position++
 					{
-						position1827, tokenIndex1827 := position, tokenIndex
-						if !_rules[rulealphaundnum]() {
-							goto l1827
+						position2015, tokenIndex2015 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l2016
+						}
+						position++
+						goto l2015
+					l2016:
+						position, tokenIndex = position2015, tokenIndex2015
+						if buffer[position] != rune('C') {
+							goto l2014
 						}
-						goto l1826
-					l1827:
-						position, tokenIndex = position1827, tokenIndex1827
+						position++
+					}
+				l2015:
+					if buffer[position] != rune(')') {
+						goto l2014
+					}
+					position++
+					goto l2013
+				l2014:
+					position, tokenIndex = position2013, tokenIndex2013
+					if buffer[position] != rune('(') {
+						goto l2011
+					}
+					position++
+					if !_rules[rulen]() {
+						goto l2011
+					}
+					if buffer[position] != rune(')') {
+						goto l2011
 					}
-					add(rulePegText, position1825)
+					position++
 				}
-				add(ruleLabelText, position1824)
+			l2013:
+				add(rulePort, position2012)
 			}
 			return true
-		l1823:
-			position, tokenIndex = position1823, tokenIndex1823
+		l2011:
+			position, tokenIndex = position2011, tokenIndex2011
 			return false
 		},
-		/* 12 alphaundnum <- <(alphaund / num)> */
+		/* 166 sep <- <(ws? ',' ws?)> */
 		func() bool {
-			position1828, tokenIndex1828 := position, tokenIndex
+			position2017, tokenIndex2017 := position, tokenIndex
 			{
-				position1829 := position
+				position2018 := position
 				{
-					position1830, tokenIndex1830 := position, tokenIndex
-					if !_rules[rulealphaund]() {
-						goto l1831
+					position2019, tokenIndex2019 := position, tokenIndex
+					if !_rules[rulews]() {
+						goto l2019
 					}
-					goto l1830
-				l1831:
-					position, tokenIndex = position1830, tokenIndex1830
-					{
-						position1832 := position
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1828
-						}
-						position++
-						add(rulenum, position1832)
+					goto l2020
+				l2019:
+					position, tokenIndex = position2019, tokenIndex2019
+				}
+			l2020:
+				if buffer[position] != rune(',') {
+					goto l2017
+				}
+				position++
+				{
+					position2021, tokenIndex2021 := position, tokenIndex
+					if !_rules[rulews]() {
+						goto l2021
 					}
+					goto l2022
+				l2021:
+					position, tokenIndex = position2021, tokenIndex2021
 				}
-			l1830:
-				add(rulealphaundnum, position1829)
+			l2022:
+				add(rulesep, position2018)
 			}
 			return true
-		l1828:
-			position, tokenIndex = position1828, tokenIndex1828
+		l2017:
+			position, tokenIndex = position2017, tokenIndex2017
 			return false
 		},
-		/* 13 alphaund <- <((&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))> */
+		/* 167 ws <- <(' ' / '\t')+> */
 		func() bool {
-			position1833, tokenIndex1833 := position, tokenIndex
+			position2023, tokenIndex2023 := position, tokenIndex
 			{
-				position1834 := position
+				position2024 := position
 				{
-					switch buffer[position] {
-					case '_':
-						if buffer[position] != rune('_') {
-							goto l1833
-						}
-						position++
-						break
-					case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
-						if c := buffer[position]; c < rune('A') || c > rune('Z') {
-							goto l1833
+					position2027, tokenIndex2027 := position, tokenIndex
+					if buffer[position] != rune(' ') {
+						goto l2028
+					}
+					position++
+					goto l2027
+				l2028:
+					position, tokenIndex = position2027, tokenIndex2027
+					if buffer[position] != rune('\t') {
+						goto l2023
+					}
+					position++
+				}
+			l2027:
+			l2025:
+				{
+					position2026, tokenIndex2026 := position, tokenIndex
+					{
+						position2029, tokenIndex2029 := position, tokenIndex
+						if buffer[position] != rune(' ') {
+							goto l2030
 						}
 						position++
-						break
-					default:
-						if c := buffer[position]; c < rune('a') || c > rune('z') {
-							goto l1833
+						goto l2029
+					l2030:
+						position, tokenIndex = position2029, tokenIndex2029
+						if buffer[position] != rune('\t') {
+							goto l2026
 						}
 						position++
-						break
 					}
+				l2029:
+					goto l2025
+				l2026:
+					position, tokenIndex = position2026, tokenIndex2026
 				}
-
-				add(rulealphaund, position1834)
+				add(rulews, position2024)
 			}
 			return true
-		l1833:
-			position, tokenIndex = position1833, tokenIndex1833
+		l2023:
+			position, tokenIndex = position2023, tokenIndex2023
 			return false
 		},
-		/* 14 num <- <[0-9]> */
-		nil,
-		/* 15 Comment <- <((';' / '#') (!'\n' .)*)> */
-		nil,
-		/* 16 Instruction <- <(Assignment / Inc / Dec / Alu16 / Alu / BitOp / EDSimple / Simple / Jump / IO)> */
-		nil,
-		/* 17 Assignment <- <(Push / ((&('E' | 'e') Ex) | (&('P' | 'p') Pop) | (&('L' | 'l') Load)))> */
-		nil,
-		/* 18 Load <- <(Load16 / Load8)> */
-		nil,
-		/* 19 Load8 <- <(('l' / 'L') ('d' / 'D') ws Dst8 sep Src8 Action6)> */
-		nil,
-		/* 20 Load16 <- <(('l' / 'L') ('d' / 'D') ws Dst16 sep Src16 Action7)> */
-		nil,
-		/* 21 Push <- <(('p' / 'P') ('u' / 'U') ('s' / 'S') ('h' / 'H') ws Src16 Action8)> */
-		nil,
-		/* 22 Pop <- <(('p' / 'P') ('o' / 'O') ('p' / 'P') ws Dst16 Action9)> */
-		nil,
-		/* 23 Ex <- <(('e' / 'E') ('x' / 'X') ws Dst16 sep Src16 Action10)> */
-		nil,
-		/* 24 Inc <- <(Inc16Indexed8 / Inc16 / Inc8)> */
-		nil,
-		/* 25 Inc16Indexed8 <- <(('i' / 'I') ('n' / 'N') ('c' / 'C') ws ILoc8 Action11)> */
-		nil,
-		/* 26 Inc8 <- <(('i' / 'I') ('n' / 'N') ('c' / 'C') ws Loc8 Action12)> */
-		nil,
-		/* 27 Inc16 <- <(('i' / 'I') ('n' / 'N') ('c' / 'C') ws Loc16 Action13)> */
-		nil,
-		/* 28 Dec <- <(Dec16Indexed8 / Dec16 / Dec8)> */
-		nil,
-		/* 29 Dec16Indexed8 <- <(('d' / 'D') ('e' / 'E') ('c' / 'C') ws ILoc8 Action14)> */
-		nil,
-		/* 30 Dec8 <- <(('d' / 'D') ('e' / 'E') ('c' / 'C') ws Loc8 Action15)> */
-		nil,
-		/* 31 Dec16 <- <(('d' / 'D') ('e' / 'E') ('c' / 'C') ws Loc16 Action16)> */
-		nil,
-		/* 32 Alu16 <- <(Add16 / Adc16 / Sbc16)> */
-		nil,
-		/* 33 Add16 <- <(('a' / 'A') ('d' / 'D') ('d' / 'D') ws Dst16 sep Src16 Action17)> */
-		nil,
-		/* 34 Adc16 <- <(('a' / 'A') ('d' / 'D') ('c' / 'C') ws Dst16 sep Src16 Action18)> */
-		nil,
-		/* 35 Sbc16 <- <(('s' / 'S') ('b' / 'B') ('c' / 'C') ws Dst16 sep Src16 Action19)> */
-		nil,
-		/* 36 Dst8 <- <((Reg8 / Reg16Contents / nn_contents) Action20)> */
-		nil,
-		/* 37 Src8 <- <((n / Reg8 / Reg16Contents / nn_contents) Action21)> */
+		/* 168 A <- <('a' / 'A')> */
 		func() bool {
-			position1859, tokenIndex1859 := position, tokenIndex
+			position2031, tokenIndex2031 := position, tokenIndex
 			{
-				position1860 := position
+				position2032 := position
 				{
-					position1861, tokenIndex1861 := position, tokenIndex
-					if !_rules[rulen]() {
-						goto l1862
+					position2033, tokenIndex2033 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l2034
 					}
-					goto l1861
-				l1862:
-					position, tokenIndex = position1861, tokenIndex1861
-					if !_rules[ruleReg8]() {
-						goto l1863
+					position++
+					goto l2033
+				l2034:
+					position, tokenIndex = position2033, tokenIndex2033
+					if buffer[position] != rune('A') {
+						goto l2031
 					}
-					goto l1861
-				l1863:
-					position, tokenIndex = position1861, tokenIndex1861
-					if !_rules[ruleReg16Contents]() {
-						goto l1864
+					position++
+				}
+			l2033:
+				add(ruleA, position2032)
+			}
+			return true
+		l2031:
+			position, tokenIndex = position2031, tokenIndex2031
+			return false
+		},
+		/* 169 F <- <('f' / 'F')> */
+		func() bool {
+			position2035, tokenIndex2035 := position, tokenIndex
+			{
+				position2036 := position
+				{
+					position2037, tokenIndex2037 := position, tokenIndex
+					if buffer[position] != rune('f') {
+						goto l2038
 					}
-					goto l1861
-				l1864:
-					position, tokenIndex = position1861, tokenIndex1861
-					if !_rules[rulenn_contents]() {
-						goto l1859
+					position++
+					goto l2037
+				l2038:
+					position, tokenIndex = position2037, tokenIndex2037
+					if buffer[position] != rune('F') {
+						goto l2035
 					}
+					position++
 				}
-			l1861:
-				{
-					add(ruleAction21, position)
-				}
-				add(ruleSrc8, position1860)
+			l2037:
+				add(ruleF, position2036)
 			}
 			return true
-		l1859:
-			position, tokenIndex = position1859, tokenIndex1859
+		l2035:
+			position, tokenIndex = position2035, tokenIndex2035
 			return false
 		},
-		/* 38 Loc8 <- <((Reg8 / Reg16Contents) Action22)> */
+		/* 170 B <- <('b' / 'B')> */
 		func() bool {
-			position1866, tokenIndex1866 := position, tokenIndex
+			position2039, tokenIndex2039 := position, tokenIndex
 			{
-				position1867 := position
+				position2040 := position
 				{
-					position1868, tokenIndex1868 := position, tokenIndex
-					if !_rules[ruleReg8]() {
-						goto l1869
+					position2041, tokenIndex2041 := position, tokenIndex
+					if buffer[position] != rune('b') {
+						goto l2042
 					}
-					goto l1868
-				l1869:
-					position, tokenIndex = position1868, tokenIndex1868
-					if !_rules[ruleReg16Contents]() {
-						goto l1866
+					position++
+					goto l2041
+				l2042:
+					position, tokenIndex = position2041, tokenIndex2041
+					if buffer[position] != rune('B') {
+						goto l2039
 					}
+					position++
 				}
-			l1868:
-				{
-					add(ruleAction22, position)
-				}
-				add(ruleLoc8, position1867)
+			l2041:
+				add(ruleB, position2040)
 			}
 			return true
-		l1866:
-			position, tokenIndex = position1866, tokenIndex1866
+		l2039:
+			position, tokenIndex = position2039, tokenIndex2039
 			return false
 		},
-		/* 39 Copy8 <- <(Reg8 Action23)> */
+		/* 171 C <- <('c' / 'C')> */
 		func() bool {
-			position1871, tokenIndex1871 := position, tokenIndex
+			position2043, tokenIndex2043 := position, tokenIndex
 			{
-				position1872 := position
-				if !_rules[ruleReg8]() {
-					goto l1871
-				}
+				position2044 := position
 				{
-					add(ruleAction23, position)
+					position2045, tokenIndex2045 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l2046
+					}
+					position++
+					goto l2045
+				l2046:
+					position, tokenIndex = position2045, tokenIndex2045
+					if buffer[position] != rune('C') {
+						goto l2043
+					}
+					position++
 				}
-				add(ruleCopy8, position1872)
+			l2045:
+				add(ruleC, position2044)
 			}
 			return true
-		l1871:
-			position, tokenIndex = position1871, tokenIndex1871
+		l2043:
+			position, tokenIndex = position2043, tokenIndex2043
 			return false
 		},
-		/* 40 ILoc8 <- <(IReg8 Action24)> */
+		/* 172 D <- <('d' / 'D')> */
 		func() bool {
-			position1874, tokenIndex1874 := position, tokenIndex
+			position2047, tokenIndex2047 := position, tokenIndex
 			{
-				position1875 := position
-				if !_rules[ruleIReg8]() {
-					goto l1874
-				}
+				position2048 := position
 				{
-					add(ruleAction24, position)
+					position2049, tokenIndex2049 := position, tokenIndex
+					if buffer[position] != rune('d') {
+						goto l2050
+					}
+					position++
+					goto l2049
+				l2050:
+					position, tokenIndex = position2049, tokenIndex2049
+					if buffer[position] != rune('D') {
+						goto l2047
+					}
+					position++
 				}
-				add(ruleILoc8, position1875)
+			l2049:
+				add(ruleD, position2048)
 			}
 			return true
-		l1874:
-			position, tokenIndex = position1874, tokenIndex1874
+		l2047:
+			position, tokenIndex = position2047, tokenIndex2047
 			return false
 		},
-		/* 41 Reg8 <- <(<(IReg8 / ((&('R' | 'r') R) | (&('I' | 'i') I) | (&('L' | 'l') L) | (&('H' | 'h') H) | (&('E' | 'e') E) | (&('D' | 'd') D) | (&('C' | 'c') C) | (&('B' | 'b') B) | (&('F' | 'f') F) | (&('A' | 'a') A)))> Action25)> */
+		/* 173 E <- <('e' / 'E')> */
 		func() bool {
-			position1877, tokenIndex1877 := position, tokenIndex
+			position2051, tokenIndex2051 := position, tokenIndex
 			{
-				position1878 := position
+				position2052 := position
 				{
-					position1879 := position
-					{
-						position1880, tokenIndex1880 := position, tokenIndex
-						if !_rules[ruleIReg8]() {
-							goto l1881
-						}
-						goto l1880
-					l1881:
-						position, tokenIndex = position1880, tokenIndex1880
-						{
-							switch buffer[position] {
-							case 'R', 'r':
-								{
-									position1883 := position
-									{
-										position1884, tokenIndex1884 := position, tokenIndex
-										if buffer[position] != rune('r') {
-											goto l1885
-										}
-										position++
-										goto l1884
-									l1885:
-										position, tokenIndex = position1884, tokenIndex1884
-										if buffer[position] != rune('R') {
-											goto l1877
-										}
-										position++
-									}
-								l1884:
-									add(ruleR, position1883)
-								}
-								break
-							case 'I', 'i':
-								{
-									position1886 := position
-									{
-										position1887, tokenIndex1887 := position, tokenIndex
-										if buffer[position] != rune('i') {
-											goto l1888
-										}
-										position++
-										goto l1887
-									l1888:
-										position, tokenIndex = position1887, tokenIndex1887
-										if buffer[position] != rune('I') {
-											goto l1877
-										}
-										position++
-									}
-								l1887:
-									add(ruleI, position1886)
-								}
-								break
-							case 'L', 'l':
-								{
-									position1889 := position
-									{
-										position1890, tokenIndex1890 := position, tokenIndex
-										if buffer[position] != rune('l') {
-											goto l1891
-										}
-										position++
-										goto l1890
-									l1891:
-										position, tokenIndex = position1890, tokenIndex1890
-										if buffer[position] != rune('L') {
-											goto l1877
-										}
-										position++
-									}
-								l1890:
-									add(ruleL, position1889)
-								}
-								break
-							case 'H', 'h':
-								{
-									position1892 := position
-									{
-										position1893, tokenIndex1893 := position, tokenIndex
-										if buffer[position] != rune('h') {
-											goto l1894
-										}
-										position++
-										goto l1893
-									l1894:
-										position, tokenIndex = position1893, tokenIndex1893
-										if buffer[position] != rune('H') {
-											goto l1877
-										}
-										position++
-									}
-								l1893:
-									add(ruleH, position1892)
-								}
-								break
-							case 'E', 'e':
-								{
-									position1895 := position
-									{
-										position1896, tokenIndex1896 := position, tokenIndex
-										if buffer[position] != rune('e') {
-											goto l1897
-										}
-										position++
-										goto l1896
-									l1897:
-										position, tokenIndex = position1896, tokenIndex1896
-										if buffer[position] != rune('E') {
-											goto l1877
-										}
-										position++
-									}
-								l1896:
-									add(ruleE, position1895)
-								}
-								break
-							case 'D', 'd':
-								{
-									position1898 := position
-									{
-										position1899, tokenIndex1899 := position, tokenIndex
-										if buffer[position] != rune('d') {
-											goto l1900
-										}
-										position++
-										goto l1899
-									l1900:
-										position, tokenIndex = position1899, tokenIndex1899
-										if buffer[position] != rune('D') {
-											goto l1877
-										}
-										position++
-									}
-								l1899:
-									add(ruleD, position1898)
-								}
-								break
-							case 'C', 'c':
-								{
-									position1901 := position
-									{
-										position1902, tokenIndex1902 := position, tokenIndex
-										if buffer[position] != rune('c') {
-											goto l1903
-										}
-										position++
-										goto l1902
-									l1903:
-										position, tokenIndex = position1902, tokenIndex1902
-										if buffer[position] != rune('C') {
-											goto l1877
-										}
-										position++
-									}
-								l1902:
-									add(ruleC, position1901)
-								}
-								break
-							case 'B', 'b':
-								{
-									position1904 := position
-									{
-										position1905, tokenIndex1905 := position, tokenIndex
-										if buffer[position] != rune('b') {
-											goto l1906
-										}
-										position++
-										goto l1905
-									l1906:
-										position, tokenIndex = position1905, tokenIndex1905
-										if buffer[position] != rune('B') {
-											goto l1877
-										}
-										position++
-									}
-								l1905:
-									add(ruleB, position1904)
-								}
-								break
-							case 'F', 'f':
-								{
-									position1907 := position
-									{
-										position1908, tokenIndex1908 := position, tokenIndex
-										if buffer[position] != rune('f') {
-											goto l1909
-										}
-										position++
-										goto l1908
-									l1909:
-										position, tokenIndex = position1908, tokenIndex1908
-										if buffer[position] != rune('F') {
-											goto l1877
-										}
-										position++
-									}
-								l1908:
-									add(ruleF, position1907)
-								}
-								break
-							default:
-								{
-									position1910 := position
-									{
-										position1911, tokenIndex1911 := position, tokenIndex
-										if buffer[position] != rune('a') {
-											goto l1912
-										}
-										position++
-										goto l1911
-									l1912:
-										position, tokenIndex = position1911, tokenIndex1911
-										if buffer[position] != rune('A') {
-											goto l1877
-										}
-										position++
-									}
-								l1911:
-									add(ruleA, position1910)
-								}
-								break
-							}
-						}
-
+					position2053, tokenIndex2053 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l2054
 					}
-				l1880:
-					add(rulePegText, position1879)
-				}
-				{
-					add(ruleAction25, position)
+					position++
+					goto l2053
+				l2054:
+					position, tokenIndex = position2053, tokenIndex2053
+					if buffer[position] != rune('E') {
+						goto l2051
+					}
+					position++
 				}
-				add(ruleReg8, position1878)
+			l2053:
+				add(ruleE, position2052)
 			}
 			return true
-		l1877:
-			position, tokenIndex = position1877, tokenIndex1877
+		l2051:
+			position, tokenIndex = position2051, tokenIndex2051
 			return false
 		},
-		/* 42 IReg8 <- <(<(IXH / IXL / IYH / IYL)> Action26)> */
+		/* 174 H <- <('h' / 'H')> */
 		func() bool {
-			position1914, tokenIndex1914 := position, tokenIndex
+			position2055, tokenIndex2055 := position, tokenIndex
 			{
-				position1915 := position
+				position2056 := position
 				{
-					position1916 := position
-					{
-						position1917, tokenIndex1917 := position, tokenIndex
-						{
-							position1919 := position
-							{
-								position1920, tokenIndex1920 := position, tokenIndex
-								if buffer[position] != rune('i') {
-									goto l1921
-								}
-								position++
-								goto l1920
-							l1921:
-								position, tokenIndex = position1920, tokenIndex1920
-								if buffer[position] != rune('I') {
-									goto l1918
-								}
-								position++
-							}
-						l1920:
-							{
-								position1922, tokenIndex1922 := position, tokenIndex
-								if buffer[position] != rune('x') {
-									goto l1923
-								}
-								position++
-								goto l1922
-							l1923:
-								position, tokenIndex = position1922, tokenIndex1922
-								if buffer[position] != rune('X') {
-									goto l1918
-								}
-								position++
-							}
-						l1922:
-							{
-								position1924, tokenIndex1924 := position, tokenIndex
-								if buffer[position] != rune('h') {
-									goto l1925
-								}
-								position++
-								goto l1924
-							l1925:
-								position, tokenIndex = position1924, tokenIndex1924
-								if buffer[position] != rune('H') {
-									goto l1918
-								}
-								position++
-							}
-						l1924:
-							add(ruleIXH, position1919)
-						}
-						goto l1917
-					l1918:
-						position, tokenIndex = position1917, tokenIndex1917
-						{
-							position1927 := position
-							{
-								position1928, tokenIndex1928 := position, tokenIndex
-								if buffer[position] != rune('i') {
-									goto l1929
-								}
-								position++
-								goto l1928
-							l1929:
-								position, tokenIndex = position1928, tokenIndex1928
-								if buffer[position] != rune('I') {
-									goto l1926
-								}
-								position++
-							}
-						l1928:
-							{
-								position1930, tokenIndex1930 := position, tokenIndex
-								if buffer[position] != rune('x') {
-									goto l1931
-								}
-								position++
-								goto l1930
-							l1931:
-								position, tokenIndex = position1930, tokenIndex1930
-								if buffer[position] != rune('X') {
-									goto l1926
-								}
-								position++
-							}
-						l1930:
-							{
-								position1932, tokenIndex1932 := position, tokenIndex
-								if buffer[position] != rune('l') {
-									goto l1933
-								}
-								position++
-								goto l1932
-							l1933:
-								position, tokenIndex = position1932, tokenIndex1932
-								if buffer[position] != rune('L') {
-									goto l1926
-								}
-								position++
-							}
-						l1932:
-							add(ruleIXL, position1927)
-						}
-						goto l1917
-					l1926:
-						position, tokenIndex = position1917, tokenIndex1917
-						{
-							position1935 := position
-							{
-								position1936, tokenIndex1936 := position, tokenIndex
-								if buffer[position] != rune('i') {
-									goto l1937
-								}
-								position++
-								goto l1936
-							l1937:
-								position, tokenIndex = position1936, tokenIndex1936
-								if buffer[position] != rune('I') {
-									goto l1934
-								}
-								position++
-							}
-						l1936:
-							{
-								position1938, tokenIndex1938 := position, tokenIndex
-								if buffer[position] != rune('y') {
-									goto l1939
-								}
-								position++
-								goto l1938
-							l1939:
-								position, tokenIndex = position1938, tokenIndex1938
-								if buffer[position] != rune('Y') {
-									goto l1934
-								}
-								position++
-							}
-						l1938:
-							{
-								position1940, tokenIndex1940 := position, tokenIndex
-								if buffer[position] != rune('h') {
-									goto l1941
-								}
-								position++
-								goto l1940
-							l1941:
-								position, tokenIndex = position1940, tokenIndex1940
-								if buffer[position] != rune('H') {
-									goto l1934
-								}
-								position++
-							}
-						l1940:
-							add(ruleIYH, position1935)
-						}
-						goto l1917
-					l1934:
-						position, tokenIndex = position1917, tokenIndex1917
-						{
-							position1942 := position
-							{
-								position1943, tokenIndex1943 := position, tokenIndex
-								if buffer[position] != rune('i') {
-									goto l1944
-								}
-								position++
-								goto l1943
-							l1944:
-								position, tokenIndex = position1943, tokenIndex1943
-								if buffer[position] != rune('I') {
-									goto l1914
-								}
-								position++
-							}
-						l1943:
-							{
-								position1945, tokenIndex1945 := position, tokenIndex
-								if buffer[position] != rune('y') {
-									goto l1946
-								}
-								position++
-								goto l1945
-							l1946:
-								position, tokenIndex = position1945, tokenIndex1945
-								if buffer[position] != rune('Y') {
-									goto l1914
-								}
-								position++
-							}
-						l1945:
-							{
-								position1947, tokenIndex1947 := position, tokenIndex
-								if buffer[position] != rune('l') {
-									goto l1948
-								}
-								position++
-								goto l1947
-							l1948:
-								position, tokenIndex = position1947, tokenIndex1947
-								if buffer[position] != rune('L') {
-									goto l1914
-								}
-								position++
-							}
-						l1947:
-							add(ruleIYL, position1942)
-						}
+					position2057, tokenIndex2057 := position, tokenIndex
+					if buffer[position] != rune('h') {
+						goto l2058
 					}
-				l1917:
-					add(rulePegText, position1916)
-				}
-				{
-					add(ruleAction26, position)
+					position++
+					goto l2057
+				l2058:
+					position, tokenIndex = position2057, tokenIndex2057
+					if buffer[position] != rune('H') {
+						goto l2055
+					}
+					position++
 				}
-				add(ruleIReg8, position1915)
+			l2057:
+				add(ruleH, position2056)
 			}
 			return true
-		l1914:
-			position, tokenIndex = position1914, tokenIndex1914
+		l2055:
+			position, tokenIndex = position2055, tokenIndex2055
 			return false
 		},
-		/* 43 Dst16 <- <((Reg16 / nn_contents / Reg16Contents) Action27)> */
+		/* 175 L <- <('l' / 'L')> */
 		func() bool {
-			position1950, tokenIndex1950 := position, tokenIndex
+			position2059, tokenIndex2059 := position, tokenIndex
 			{
-				position1951 := position
+				position2060 := position
 				{
-					position1952, tokenIndex1952 := position, tokenIndex
-					if !_rules[ruleReg16]() {
-						goto l1953
-					}
-					goto l1952
-				l1953:
-					position, tokenIndex = position1952, tokenIndex1952
-					if !_rules[rulenn_contents]() {
-						goto l1954
+					position2061, tokenIndex2061 := position, tokenIndex
+					if buffer[position] != rune('l') {
+						goto l2062
 					}
-					goto l1952
-				l1954:
-					position, tokenIndex = position1952, tokenIndex1952
-					if !_rules[ruleReg16Contents]() {
-						goto l1950
+					position++
+					goto l2061
+				l2062:
+					position, tokenIndex = position2061, tokenIndex2061
+					if buffer[position] != rune('L') {
+						goto l2059
 					}
+					position++
 				}
-			l1952:
-				{
-					add(ruleAction27, position)
-				}
-				add(ruleDst16, position1951)
+			l2061:
+				add(ruleL, position2060)
 			}
 			return true
-		l1950:
-			position, tokenIndex = position1950, tokenIndex1950
+		l2059:
+			position, tokenIndex = position2059, tokenIndex2059
 			return false
 		},
-		/* 44 Src16 <- <((Reg16 / nn / nn_contents) Action28)> */
+		/* 176 IXH <- <(('i' / 'I') ('x' / 'X') ('h' / 'H'))> */
 		func() bool {
-			position1956, tokenIndex1956 := position, tokenIndex
+			position2063, tokenIndex2063 := position, tokenIndex
 			{
-				position1957 := position
+				position2064 := position
 				{
-					position1958, tokenIndex1958 := position, tokenIndex
-					if !_rules[ruleReg16]() {
-						goto l1959
+					position2065, tokenIndex2065 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l2066
 					}
-					goto l1958
-				l1959:
-					position, tokenIndex = position1958, tokenIndex1958
-					if !_rules[rulenn]() {
-						goto l1960
+					position++
+					goto l2065
+				l2066:
+					position, tokenIndex = position2065, tokenIndex2065
+					if buffer[position] != rune('I') {
+						goto l2063
 					}
-					goto l1958
-				l1960:
-					position, tokenIndex = position1958, tokenIndex1958
-					if !_rules[rulenn_contents]() {
-						goto l1956
+					position++
+				}
+			l2065:
+				{
+					position2067, tokenIndex2067 := position, tokenIndex
+					if buffer[position] != rune('x') {
+						goto l2068
+					}
+					position++
+					goto l2067
+				l2068:
+					position, tokenIndex = position2067, tokenIndex2067
+					if buffer[position] != rune('X') {
+						goto l2063
 					}
+					position++
 				}
-			l1958:
+			l2067:
 				{
-					add(ruleAction28, position)
+					position2069, tokenIndex2069 := position, tokenIndex
+					if buffer[position] != rune('h') {
+						goto l2070
+					}
+					position++
+					goto l2069
+				l2070:
+					position, tokenIndex = position2069, tokenIndex2069
+					if buffer[position] != rune('H') {
+						goto l2063
+					}
+					position++
 				}
-				add(ruleSrc16, position1957)
+			l2069:
+				add(ruleIXH, position2064)
 			}
 			return true
-		l1956:
-			position, tokenIndex = position1956, tokenIndex1956
+		l2063:
+			position, tokenIndex = position2063, tokenIndex2063
 			return false
 		},
-		/* 45 Loc16 <- <(Reg16 Action29)> */
+		/* 177 IXL <- <(('i' / 'I') ('x' / 'X') ('l' / 'L'))> */
 		func() bool {
-			position1962, tokenIndex1962 := position, tokenIndex
+			position2071, tokenIndex2071 := position, tokenIndex
 			{
-				position1963 := position
-				if !_rules[ruleReg16]() {
-					goto l1962
-				}
+				position2072 := position
 				{
-					add(ruleAction29, position)
+					position2073, tokenIndex2073 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l2074
+					}
+					position++
+					goto l2073
+				l2074:
+					position, tokenIndex = position2073, tokenIndex2073
+					if buffer[position] != rune('I') {
+						goto l2071
+					}
+					position++
 				}
-				add(ruleLoc16, position1963)
-			}
-			return true
-		l1962:
-			position, tokenIndex = position1962, tokenIndex1962
-			return false
-		},
-		/* 46 Reg16 <- <(<(AF_PRIME / ((&('I' | 'i') IReg16) | (&('S' | 's') SP) | (&('H' | 'h') HL) | (&('D' | 'd') DE) | (&('B' | 'b') BC) | (&('A' | 'a') AF)))> Action30)> */
-		func() bool {
-			position1965, tokenIndex1965 := position, tokenIndex
-			{
-				position1966 := position
+			l2073:
 				{
-					position1967 := position
-					{
-						position1968, tokenIndex1968 := position, tokenIndex
-						{
-							position1970 := position
-							{
-								position1971, tokenIndex1971 := position, tokenIndex
-								if buffer[position] != rune('a') {
-									goto l1972
-								}
-								position++
-								goto l1971
-							l1972:
-								position, tokenIndex = position1971, tokenIndex1971
-								if buffer[position] != rune('A') {
-									goto l1969
-								}
-								position++
-							}
-						l1971:
-							{
-								position1973, tokenIndex1973 := position, tokenIndex
-								if buffer[position] != rune('f') {
-									goto l1974
-								}
-								position++
-								goto l1973
-							l1974:
-								position, tokenIndex = position1973, tokenIndex1973
-								if buffer[position] != rune('F') {
-									goto l1969
-								}
-								position++
-							}
-						l1973:
-							if buffer[position] != rune('\'') {
-								goto l1969
-							}
-							position++
-							add(ruleAF_PRIME, position1970)
-						}
-						goto l1968
-					l1969:
-						position, tokenIndex = position1968, tokenIndex1968
-						{
-							switch buffer[position] {
-							case 'I', 'i':
-								if !_rules[ruleIReg16]() {
-									goto l1965
-								}
-								break
-							case 'S', 's':
-								{
-									position1976 := position
-									{
-										position1977, tokenIndex1977 := position, tokenIndex
-										if buffer[position] != rune('s') {
-											goto l1978
-										}
-										position++
-										goto l1977
-									l1978:
-										position, tokenIndex = position1977, tokenIndex1977
-										if buffer[position] != rune('S') {
-											goto l1965
-										}
-										position++
-									}
-								l1977:
-									{
-										position1979, tokenIndex1979 := position, tokenIndex
-										if buffer[position] != rune('p') {
-											goto l1980
-										}
-										position++
-										goto l1979
-									l1980:
-										position, tokenIndex = position1979, tokenIndex1979
-										if buffer[position] != rune('P') {
-											goto l1965
-										}
-										position++
-									}
-								l1979:
-									add(ruleSP, position1976)
-								}
-								break
-							case 'H', 'h':
-								{
-									position1981 := position
-									{
-										position1982, tokenIndex1982 := position, tokenIndex
-										if buffer[position] != rune('h') {
-											goto l1983
-										}
-										position++
-										goto l1982
-									l1983:
-										position, tokenIndex = position1982, tokenIndex1982
-										if buffer[position] != rune('H') {
-											goto l1965
-										}
-										position++
-									}
-								l1982:
-									{
-										position1984, tokenIndex1984 := position, tokenIndex
-										if buffer[position] != rune('l') {
-											goto l1985
-										}
-										position++
-										goto l1984
-									l1985:
-										position, tokenIndex = position1984, tokenIndex1984
-										if buffer[position] != rune('L') {
-											goto l1965
-										}
-										position++
-									}
-								l1984:
-									add(ruleHL, position1981)
-								}
-								break
-							case 'D', 'd':
-								{
-									position1986 := position
-									{
-										position1987, tokenIndex1987 := position, tokenIndex
-										if buffer[position] != rune('d') {
-											goto l1988
-										}
-										position++
-										goto l1987
-									l1988:
-										position, tokenIndex = position1987, tokenIndex1987
-										if buffer[position] != rune('D') {
-											goto l1965
-										}
-										position++
-									}
-								l1987:
-									{
-										position1989, tokenIndex1989 := position, tokenIndex
-										if buffer[position] != rune('e') {
-											goto l1990
-										}
-										position++
-										goto l1989
-									l1990:
-										position, tokenIndex = position1989, tokenIndex1989
-										if buffer[position] != rune('E') {
-											goto l1965
-										}
-										position++
-									}
-								l1989:
-									add(ruleDE, position1986)
-								}
-								break
-							case 'B', 'b':
-								{
-									position1991 := position
-									{
-										position1992, tokenIndex1992 := position, tokenIndex
-										if buffer[position] != rune('b') {
-											goto l1993
-										}
-										position++
-										goto l1992
-									l1993:
-										position, tokenIndex = position1992, tokenIndex1992
-										if buffer[position] != rune('B') {
-											goto l1965
-										}
-										position++
-									}
-								l1992:
-									{
-										position1994, tokenIndex1994 := position, tokenIndex
-										if buffer[position] != rune('c') {
-											goto l1995
-										}
-										position++
-										goto l1994
-									l1995:
-										position, tokenIndex = position1994, tokenIndex1994
-										if buffer[position] != rune('C') {
-											goto l1965
-										}
-										position++
-									}
-								l1994:
-									add(ruleBC, position1991)
-								}
-								break
-							default:
-								{
-									position1996 := position
-									{
-										position1997, tokenIndex1997 := position, tokenIndex
-										if buffer[position] != rune('a') {
-											goto l1998
-										}
-										position++
-										goto l1997
-									l1998:
-										position, tokenIndex = position1997, tokenIndex1997
-										if buffer[position] != rune('A') {
-											goto l1965
-										}
-										position++
-									}
-								l1997:
-									{
-										position1999, tokenIndex1999 := position, tokenIndex
-										if buffer[position] != rune('f') {
-											goto l2000
-										}
-										position++
-										goto l1999
-									l2000:
-										position, tokenIndex = position1999, tokenIndex1999
-										if buffer[position] != rune('F') {
-											goto l1965
-										}
-										position++
-									}
-								l1999:
-									add(ruleAF, position1996)
-								}
-								break
-							}
-						}
-
+					position2075, tokenIndex2075 := position, tokenIndex
+					if buffer[position] != rune('x') {
+						goto l2076
 					}
-				l1968:
-					add(rulePegText, position1967)
+					position++
+					goto l2075
+				l2076:
+					position, tokenIndex = position2075, tokenIndex2075
+					if buffer[position] != rune('X') {
+						goto l2071
+					}
+					position++
 				}
+			l2075:
 				{
-					add(ruleAction30, position)
+					position2077, tokenIndex2077 := position, tokenIndex
+					if buffer[position] != rune('l') {
+						goto l2078
+					}
+					position++
+					goto l2077
+				l2078:
+					position, tokenIndex = position2077, tokenIndex2077
+					if buffer[position] != rune('L') {
+						goto l2071
+					}
+					position++
 				}
-				add(ruleReg16, position1966)
+			l2077:
+				add(ruleIXL, position2072)
 			}
 			return true
-		l1965:
-			position, tokenIndex = position1965, tokenIndex1965
+		l2071:
+			position, tokenIndex = position2071, tokenIndex2071
 			return false
 		},
-		/* 47 IReg16 <- <(<(IX / IY)> Action31)> */
+		/* 178 IYH <- <(('i' / 'I') ('y' / 'Y') ('h' / 'H'))> */
 		func() bool {
-			position2002, tokenIndex2002 := position, tokenIndex
+			position2079, tokenIndex2079 := position, tokenIndex
 			{
-				position2003 := position
+				position2080 := position
 				{
-					position2004 := position
-					{
-						position2005, tokenIndex2005 := position, tokenIndex
-						{
-							position2007 := position
-							{
-								position2008, tokenIndex2008 := position, tokenIndex
-								if buffer[position] != rune('i') {
-									goto l2009
-								}
-								position++
-								goto l2008
-							l2009:
-								position, tokenIndex = position2008, tokenIndex2008
-								if buffer[position] != rune('I') {
-									goto l2006
-								}
-								position++
-							}
-						l2008:
-							{
-								position2010, tokenIndex2010 := position, tokenIndex
-								if buffer[position] != rune('x') {
-									goto l2011
-								}
-								position++
-								goto l2010
-							l2011:
-								position, tokenIndex = position2010, tokenIndex2010
-								if buffer[position] != rune('X') {
-									goto l2006
-								}
-								position++
-							}
-						l2010:
-							add(ruleIX, position2007)
-						}
-						goto l2005
-					l2006:
-						position, tokenIndex = position2005, tokenIndex2005
-						{
-							position2012 := position
-							{
-								position2013, tokenIndex2013 := position, tokenIndex
-								if buffer[position] != rune('i') {
-									goto l2014
-								}
-								position++
-								goto l2013
-							l2014:
-								position, tokenIndex = position2013, tokenIndex2013
-								if buffer[position] != rune('I') {
-									goto l2002
-								}
-								position++
-							}
-						l2013:
-							{
-								position2015, tokenIndex2015 := position, tokenIndex
-								if buffer[position] != rune('y') {
-									goto l2016
-								}
-								position++
-								goto l2015
-							l2016:
-								position, tokenIndex = position2015, tokenIndex2015
-								if buffer[position] != rune('Y') {
-									goto l2002
-								}
-								position++
-							}
-						l2015:
-							add(ruleIY, position2012)
-						}
+					position2081, tokenIndex2081 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l2082
+					}
+					position++
+					goto l2081
+				l2082:
+					position, tokenIndex = position2081, tokenIndex2081
+					if buffer[position] != rune('I') {
+						goto l2079
 					}
-				l2005:
-					add(rulePegText, position2004)
+					position++
 				}
+			l2081:
 				{
-					add(ruleAction31, position)
+					position2083, tokenIndex2083 := position, tokenIndex
+					if buffer[position] != rune('y') {
+						goto l2084
+					}
+					position++
+					goto l2083
+				l2084:
+					position, tokenIndex = position2083, tokenIndex2083
+					if buffer[position] != rune('Y') {
+						goto l2079
+					}
+					position++
 				}
-				add(ruleIReg16, position2003)
-			}
-			return true
-		l2002:
-			position, tokenIndex = position2002, tokenIndex2002
-			return false
-		},
-		/* 48 Reg16Contents <- <(IndexedR16C / PlainR16C)> */
-		func() bool {
-			position2018, tokenIndex2018 := position, tokenIndex
-			{
-				position2019 := position
+			l2083:
 				{
-					position2020, tokenIndex2020 := position, tokenIndex
-					{
-						position2022 := position
-						if buffer[position] != rune('(') {
-							goto l2021
-						}
-						position++
-						if !_rules[ruleIReg16]() {
-							goto l2021
-						}
-						{
-							position2023, tokenIndex2023 := position, tokenIndex
-							if !_rules[rulews]() {
-								goto l2023
-							}
-							goto l2024
-						l2023:
-							position, tokenIndex = position2023, tokenIndex2023
-						}
-					l2024:
-						if !_rules[ruledisp]() {
-							goto l2021
-						}
-						{
-							position2025, tokenIndex2025 := position, tokenIndex
-							if !_rules[rulews]() {
-								goto l2025
-							}
-							goto l2026
-						l2025:
-							position, tokenIndex = position2025, tokenIndex2025
-						}
-					l2026:
-						if buffer[position] != rune(')') {
-							goto l2021
-						}
-						position++
-						{
-							add(ruleAction33, position)
-						}
-						add(ruleIndexedR16C, position2022)
+					position2085, tokenIndex2085 := position, tokenIndex
+					if buffer[position] != rune('h') {
+						goto l2086
 					}
-					goto l2020
-				l2021:
-					position, tokenIndex = position2020, tokenIndex2020
-					{
-						position2028 := position
-						if buffer[position] != rune('(') {
-							goto l2018
-						}
-						position++
-						if !_rules[ruleReg16]() {
-							goto l2018
-						}
-						if buffer[position] != rune(')') {
-							goto l2018
-						}
-						position++
-						{
-							add(ruleAction32, position)
-						}
-						add(rulePlainR16C, position2028)
+					position++
+					goto l2085
+				l2086:
+					position, tokenIndex = position2085, tokenIndex2085
+					if buffer[position] != rune('H') {
+						goto l2079
 					}
+					position++
 				}
-			l2020:
-				add(ruleReg16Contents, position2019)
+			l2085:
+				add(ruleIYH, position2080)
 			}
 			return true
-		l2018:
-			position, tokenIndex = position2018, tokenIndex2018
+		l2079:
+			position, tokenIndex = position2079, tokenIndex2079
 			return false
 		},
-		/* 49 PlainR16C <- <('(' Reg16 ')' Action32)> */
-		nil,
-		/* 50 IndexedR16C <- <('(' IReg16 ws? disp ws? ')' Action33)> */
-		nil,
-		/* 51 n <- <(hexByteH / hexByte0x / decimalByte)> */
+		/* 179 IYL <- <(('i' / 'I') ('y' / 'Y') ('l' / 'L'))> */
 		func() bool {
-			position2032, tokenIndex2032 := position, tokenIndex
+			position2087, tokenIndex2087 := position, tokenIndex
 			{
-				position2033 := position
+				position2088 := position
 				{
-					position2034, tokenIndex2034 := position, tokenIndex
-					{
-						position2036 := position
-						{
-							position2037 := position
-							if !_rules[rulehexdigit]() {
-								goto l2035
-							}
-							if !_rules[rulehexdigit]() {
-								goto l2035
-							}
-							add(rulePegText, position2037)
-						}
-						{
-							position2038, tokenIndex2038 := position, tokenIndex
-							if buffer[position] != rune('h') {
-								goto l2039
-							}
-							position++
-							goto l2038
-						l2039:
-							position, tokenIndex = position2038, tokenIndex2038
-							if buffer[position] != rune('H') {
-								goto l2035
-							}
-							position++
-						}
-					l2038:
-						{
-							add(ruleAction37, position)
-						}
-						add(rulehexByteH, position2036)
+					position2089, tokenIndex2089 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l2090
 					}
-					goto l2034
-				l2035:
-					position, tokenIndex = position2034, tokenIndex2034
-					{
-						position2042 := position
-						if buffer[position] != rune('0') {
-							goto l2041
-						}
-						position++
-						{
-							position2043, tokenIndex2043 := position, tokenIndex
-							if buffer[position] != rune('x') {
-								goto l2044
-							}
-							position++
-							goto l2043
-						l2044:
-							position, tokenIndex = position2043, tokenIndex2043
-							if buffer[position] != rune('X') {
-								goto l2041
-							}
-							position++
-						}
-					l2043:
-						{
-							position2045 := position
-							if !_rules[rulehexdigit]() {
-								goto l2041
-							}
-							if !_rules[rulehexdigit]() {
-								goto l2041
-							}
-							add(rulePegText, position2045)
-						}
-						{
-							add(ruleAction38, position)
-						}
-						add(rulehexByte0x, position2042)
+					position++
+					goto l2089
+				l2090:
+					position, tokenIndex = position2089, tokenIndex2089
+					if buffer[position] != rune('I') {
+						goto l2087
+					}
+					position++
+				}
+			l2089:
+				{
+					position2091, tokenIndex2091 := position, tokenIndex
+					if buffer[position] != rune('y') {
+						goto l2092
 					}
-					goto l2034
-				l2041:
-					position, tokenIndex = position2034, tokenIndex2034
-					{
-						position2047 := position
-						{
-							position2048 := position
-							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l2032
-							}
-							position++
-						l2049:
-							{
-								position2050, tokenIndex2050 := position, tokenIndex
-								if c := buffer[position]; c < rune('0') || c > rune('9') {
-									goto l2050
-								}
-								position++
-								goto l2049
-							l2050:
-								position, tokenIndex = position2050, tokenIndex2050
-							}
-							add(rulePegText, position2048)
-						}
-						{
-							add(ruleAction39, position)
-						}
-						add(ruledecimalByte, position2047)
+					position++
+					goto l2091
+				l2092:
+					position, tokenIndex = position2091, tokenIndex2091
+					if buffer[position] != rune('Y') {
+						goto l2087
+					}
+					position++
+				}
+			l2091:
+				{
+					position2093, tokenIndex2093 := position, tokenIndex
+					if buffer[position] != rune('l') {
+						goto l2094
+					}
+					position++
+					goto l2093
+				l2094:
+					position, tokenIndex = position2093, tokenIndex2093
+					if buffer[position] != rune('L') {
+						goto l2087
 					}
+					position++
 				}
-			l2034:
-				add(rulen, position2033)
+			l2093:
+				add(ruleIYL, position2088)
 			}
 			return true
-		l2032:
-			position, tokenIndex = position2032, tokenIndex2032
+		l2087:
+			position, tokenIndex = position2087, tokenIndex2087
 			return false
 		},
-		/* 52 nn <- <(LabelNN / hexWordH / hexWord0x)> */
+		/* 180 I <- <('i' / 'I')> */
 		func() bool {
-			position2052, tokenIndex2052 := position, tokenIndex
+			position2095, tokenIndex2095 := position, tokenIndex
 			{
-				position2053 := position
+				position2096 := position
 				{
-					position2054, tokenIndex2054 := position, tokenIndex
-					{
-						position2056 := position
-						{
-							position2057 := position
-							if !_rules[ruleLabelText]() {
-								goto l2055
-							}
-							add(rulePegText, position2057)
-						}
-						{
-							add(ruleAction40, position)
-						}
-						add(ruleLabelNN, position2056)
+					position2097, tokenIndex2097 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l2098
 					}
-					goto l2054
-				l2055:
-					position, tokenIndex = position2054, tokenIndex2054
-					{
-						position2060 := position
-						{
-							position2061, tokenIndex2061 := position, tokenIndex
-							if !_rules[rulezeroHexWord]() {
-								goto l2062
-							}
-							goto l2061
-						l2062:
-							position, tokenIndex = position2061, tokenIndex2061
-							if !_rules[rulehexWord]() {
-								goto l2059
-							}
-						}
-					l2061:
-						{
-							position2063, tokenIndex2063 := position, tokenIndex
-							if buffer[position] != rune('h') {
-								goto l2064
-							}
-							position++
-							goto l2063
-						l2064:
-							position, tokenIndex = position2063, tokenIndex2063
-							if buffer[position] != rune('H') {
-								goto l2059
-							}
-							position++
-						}
-					l2063:
-						add(rulehexWordH, position2060)
+					position++
+					goto l2097
+				l2098:
+					position, tokenIndex = position2097, tokenIndex2097
+					if buffer[position] != rune('I') {
+						goto l2095
 					}
-					goto l2054
-				l2059:
-					position, tokenIndex = position2054, tokenIndex2054
-					{
-						position2065 := position
-						if buffer[position] != rune('0') {
-							goto l2052
-						}
-						position++
-						{
-							position2066, tokenIndex2066 := position, tokenIndex
-							if buffer[position] != rune('x') {
-								goto l2067
-							}
-							position++
-							goto l2066
-						l2067:
-							position, tokenIndex = position2066, tokenIndex2066
-							if buffer[position] != rune('X') {
-								goto l2052
-							}
-							position++
-						}
-					l2066:
-						{
-							position2068, tokenIndex2068 := position, tokenIndex
-							if !_rules[rulezeroHexWord]() {
-								goto l2069
-							}
-							goto l2068
-						l2069:
-							position, tokenIndex = position2068, tokenIndex2068
-							if !_rules[rulehexWord]() {
-								goto l2052
-							}
-						}
-					l2068:
-						add(rulehexWord0x, position2065)
+					position++
+				}
+			l2097:
+				add(ruleI, position2096)
+			}
+			return true
+		l2095:
+			position, tokenIndex = position2095, tokenIndex2095
+			return false
+		},
+		/* 181 R <- <('r' / 'R')> */
+		func() bool {
+			position2099, tokenIndex2099 := position, tokenIndex
+			{
+				position2100 := position
+				{
+					position2101, tokenIndex2101 := position, tokenIndex
+					if buffer[position] != rune('r') {
+						goto l2102
 					}
+					position++
+					goto l2101
+				l2102:
+					position, tokenIndex = position2101, tokenIndex2101
+					if buffer[position] != rune('R') {
+						goto l2099
+					}
+					position++
 				}
-			l2054:
-				add(rulenn, position2053)
+			l2101:
+				add(ruleR, position2100)
 			}
 			return true
-		l2052:
-			position, tokenIndex = position2052, tokenIndex2052
+		l2099:
+			position, tokenIndex = position2099, tokenIndex2099
 			return false
 		},
-		/* 53 disp <- <(signedHexByteH / signedHexByte0x / signedDecimalByte)> */
+		/* 182 AF <- <(('a' / 'A') ('f' / 'F'))> */
 		func() bool {
-			position2070, tokenIndex2070 := position, tokenIndex
+			position2103, tokenIndex2103 := position, tokenIndex
 			{
-				position2071 := position
+				position2104 := position
 				{
-					position2072, tokenIndex2072 := position, tokenIndex
-					{
-						position2074 := position
-						{
-							position2075 := position
-							{
-								position2076, tokenIndex2076 := position, tokenIndex
-								{
-									position2078, tokenIndex2078 := position, tokenIndex
-									if buffer[position] != rune('-') {
-										goto l2079
-									}
-									position++
-									goto l2078
-								l2079:
-									position, tokenIndex = position2078, tokenIndex2078
-									if buffer[position] != rune('+') {
-										goto l2076
-									}
-									position++
-								}
-							l2078:
-								goto l2077
-							l2076:
-								position, tokenIndex = position2076, tokenIndex2076
-							}
-						l2077:
-							{
-								switch buffer[position] {
-								case 'A', 'B', 'C', 'D', 'E', 'F':
-									if c := buffer[position]; c < rune('A') || c > rune('F') {
-										goto l2073
-									}
-									position++
-									break
-								case 'a', 'b', 'c', 'd', 'e', 'f':
-									if c := buffer[position]; c < rune('a') || c > rune('f') {
-										goto l2073
-									}
-									position++
-									break
-								default:
-									if c := buffer[position]; c < rune('0') || c > rune('9') {
-										goto l2073
-									}
-									position++
-									break
-								}
-							}
-
-						l2080:
-							{
-								position2081, tokenIndex2081 := position, tokenIndex
-								{
-									switch buffer[position] {
-									case 'A', 'B', 'C', 'D', 'E', 'F':
-										if c := buffer[position]; c < rune('A') || c > rune('F') {
-											goto l2081
-										}
-										position++
-										break
-									case 'a', 'b', 'c', 'd', 'e', 'f':
-										if c := buffer[position]; c < rune('a') || c > rune('f') {
-											goto l2081
-										}
-										position++
-										break
-									default:
-										if c := buffer[position]; c < rune('0') || c > rune('9') {
-											goto l2081
-										}
-										position++
-										break
-									}
-								}
-
-								goto l2080
-							l2081:
-								position, tokenIndex = position2081, tokenIndex2081
-							}
-							add(rulePegText, position2075)
-						}
-						{
-							position2084, tokenIndex2084 := position, tokenIndex
-							if buffer[position] != rune('h') {
-								goto l2085
-							}
-							position++
-							goto l2084
-						l2085:
-							position, tokenIndex = position2084, tokenIndex2084
-							if buffer[position] != rune('H') {
-								goto l2073
-							}
-							position++
-						}
-					l2084:
-						{
-							add(ruleAction35, position)
-						}
-						add(rulesignedHexByteH, position2074)
+					position2105, tokenIndex2105 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l2106
 					}
-					goto l2072
-				l2073:
-					position, tokenIndex = position2072, tokenIndex2072
-					{
-						position2088 := position
-						{
-							position2089 := position
-							{
-								position2090, tokenIndex2090 := position, tokenIndex
-								{
-									position2092, tokenIndex2092 := position, tokenIndex
-									if buffer[position] != rune('-') {
-										goto l2093
-									}
-									position++
-									goto l2092
-								l2093:
-									position, tokenIndex = position2092, tokenIndex2092
-									if buffer[position] != rune('+') {
-										goto l2090
-									}
-									position++
-								}
-							l2092:
-								goto l2091
-							l2090:
-								position, tokenIndex = position2090, tokenIndex2090
-							}
-						l2091:
-							if buffer[position] != rune('0') {
-								goto l2087
-							}
-							position++
-							{
-								position2094, tokenIndex2094 := position, tokenIndex
-								if buffer[position] != rune('x') {
-									goto l2095
-								}
-								position++
-								goto l2094
-							l2095:
-								position, tokenIndex = position2094, tokenIndex2094
-								if buffer[position] != rune('X') {
-									goto l2087
-								}
-								position++
-							}
-						l2094:
-							{
-								switch buffer[position] {
-								case 'A', 'B', 'C', 'D', 'E', 'F':
-									if c := buffer[position]; c < rune('A') || c > rune('F') {
-										goto l2087
-									}
-									position++
-									break
-								case 'a', 'b', 'c', 'd', 'e', 'f':
-									if c := buffer[position]; c < rune('a') || c > rune('f') {
-										goto l2087
-									}
-									position++
-									break
-								default:
-									if c := buffer[position]; c < rune('0') || c > rune('9') {
-										goto l2087
-									}
-									position++
-									break
-								}
-							}
-
-						l2096:
-							{
-								position2097, tokenIndex2097 := position, tokenIndex
-								{
-									switch buffer[position] {
-									case 'A', 'B', 'C', 'D', 'E', 'F':
-										if c := buffer[position]; c < rune('A') || c > rune('F') {
-											goto l2097
-										}
-										position++
-										break
-									case 'a', 'b', 'c', 'd', 'e', 'f':
-										if c := buffer[position]; c < rune('a') || c > rune('f') {
-											goto l2097
-										}
-										position++
-										break
-									default:
-										if c := buffer[position]; c < rune('0') || c > rune('9') {
-											goto l2097
-										}
-										position++
-										break
-									}
-								}
-
-								goto l2096
-							l2097:
-								position, tokenIndex = position2097, tokenIndex2097
-							}
-							add(rulePegText, position2089)
-						}
-						{
-							add(ruleAction36, position)
-						}
-						add(rulesignedHexByte0x, position2088)
+					position++
+					goto l2105
+				l2106:
+					position, tokenIndex = position2105, tokenIndex2105
+					if buffer[position] != rune('A') {
+						goto l2103
 					}
-					goto l2072
-				l2087:
-					position, tokenIndex = position2072, tokenIndex2072
-					{
-						position2101 := position
-						{
-							position2102 := position
-							{
-								position2103, tokenIndex2103 := position, tokenIndex
-								{
-									position2105, tokenIndex2105 := position, tokenIndex
-									if buffer[position] != rune('-') {
-										goto l2106
-									}
-									position++
-									goto l2105
-								l2106:
-									position, tokenIndex = position2105, tokenIndex2105
-									if buffer[position] != rune('+') {
-										goto l2103
-									}
-									position++
-								}
-							l2105:
-								goto l2104
-							l2103:
-								position, tokenIndex = position2103, tokenIndex2103
-							}
-						l2104:
-							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l2070
-							}
-							position++
-						l2107:
-							{
-								position2108, tokenIndex2108 := position, tokenIndex
-								if c := buffer[position]; c < rune('0') || c > rune('9') {
-									goto l2108
-								}
-								position++
-								goto l2107
-							l2108:
-								position, tokenIndex = position2108, tokenIndex2108
-							}
-							add(rulePegText, position2102)
-						}
-						{
-							add(ruleAction34, position)
-						}
-						add(rulesignedDecimalByte, position2101)
+					position++
+				}
+			l2105:
+				{
+					position2107, tokenIndex2107 := position, tokenIndex
+					if buffer[position] != rune('f') {
+						goto l2108
+					}
+					position++
+					goto l2107
+				l2108:
+					position, tokenIndex = position2107, tokenIndex2107
+					if buffer[position] != rune('F') {
+						goto l2103
 					}
+					position++
 				}
-			l2072:
-				add(ruledisp, position2071)
+			l2107:
+				add(ruleAF, position2104)
 			}
 			return true
-		l2070:
-			position, tokenIndex = position2070, tokenIndex2070
+		l2103:
+			position, tokenIndex = position2103, tokenIndex2103
 			return false
 		},
-		/* 54 signedDecimalByte <- <(<(('-' / '+')? [0-9]+)> Action34)> */
-		nil,
-		/* 55 signedHexByteH <- <(<(('-' / '+')? ((&('A' | 'B' | 'C' | 'D' | 'E' | 'F') [A-F]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f') [a-f]) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]))+)> ('h' / 'H') Action35)> */
-		nil,
-		/* 56 signedHexByte0x <- <(<(('-' / '+')? ('0' ('x' / 'X')) ((&('A' | 'B' | 'C' | 'D' | 'E' | 'F') [A-F]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f') [a-f]) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]))+)> Action36)> */
-		nil,
-		/* 57 hexByteH <- <(<(hexdigit hexdigit)> ('h' / 'H') Action37)> */
-		nil,
-		/* 58 hexByte0x <- <('0' ('x' / 'X') <(hexdigit hexdigit)> Action38)> */
-		nil,
-		/* 59 decimalByte <- <(<[0-9]+> Action39)> */
-		nil,
-		/* 60 LabelNN <- <(<LabelText> Action40)> */
-		nil,
-		/* 61 hexWordH <- <((zeroHexWord / hexWord) ('h' / 'H'))> */
-		nil,
-		/* 62 hexWord0x <- <('0' ('x' / 'X') (zeroHexWord / hexWord))> */
-		nil,
-		/* 63 hexWord <- <(<(hexdigit hexdigit hexdigit hexdigit)> Action41)> */
+		/* 183 AF_PRIME <- <(('a' / 'A') ('f' / 'F') '\'')> */
+		func() bool {
+			position2109, tokenIndex2109 := position, tokenIndex
+			{
+				position2110 := position
+				{
+					position2111, tokenIndex2111 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l2112
+					}
+					position++
+					goto l2111
+				l2112:
+					position, tokenIndex = position2111, tokenIndex2111
+					if buffer[position] != rune('A') {
+						goto l2109
+					}
+					position++
+				}
+			l2111:
+				{
+					position2113, tokenIndex2113 := position, tokenIndex
+					if buffer[position] != rune('f') {
+						goto l2114
+					}
+					position++
+					goto l2113
+				l2114:
+					position, tokenIndex = position2113, tokenIndex2113
+					if buffer[position] != rune('F') {
+						goto l2109
+					}
+					position++
+				}
+			l2113:
+				if buffer[position] != rune('\'') {
+					goto l2109
+				}
+				position++
+				add(ruleAF_PRIME, position2110)
+			}
+			return true
+		l2109:
+			position, tokenIndex = position2109, tokenIndex2109
+			return false
+		},
+		/* 184 BC <- <(('b' / 'B') ('c' / 'C'))> */
 		func() bool {
-			position2119, tokenIndex2119 := position, tokenIndex
+			position2115, tokenIndex2115 := position, tokenIndex
 			{
-				position2120 := position
+				position2116 := position
 				{
-					position2121 := position
-					if !_rules[rulehexdigit]() {
-						goto l2119
-					}
-					if !_rules[rulehexdigit]() {
-						goto l2119
+					position2117, tokenIndex2117 := position, tokenIndex
+					if buffer[position] != rune('b') {
+						goto l2118
 					}
-					if !_rules[rulehexdigit]() {
-						goto l2119
-					}
-					if !_rules[rulehexdigit]() {
-						goto l2119
+					position++
+					goto l2117
+				l2118:
+					position, tokenIndex = position2117, tokenIndex2117
+					if buffer[position] != rune('B') {
+						goto l2115
 					}
-					add(rulePegText, position2121)
+					position++
 				}
+			l2117:
 				{
-					add(ruleAction41, position)
+					position2119, tokenIndex2119 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l2120
+					}
+					position++
+					goto l2119
+				l2120:
+					position, tokenIndex = position2119, tokenIndex2119
+					if buffer[position] != rune('C') {
+						goto l2115
+					}
+					position++
 				}
-				add(rulehexWord, position2120)
+			l2119:
+				add(ruleBC, position2116)
 			}
 			return true
-		l2119:
-			position, tokenIndex = position2119, tokenIndex2119
+		l2115:
+			position, tokenIndex = position2115, tokenIndex2115
 			return false
 		},
-		/* 64 zeroHexWord <- <('0' hexWord)> */
+		/* 185 DE <- <(('d' / 'D') ('e' / 'E'))> */
 		func() bool {
-			position2123, tokenIndex2123 := position, tokenIndex
+			position2121, tokenIndex2121 := position, tokenIndex
 			{
-				position2124 := position
-				if buffer[position] != rune('0') {
+				position2122 := position
+				{
+					position2123, tokenIndex2123 := position, tokenIndex
+					if buffer[position] != rune('d') {
+						goto l2124
+					}
+					position++
 					goto l2123
+				l2124:
+					position, tokenIndex = position2123, tokenIndex2123
+					if buffer[position] != rune('D') {
+						goto l2121
+					}
+					position++
 				}
-				position++
-				if !_rules[rulehexWord]() {
-					goto l2123
+			l2123:
+				{
+					position2125, tokenIndex2125 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l2126
+					}
+					position++
+					goto l2125
+				l2126:
+					position, tokenIndex = position2125, tokenIndex2125
+					if buffer[position] != rune('E') {
+						goto l2121
+					}
+					position++
 				}
-				add(rulezeroHexWord, position2124)
+			l2125:
+				add(ruleDE, position2122)
 			}
 			return true
-		l2123:
-			position, tokenIndex = position2123, tokenIndex2123
+		l2121:
+			position, tokenIndex = position2121, tokenIndex2121
 			return false
 		},
-		/* 65 nn_contents <- <('(' nn ')' Action42)> */
+		/* 186 HL <- <(('h' / 'H') ('l' / 'L'))> */
 		func() bool {
-			position2125, tokenIndex2125 := position, tokenIndex
+			position2127, tokenIndex2127 := position, tokenIndex
 			{
-				position2126 := position
-				if buffer[position] != rune('(') {
-					goto l2125
-				}
-				position++
-				if !_rules[rulenn]() {
-					goto l2125
-				}
-				if buffer[position] != rune(')') {
-					goto l2125
+				position2128 := position
+				{
+					position2129, tokenIndex2129 := position, tokenIndex
+					if buffer[position] != rune('h') {
+						goto l2130
+					}
+					position++
+					goto l2129
+				l2130:
+					position, tokenIndex = position2129, tokenIndex2129
+					if buffer[position] != rune('H') {
+						goto l2127
+					}
+					position++
 				}
-				position++
+			l2129:
 				{
-					add(ruleAction42, position)
+					position2131, tokenIndex2131 := position, tokenIndex
+					if buffer[position] != rune('l') {
+						goto l2132
+					}
+					position++
+					goto l2131
+				l2132:
+					position, tokenIndex = position2131, tokenIndex2131
+					if buffer[position] != rune('L') {
+						goto l2127
+					}
+					position++
 				}
-				add(rulenn_contents, position2126)
+			l2131:
+				add(ruleHL, position2128)
 			}
 			return true
-		l2125:
-			position, tokenIndex = position2125, tokenIndex2125
+		l2127:
+			position, tokenIndex = position2127, tokenIndex2127
 			return false
 		},
-		/* 66 Alu <- <(Add / Adc / Sub / ((&('C' | 'c') Cp) | (&('O' | 'o') Or) | (&('X' | 'x') Xor) | (&('A' | 'a') And) | (&('S' | 's') Sbc)))> */
-		nil,
-		/* 67 Add <- <(('a' / 'A') ('d' / 'D') ('d' / 'D') ws ('a' / 'A') sep Src8 Action43)> */
-		nil,
-		/* 68 Adc <- <(('a' / 'A') ('d' / 'D') ('c' / 'C') ws ('a' / 'A') sep Src8 Action44)> */
-		nil,
-		/* 69 Sub <- <(('s' / 'S') ('u' / 'U') ('b' / 'B') ws Src8 Action45)> */
-		nil,
-		/* 70 Sbc <- <(('s' / 'S') ('b' / 'B') ('c' / 'C') ws ('a' / 'A') sep Src8 Action46)> */
-		nil,
-		/* 71 And <- <(('a' / 'A') ('n' / 'N') ('d' / 'D') ws (('a' / 'A') sep)? Src8 Action47)> */
-		nil,
-		/* 72 Xor <- <(('x' / 'X') ('o' / 'O') ('r' / 'R') ws Src8 Action48)> */
-		nil,
-		/* 73 Or <- <(('o' / 'O') ('r' / 'R') ws Src8 Action49)> */
-		nil,
-		/* 74 Cp <- <(('c' / 'C') ('p' / 'P') ws Src8 Action50)> */
-		nil,
-		/* 75 BitOp <- <(Rot / ((&('S' | 's') Set) | (&('R' | 'r') Res) | (&('B' | 'b') Bit)))> */
-		nil,
-		/* 76 Rot <- <(Rlc / Rrc / Rl / Rr / Sla / Sra / Sll / Srl)> */
-		nil,
-		/* 77 Rlc <- <(('r' / 'R') ('l' / 'L') ('c' / 'C') ws Loc8 (sep Copy8)? Action51)> */
-		nil,
-		/* 78 Rrc <- <(('r' / 'R') ('r' / 'R') ('c' / 'C') ws Loc8 (sep Copy8)? Action52)> */
-		nil,
-		/* 79 Rl <- <(('r' / 'R') ('l' / 'L') ws Loc8 (sep Copy8)? Action53)> */
-		nil,
-		/* 80 Rr <- <(('r' / 'R') ('r' / 'R') ws Loc8 (sep Copy8)? Action54)> */
-		nil,
-		/* 81 Sla <- <(('s' / 'S') ('l' / 'L') ('a' / 'A') ws Loc8 (sep Copy8)? Action55)> */
-		nil,
-		/* 82 Sra <- <(('s' / 'S') ('r' / 'R') ('a' / 'A') ws Loc8 (sep Copy8)? Action56)> */
-		nil,
-		/* 83 Sll <- <(('s' / 'S') ('l' / 'L') ('l' / 'L') ws Loc8 (sep Copy8)? Action57)> */
-		nil,
-		/* 84 Srl <- <(('s' / 'S') ('r' / 'R') ('l' / 'L') ws Loc8 (sep Copy8)? Action58)> */
-		nil,
-		/* 85 Bit <- <(('b' / 'B') ('i' / 'I') ('t' / 'T') ws octaldigit sep Loc8 Action59)> */
-		nil,
-		/* 86 Res <- <(('r' / 'R') ('e' / 'E') ('s' / 'S') ws octaldigit sep Loc8 (sep Copy8)? Action60)> */
-		nil,
-		/* 87 Set <- <(('s' / 'S') ('e' / 'E') ('t' / 'T') ws octaldigit sep Loc8 (sep Copy8)? Action61)> */
-		nil,
-		/* 88 Simple <- <(Rlca / Rrca / Rla / Daa / Cpl / Exx / ((&('E' | 'e') Ei) | (&('D' | 'd') Di) | (&('C' | 'c') Ccf) | (&('S' | 's') Scf) | (&('R' | 'r') Rra) | (&('H' | 'h') Halt) | (&('N' | 'n') Nop)))> */
-		nil,
-		/* 89 Nop <- <(<(('n' / 'N') ('o' / 'O') ('p' / 'P'))> Action62)> */
-		nil,
-		/* 90 Halt <- <(<(('h' / 'H') ('a' / 'A') ('l' / 'L') ('t' / 'T'))> Action63)> */
-		nil,
-		/* 91 Rlca <- <(<(('r' / 'R') ('l' / 'L') ('c' / 'C') ('a' / 'A'))> Action64)> */
-		nil,
-		/* 92 Rrca <- <(<(('r' / 'R') ('r' / 'R') ('c' / 'C') ('a' / 'A'))> Action65)> */
-		nil,
-		/* 93 Rla <- <(<(('r' / 'R') ('l' / 'L') ('a' / 'A'))> Action66)> */
-		nil,
-		/* 94 Rra <- <(<(('r' / 'R') ('r' / 'R') ('a' / 'A'))> Action67)> */
-		nil,
-		/* 95 Daa <- <(<(('d' / 'D') ('a' / 'A') ('a' / 'A'))> Action68)> */
-		nil,
-		/* 96 Cpl <- <(<(('c' / 'C') ('p' / 'P') ('l' / 'L'))> Action69)> */
-		nil,
-		/* 97 Scf <- <(<(('s' / 'S') ('c' / 'C') ('f' / 'F'))> Action70)> */
-		nil,
-		/* 98 Ccf <- <(<(('c' / 'C') ('c' / 'C') ('f' / 'F'))> Action71)> */
-		nil,
-		/* 99 Exx <- <(<(('e' / 'E') ('x' / 'X') ('x' / 'X'))> Action72)> */
-		nil,
-		/* 100 Di <- <(<(('d' / 'D') ('i' / 'I'))> Action73)> */
-		nil,
-		/* 101 Ei <- <(<(('e' / 'E') ('i' / 'I'))> Action74)> */
-		nil,
-		/* 102 EDSimple <- <(Retn / Reti / Rrd / Im0 / Im1 / Im2 / ((&('I' | 'O' | 'i' | 'o') BlitIO) | (&('R' | 'r') Rld) | (&('N' | 'n') Neg) | (&('C' | 'L' | 'c' | 'l') Blit)))> */
-		nil,
-		/* 103 Neg <- <(<(('n' / 'N') ('e' / 'E') ('g' / 'G'))> Action75)> */
-		nil,
-		/* 104 Retn <- <(<(('r' / 'R') ('e' / 'E') ('t' / 'T') ('n' / 'N'))> Action76)> */
-		nil,
-		/* 105 Reti <- <(<(('r' / 'R') ('e' / 'E') ('t' / 'T') ('i' / 'I'))> Action77)> */
-		nil,
-		/* 106 Rrd <- <(<(('r' / 'R') ('r' / 'R') ('d' / 'D'))> Action78)> */
-		nil,
-		/* 107 Rld <- <(<(('r' / 'R') ('l' / 'L') ('d' / 'D'))> Action79)> */
-		nil,
-		/* 108 Im0 <- <(<(('i' / 'I') ('m' / 'M') ' ' '0')> Action80)> */
-		nil,
-		/* 109 Im1 <- <(<(('i' / 'I') ('m' / 'M') ' ' '1')> Action81)> */
-		nil,
-		/* 110 Im2 <- <(<(('i' / 'I') ('m' / 'M') ' ' '2')> Action82)> */
-		nil,
-		/* 111 Blit <- <(Ldir / Ldi / Cpir / Cpi / Lddr / Ldd / Cpdr / Cpd)> */
-		nil,
-		/* 112 BlitIO <- <(Inir / Ini / Otir / Outi / Indr / Ind / Otdr / Outd)> */
-		nil,
-		/* 113 Ldi <- <(<(('l' / 'L') ('d' / 'D') ('i' / 'I'))> Action83)> */
-		nil,
-		/* 114 Cpi <- <(<(('c' / 'C') ('p' / 'P') ('i' / 'I'))> Action84)> */
-		nil,
-		/* 115 Ini <- <(<(('i' / 'I') ('n' / 'N') ('i' / 'I'))> Action85)> */
-		nil,
-		/* 116 Outi <- <(<(('o' / 'O') ('u' / 'U') ('t' / 'T') ('i' / 'I'))> Action86)> */
-		nil,
-		/* 117 Ldd <- <(<(('l' / 'L') ('d' / 'D') ('d' / 'D'))> Action87)> */
-		nil,
-		/* 118 Cpd <- <(<(('c' / 'C') ('p' / 'P') ('d' / 'D'))> Action88)> */
-		nil,
-		/* 119 Ind <- <(<(('i' / 'I') ('n' / 'N') ('d' / 'D'))> Action89)> */
-		nil,
-		/* 120 Outd <- <(<(('o' / 'O') ('u' / 'U') ('t' / 'T') ('d' / 'D'))> Action90)> */
-		nil,
-		/* 121 Ldir <- <(<(('l' / 'L') ('d' / 'D') ('i' / 'I') ('r' / 'R'))> Action91)> */
-		nil,
-		/* 122 Cpir <- <(<(('c' / 'C') ('p' / 'P') ('i' / 'I') ('r' / 'R'))> Action92)> */
-		nil,
-		/* 123 Inir <- <(<(('i' / 'I') ('n' / 'N') ('i' / 'I') ('r' / 'R'))> Action93)> */
-		nil,
-		/* 124 Otir <- <(<(('o' / 'O') ('t' / 'T') ('i' / 'I') ('r' / 'R'))> Action94)> */
-		nil,
-		/* 125 Lddr <- <(<(('l' / 'L') ('d' / 'D') ('d' / 'D') ('r' / 'R'))> Action95)> */
-		nil,
-		/* 126 Cpdr <- <(<(('c' / 'C') ('p' / 'P') ('d' / 'D') ('r' / 'R'))> Action96)> */
-		nil,
-		/* 127 Indr <- <(<(('i' / 'I') ('n' / 'N') ('d' / 'D') ('r' / 'R'))> Action97)> */
-		nil,
-		/* 128 Otdr <- <(<(('o' / 'O') ('t' / 'T') ('d' / 'D') ('r' / 'R'))> Action98)> */
-		nil,
-		/* 129 Jump <- <(Rst / Jp / ((&('D' | 'd') Djnz) | (&('J' | 'j') Jr) | (&('R' | 'r') Ret) | (&('C' | 'c') Call)))> */
-		nil,
-		/* 130 Rst <- <(('r' / 'R') ('s' / 'S') ('t' / 'T') ws n Action99)> */
-		nil,
-		/* 131 Call <- <(('c' / 'C') ('a' / 'A') ('l' / 'L') ('l' / 'L') ws (cc sep)? Src16 Action100)> */
-		nil,
-		/* 132 Ret <- <(('r' / 'R') ('e' / 'E') ('t' / 'T') (ws cc)? Action101)> */
-		nil,
-		/* 133 Jp <- <(('j' / 'J') ('p' / 'P') ws (cc sep)? Src16 Action102)> */
-		nil,
-		/* 134 Jr <- <(('j' / 'J') ('r' / 'R') ws (cc sep)? disp Action103)> */
-		nil,
-		/* 135 Djnz <- <(('d' / 'D') ('j' / 'J') ('n' / 'N') ('z' / 'Z') ws disp Action104)> */
-		nil,
-		/* 136 IO <- <(IN / OUT)> */
-		nil,
-		/* 137 IN <- <(('i' / 'I') ('n' / 'N') ws Reg8 sep Port Action105)> */
-		nil,
-		/* 138 OUT <- <(('o' / 'O') ('u' / 'U') ('t' / 'T') ws Port sep Reg8 Action106)> */
-		nil,
-		/* 139 Port <- <(('(' ('c' / 'C') ')') / ('(' n ')'))> */
+		/* 187 IX <- <(('i' / 'I') ('x' / 'X'))> */
 		func() bool {
-			position2201, tokenIndex2201 := position, tokenIndex
+			position2133, tokenIndex2133 := position, tokenIndex
 			{
-				position2202 := position
+				position2134 := position
 				{
-					position2203, tokenIndex2203 := position, tokenIndex
-					if buffer[position] != rune('(') {
-						goto l2204
+					position2135, tokenIndex2135 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l2136
 					}
 					position++
-					{
-						position2205, tokenIndex2205 := position, tokenIndex
-						if buffer[position] != rune('c') {
-							goto l2206
-						}
-						position++
-						goto l2205
-					l2206:
-						position, tokenIndex = position2205, tokenIndex2205
-						if buffer[position] != rune('C') {
-							goto l2204
-						}
-						position++
-					}
-				l2205:
-					if buffer[position] != rune(')') {
-						goto l2204
+					goto l2135
+				l2136:
+					position, tokenIndex = position2135, tokenIndex2135
+					if buffer[position] != rune('I') {
+						goto l2133
 					}
 					position++
-					goto l2203
-				l2204:
-					position, tokenIndex = position2203, tokenIndex2203
-					if buffer[position] != rune('(') {
-						goto l2201
+				}
+			l2135:
+				{
+					position2137, tokenIndex2137 := position, tokenIndex
+					if buffer[position] != rune('x') {
+						goto l2138
 					}
 					position++
-					if !_rules[rulen]() {
-						goto l2201
-					}
-					if buffer[position] != rune(')') {
-						goto l2201
+					goto l2137
+				l2138:
+					position, tokenIndex = position2137, tokenIndex2137
+					if buffer[position] != rune('X') {
+						goto l2133
 					}
 					position++
 				}
-			l2203:
-				add(rulePort, position2202)
+			l2137:
+				add(ruleIX, position2134)
 			}
 			return true
-		l2201:
-			position, tokenIndex = position2201, tokenIndex2201
+		l2133:
+			position, tokenIndex = position2133, tokenIndex2133
 			return false
 		},
-		/* 140 sep <- <(ws? ',' ws?)> */
+		/* 188 IY <- <(('i' / 'I') ('y' / 'Y'))> */
 		func() bool {
-			position2207, tokenIndex2207 := position, tokenIndex
+			position2139, tokenIndex2139 := position, tokenIndex
 			{
-				position2208 := position
+				position2140 := position
 				{
-					position2209, tokenIndex2209 := position, tokenIndex
-					if !_rules[rulews]() {
-						goto l2209
+					position2141, tokenIndex2141 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l2142
 					}
-					goto l2210
-				l2209:
-					position, tokenIndex = position2209, tokenIndex2209
-				}
-			l2210:
-				if buffer[position] != rune(',') {
-					goto l2207
+					position++
+					goto l2141
+				l2142:
+					position, tokenIndex = position2141, tokenIndex2141
+					if buffer[position] != rune('I') {
+						goto l2139
+					}
+					position++
 				}
-				position++
+			l2141:
 				{
-					position2211, tokenIndex2211 := position, tokenIndex
-					if !_rules[rulews]() {
-						goto l2211
+					position2143, tokenIndex2143 := position, tokenIndex
+					if buffer[position] != rune('y') {
+						goto l2144
 					}
-					goto l2212
-				l2211:
-					position, tokenIndex = position2211, tokenIndex2211
+					position++
+					goto l2143
+				l2144:
+					position, tokenIndex = position2143, tokenIndex2143
+					if buffer[position] != rune('Y') {
+						goto l2139
+					}
+					position++
 				}
-			l2212:
-				add(rulesep, position2208)
+			l2143:
+				add(ruleIY, position2140)
 			}
 			return true
-		l2207:
-			position, tokenIndex = position2207, tokenIndex2207
+		l2139:
+			position, tokenIndex = position2139, tokenIndex2139
 			return false
 		},
-		/* 141 ws <- <(' ' / '\t')+> */
+		/* 189 SP <- <(('s' / 'S') ('p' / 'P'))> */
 		func() bool {
-			position2213, tokenIndex2213 := position, tokenIndex
+			position2145, tokenIndex2145 := position, tokenIndex
 			{
-				position2214 := position
+				position2146 := position
 				{
-					position2217, tokenIndex2217 := position, tokenIndex
-					if buffer[position] != rune(' ') {
-						goto l2218
+					position2147, tokenIndex2147 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l2148
 					}
 					position++
-					goto l2217
-				l2218:
-					position, tokenIndex = position2217, tokenIndex2217
-					if buffer[position] != rune('\t') {
-						goto l2213
+					goto l2147
+				l2148:
+					position, tokenIndex = position2147, tokenIndex2147
+					if buffer[position] != rune('S') {
+						goto l2145
 					}
 					position++
 				}
-			l2217:
-			l2215:
+			l2147:
 				{
-					position2216, tokenIndex2216 := position, tokenIndex
-					{
-						position2219, tokenIndex2219 := position, tokenIndex
-						if buffer[position] != rune(' ') {
-							goto l2220
-						}
-						position++
-						goto l2219
-					l2220:
-						position, tokenIndex = position2219, tokenIndex2219
-						if buffer[position] != rune('\t') {
-							goto l2216
-						}
-						position++
+					position2149, tokenIndex2149 := position, tokenIndex
+					if buffer[position] != rune('p') {
+						goto l2150
 					}
-				l2219:
-					goto l2215
-				l2216:
-					position, tokenIndex = position2216, tokenIndex2216
+					position++
+					goto l2149
+				l2150:
+					position, tokenIndex = position2149, tokenIndex2149
+					if buffer[position] != rune('P') {
+						goto l2145
+					}
+					position++
 				}
-				add(rulews, position2214)
+			l2149:
+				add(ruleSP, position2146)
 			}
 			return true
-		l2213:
-			position, tokenIndex = position2213, tokenIndex2213
+		l2145:
+			position, tokenIndex = position2145, tokenIndex2145
 			return false
 		},
-		/* 142 A <- <('a' / 'A')> */
-		nil,
-		/* 143 F <- <('f' / 'F')> */
-		nil,
-		/* 144 B <- <('b' / 'B')> */
-		nil,
-		/* 145 C <- <('c' / 'C')> */
-		nil,
-		/* 146 D <- <('d' / 'D')> */
-		nil,
-		/* 147 E <- <('e' / 'E')> */
-		nil,
-		/* 148 H <- <('h' / 'H')> */
-		nil,
-		/* 149 L <- <('l' / 'L')> */
-		nil,
-		/* 150 IXH <- <(('i' / 'I') ('x' / 'X') ('h' / 'H'))> */
-		nil,
-		/* 151 IXL <- <(('i' / 'I') ('x' / 'X') ('l' / 'L'))> */
-		nil,
-		/* 152 IYH <- <(('i' / 'I') ('y' / 'Y') ('h' / 'H'))> */
-		nil,
-		/* 153 IYL <- <(('i' / 'I') ('y' / 'Y') ('l' / 'L'))> */
-		nil,
-		/* 154 I <- <('i' / 'I')> */
-		nil,
-		/* 155 R <- <('r' / 'R')> */
-		nil,
-		/* 156 AF <- <(('a' / 'A') ('f' / 'F'))> */
-		nil,
-		/* 157 AF_PRIME <- <(('a' / 'A') ('f' / 'F') '\'')> */
-		nil,
-		/* 158 BC <- <(('b' / 'B') ('c' / 'C'))> */
-		nil,
-		/* 159 DE <- <(('d' / 'D') ('e' / 'E'))> */
-		nil,
-		/* 160 HL <- <(('h' / 'H') ('l' / 'L'))> */
-		nil,
-		/* 161 IX <- <(('i' / 'I') ('x' / 'X'))> */
-		nil,
-		/* 162 IY <- <(('i' / 'I') ('y' / 'Y'))> */
-		nil,
-		/* 163 SP <- <(('s' / 'S') ('p' / 'P'))> */
-		nil,
-		/* 164 hexdigit <- <([0-9] / ([a-f] / [A-F]))> */
+		/* 190 hexdigit <- <([0-9] / ([a-f] / [A-F]))> */
 		func() bool {
-			position2243, tokenIndex2243 := position, tokenIndex
+			position2151, tokenIndex2151 := position, tokenIndex
 			{
-				position2244 := position
+				position2152 := position
 				{
-					position2245, tokenIndex2245 := position, tokenIndex
+					position2153, tokenIndex2153 := position, tokenIndex
 					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l2246
+						goto l2154
 					}
 					position++
-					goto l2245
-				l2246:
-					position, tokenIndex = position2245, tokenIndex2245
+					goto l2153
+				l2154:
+					position, tokenIndex = position2153, tokenIndex2153
 					{
-						position2247, tokenIndex2247 := position, tokenIndex
+						position2155, tokenIndex2155 := position, tokenIndex
 						if c := buffer[position]; c < rune('a') || c > rune('f') {
-							goto l2248
+							goto l2156
 						}
 						position++
-						goto l2247
-					l2248:
-						position, tokenIndex = position2247, tokenIndex2247
+						goto l2155
+					l2156:
+						position, tokenIndex = position2155, tokenIndex2155
 						if c := buffer[position]; c < rune('A') || c > rune('F') {
-							goto l2243
+							goto l2151
 						}
 						position++
 					}
-				l2247:
+				l2155:
 				}
-			l2245:
-				add(rulehexdigit, position2244)
+			l2153:
+				add(rulehexdigit, position2152)
 			}
 			return true
-		l2243:
-			position, tokenIndex = position2243, tokenIndex2243
+		l2151:
+			position, tokenIndex = position2151, tokenIndex2151
 			return false
 		},
-		/* 165 octaldigit <- <(<[0-7]> Action107)> */
+		/* 191 octaldigit <- <(<[0-7]> Action182)> */
 		func() bool {
-			position2249, tokenIndex2249 := position, tokenIndex
+			position2157, tokenIndex2157 := position, tokenIndex
 			{
-				position2250 := position
+				position2158 := position
 				{
-					position2251 := position
+					position2159 := position
 					if c := buffer[position]; c < rune('0') || c > rune('7') {
-						goto l2249
+						goto l2157
 					}
 					position++
-					add(rulePegText, position2251)
+					add(rulePegText, position2159)
 				}
 				{
-					add(ruleAction107, position)
+					add(ruleAction182, position)
 				}
-				add(ruleoctaldigit, position2250)
+				add(ruleoctaldigit, position2158)
 			}
 			return true
-		l2249:
-			position, tokenIndex = position2249, tokenIndex2249
+		l2157:
+			position, tokenIndex = position2157, tokenIndex2157
 			return false
 		},
-		/* 166 cc <- <(FT_NZ / FT_PO / FT_PE / ((&('M' | 'm') FT_M) | (&('P' | 'p') FT_P) | (&('C' | 'c') FT_C) | (&('N' | 'n') FT_NC) | (&('Z' | 'z') FT_Z)))> */
+		/* 192 cc <- <(FT_NZ / FT_PO / FT_PE / ((&('M' | 'm') FT_M) | (&('P' | 'p') FT_P) | (&('C' | 'c') FT_C) | (&('N' | 'n') FT_NC) | (&('Z' | 'z') FT_Z)))> */
 		func() bool {
-			position2253, tokenIndex2253 := position, tokenIndex
+			position2161, tokenIndex2161 := position, tokenIndex
 			{
-				position2254 := position
+				position2162 := position
 				{
-					position2255, tokenIndex2255 := position, tokenIndex
+					position2163, tokenIndex2163 := position, tokenIndex
 					{
-						position2257 := position
+						position2165 := position
 						{
-							position2258, tokenIndex2258 := position, tokenIndex
+							position2166, tokenIndex2166 := position, tokenIndex
 							if buffer[position] != rune('n') {
-								goto l2259
+								goto l2167
 							}
 							position++
-							goto l2258
-						l2259:
-							position, tokenIndex = position2258, tokenIndex2258
+							goto l2166
+						l2167:
+							position, tokenIndex = position2166, tokenIndex2166
 							if buffer[position] != rune('N') {
-								goto l2256
+								goto l2164
 							}
 							position++
 						}
-					l2258:
+					l2166:
 						{
-							position2260, tokenIndex2260 := position, tokenIndex
+							position2168, tokenIndex2168 := position, tokenIndex
 							if buffer[position] != rune('z') {
-								goto l2261
+								goto l2169
 							}
 							position++
-							goto l2260
-						l2261:
-							position, tokenIndex = position2260, tokenIndex2260
+							goto l2168
+						l2169:
+							position, tokenIndex = position2168, tokenIndex2168
 							if buffer[position] != rune('Z') {
-								goto l2256
+								goto l2164
 							}
 							position++
 						}
-					l2260:
+					l2168:
 						{
-							add(ruleAction108, position)
+							add(ruleAction183, position)
 						}
-						add(ruleFT_NZ, position2257)
+						add(ruleFT_NZ, position2165)
 					}
-					goto l2255
-				l2256:
-					position, tokenIndex = position2255, tokenIndex2255
+					goto l2163
+				l2164:
+					position, tokenIndex = position2163, tokenIndex2163
 					{
-						position2264 := position
+						position2172 := position
 						{
-							position2265, tokenIndex2265 := position, tokenIndex
+							position2173, tokenIndex2173 := position, tokenIndex
 							if buffer[position] != rune('p') {
-								goto l2266
+								goto l2174
 							}
 							position++
-							goto l2265
-						l2266:
-							position, tokenIndex = position2265, tokenIndex2265
+							goto l2173
+						l2174:
+							position, tokenIndex = position2173, tokenIndex2173
 							if buffer[position] != rune('P') {
-								goto l2263
+								goto l2171
 							}
 							position++
 						}
-					l2265:
+					l2173:
 						{
-							position2267, tokenIndex2267 := position, tokenIndex
+							position2175, tokenIndex2175 := position, tokenIndex
 							if buffer[position] != rune('o') {
-								goto l2268
+								goto l2176
 							}
 							position++
-							goto l2267
-						l2268:
-							position, tokenIndex = position2267, tokenIndex2267
+							goto l2175
+						l2176:
+							position, tokenIndex = position2175, tokenIndex2175
 							if buffer[position] != rune('O') {
-								goto l2263
+								goto l2171
 							}
 							position++
 						}
-					l2267:
+					l2175:
 						{
-							add(ruleAction112, position)
+							add(ruleAction187, position)
 						}
-						add(ruleFT_PO, position2264)
+						add(ruleFT_PO, position2172)
 					}
-					goto l2255
-				l2263:
-					position, tokenIndex = position2255, tokenIndex2255
+					goto l2163
+				l2171:
+					position, tokenIndex = position2163, tokenIndex2163
 					{
-						position2271 := position
+						position2179 := position
 						{
-							position2272, tokenIndex2272 := position, tokenIndex
+							position2180, tokenIndex2180 := position, tokenIndex
 							if buffer[position] != rune('p') {
-								goto l2273
+								goto l2181
 							}
 							position++
-							goto l2272
-						l2273:
-							position, tokenIndex = position2272, tokenIndex2272
+							goto l2180
+						l2181:
+							position, tokenIndex = position2180, tokenIndex2180
 							if buffer[position] != rune('P') {
-								goto l2270
+								goto l2178
 							}
 							position++
 						}
-					l2272:
+					l2180:
 						{
-							position2274, tokenIndex2274 := position, tokenIndex
+							position2182, tokenIndex2182 := position, tokenIndex
 							if buffer[position] != rune('e') {
-								goto l2275
+								goto l2183
 							}
 							position++
-							goto l2274
-						l2275:
-							position, tokenIndex = position2274, tokenIndex2274
+							goto l2182
+						l2183:
+							position, tokenIndex = position2182, tokenIndex2182
 							if buffer[position] != rune('E') {
-								goto l2270
+								goto l2178
 							}
 							position++
 						}
-					l2274:
+					l2182:
 						{
-							add(ruleAction113, position)
+							add(ruleAction188, position)
 						}
-						add(ruleFT_PE, position2271)
+						add(ruleFT_PE, position2179)
 					}
-					goto l2255
-				l2270:
-					position, tokenIndex = position2255, tokenIndex2255
+					goto l2163
+				l2178:
+					position, tokenIndex = position2163, tokenIndex2163
 					{
 						switch buffer[position] {
 						case 'M', 'm':
 							{
-								position2278 := position
+								position2186 := position
 								{
-									position2279, tokenIndex2279 := position, tokenIndex
+									position2187, tokenIndex2187 := position, tokenIndex
 									if buffer[position] != rune('m') {
-										goto l2280
+										goto l2188
 									}
 									position++
-									goto l2279
-								l2280:
-									position, tokenIndex = position2279, tokenIndex2279
+									goto l2187
+								l2188:
+									position, tokenIndex = position2187, tokenIndex2187
 									if buffer[position] != rune('M') {
-										goto l2253
+										goto l2161
 									}
 									position++
 								}
-							l2279:
+							l2187:
 								{
-									add(ruleAction115, position)
+									add(ruleAction190, position)
 								}
-								add(ruleFT_M, position2278)
+								add(ruleFT_M, position2186)
 							}
 							break
 						case 'P', 'p':
 							{
-								position2282 := position
+								position2190 := position
 								{
-									position2283, tokenIndex2283 := position, tokenIndex
+									position2191, tokenIndex2191 := position, tokenIndex
 									if buffer[position] != rune('p') {
-										goto l2284
+										goto l2192
 									}
 									position++
-									goto l2283
-								l2284:
-									position, tokenIndex = position2283, tokenIndex2283
+									goto l2191
+								l2192:
+									position, tokenIndex = position2191, tokenIndex2191
 									if buffer[position] != rune('P') {
-										goto l2253
+										goto l2161
 									}
 									position++
 								}
-							l2283:
+							l2191:
 								{
-									add(ruleAction114, position)
+									add(ruleAction189, position)
 								}
-								add(ruleFT_P, position2282)
+								add(ruleFT_P, position2190)
 							}
 							break
 						case 'C', 'c':
 							{
-								position2286 := position
+								position2194 := position
 								{
-									position2287, tokenIndex2287 := position, tokenIndex
+									position2195, tokenIndex2195 := position, tokenIndex
 									if buffer[position] != rune('c') {
-										goto l2288
+										goto l2196
 									}
 									position++
-									goto l2287
-								l2288:
-									position, tokenIndex = position2287, tokenIndex2287
+									goto l2195
+								l2196:
+									position, tokenIndex = position2195, tokenIndex2195
 									if buffer[position] != rune('C') {
-										goto l2253
+										goto l2161
 									}
 									position++
 								}
-							l2287:
+							l2195:
 								{
-									add(ruleAction111, position)
+									add(ruleAction186, position)
 								}
-								add(ruleFT_C, position2286)
+								add(ruleFT_C, position2194)
 							}
 							break
 						case 'N', 'n':
 							{
-								position2290 := position
+								position2198 := position
 								{
-									position2291, tokenIndex2291 := position, tokenIndex
+									position2199, tokenIndex2199 := position, tokenIndex
 									if buffer[position] != rune('n') {
-										goto l2292
+										goto l2200
 									}
 									position++
-									goto l2291
-								l2292:
-									position, tokenIndex = position2291, tokenIndex2291
+									goto l2199
+								l2200:
+									position, tokenIndex = position2199, tokenIndex2199
 									if buffer[position] != rune('N') {
-										goto l2253
+										goto l2161
 									}
 									position++
 								}
-							l2291:
+							l2199:
 								{
-									position2293, tokenIndex2293 := position, tokenIndex
+									position2201, tokenIndex2201 := position, tokenIndex
 									if buffer[position] != rune('c') {
-										goto l2294
+										goto l2202
 									}
 									position++
-									goto l2293
-								l2294:
-									position, tokenIndex = position2293, tokenIndex2293
+									goto l2201
+								l2202:
+									position, tokenIndex = position2201, tokenIndex2201
 									if buffer[position] != rune('C') {
-										goto l2253
+										goto l2161
 									}
 									position++
 								}
-							l2293:
+							l2201:
 								{
-									add(ruleAction110, position)
+									add(ruleAction185, position)
 								}
-								add(ruleFT_NC, position2290)
+								add(ruleFT_NC, position2198)
 							}
 							break
 						default:
 							{
-								position2296 := position
+								position2204 := position
 								{
-									position2297, tokenIndex2297 := position, tokenIndex
+									position2205, tokenIndex2205 := position, tokenIndex
 									if buffer[position] != rune('z') {
-										goto l2298
+										goto l2206
 									}
 									position++
-									goto l2297
-								l2298:
-									position, tokenIndex = position2297, tokenIndex2297
+									goto l2205
+								l2206:
+									position, tokenIndex = position2205, tokenIndex2205
 									if buffer[position] != rune('Z') {
-										goto l2253
+										goto l2161
 									}
 									position++
 								}
-							l2297:
+							l2205:
 								{
-									add(ruleAction109, position)
+									add(ruleAction184, position)
 								}
-								add(ruleFT_Z, position2296)
+								add(ruleFT_Z, position2204)
 							}
 							break
 						}
 					}
 
 				}
-			l2255:
-				add(rulecc, position2254)
+			l2163:
+				add(rulecc, position2162)
 			}
 			return true
-		l2253:
-			position, tokenIndex = position2253, tokenIndex2253
+		l2161:
+			position, tokenIndex = position2161, tokenIndex2161
 			return false
 		},
-		/* 167 FT_NZ <- <(('n' / 'N') ('z' / 'Z') Action108)> */
+		/* 193 FT_NZ <- <(('n' / 'N') ('z' / 'Z') Action183)> */
+		nil,
+		/* 194 FT_Z <- <(('z' / 'Z') Action184)> */
+		nil,
+		/* 195 FT_NC <- <(('n' / 'N') ('c' / 'C') Action185)> */
+		nil,
+		/* 196 FT_C <- <(('c' / 'C') Action186)> */
+		nil,
+		/* 197 FT_PO <- <(('p' / 'P') ('o' / 'O') Action187)> */
+		nil,
+		/* 198 FT_PE <- <(('p' / 'P') ('e' / 'E') Action188)> */
+		nil,
+		/* 199 FT_P <- <(('p' / 'P') Action189)> */
+		nil,
+		/* 200 FT_M <- <(('m' / 'M') Action190)> */
+		nil,
+		/* 202 Action0 <- <{ p.Emit() }> */
+		nil,
+		/* 203 Action1 <- <{ p.NewLine() }> */
+		nil,
+		/* 204 Action2 <- <{ p.Section(buffer[begin:end]) }> */
+		nil,
+		/* 205 Action3 <- <{ p.Section("code") }> */
+		nil,
+		/* 206 Action4 <- <{ p.Section("data") }> */
+		nil,
+		/* 207 Action5 <- <{ p.Section("") }> */
+		nil,
+		/* 208 Action6 <- <{ p.Public() }> */
+		nil,
+		/* 209 Action7 <- <{ p.Extern() }> */
+		nil,
+		/* 210 Action8 <- <{ p.Org() }> */
+		nil,
+		/* 211 Action9 <- <{ p.Equ() }> */
+		nil,
+		/* 212 Action10 <- <{ p.Defl() }> */
+		nil,
+		/* 213 Action11 <- <{ p.Defl() }> */
+		nil,
+		/* 214 Action12 <- <{ p.DefByte() }> */
+		nil,
+		/* 215 Action13 <- <{ p.DefZero() }> */
+		nil,
+		/* 216 Action14 <- <{ p.DefLastHigh() }> */
+		nil,
+		/* 217 Action15 <- <{ p.DefWord() }> */
+		nil,
+		/* 218 Action16 <- <{ p.Fill() }> */
+		nil,
+		/* 219 Action17 <- <{ p.DefSpace() }> */
+		nil,
+		/* 220 Action18 <- <{ p.Fill() }> */
+		nil,
+		/* 221 Action19 <- <{ p.Align() }> */
+		nil,
+		nil,
+		/* 223 Action20 <- <{ p.DataString(buffer[begin:end]) }> */
+		nil,
+		/* 224 Action21 <- <{ p.DataString(buffer[begin:end]) }> */
+		nil,
+		/* 225 Action22 <- <{ p.DataByte() }> */
+		nil,
+		/* 226 Action23 <- <{ p.DataWord() }> */
+		nil,
+		/* 227 Action24 <- <{ p.DataWord() }> */
+		nil,
+		/* 228 Action25 <- <{ p.Name(buffer[begin:end]) }> */
+		nil,
+		/* 229 Action26 <- <{ p.Name(buffer[begin:end]) }> */
+		nil,
+		/* 230 Action27 <- <{ p.SymbolDefn(buffer[begin:end]) }> */
+		nil,
+		/* 231 Action28 <- <{ p.LabelDefn(buffer[begin:end]) }> */
+		nil,
+		/* 232 Action29 <- <{ p.SymbolDefn(buffer[begin:end]) }> */
+		nil,
+		/* 233 Action30 <- <{ p.LabelDefn(buffer[begin:end]) }> */
+		nil,
+		/* 234 Action31 <- <{ p.LD8() }> */
+		nil,
+		/* 235 Action32 <- <{ p.LD16() }> */
+		nil,
+		/* 236 Action33 <- <{ p.Push() }> */
+		nil,
+		/* 237 Action34 <- <{ p.Pop() }> */
+		nil,
+		/* 238 Action35 <- <{ p.Ex() }> */
+		nil,
+		/* 239 Action36 <- <{ p.Inc8() }> */
+		nil,
+		/* 240 Action37 <- <{ p.Inc8() }> */
+		nil,
+		/* 241 Action38 <- <{ p.Inc16() }> */
+		nil,
+		/* 242 Action39 <- <{ p.Dec8() }> */
+		nil,
+		/* 243 Action40 <- <{ p.Dec8() }> */
+		nil,
+		/* 244 Action41 <- <{ p.Dec16() }> */
+		nil,
+		/* 245 Action42 <- <{ p.Add16() }> */
+		nil,
+		/* 246 Action43 <- <{ p.Adc16() }> */
+		nil,
+		/* 247 Action44 <- <{ p.Sbc16() }> */
+		nil,
+		/* 248 Action45 <- <{ p.Dst8() }> */
+		nil,
+		/* 249 Action46 <- <{ p.Src8() }> */
+		nil,
+		/* 250 Action47 <- <{ p.Loc8() }> */
+		nil,
+		/* 251 Action48 <- <{ p.Copy8() }> */
+		nil,
+		/* 252 Action49 <- <{ p.Loc8() }> */
+		nil,
+		/* 253 Action50 <- <{ p.R8(buffer[begin:end]) }> */
+		nil,
+		/* 254 Action51 <- <{ p.R8(buffer[begin:end]) }> */
+		nil,
+		/* 255 Action52 <- <{ p.Dst16() }> */
+		nil,
+		/* 256 Action53 <- <{ p.Dst16() }> */
+		nil,
+		/* 257 Action54 <- <{ p.Src16() }> */
+		nil,
+		/* 258 Action55 <- <{ p.Loc16() }> */
+		nil,
+		/* 259 Action56 <- <{ p.R16(buffer[begin:end]) }> */
+		nil,
+		/* 260 Action57 <- <{ p.R16(buffer[begin:end]) }> */
+		nil,
+		/* 261 Action58 <- <{ p.R16Contents() }> */
+		nil,
+		/* 262 Action59 <- <{ p.IR16Contents() }> */
+		nil,
+		/* 263 Action60 <- <{ p.IndexDisp(1) }> */
+		nil,
+		/* 264 Action61 <- <{ p.IndexDisp(-1) }> */
+		nil,
+		/* 265 Action62 <- <{ p.N() }> */
+		nil,
+		/* 266 Action63 <- <{ p.NN() }> */
+		nil,
+		/* 267 Action64 <- <{ p.DispDecimal(buffer[begin:end]) }> */
+		nil,
+		/* 268 Action65 <- <{ p.DispHex(buffer[begin:end]) }> */
+		nil,
+		/* 269 Action66 <- <{ p.Disp0xHex(buffer[begin:end]) }> */
 		nil,
-		/* 168 FT_Z <- <(('z' / 'Z') Action109)> */
+		/* 270 Action67 <- <{ p.Binary("==") }> */
 		nil,
-		/* 169 FT_NC <- <(('n' / 'N') ('c' / 'C') Action110)> */
+		/* 271 Action68 <- <{ p.Binary("!=") }> */
 		nil,
-		/* 170 FT_C <- <(('c' / 'C') Action111)> */
+		/* 272 Action69 <- <{ p.Binary("<=") }> */
 		nil,
-		/* 171 FT_PO <- <(('p' / 'P') ('o' / 'O') Action112)> */
+		/* 273 Action70 <- <{ p.Binary(">=") }> */
 		nil,
-		/* 172 FT_PE <- <(('p' / 'P') ('e' / 'E') Action113)> */
+		/* 274 Action71 <- <{ p.Binary("<") }> */
 		nil,
-		/* 173 FT_P <- <(('p' / 'P') Action114)> */
+		/* 275 Action72 <- <{ p.Binary(">") }> */
 		nil,
-		/* 174 FT_M <- <(('m' / 'M') Action115)> */
+		/* 276 Action73 <- <{ p.Binary("==") }> */
 		nil,
-		/* 176 Action0 <- <{ p.Emit() }> */
+		/* 277 Action74 <- <{ p.Binary("!=") }> */
 		nil,
-		/* 177 Action1 <- <{ p.Org() }> */
+		/* 278 Action75 <- <{ p.Binary("<=") }> */
 		nil,
-		/* 178 Action2 <- <{ p.DefByte() }> */
+		/* 279 Action76 <- <{ p.Binary(">=") }> */
 		nil,
-		/* 179 Action3 <- <{ p.DefWord() }> */
+		/* 280 Action77 <- <{ p.Binary("<") }> */
 		nil,
-		/* 180 Action4 <- <{ p.DefSpace() }> */
+		/* 281 Action78 <- <{ p.Binary(">") }> */
 		nil,
-		/* 181 Action5 <- <{ p.LabelDefn(buffer[begin:end])}> */
+		/* 282 Action79 <- <{ p.Binary("|") }> */
 		nil,
+		/* 283 Action80 <- <{ p.Binary("|") }> */
 		nil,
-		/* 183 Action6 <- <{ p.LD8() }> */
+		/* 284 Action81 <- <{ p.Binary("^") }> */
 		nil,
-		/* 184 Action7 <- <{ p.LD16() }> */
+		/* 285 Action82 <- <{ p.Binary("^") }> */
 		nil,
-		/* 185 Action8 <- <{ p.Push() }> */
+		/* 286 Action83 <- <{ p.Binary("&") }> */
 		nil,
-		/* 186 Action9 <- <{ p.Pop() }> */
+		/* 287 Action84 <- <{ p.Binary("&") }> */
 		nil,
-		/* 187 Action10 <- <{ p.Ex() }> */
+		/* 288 Action85 <- <{ p.Binary("<<") }> */
 		nil,
-		/* 188 Action11 <- <{ p.Inc8() }> */
+		/* 289 Action86 <- <{ p.Binary(">>") }> */
 		nil,
-		/* 189 Action12 <- <{ p.Inc8() }> */
+		/* 290 Action87 <- <{ p.Binary("<<") }> */
 		nil,
-		/* 190 Action13 <- <{ p.Inc16() }> */
+		/* 291 Action88 <- <{ p.Binary(">>") }> */
 		nil,
-		/* 191 Action14 <- <{ p.Dec8() }> */
+		/* 292 Action89 <- <{ p.Binary("+") }> */
 		nil,
-		/* 192 Action15 <- <{ p.Dec8() }> */
+		/* 293 Action90 <- <{ p.Binary("-") }> */
 		nil,
-		/* 193 Action16 <- <{ p.Dec16() }> */
+		/* 294 Action91 <- <{ p.Binary("*") }> */
 		nil,
-		/* 194 Action17 <- <{ p.Add16() }> */
+		/* 295 Action92 <- <{ p.Binary("/") }> */
 		nil,
-		/* 195 Action18 <- <{ p.Adc16() }> */
+		/* 296 Action93 <- <{ p.Binary("%") }> */
 		nil,
-		/* 196 Action19 <- <{ p.Sbc16() }> */
+		/* 297 Action94 <- <{ p.Binary("%") }> */
 		nil,
-		/* 197 Action20 <- <{ p.Dst8() }> */
+		/* 298 Action95 <- <{ p.Unary("-") }> */
 		nil,
-		/* 198 Action21 <- <{ p.Src8() }> */
+		/* 299 Action96 <- <{ p.Unary("~") }> */
 		nil,
-		/* 199 Action22 <- <{ p.Loc8() }> */
+		/* 300 Action97 <- <{ p.Unary("!") }> */
 		nil,
-		/* 200 Action23 <- <{ p.Copy8() }> */
+		/* 301 Action98 <- <{ p.Unary("~") }> */
 		nil,
-		/* 201 Action24 <- <{ p.Loc8() }> */
+		/* 302 Action99 <- <{ p.Unary("LOW") }> */
 		nil,
-		/* 202 Action25 <- <{ p.R8(buffer[begin:end]) }> */
+		/* 303 Action100 <- <{ p.Unary("HIGH") }> */
 		nil,
-		/* 203 Action26 <- <{ p.R8(buffer[begin:end]) }> */
+		/* 304 Action101 <- <{ p.Dollar() }> */
 		nil,
-		/* 204 Action27 <- <{ p.Dst16() }> */
+		/* 305 Action102 <- <{ p.Symbol(buffer[begin:end]) }> */
 		nil,
-		/* 205 Action28 <- <{ p.Src16() }> */
+		/* 306 Action103 <- <{ p.Number(buffer[begin:end], 16) }> */
 		nil,
-		/* 206 Action29 <- <{ p.Loc16() }> */
+		/* 307 Action104 <- <{ p.Number(buffer[begin:end], 2) }> */
 		nil,
-		/* 207 Action30 <- <{ p.R16(buffer[begin:end]) }> */
+		/* 308 Action105 <- <{ p.Number(buffer[begin:end], 16) }> */
 		nil,
-		/* 208 Action31 <- <{ p.R16(buffer[begin:end]) }> */
+		/* 309 Action106 <- <{ p.Number(buffer[begin:end], 16) }> */
 		nil,
-		/* 209 Action32 <- <{ p.R16Contents() }> */
+		/* 310 Action107 <- <{ p.Number(buffer[begin:end], 2) }> */
 		nil,
-		/* 210 Action33 <- <{ p.IR16Contents() }> */
+		/* 311 Action108 <- <{ p.Number(buffer[begin:end], 2) }> */
 		nil,
-		/* 211 Action34 <- <{ p.DispDecimal(buffer[begin:end]) }> */
+		/* 312 Action109 <- <{ p.Number(buffer[begin:end], 8) }> */
 		nil,
-		/* 212 Action35 <- <{ p.DispHex(buffer[begin:end]) }> */
+		/* 313 Action110 <- <{ p.Number(buffer[begin:end], 10) }> */
 		nil,
-		/* 213 Action36 <- <{ p.Disp0xHex(buffer[begin:end]) }> */
+		/* 314 Action111 <- <{ p.Char(buffer[begin:end]) }> */
 		nil,
-		/* 214 Action37 <- <{ p.Nhex(buffer[begin:end]) }> */
+		/* 315 Action112 <- <{ p.Char(buffer[begin:end]) }> */
 		nil,
-		/* 215 Action38 <- <{ p.Nhex(buffer[begin:end]) }> */
+		/* 316 Action113 <- <{ p.NNContents() }> */
 		nil,
-		/* 216 Action39 <- <{ p.Ndec(buffer[begin:end]) }> */
+		/* 317 Action114 <- <{ p.Accum("ADD") }> */
 		nil,
-		/* 217 Action40 <- <{ p.NNLabel(buffer[begin:end]) }> */
+		/* 318 Action115 <- <{ p.Accum("ADC") }> */
 		nil,
-		/* 218 Action41 <- <{ p.NNhex(buffer[begin:end]) }> */
+		/* 319 Action116 <- <{ p.Accum("SUB") }> */
 		nil,
-		/* 219 Action42 <- <{ p.NNContents() }> */
+		/* 320 Action117 <- <{ p.Accum("SBC") }> */
 		nil,
-		/* 220 Action43 <- <{ p.Accum("ADD") }> */
+		/* 321 Action118 <- <{ p.Accum("AND") }> */
 		nil,
-		/* 221 Action44 <- <{ p.Accum("ADC") }> */
+		/* 322 Action119 <- <{ p.Accum("XOR") }> */
 		nil,
-		/* 222 Action45 <- <{ p.Accum("SUB") }> */
+		/* 323 Action120 <- <{ p.Accum("OR") }> */
 		nil,
-		/* 223 Action46 <- <{ p.Accum("SBC") }> */
+		/* 324 Action121 <- <{ p.Accum("CP") }> */
 		nil,
-		/* 224 Action47 <- <{ p.Accum("AND") }> */
+		/* 325 Action122 <- <{ p.Rot("RLC") }> */
 		nil,
-		/* 225 Action48 <- <{ p.Accum("XOR") }> */
+		/* 326 Action123 <- <{ p.Rot("RRC") }> */
 		nil,
-		/* 226 Action49 <- <{ p.Accum("OR") }> */
+		/* 327 Action124 <- <{ p.Rot("RL") }> */
 		nil,
-		/* 227 Action50 <- <{ p.Accum("CP") }> */
+		/* 328 Action125 <- <{ p.Rot("RR") }> */
 		nil,
-		/* 228 Action51 <- <{ p.Rot("RLC") }> */
+		/* 329 Action126 <- <{ p.Rot("SLA") }> */
 		nil,
-		/* 229 Action52 <- <{ p.Rot("RRC") }> */
+		/* 330 Action127 <- <{ p.Rot("SRA") }> */
 		nil,
-		/* 230 Action53 <- <{ p.Rot("RL") }> */
+		/* 331 Action128 <- <{ p.Rot("SLL") }> */
 		nil,
-		/* 231 Action54 <- <{ p.Rot("RR") }> */
+		/* 332 Action129 <- <{ p.Rot("SRL") }> */
 		nil,
-		/* 232 Action55 <- <{ p.Rot("SLA") }> */
+		/* 333 Action130 <- <{ p.Bit() }> */
 		nil,
-		/* 233 Action56 <- <{ p.Rot("SRA") }> */
+		/* 334 Action131 <- <{ p.Res() }> */
 		nil,
-		/* 234 Action57 <- <{ p.Rot("SLL") }> */
+		/* 335 Action132 <- <{ p.Set() }> */
 		nil,
-		/* 235 Action58 <- <{ p.Rot("SRL") }> */
+		/* 336 Action133 <- <{ p.Simple(buffer[begin:end]) }> */
 		nil,
-		/* 236 Action59 <- <{ p.Bit() }> */
+		/* 337 Action134 <- <{ p.Simple(buffer[begin:end]) }> */
 		nil,
-		/* 237 Action60 <- <{ p.Res() }> */
+		/* 338 Action135 <- <{ p.Simple(buffer[begin:end]) }> */
 		nil,
-		/* 238 Action61 <- <{ p.Set() }> */
+		/* 339 Action136 <- <{ p.Simple(buffer[begin:end]) }> */
 		nil,
-		/* 239 Action62 <- <{ p.Simple(buffer[begin:end]) }> */
+		/* 340 Action137 <- <{ p.Simple(buffer[begin:end]) }> */
 		nil,
-		/* 240 Action63 <- <{ p.Simple(buffer[begin:end]) }> */
+		/* 341 Action138 <- <{ p.Simple(buffer[begin:end]) }> */
 		nil,
-		/* 241 Action64 <- <{ p.Simple(buffer[begin:end]) }> */
+		/* 342 Action139 <- <{ p.Simple(buffer[begin:end]) }> */
 		nil,
-		/* 242 Action65 <- <{ p.Simple(buffer[begin:end]) }> */
+		/* 343 Action140 <- <{ p.Simple(buffer[begin:end]) }> */
 		nil,
-		/* 243 Action66 <- <{ p.Simple(buffer[begin:end]) }> */
+		/* 344 Action141 <- <{ p.Simple(buffer[begin:end]) }> */
 		nil,
-		/* 244 Action67 <- <{ p.Simple(buffer[begin:end]) }> */
+		/* 345 Action142 <- <{ p.Simple(buffer[begin:end]) }> */
 		nil,
-		/* 245 Action68 <- <{ p.Simple(buffer[begin:end]) }> */
+		/* 346 Action143 <- <{ p.Simple(buffer[begin:end]) }> */
 		nil,
-		/* 246 Action69 <- <{ p.Simple(buffer[begin:end]) }> */
+		/* 347 Action144 <- <{ p.Simple(buffer[begin:end]) }> */
 		nil,
-		/* 247 Action70 <- <{ p.Simple(buffer[begin:end]) }> */
+		/* 348 Action145 <- <{ p.Simple(buffer[begin:end]) }> */
 		nil,
-		/* 248 Action71 <- <{ p.Simple(buffer[begin:end]) }> */
+		/* 349 Action146 <- <{ p.EDSimple(buffer[begin:end]) }> */
 		nil,
-		/* 249 Action72 <- <{ p.Simple(buffer[begin:end]) }> */
+		/* 350 Action147 <- <{ p.EDSimple(buffer[begin:end]) }> */
 		nil,
-		/* 250 Action73 <- <{ p.Simple(buffer[begin:end]) }> */
+		/* 351 Action148 <- <{ p.EDSimple(buffer[begin:end]) }> */
 		nil,
-		/* 251 Action74 <- <{ p.Simple(buffer[begin:end]) }> */
+		/* 352 Action149 <- <{ p.EDSimple(buffer[begin:end]) }> */
 		nil,
-		/* 252 Action75 <- <{ p.EDSimple(buffer[begin:end]) }> */
+		/* 353 Action150 <- <{ p.EDSimple(buffer[begin:end]) }> */
 		nil,
-		/* 253 Action76 <- <{ p.EDSimple(buffer[begin:end]) }> */
+		/* 354 Action151 <- <{ p.EDSimple(buffer[begin:end]) }> */
 		nil,
-		/* 254 Action77 <- <{ p.EDSimple(buffer[begin:end]) }> */
+		/* 355 Action152 <- <{ p.EDSimple(buffer[begin:end]) }> */
 		nil,
-		/* 255 Action78 <- <{ p.EDSimple(buffer[begin:end]) }> */
+		/* 356 Action153 <- <{ p.EDSimple(buffer[begin:end]) }> */
 		nil,
-		/* 256 Action79 <- <{ p.EDSimple(buffer[begin:end]) }> */
+		/* 357 Action154 <- <{  p.EDSimple(buffer[begin:end]) }> */
 		nil,
-		/* 257 Action80 <- <{ p.EDSimple(buffer[begin:end]) }> */
+		/* 358 Action155 <- <{  p.EDSimple(buffer[begin:end]) }> */
 		nil,
-		/* 258 Action81 <- <{ p.EDSimple(buffer[begin:end]) }> */
+		/* 359 Action156 <- <{  p.EDSimple(buffer[begin:end]) }> */
 		nil,
-		/* 259 Action82 <- <{ p.EDSimple(buffer[begin:end]) }> */
+		/* 360 Action157 <- <{  p.EDSimple(buffer[begin:end]) }> */
 		nil,
-		/* 260 Action83 <- <{  p.EDSimple(buffer[begin:end]) }> */
+		/* 361 Action158 <- <{  p.EDSimple(buffer[begin:end]) }> */
 		nil,
-		/* 261 Action84 <- <{  p.EDSimple(buffer[begin:end]) }> */
+		/* 362 Action159 <- <{  p.EDSimple(buffer[begin:end]) }> */
 		nil,
-		/* 262 Action85 <- <{  p.EDSimple(buffer[begin:end]) }> */
+		/* 363 Action160 <- <{  p.EDSimple(buffer[begin:end]) }> */
 		nil,
-		/* 263 Action86 <- <{  p.EDSimple(buffer[begin:end]) }> */
+		/* 364 Action161 <- <{  p.EDSimple(buffer[begin:end]) }> */
 		nil,
-		/* 264 Action87 <- <{  p.EDSimple(buffer[begin:end]) }> */
+		/* 365 Action162 <- <{  p.EDSimple(buffer[begin:end]) }> */
 		nil,
-		/* 265 Action88 <- <{  p.EDSimple(buffer[begin:end]) }> */
+		/* 366 Action163 <- <{  p.EDSimple(buffer[begin:end]) }> */
 		nil,
-		/* 266 Action89 <- <{  p.EDSimple(buffer[begin:end]) }> */
+		/* 367 Action164 <- <{  p.EDSimple(buffer[begin:end]) }> */
 		nil,
-		/* 267 Action90 <- <{  p.EDSimple(buffer[begin:end]) }> */
+		/* 368 Action165 <- <{  p.EDSimple(buffer[begin:end]) }> */
 		nil,
-		/* 268 Action91 <- <{  p.EDSimple(buffer[begin:end]) }> */
+		/* 369 Action166 <- <{  p.EDSimple(buffer[begin:end]) }> */
 		nil,
-		/* 269 Action92 <- <{  p.EDSimple(buffer[begin:end]) }> */
+		/* 370 Action167 <- <{  p.EDSimple(buffer[begin:end]) }> */
 		nil,
-		/* 270 Action93 <- <{  p.EDSimple(buffer[begin:end]) }> */
+		/* 371 Action168 <- <{  p.EDSimple(buffer[begin:end]) }> */
 		nil,
-		/* 271 Action94 <- <{  p.EDSimple(buffer[begin:end]) }> */
+		/* 372 Action169 <- <{  p.EDSimple(buffer[begin:end]) }> */
 		nil,
-		/* 272 Action95 <- <{  p.EDSimple(buffer[begin:end]) }> */
+		/* 373 Action170 <- <{ p.Rst() }> */
 		nil,
-		/* 273 Action96 <- <{  p.EDSimple(buffer[begin:end]) }> */
+		/* 374 Action171 <- <{ p.Call() }> */
 		nil,
-		/* 274 Action97 <- <{  p.EDSimple(buffer[begin:end]) }> */
+		/* 375 Action172 <- <{ p.Ret() }> */
 		nil,
-		/* 275 Action98 <- <{  p.EDSimple(buffer[begin:end]) }> */
+		/* 376 Action173 <- <{ p.Jp() }> */
 		nil,
-		/* 276 Action99 <- <{ p.Rst() }> */
+		/* 377 Action174 <- <{ p.Jp() }> */
 		nil,
-		/* 277 Action100 <- <{ p.Call() }> */
+		/* 378 Action175 <- <{ p.Jr() }> */
 		nil,
-		/* 278 Action101 <- <{ p.Ret() }> */
+		/* 379 Action176 <- <{ p.Djnz() }> */
 		nil,
-		/* 279 Action102 <- <{ p.Jp() }> */
+		/* 380 Action177 <- <{ p.Src16() }> */
 		nil,
-		/* 280 Action103 <- <{ p.Jr() }> */
+		/* 381 Action178 <- <{ p.R16(buffer[begin:end]) }> */
 		nil,
-		/* 281 Action104 <- <{ p.Djnz() }> */
+		/* 382 Action179 <- <{ p.JrTarget() }> */
 		nil,
-		/* 282 Action105 <- <{ p.In() }> */
+		/* 383 Action180 <- <{ p.In() }> */
 		nil,
-		/* 283 Action106 <- <{ p.Out() }> */
+		/* 384 Action181 <- <{ p.Out() }> */
 		nil,
-		/* 284 Action107 <- <{ p.ODigit(buffer[begin:end]) }> */
+		/* 385 Action182 <- <{ p.ODigit(buffer[begin:end]) }> */
 		nil,
-		/* 285 Action108 <- <{ p.Conditional(Not{FT_Z}) }> */
+		/* 386 Action183 <- <{ p.Conditional(Not{FT_Z}) }> */
 		nil,
-		/* 286 Action109 <- <{ p.Conditional(FT_Z) }> */
+		/* 387 Action184 <- <{ p.Conditional(FT_Z) }> */
 		nil,
-		/* 287 Action110 <- <{ p.Conditional(Not{FT_C}) }> */
+		/* 388 Action185 <- <{ p.Conditional(Not{FT_C}) }> */
 		nil,
-		/* 288 Action111 <- <{ p.Conditional(FT_C) }> */
+		/* 389 Action186 <- <{ p.Conditional(FT_C) }> */
 		nil,
-		/* 289 Action112 <- <{ p.Conditional(FT_PO) }> */
+		/* 390 Action187 <- <{ p.Conditional(FT_PO) }> */
 		nil,
-		/* 290 Action113 <- <{ p.Conditional(FT_PE) }> */
+		/* 391 Action188 <- <{ p.Conditional(FT_PE) }> */
 		nil,
-		/* 291 Action114 <- <{ p.Conditional(FT_P) }> */
+		/* 392 Action189 <- <{ p.Conditional(FT_P) }> */
 		nil,
-		/* 292 Action115 <- <{ p.Conditional(FT_M) }> */
+		/* 393 Action190 <- <{ p.Conditional(FT_M) }> */
 		nil,
 	}
 	p.rules = _rules
@@ -3,7 +3,9 @@ package zog
 import (
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
+	"os"
 	"strconv"
 	"strings"
 	"time"
@@ -11,6 +13,7 @@ import (
 
 type Zog struct {
 	Mem *Memory
+	IO  *IOBus
 	reg Registers
 
 	/* In the Z80 CPU, there is
@@ -21,17 +24,53 @@ type Zog struct {
 	is InterruptState
 
 	interruptCh chan byte
+	// An interrupt raised but not yet taken, from a restored state
+	intPending bool
+	intMode    byte
+	// Funcs to run between instructions
+	doCh chan func()
+	// Sent on to stop Run
+	stopCh           chan struct{}
+	interruptSources []InterruptSource
+	// Set by EI, as no interrupt is taken until the instruction after it
+	// has run
+	eiDelay bool
+
+	// Ports claimed through the older per-port handler API
+	outputHandlers map[uint16]bool
+	inputHandler   bool
+
+	// Nil unless something wants traces
+	tracer *Tracer
+	// The record for the instruction being executed
+	traceRec *TraceRecord
+	// Every access goes in the record, not just those to watched addresses
+	traceAll bool
+	// How many records to print on halt
+	haltTraces int
+	// Names for addresses in traces
+	symbols *SymbolTable
+
+	// Fetches instructions from memory at PC
+	decoder *Decoder
+	// Speed to run at, or 0 to run flat out
+	clockHz int
+
+	// Instructions executed, and whether HALT is waiting for an interrupt
+	ops     uint64
+	tstates uint64
+	halted  bool
+	// Nil unless going back in time is enabled
+	rewind *Rewind
+	// Nil unless input is being recorded or played back
+	input *InputLog
+	// Nil unless drawing a heatmap of memory accesses
+	heatmap *Heatmap
+	// Nil unless profiling calls
+	profiler *Profiler
 
-	outputHandlers map[uint16]func(n byte)
-	inputHandler   func(uint16) byte
-
-	traces Regions
-
-	eTrace executeTrace
-
-	numRecentTraces   int
-	indexRecentTraces int
-	recentTraces      []executeTrace
+	// Called when the user presses a hotkey on a machine's keyboard
+	hotkeyFunc func(Hotkey)
 }
 
 type InterruptState struct {
@@ -40,49 +79,38 @@ type InterruptState struct {
 	Mode byte
 }
 
-type locWatch struct {
-	new byte
-	old byte
-}
-
-type executeTrace struct {
-	ops     uint64
-	pc      uint16
-	inst    Instruction
-	reg     Registers
-	watches map[uint16]locWatch
-}
-
-func (et *executeTrace) String() string {
-	s := fmt.Sprintf("%d: %04X %s %s", et.ops, et.pc, et.reg.Summary(), et.inst)
-	for addr, lw := range et.watches {
-		s += fmt.Sprintf(" W:%04X [%02X->%02X]", addr, lw.old, lw.new)
-	}
-	return s
-}
-
 func New(memSize uint16) *Zog {
 	is := InterruptState{
 		Mode: 1,
 	}
 	z := &Zog{
 		Mem:            NewMemory(memSize),
-		outputHandlers: make(map[uint16]func(n byte)),
+		IO:             NewIOBus(),
+		outputHandlers: make(map[uint16]bool),
 		interruptCh:    make(chan byte),
+		stopCh:         make(chan struct{}, 1),
+		doCh:           make(chan func(), 16),
 		is:             is,
+		clockHz:        4000000,
 	}
+	z.decoder = NewDecoder(z, 0)
 	z.Clear()
 	return z
 }
 
 type Region struct {
 	start uint16
-	end   uint16
+	// Exclusive, int so that a region can run to the top of memory
+	end int
 }
 type Regions []Region
 
 func NewRegion(start, end uint16) Region {
-	return Region{start: start, end: end}
+	return Region{start: start, end: int(end)}
+}
+
+func NewRegionSize(start uint16, size int) Region {
+	return Region{start: start, end: int(start) + size}
 }
 
 func ParseRegions(s string) (Regions, error) {
@@ -130,26 +158,76 @@ func (rs Regions) String() string {
 }
 
 func (r *Region) contains(addr uint16) bool {
-	return r.start <= addr && addr < r.end
+	return r.start <= addr && int(addr) < r.end
 }
 
 func (r Region) String() string {
 	return fmt.Sprintf("%04X-%04X", r.start, r.end)
 }
 
+// TraceRegions prints each instruction executed in the regions
 func (z *Zog) TraceRegions(regions Regions) error {
-	z.traces.add(regions)
+	if len(regions) > 0 {
+		z.AddTraceSink(NewTextTraceSink(os.Stderr, z.symbols), regions.Filter())
+	}
 	return nil
 }
 
+// AddTraceSink sends a trace of each instruction the filter passes to the
+// sink. A nil filter passes everything.
+func (z *Zog) AddTraceSink(sink TraceSink, filter TraceFilter) {
+	z.traceRing(1).AddSink(sink, filter)
+}
+
+// RecordTrace writes a binary trace of every instruction, with all the
+// memory reads and writes each makes
+func (z *Zog) RecordTrace(w io.Writer) error {
+	sink, err := NewBinaryTraceSink(w)
+	if err != nil {
+		return err
+	}
+	z.traceAll = true
+	z.Mem.SetWatchFunc(z.memWatchSeen)
+	z.Mem.SetReadWatchFunc(z.memReadSeen)
+	z.Mem.SetDebug(true)
+	z.AddTraceSink(sink, nil)
+	return nil
+}
+
+// traceRing turns tracing on, keeping at least the last n records
+func (z *Zog) traceRing(n int) *Tracer {
+	if z.tracer == nil {
+		z.tracer = NewTracer(n)
+	}
+	z.tracer.grow(n)
+	return z.tracer
+}
+
+// WatchRegions puts reads and writes to the regions in traces
 func (z *Zog) WatchRegions(regions Regions) error {
 	z.Mem.SetWatchFunc(z.memWatchSeen)
+	z.Mem.SetReadWatchFunc(z.memReadSeen)
 	z.Mem.watches.add(regions)
 	return nil
 }
 
+// SetSymbols names addresses in traces
+func (z *Zog) SetSymbols(st *SymbolTable) {
+	z.symbols = st
+}
+
+// SetClockHz sets the speed the CPU runs at. 0 runs it as fast as the host
+// can manage.
+func (z *Zog) SetClockHz(hz int) {
+	z.clockHz = hz
+}
+
+// TraceOnHalt prints the last few instructions when execution stops
 func (z *Zog) TraceOnHalt(numHaltTraces int) {
-	z.numRecentTraces = numHaltTraces
+	z.haltTraces = numHaltTraces
+	if numHaltTraces > 0 {
+		z.traceRing(numHaltTraces)
+	}
 }
 
 func (z *Zog) State() string {
@@ -190,9 +268,9 @@ func (z *Zog) LoadFile(addr uint16, fname string, readonly bool) error {
 		return fmt.Errorf("Can't load file [%s]: %s", fname, err)
 	}
 	if readonly {
-		roRegion := NewRegion(addr, uint16(len(buf)))
+		roRegion := NewRegionSize(addr, len(buf))
 		fmt.Printf("Add RO region %s\n", roRegion)
-		z.Mem.readonly = append(z.Mem.readonly, roRegion)
+		z.Mem.AddReadOnly(roRegion)
 	}
 	return z.LoadBytes(addr, buf)
 }
@@ -209,6 +287,10 @@ func (z *Zog) LoadInterruptState(is InterruptState) {
 	z.is = is
 }
 
+func (z *Zog) GetInterruptState() InterruptState {
+	return z.is
+}
+
 func (z *Zog) LoadBytes(addr uint16, buf []byte) error {
 	err := z.Mem.Copy(addr, buf)
 	if err != nil {
@@ -235,21 +317,24 @@ func (z *Zog) Clear() {
 	z.is.IFF2 = false
 }
 
+// RegisterOutputHandler is shorthand for attaching a write-only device
+// which decodes all 16 bits of addr.
 func (z *Zog) RegisterOutputHandler(addr uint16, handler func(n byte)) error {
-	_, ok := z.outputHandlers[addr]
-	if ok {
+	if z.outputHandlers[addr] {
 		return fmt.Errorf("Addr [%04X] already has an output handler", addr)
 	}
-	z.outputHandlers[addr] = handler
-	return nil
+	z.outputHandlers[addr] = true
+	return z.IO.Attach(0xffff, addr, IOFuncs{OutFunc: func(port uint16, n byte) { handler(n) }})
 }
 
+// RegisterInputHandler is shorthand for attaching a read-only device
+// which sees every port.
 func (z *Zog) RegisterInputHandler(handler func(uint16) byte) error {
-	if z.inputHandler != nil {
+	if z.inputHandler {
 		return errors.New("Input handler already registered")
 	}
-	z.inputHandler = handler
-	return nil
+	z.inputHandler = true
+	return z.IO.Attach(0, 0, IOFuncs{InFunc: handler})
 }
 
 // F flag register:
@@ -335,18 +420,44 @@ func (z *Zog) FlagString() string {
 
 var ErrHalted = errors.New("HALT called")
 
-// Implement io.Reader
-func (z *Zog) Read(buf []byte) (int, error) {
-	if len(buf) != 1 {
-		panic("Non-byte read")
+// ErrStopped is returned by Run after Stop
+var ErrStopped = errors.New("Stopped")
+
+// Stop makes Run return before the next instruction, for example so that
+// traces are written out when the user gives up on a hung program. Run
+// can be called again afterwards to carry on.
+func (z *Zog) Stop() {
+	select {
+	case z.stopCh <- struct{}{}:
+	default:
 	}
-	n, err := z.Mem.Peek(z.reg.PC)
+}
+
+// Ops is the number of instructions executed, counting interrupts taken
+func (z *Zog) Ops() uint64 {
+	return z.ops
+}
+
+// TStates is the number of t-states taken by the instructions executed
+func (z *Zog) TStates() uint64 {
+	return z.tstates
+}
+
+// Do runs f between instructions, on the goroutine calling Run, so that f
+// can safely look at or change the Zog while it's running. It doesn't
+// wait for f, which runs once Run is next executing.
+func (z *Zog) Do(f func()) {
+	z.doCh <- f
+}
+
+// ReadByte fetches the byte at PC, for the decoder
+func (z *Zog) ReadByte() (byte, error) {
+	n, err := z.Mem.fetch(z.reg.PC)
 	if err != nil {
 		return 0, fmt.Errorf("Error reading: %s", err)
 	}
 	z.reg.PC++
-	buf[0] = n
-	return 1, nil
+	return n, nil
 }
 
 func (z *Zog) jp(addr uint16) {
@@ -368,6 +479,7 @@ func (z *Zog) di() error {
 func (z *Zog) ei() error {
 	z.is.IFF1 = true
 	z.is.IFF2 = true
+	z.eiDelay = true
 	return nil
 }
 
@@ -403,32 +515,39 @@ func (z *Zog) pop() uint16 {
 }
 
 func (z *Zog) out(port uint16, n byte) {
-	//	fmt.Printf("OUT: [%04X] %02X\n", port, n)
-	handler, ok := z.outputHandlers[port]
-	if ok {
-		handler(n)
+	if z.replaying() {
+		// Devices have already seen it
+		return
 	}
+	//	fmt.Printf("OUT: [%04X] %02X\n", port, n)
+	z.IO.Out(port, n)
 }
 
 func (z *Zog) in(port uint16) byte {
-	if z.inputHandler != nil {
-		return z.inputHandler(port)
+	if z.replaying() {
+		return z.rewind.replayIn(port)
 	}
-	return 0xff
-}
-
-func (z *Zog) addRecentTrace(et executeTrace) {
-	if z.numRecentTraces <= 0 {
-		panic("wtf")
+	var n byte
+	if z.input != nil {
+		n = z.input.in(port)
+	} else {
+		n = z.IO.In(port)
 	}
-	if len(z.recentTraces) < z.numRecentTraces {
-		z.recentTraces = append(z.recentTraces, et)
-		return
+	if z.rewind != nil {
+		z.rewind.logIn(port, n)
 	}
-	z.recentTraces[z.indexRecentTraces] = et
-	z.indexRecentTraces++
-	z.indexRecentTraces = z.indexRecentTraces % z.numRecentTraces
-	return
+	return n
+}
+
+// replaying is whether the Zog is going forward again after going back
+func (z *Zog) replaying() bool {
+	return z.rewind != nil && z.rewind.replay
+}
+
+// playingInput is whether recorded input is being played back, when the
+// recording says when interrupts happen
+func (z *Zog) playingInput() bool {
+	return z.input != nil && z.input.playing
 }
 
 func (z *Zog) DoInterrupt() {
@@ -438,23 +557,108 @@ func (z *Zog) DoInterrupt() {
 	z.interruptCh <- z.is.Mode
 }
 
-func (z *Zog) getInstruction(halted bool) (Instruction, error) {
-	// Check for interrupt
-	imMode := byte(0)
-	select {
-	case imMode = <-z.interruptCh:
-		return z.processInterrupt(imMode)
-	default:
+// InterruptSource is a device which can hold the /INT line low, such as a
+// UART. Sources are polled before each instruction while interrupts are
+// enabled, other than the one after EI, and the vector is what the device
+// puts on the data bus in IM 2.
+type InterruptSource interface {
+	InterruptPending() (pending bool, vector byte)
+}
+
+func (z *Zog) AddInterruptSource(src InterruptSource) {
+	z.interruptSources = append(z.interruptSources, src)
+}
+
+func (z *Zog) pendingSource() (bool, byte) {
+	if !z.InterruptEnabled() || z.eiDelay || z.playingInput() {
+		return false, 0
+	}
+	for _, src := range z.interruptSources {
+		pending, vector := src.InterruptPending()
+		if pending {
+			return true, vector
+		}
 	}
+	return false, 0
+}
 
-	if !halted {
-		return DecodeOne(z)
+// getInstruction fetches the instruction at PC, or the one an interrupt
+// runs, which has no bytes
+func (z *Zog) getInstruction() (Decoded, error) {
+	for {
+		if z.replaying() {
+			return z.rewind.replayInstruction()
+		}
+		if z.intPending {
+			z.intPending = false
+			return z.interrupt(z.intMode, 0)
+		}
+		if z.playingInput() && z.input.frameEnded() {
+			if z.input.nextFrame() && z.InterruptEnabled() {
+				return z.interrupt(z.is.Mode, 0)
+			}
+			continue
+		}
+		// Check for interrupt
+		select {
+		case imMode := <-z.interruptCh:
+			if z.playingInput() {
+				continue
+			}
+			return z.interrupt(imMode, 0)
+		case <-z.stopCh:
+			return Decoded{}, ErrStopped
+		case f := <-z.doCh:
+			f()
+			continue
+		default:
+		}
+		pending, vector := z.pendingSource()
+		if pending {
+			return z.interrupt(z.is.Mode, vector)
+		}
+
+		if !z.halted {
+			z.decoder.SetAddr(z.reg.PC)
+			return z.decoder.Decode()
+		}
+		if len(z.interruptSources) == 0 {
+			select {
+			case imMode := <-z.interruptCh:
+				return z.interrupt(imMode, 0)
+			case <-z.stopCh:
+				return Decoded{}, ErrStopped
+			case f := <-z.doCh:
+				f()
+			}
+			continue
+		}
+		// Halted with devices attached, wait a little and poll them again
+		select {
+		case imMode := <-z.interruptCh:
+			return z.interrupt(imMode, 0)
+		case <-z.stopCh:
+			return Decoded{}, ErrStopped
+		case f := <-z.doCh:
+			f()
+		case <-time.After(time.Millisecond):
+		}
 	}
-	imMode = <-z.interruptCh
-	return z.processInterrupt(imMode)
 }
 
-func (z *Zog) processInterrupt(imMode byte) (Instruction, error) {
+func (z *Zog) interrupt(imMode byte, vector byte) (Decoded, error) {
+	addr := z.reg.PC
+	if z.rewind != nil {
+		z.rewind.interrupted(imMode, vector)
+	}
+	if z.input != nil && !z.replaying() {
+		z.input.interrupted()
+	}
+	inst, err := z.processInterrupt(imMode, vector)
+	return Decoded{Inst: inst, Addr: addr}, err
+}
+
+func (z *Zog) processInterrupt(imMode byte, vector byte) (Instruction, error) {
 	z.di()
 	switch imMode {
 	case 0:
@@ -463,9 +667,14 @@ func (z *Zog) processInterrupt(imMode byte) (Instruction, error) {
 		// We need to do RST 38h
 		return &RST{0x38}, nil
 	case 2:
-		// We get LSB from data bus (we choose 0) and MSG from I reg
-		addr := uint16(z.reg.I) << 8
-		return NewCALL(True, Imm16(addr)), nil
+		// We get LSB from data bus and MSB from I reg, and call
+		// through the table entry there
+		addr := uint16(z.reg.I)<<8 | uint16(vector)
+		target, err := z.Mem.Peek16(addr)
+		if err != nil {
+			return nil, fmt.Errorf("Can't read IM2 vector at [%04X]: %s", addr, err)
+		}
+		return NewCALL(True, Imm16(target)), nil
 	default:
 		return nil, fmt.Errorf("Unknown interrupt mode: %d", imMode)
 	}
@@ -477,16 +686,15 @@ func (z *Zog) execute(addr uint16) (errRet error) {
 }
 
 func (z *Zog) Run() (errRet error) {
-	ops := uint64(0)
-	TStates := uint64(0)
-	lastOps := uint64(0)
-	lastTStates := uint64(0)
+	lastOps := z.ops
+	lastTStates := z.tstates
 	statsEvery := uint64(1000000)
 	startTime := time.Now()
 	lastEmit := startTime
 
 	var err error
-	var inst Instruction
+	var waitTStates int
+	var before time.Time
 
 	defer func() {
 		if r := recover(); r != nil {
@@ -497,55 +705,31 @@ func (z *Zog) Run() (errRet error) {
 				errRet = fmt.Errorf("PANIC: %v", v)
 			}
 		}
-		if z.numRecentTraces > 0 {
-			for i := range z.recentTraces {
-				fmt.Printf("%s\n", z.recentTraces[(i+z.indexRecentTraces)%z.numRecentTraces].String())
+		if z.tracer != nil {
+			if z.haltTraces > 0 {
+				z.tracer.Recent(z.haltTraces, NewTextTraceSink(os.Stdout, z.symbols))
+			}
+			err := z.tracer.Flush()
+			if err != nil && errRet == nil {
+				errRet = err
 			}
 		}
 	}()
 
-	halted := false
-
-	clockHz := 4000000
-	tStateDuration := time.Second / time.Duration(clockHz)
-
 EXECUTING:
 	for {
 
-		before := time.Now()
+		if z.clockHz != 0 {
+			before = time.Now()
+		}
 
 		lastPC := z.reg.PC
-		// May be from PC, or may be interrupt
-		inst, err = z.getInstruction(halted)
-		halted = false
+		waitTStates, err = z.step()
 		if err != nil {
-			fmt.Printf("Error decoding: %s\n", err)
-			break EXECUTING
-		}
-
-		waitTStates := inst.TStates(z)
-
-		//		fmt.Printf("I: %04X %s\n", lastPC, inst)
-		instErr := inst.Execute(z)
-		z.eTrace = executeTrace{ops: ops, pc: lastPC, reg: z.reg, inst: inst, watches: make(map[uint16]locWatch)}
-		if z.traces.contains(lastPC) {
-			println(z.eTrace.String())
-		}
-		if z.numRecentTraces > 0 {
-			z.addRecentTrace(z.eTrace)
-		}
-		if instErr != nil {
-			if instErr == ErrHalted && z.InterruptEnabled() {
-				halted = true
-				continue EXECUTING
-			}
-
 			// Error handling after the loop
-			err = instErr
 			break EXECUTING
 		}
-		ops++
-		TStates += uint64(waitTStates)
+		ops, TStates := z.ops, z.tstates
 		if ops%statsEvery == 0 {
 			now := time.Now()
 			dur := now.Sub(lastEmit)
@@ -558,10 +742,10 @@ EXECUTING:
 			lastTStates = TStates
 		}
 
-		//		if waitTStates > 30 {
-		//			println(waitTStates, " ", z.eTrace.String())
-		//		}
-		waitDuration := time.Duration(waitTStates) * tStateDuration
+		if z.clockHz == 0 {
+			continue
+		}
+		waitDuration := time.Duration(waitTStates) * (time.Second / time.Duration(z.clockHz))
 		// Busy wait - can't get time.Sleep or syscall.Nanosleep to give me good enough granularity
 		waitUntil := before.Add(waitDuration)
 		for time.Now().Before(waitUntil) {
@@ -572,12 +756,100 @@ EXECUTING:
 	if err == ErrHalted {
 		return nil
 	}
+	if err == ErrStopped {
+		return err
+	}
 	if err == nil {
 		return errors.New("Execute returned nil error")
 	}
 	return fmt.Errorf("Failed to execute: %s", err)
 }
 
+// step fetches and executes one instruction, or takes an interrupt, and
+// gives the t-states it took. After HALT the next step waits for an
+// interrupt, unless interrupts are disabled, when HALT gives ErrHalted.
+func (z *Zog) step() (int, error) {
+	if z.rewind != nil {
+		z.rewind.beforeStep()
+	}
+	// May be from PC, or may be interrupt
+	decoded, err := z.getInstruction()
+	if err == ErrStopped {
+		return 0, err
+	}
+	if err != nil {
+		return 0, fmt.Errorf("Error decoding: %s", err)
+	}
+	z.eiDelay = false
+	inst := decoded.Inst
+	if z.input != nil && decoded.Len > 0 && !z.replaying() {
+		z.input.fetches += m1Fetches(decoded.Bytes)
+	}
+
+	waitTStates := inst.TStates(z)
+	z.tstates += uint64(waitTStates)
+
+	var rec *TraceRecord
+	if z.tracer != nil && !z.replaying() {
+		rec = z.tracer.start()
+		rec.Ops = z.ops
+		rec.PC = decoded.Addr
+		rec.Inst = inst
+		if decoded.Len == 0 {
+			rec.Interrupt = true
+			rec.setBytes(inst.Encode())
+		} else {
+			rec.setBytes(decoded.Bytes)
+		}
+		// Watches seen while executing belong to this record
+		z.traceRec = rec
+	}
+
+	//		fmt.Printf("I: %04X %s\n", decoded.Addr, inst)
+	sp := z.reg.SP
+	instErr := inst.Execute(z)
+	z.ops++
+	z.halted = false
+	if instErr == ErrHalted && z.InterruptEnabled() {
+		z.halted = true
+		instErr = nil
+	}
+
+	if rec != nil {
+		z.traceRec = nil
+		rec.Reg = z.reg
+		err = z.tracer.finish(rec)
+		if err != nil && instErr == nil {
+			instErr = err
+		}
+	}
+	if z.profiler != nil && !z.replaying() {
+		z.profiler.step(decoded.Addr, inst, sp, waitTStates)
+	}
+	if z.heatmap != nil && !z.replaying() {
+		z.heatmap.execute(decoded.Addr, decoded.Len)
+		err = z.heatmap.tick(z.tstates)
+		if err != nil && instErr == nil {
+			instErr = err
+		}
+	}
+	return waitTStates, instErr
+}
+
 func (z *Zog) memWatchSeen(addr uint16, old byte, new byte) {
-	z.eTrace.watches[addr] = locWatch{old: old, new: new}
+	if z.traceRec != nil && (z.traceAll || z.Mem.watches.contains(addr)) {
+		z.traceRec.addAccess(addr, old, new, false)
+	}
+	if z.heatmap != nil && !z.replaying() {
+		z.heatmap.write(addr)
+	}
+}
+
+func (z *Zog) memReadSeen(addr uint16, n byte) {
+	if z.traceRec != nil && (z.traceAll || z.Mem.watches.contains(addr)) {
+		z.traceRec.addAccess(addr, n, n, true)
+	}
+	if z.heatmap != nil && !z.replaying() {
+		z.heatmap.read(addr)
+	}
 }
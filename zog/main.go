@@ -6,12 +6,17 @@ import (
 	"io/ioutil"
 	"log"
 	"os"
+	"os/signal"
 	"runtime/pprof"
+	"strconv"
+	"strings"
 
 	"github.com/jbert/zog"
+	"github.com/jbert/zog/console"
 	"github.com/jbert/zog/cpm"
 	"github.com/jbert/zog/file"
 	"github.com/jbert/zog/repl"
+	"github.com/jbert/zog/sbc"
 	"github.com/jbert/zog/speccy"
 )
 
@@ -21,9 +26,27 @@ func main() {
 	watch := flag.String("watch", "", "Watch addresses: start-end,s2-e2")
 	haltstate := flag.Bool("haltstate", false, "Print state on halt")
 	numhalttrace := flag.Int("halttrace", 0, "Number of traces to print on halt")
-	machineName := flag.String("machine", "none", "Machine for console printer (none, cpm, spectrum)")
+	machineName := flag.String("machine", "none", "Machine for console printer (none, cpm, spectrum, repl, console)")
+	machineFile := flag.String("machine-file", "", "JSON machine description `file` (overrides -machine)")
+	termName := flag.String("term", "ansi", "Terminal emulation for console machine (ansi, vt52, adm3a)")
 	imageFname := flag.String("image", "", "Name of image file (.z80 supported)")
 	quiet := flag.Bool("quiet", false, "Suppress messages")
+	recordTrace := flag.String("record-trace", "", "Write a binary trace of every instruction to `file`, for zogtrace")
+	mhz := flag.Float64("mhz", 4, "CPU clock speed in MHz, 0 to run as fast as possible")
+	rewindEvery := flag.Uint64("rewind", 0, "Snapshot every `n` instructions so a stopped run can go back, 0 for none")
+	backToWrite := flag.String("back-to-write", "", "When the run stops, go back to before the last write to hex `addr`ess and print the state (needs -rewind)")
+	stateFile := flag.String("state-file", "zog.state", "State `file` for the save and load hotkeys (^] s and ^] l on a console, F2 and F3 on a spectrum)")
+	loadState := flag.String("load-state", "", "Start from a saved state `file` rather than a program")
+	saveState := flag.String("save-state", "", "Save the machine's state to `file` when the run stops")
+	recordInput := flag.String("record-input", "", "Record keyboard and tape input to an RZX `file`, to play back with -play-input")
+	playInput := flag.String("play-input", "", "Start from the snapshot in an RZX `file` and play back its input")
+	heatmapFile := flag.String("heatmap", "", "Draw a heatmap of memory reads (red), writes (green) and execution (blue) to a .gif `file`, or PNG files named with a pattern like heat%05d.png")
+	heatmapTStates := flag.Uint64("heatmap-tstates", 69888, "T-states in each heatmap frame")
+	heatmapEvery := flag.Int("heatmap-every", 1, "Keep one in `n` heatmap frames in a .gif")
+	heatmapWindow := flag.Bool("heatmap-window", false, "Show a heatmap of memory in a window (spectrum only)")
+	profile := flag.String("profile", "", "Profile calls into routines, writing a profile for go tool pprof to `file`")
+	profileTop := flag.Int("profile-top", 0, "Profile calls into routines, printing the `n` which took longest when the run stops")
+	symbols := flag.String("symbols", "", "Name addresses in traces from symbol `files`, comma separated. spectrum48k and cpm are built in.")
 
 	flag.Parse()
 
@@ -40,20 +63,108 @@ func main() {
 
 	z := zog.New(0)
 	z.TraceOnHalt(*numhalttrace)
+	z.SetClockHz(int(*mhz * 1000000))
+	if *symbols != "" {
+		st, err := zog.LoadSymbols(*symbols)
+		if err != nil {
+			log.Fatalf("Bad symbols: %s", err)
+		}
+		z.SetSymbols(st)
+	}
+
+	if *recordTrace != "" {
+		f, err := os.Create(*recordTrace)
+		if err != nil {
+			log.Fatalf("Can't create trace file: %s", err)
+		}
+		defer f.Close()
+		err = z.RecordTrace(f)
+		if err != nil {
+			log.Fatalf("Can't record trace: %s", err)
+		}
+	}
+
+	var rewind *zog.Rewind
+	if *rewindEvery > 0 {
+		rewind = z.EnableRewind(*rewindEvery, rewindKeep)
+	}
+	var writeAddr uint16
+	if *backToWrite != "" {
+		if rewind == nil {
+			log.Fatalf("-back-to-write needs -rewind")
+		}
+		addr, err := strconv.ParseUint(*backToWrite, 16, 16)
+		if err != nil {
+			log.Fatalf("Bad address [%s]: %s", *backToWrite, err)
+		}
+		writeAddr = uint16(addr)
+	}
 
 	var machine zog.Machine
 
+	if *machineFile != "" {
+		*machineName = "file"
+	}
+
 	switch *machineName {
+	case "file":
+		desc, err := sbc.ReadDescription(*machineFile)
+		if err != nil {
+			log.Fatal(err)
+		}
+		machine = sbc.NewMachine(z, desc)
 	case "cpm":
 		machine = cpm.NewMachine(z)
 	case "spectrum", "speccy":
 		machine = speccy.NewMachine(z)
 	case "repl":
 		machine = repl.NewMachine(z)
+	case "console":
+		emu, err := console.ParseEmulation(*termName)
+		if err != nil {
+			log.Fatal(err)
+		}
+		machine = console.NewMachine(z, emu)
 	default:
 		panic("Specify a machine type")
 	}
 
+	var heatmap *zog.Heatmap
+	var heatmapGIF *zog.GIFHeatmapSink
+	if *heatmapFile != "" || *heatmapWindow {
+		heatmap = z.EnableHeatmap(*heatmapTStates)
+	}
+	if strings.HasSuffix(strings.ToLower(*heatmapFile), ".gif") {
+		f, err := os.Create(*heatmapFile)
+		if err != nil {
+			log.Fatalf("Can't create heatmap file: %s", err)
+		}
+		defer f.Close()
+		// In hundredths of a second
+		delay := 2
+		if *mhz != 0 {
+			delay = int(float64(*heatmapTStates) * float64(*heatmapEvery) / (*mhz * 10000))
+		}
+		if delay < 1 {
+			delay = 1
+		}
+		heatmapGIF = zog.NewGIFHeatmapSink(f, *heatmapEvery, delay)
+		heatmap.AddSink(heatmapGIF)
+	} else if *heatmapFile != "" {
+		heatmap.AddSink(zog.NewPNGHeatmapSink(*heatmapFile))
+	}
+	if *heatmapWindow {
+		sm, ok := machine.(*speccy.Machine)
+		if !ok {
+			log.Fatalf("-heatmap-window needs -machine spectrum")
+		}
+		hw, err := sm.ShowHeatmap()
+		if err != nil {
+			log.Fatalf("Can't show heatmap: %s", err)
+		}
+		heatmap.AddSink(hw)
+	}
+
 	if !*quiet {
 		fmt.Printf("Loading %s\n", machine.Name())
 	}
@@ -61,6 +172,40 @@ func main() {
 	if err != nil {
 		log.Fatalf("Failed to load machine %s: %s", machine.Name(), err)
 	}
+	defer machine.Stop()
+
+	z.SetHotkeyFunc(func(hk zog.Hotkey) {
+		// Keyboards may be read from Run, so mustn't wait
+		z.Do(func() {
+			var err error
+			switch hk {
+			case zog.HotkeySaveState:
+				err = zog.SaveMachineFile(machine, *stateFile)
+			case zog.HotkeyLoadState:
+				err = zog.LoadMachineFile(machine, *stateFile)
+			}
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "%s\n", err)
+			}
+		})
+	})
+
+	profiling := *profile != "" || *profileTop > 0
+
+	// Give the machine a chance to tidy up (e.g. terminal modes) on ^C
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, os.Interrupt)
+	go func() {
+		<-sigCh
+		if *recordTrace != "" || rewind != nil || *saveState != "" || *recordInput != "" || *heatmapFile != "" || profiling {
+			// Stop running so that the trace, state, input, heatmap or profile is written out,
+			// or to go back, unless asked again
+			z.Stop()
+			<-sigCh
+		}
+		machine.Stop()
+		os.Exit(1)
+	}()
 
 	regions, err := zog.ParseRegions(*trace)
 	if err != nil {
@@ -82,9 +227,53 @@ func main() {
 		log.Fatalf("Can't add watches [%s]: %s", err)
 	}
 
+	var profiler *zog.Profiler
+	if profiling {
+		// Start in the program, once it's loaded
+		z.Do(func() {
+			profiler = z.EnableProfiler()
+		})
+	}
+
+	var rzx *file.RZX
+	var input *zog.InputLog
+	if *recordInput != "" {
+		// Start recording once the program is loaded
+		z.Do(func() {
+			var err error
+			rzx, err = file.NewRZX(machine, z)
+			if err != nil {
+				log.Fatalf("Can't snapshot to record input: %s", err)
+			}
+			input = z.RecordInput()
+		})
+	}
+
 	var runErr error
 
-	if *imageFname != "" {
+	if *playInput != "" {
+		f, err := os.Open(*playInput)
+		if err != nil {
+			log.Fatalf("Can't open input recording: %s", err)
+		}
+		rzx, err = file.ReadRZX(f)
+		f.Close()
+		if err != nil {
+			log.Fatalf("Can't read input recording: %s", err)
+		}
+		err = rzx.Restore(machine, z)
+		if err != nil {
+			log.Fatalf("Can't restore input recording snapshot: %s", err)
+		}
+		input = z.PlayInput(rzx.Frames)
+		runErr = z.Run()
+	} else if *loadState != "" {
+		err = zog.LoadMachineFile(machine, *loadState)
+		if err != nil {
+			log.Fatalf("Can't load state: %s", err)
+		}
+		runErr = z.Run()
+	} else if *imageFname != "" {
 		h := file.Z80header{}
 		f, err := os.Open(*imageFname)
 		if err != nil {
@@ -106,15 +295,17 @@ func main() {
 		runErr = z.Run()
 	} else {
 
-		if flag.NArg() < 1 {
+		// A machine file can boot from ROM, with no program to load
+		var buf []byte
+		if flag.NArg() >= 1 {
+			fname := flag.Arg(0)
+			buf, err = ioutil.ReadFile(fname)
+			if err != nil {
+				log.Fatalf("Failed to open file [%s] : %s\n", fname, err)
+			}
+		} else if *machineFile == "" {
 			usage("Missing filename")
 		}
-		fname := flag.Arg(0)
-
-		buf, err := ioutil.ReadFile(fname)
-		if err != nil {
-			log.Fatalf("Failed to open file [%s] : %s\n", fname, err)
-		}
 
 		runErr = z.RunBytes(machine.LoadAddr(), buf, machine.RunAddr())
 		if err != nil {
@@ -126,11 +317,93 @@ func main() {
 		fmt.Printf("ERR: %s\n", runErr)
 	}
 
+	if *playInput != "" {
+		fmt.Printf("Played %d of %d frames of input\n", input.Frame(), len(rzx.Frames))
+		if input.Err() != nil {
+			fmt.Printf("Playback went wrong: %s\n", input.Err())
+		}
+	}
+
+	if *recordInput != "" && input != nil {
+		rzx.Frames = input.Frames()
+		err := writeRZX(*recordInput, rzx)
+		if err != nil {
+			fmt.Printf("Can't write input recording: %s\n", err)
+		}
+	}
+
+	if *heatmapFile != "" {
+		// The last frame, however short
+		err := heatmap.Frame()
+		if err == nil && heatmapGIF != nil {
+			err = heatmapGIF.Close()
+		}
+		if err != nil {
+			fmt.Printf("Can't write heatmap: %s\n", err)
+		}
+	}
+
+	if *profile != "" && profiler != nil {
+		err := writeProfile(*profile, profiler)
+		if err != nil {
+			fmt.Printf("Can't write profile: %s\n", err)
+		}
+	}
+	if *profileTop > 0 && profiler != nil {
+		profiler.WriteReport(os.Stdout, *profileTop)
+	}
+
+	if *saveState != "" {
+		err := zog.SaveMachineFile(machine, *saveState)
+		if err != nil {
+			fmt.Printf("Can't save state: %s\n", err)
+		}
+	}
+
+	if *backToWrite != "" {
+		err := rewind.RunBackToWrite(writeAddr)
+		if err != nil {
+			fmt.Printf("Can't go back: %s\n", err)
+		} else {
+			fmt.Printf("Last write to %04X is instruction %d: %s\n", writeAddr, z.Ops(), z.State())
+		}
+	}
+
 	if *haltstate {
 		fmt.Printf("STATE: %s\n", z.State())
 	}
 }
 
+func writeProfile(fname string, p *zog.Profiler) error {
+	f, err := os.Create(fname)
+	if err != nil {
+		return err
+	}
+	err = p.WritePprof(f)
+	if err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func writeRZX(fname string, x *file.RZX) error {
+	f, err := os.Create(fname)
+	if err != nil {
+		return err
+	}
+	err = file.WriteRZX(f, x)
+	if err != nil {
+		f.Close()
+		os.Remove(fname)
+		return err
+	}
+	return f.Close()
+}
+
+// How many snapshots -rewind keeps
+const rewindKeep = 100
+
 func usage(reason string) {
 	fmt.Printf(`%s
 
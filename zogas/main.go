@@ -7,13 +7,23 @@ import (
 	"io/ioutil"
 	"log"
 	"os"
+	"path/filepath"
+	"strconv"
 
 	"github.com/jbert/zog"
+	"github.com/jbert/zog/file"
 )
 
 type options struct {
-	inFileName  string
-	outFileName string
+	inFileName   string
+	outFileName  string
+	listFileName string
+	symFileName  string
+	mapFileName  string
+	format       string
+	fill         int
+	entry        string
+	name         string
 }
 
 func main() {
@@ -23,18 +33,6 @@ func main() {
 		os.Exit(1)
 	}
 
-	var in io.Reader
-	if o.inFileName == "-" {
-		in = os.Stdin
-	} else {
-		f, err := os.Open(o.inFileName)
-		if err != nil {
-			log.Fatalf("Can't open [%s]: %s", o.inFileName, err)
-		}
-		defer f.Close()
-		in = f
-	}
-
 	var w io.Writer
 	if o.outFileName == "-" {
 		w = os.Stdout
@@ -47,37 +45,161 @@ func main() {
 		w = f
 	}
 
-	contents, err := ioutil.ReadAll(in)
+	var assembly *zog.Assembly
+	if o.inFileName == "-" {
+		var contents []byte
+		contents, err = ioutil.ReadAll(os.Stdin)
+		if err != nil {
+			log.Fatalf("Failed to read file: %s", err)
+		}
+		assembly, err = zog.Assemble(string(contents))
+	} else {
+		assembly, err = zog.AssembleFile(o.inFileName)
+	}
 	if err != nil {
-		log.Fatalf("Failed to read file: %s", err)
+		reportErrors(err)
+		os.Exit(1)
+	}
+	for _, w := range assembly.Warnings {
+		fmt.Fprintln(os.Stderr, w.Detail())
+	}
+
+	if o.format == "obj" {
+		var obj *zog.Object
+		obj, err = assembly.Object(o.inFileName)
+		if err == nil {
+			err = obj.Write(w)
+		}
+	} else {
+		err = writeProgram(w, o, assembly)
+	}
+	if e, ok := err.(*zog.Error); ok {
+		// Code which can't be placed
+		fmt.Fprintln(os.Stderr, e.Detail())
+		os.Exit(1)
 	}
-	assembly, err := zog.Assemble(string(contents))
 	if err != nil {
-		log.Fatalf("failed to assemble: %s", err)
+		log.Fatalf("failed to write: %s", err)
+	}
+
+	writeExtra(o.listFileName, assembly.WriteListing)
+	writeExtra(o.symFileName, assembly.WriteSymbols)
+	writeExtra(o.mapFileName, assembly.SourceMap().Write)
+}
+
+// writeProgram places the code at its addresses and writes it out.
+// Relocatable code is linked on its own, with the sections following the
+// absolute code.
+func writeProgram(w io.Writer, o *options, assembly *zog.Assembly) error {
+	prog := &file.Program{Name: o.name, Fill: byte(o.fill)}
+	var entry uint16
+	var hasEntry bool
+	symbols := make(map[string]int)
+	if assembly.Relocatable() {
+		obj, err := assembly.Object(o.inFileName)
+		if err != nil {
+			return err
+		}
+		linked, err := zog.Link([]*zog.Object{obj}, nil)
+		if err != nil {
+			return err
+		}
+		prog.Blocks, entry, hasEntry, symbols = linked.Blocks, linked.Entry, linked.HasEntry, linked.Symbols
+	} else {
+		var err error
+		prog.Blocks, err = assembly.Blocks()
+		if err != nil {
+			return err
+		}
+		entry, hasEntry = assembly.Entry, assembly.HasEntry
+		for name, sym := range assembly.Symbols {
+			symbols[name] = sym.Value
+		}
 	}
 
-	fmt.Fprintf(os.Stderr, assembly.String())
-	for _, linst := range assembly.Linsts {
-		encodedBuf := linst.Inst.Encode()
-		n, err := w.Write(encodedBuf)
+	switch {
+	case o.entry != "":
+		var err error
+		prog.Entry, err = parseEntry(o.entry, symbols)
 		if err != nil {
-			log.Fatalf("failed to write: %s", err)
+			return fmt.Errorf("Bad entry point: %s", err)
 		}
-		if n != len(encodedBuf) {
-			log.Fatalf("partial write: wrote %d not %d", n, len(encodedBuf))
+	case hasEntry:
+		prog.Entry = entry
+	default:
+		prog.Entry = uint16(prog.Low())
+	}
+	return file.WriteProgram(w, o.format, prog)
+}
+
+// parseEntry takes an entry point given as a symbol or an address
+func parseEntry(s string, symbols map[string]int) (uint16, error) {
+	if v, ok := symbols[s]; ok {
+		return uint16(v), nil
+	}
+	n, err := strconv.ParseUint(s, 0, 16)
+	if err != nil {
+		return 0, fmt.Errorf("[%s] is neither a symbol nor an address", s)
+	}
+	return uint16(n), nil
+}
+
+// reportErrors shows each error with the source line it is on
+func reportErrors(err error) {
+	switch e := err.(type) {
+	case zog.ErrorList:
+		for _, d := range e {
+			fmt.Fprintln(os.Stderr, d.Detail())
 		}
+		fmt.Fprintf(os.Stderr, "%d error(s)\n", e.Errors())
+	default:
+		fmt.Fprintf(os.Stderr, "failed to assemble: %s\n", err)
 	}
+}
 
+// writeExtra writes one of the optional outputs, if a file was given for it
+func writeExtra(fname string, write func(w io.Writer) error) {
+	if fname == "" {
+		return
+	}
+	f, err := os.Create(fname)
+	if err != nil {
+		log.Fatalf("Can't open [%s]: %s", fname, err)
+	}
+	err = write(f)
+	if err == nil {
+		err = f.Close()
+	}
+	if err != nil {
+		log.Fatalf("Can't write [%s]: %s", fname, err)
+	}
 }
 
 func parseArgs() (*options, error) {
 	o := options{}
 	flag.StringVar(&o.outFileName, "out", "-", "Output file (default stdout)")
+	flag.StringVar(&o.listFileName, "list", "", "Write a listing to `file`")
+	flag.StringVar(&o.symFileName, "sym", "", "Write the symbol table to `file`")
+	flag.StringVar(&o.mapFileName, "map", "", "Write a JSON source map to `file`")
+	flag.StringVar(&o.format, "format", "", "Output format: bin, hex, com, tap, sna, z80, or obj for a relocatable object (default from the -out extension)")
+	flag.IntVar(&o.fill, "fill", 0, "Byte to fill gaps between ORG blocks")
+	flag.StringVar(&o.entry, "entry", "", "Entry point, as a symbol or address (default from END, else the lowest address)")
+	flag.StringVar(&o.name, "name", "", "Program name, for .tap output")
 	flag.Parse()
-	if len(os.Args) == 2 {
-		o.inFileName = os.Args[1]
+	if flag.NArg() == 1 {
+		o.inFileName = flag.Arg(0)
 	} else {
 		o.inFileName = "-"
 	}
+	if o.format == "" {
+		o.format = file.FormatForFile(o.outFileName)
+		ext := filepath.Ext(o.outFileName)
+		if ext == ".o" || ext == ".obj" {
+			o.format = "obj"
+		}
+	}
+	if o.fill < 0 || o.fill > 0xff {
+		return nil, fmt.Errorf("Fill byte %d out of range", o.fill)
+	}
 	return &o, nil
 }
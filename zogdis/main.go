@@ -0,0 +1,112 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/jbert/zog"
+)
+
+type options struct {
+	inFileName  string
+	outFileName string
+	org         int
+	entries     addrList
+	symbols     string
+}
+
+// addrList collects repeated or comma separated -entry flags
+type addrList []uint16
+
+func (l *addrList) String() string {
+	var parts []string
+	for _, addr := range *l {
+		parts = append(parts, fmt.Sprintf("0x%04X", addr))
+	}
+	return strings.Join(parts, ",")
+}
+
+func (l *addrList) Set(v string) error {
+	for _, s := range strings.Split(v, ",") {
+		addr, err := strconv.ParseUint(strings.TrimSpace(s), 0, 16)
+		if err != nil {
+			return fmt.Errorf("Bad address [%s]: %s", s, err)
+		}
+		*l = append(*l, uint16(addr))
+	}
+	return nil
+}
+
+func main() {
+	o, err := parseArgs()
+	if err != nil {
+		log.Fatalf("Bad arguments: %s\n", err)
+	}
+
+	buf, err := ioutil.ReadFile(o.inFileName)
+	if err != nil {
+		log.Fatalf("Can't read [%s]: %s", o.inFileName, err)
+	}
+
+	d, err := zog.Disassemble(buf, uint16(o.org), o.entries)
+	if err != nil {
+		log.Fatalf("failed to disassemble: %s", err)
+	}
+	if o.symbols != "" {
+		st, err := zog.LoadSymbols(o.symbols)
+		if err != nil {
+			log.Fatalf("Bad symbols: %s", err)
+		}
+		d.Annotate(st)
+	}
+
+	var w io.Writer
+	if o.outFileName == "-" {
+		w = os.Stdout
+	} else {
+		f, err := os.Create(o.outFileName)
+		if err != nil {
+			log.Fatalf("Can't open [%s]: %s", o.outFileName, err)
+		}
+		defer f.Close()
+		w = f
+	}
+	err = d.Write(w)
+	if err != nil {
+		log.Fatalf("failed to write: %s", err)
+	}
+}
+
+func parseArgs() (*options, error) {
+	o := options{}
+	flag.StringVar(&o.outFileName, "out", "-", "Output file (default stdout)")
+	flag.IntVar(&o.org, "org", -1, "Address the binary loads at (default 0x100 for .com files, else 0)")
+	flag.StringVar(&o.symbols, "symbols", "", "Name addresses from symbol `files`, comma separated. spectrum48k and cpm are built in.")
+	flag.Var(&o.entries, "entry", "Entry point `addr`esses, comma separated or repeated (default the load address)")
+	flag.Parse()
+	if flag.NArg() != 1 {
+		return nil, fmt.Errorf("Expected one binary file to disassemble")
+	}
+	o.inFileName = flag.Arg(0)
+	if o.org < 0 {
+		o.org = 0
+		if strings.ToLower(filepath.Ext(o.inFileName)) == ".com" {
+			// CP/M programs load at the start of the TPA
+			o.org = 0x100
+		}
+	}
+	if o.org > 0xffff {
+		return nil, fmt.Errorf("Load address %d out of range", o.org)
+	}
+	if len(o.entries) == 0 {
+		o.entries = addrList{uint16(o.org)}
+	}
+	return &o, nil
+}
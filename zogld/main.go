@@ -0,0 +1,179 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jbert/zog"
+	"github.com/jbert/zog/file"
+)
+
+type options struct {
+	objFileNames []string
+	outFileName  string
+	symFileName  string
+	format       string
+	fill         int
+	entry        string
+	name         string
+	sections     sectionAddrs
+	verbose      bool
+}
+
+// sectionAddrs collects -section name=addr flags
+type sectionAddrs map[string]uint16
+
+func (s sectionAddrs) String() string {
+	var parts []string
+	for name, addr := range s {
+		parts = append(parts, fmt.Sprintf("%s=0x%04X", name, addr))
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, ",")
+}
+
+func (s sectionAddrs) Set(v string) error {
+	i := strings.Index(v, "=")
+	if i < 0 {
+		return fmt.Errorf("Expected name=addr, got [%s]", v)
+	}
+	addr, err := strconv.ParseUint(v[i+1:], 0, 16)
+	if err != nil {
+		return fmt.Errorf("Bad address for section %s: %s", v[:i], err)
+	}
+	s[v[:i]] = uint16(addr)
+	return nil
+}
+
+func main() {
+	o, err := parseArgs()
+	if err != nil {
+		log.Fatalf("Bad arguments: %s\n", err)
+	}
+
+	var objs []*zog.Object
+	for _, fname := range o.objFileNames {
+		obj, err := readObject(fname)
+		if err != nil {
+			log.Fatalf("Can't read [%s]: %s", fname, err)
+		}
+		objs = append(objs, obj)
+	}
+
+	linked, err := zog.Link(objs, o.sections)
+	if err != nil {
+		log.Fatalf("failed to link: %s", err)
+	}
+	if o.verbose {
+		for _, s := range linked.Sections {
+			fmt.Fprintf(os.Stderr, "%-16s %04X-%04X (%d bytes)\n", s.Name, s.Addr, int(s.Addr)+s.Len, s.Len)
+		}
+	}
+
+	prog := &file.Program{Blocks: linked.Blocks, Name: o.name, Fill: byte(o.fill)}
+	switch {
+	case o.entry != "":
+		prog.Entry, err = parseEntry(o.entry, linked.Symbols)
+		if err != nil {
+			log.Fatalf("Bad entry point: %s", err)
+		}
+	case linked.HasEntry:
+		prog.Entry = linked.Entry
+	default:
+		prog.Entry = uint16(prog.Low())
+	}
+
+	var w io.Writer
+	if o.outFileName == "-" {
+		w = os.Stdout
+	} else {
+		f, err := os.Create(o.outFileName)
+		if err != nil {
+			log.Fatalf("Can't open [%s]: %s", o.outFileName, err)
+		}
+		defer f.Close()
+		w = f
+	}
+	err = file.WriteProgram(w, o.format, prog)
+	if err != nil {
+		log.Fatalf("failed to write: %s", err)
+	}
+
+	if o.symFileName != "" {
+		err = writeSymbols(o.symFileName, linked.Symbols)
+		if err != nil {
+			log.Fatalf("Can't write [%s]: %s", o.symFileName, err)
+		}
+	}
+}
+
+func readObject(fname string) (*zog.Object, error) {
+	f, err := os.Open(fname)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	obj, err := zog.ReadObject(f)
+	if err != nil {
+		return nil, err
+	}
+	if obj.Name == "" {
+		obj.Name = fname
+	}
+	return obj, nil
+}
+
+// parseEntry takes an entry point given as a symbol or an address
+func parseEntry(s string, symbols map[string]int) (uint16, error) {
+	if v, ok := symbols[s]; ok {
+		return uint16(v), nil
+	}
+	n, err := strconv.ParseUint(s, 0, 16)
+	if err != nil {
+		return 0, fmt.Errorf("[%s] is neither a symbol nor an address", s)
+	}
+	return uint16(n), nil
+}
+
+// writeSymbols writes the exported symbols in the same form as zogas -sym
+func writeSymbols(fname string, symbols map[string]int) error {
+	f, err := os.Create(fname)
+	if err != nil {
+		return err
+	}
+	err = zog.WriteSymbolValues(f, symbols)
+	if err == nil {
+		err = f.Close()
+	}
+	return err
+}
+
+func parseArgs() (*options, error) {
+	o := options{sections: make(sectionAddrs)}
+	flag.StringVar(&o.outFileName, "out", "-", "Output file (default stdout)")
+	flag.StringVar(&o.symFileName, "sym", "", "Write the exported symbols to `file`")
+	flag.StringVar(&o.format, "format", "", "Output format: bin, hex, com, tap, sna or z80 (default from the -out extension)")
+	flag.IntVar(&o.fill, "fill", 0, "Byte to fill gaps between sections")
+	flag.StringVar(&o.entry, "entry", "", "Entry point, as a symbol or address (default from END, else the lowest address)")
+	flag.StringVar(&o.name, "name", "", "Program name, for .tap output")
+	flag.Var(o.sections, "section", "Place a section, as `name=addr` (may be repeated)")
+	flag.BoolVar(&o.verbose, "v", false, "Show where each section was placed")
+	flag.Parse()
+	o.objFileNames = flag.Args()
+	if len(o.objFileNames) == 0 {
+		return nil, fmt.Errorf("No object files given")
+	}
+	if o.format == "" {
+		o.format = file.FormatForFile(o.outFileName)
+	}
+	if o.fill < 0 || o.fill > 0xff {
+		return nil, fmt.Errorf("Fill byte %d out of range", o.fill)
+	}
+	return &o, nil
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/jbert/zog"
+)
+
+type options struct {
+	aFileName string
+	bFileName string
+	context   int
+	ignore    string
+	start     string
+	state     string
+	symbols   string
+}
+
+func main() {
+	o, err := parseArgs()
+	if err != nil {
+		log.Fatalf("Bad arguments: %s\n", err)
+	}
+
+	var st *zog.SymbolTable
+	if o.symbols != "" {
+		st, err = zog.LoadSymbols(o.symbols)
+		if err != nil {
+			log.Fatalf("Bad symbols: %s", err)
+		}
+	}
+
+	opts := zog.TraceDiffOptions{Context: o.context}
+	if o.ignore != "" {
+		opts.Ignore = strings.Split(strings.ToUpper(o.ignore), ",")
+	}
+	if o.start != "" {
+		start, err := strconv.ParseUint(o.start, 16, 16)
+		if err != nil {
+			log.Fatalf("Bad start address [%s]: %s", o.start, err)
+		}
+		opts.HasStart, opts.Start = true, uint16(start)
+	}
+
+	a := openTrace(o.aFileName, o.state == "before")
+	b := openTrace(o.bFileName, o.state == "before")
+	d, err := zog.DiffTraces(a, b, opts)
+	if err != nil {
+		log.Fatalf("Can't compare traces: %s", err)
+	}
+	if d == nil {
+		fmt.Printf("Traces are the same\n")
+		return
+	}
+
+	fmt.Printf("Traces diverge after %d instructions\n", d.Index)
+	for i := range d.Context {
+		fmt.Printf("  %s\n", d.Context[i].Format(st))
+	}
+	for _, side := range []struct {
+		mark string
+		name string
+		rec  *zog.TraceRecord
+	}{{"<", o.aFileName, d.A}, {">", o.bFileName, d.B}} {
+		if side.rec == nil {
+			fmt.Printf("%s %s ends\n", side.mark, side.name)
+		} else {
+			fmt.Printf("%s %s\n", side.mark, side.rec.Format(st))
+		}
+	}
+	if len(d.Fields) > 0 {
+		fmt.Printf("Differs in: %s\n", strings.Join(d.Fields, ", "))
+	}
+	os.Exit(1)
+}
+
+func openTrace(fname string, before bool) zog.TraceSource {
+	f, err := os.Open(fname)
+	if err != nil {
+		log.Fatalf("Can't open [%s]: %s", fname, err)
+	}
+	src, err := zog.OpenTrace(f, before)
+	if err != nil {
+		log.Fatalf("Can't read [%s]: %s", fname, err)
+	}
+	return src
+}
+
+func parseArgs() (*options, error) {
+	o := options{}
+	flag.IntVar(&o.context, "context", 10, "Number of matching instructions to show before the divergence")
+	flag.StringVar(&o.ignore, "ignore", "R", "Registers, or reads or writes, not to compare, comma separated")
+	flag.StringVar(&o.start, "start", "", "Skip each trace until it reaches this hex `addr`ess")
+	flag.StringVar(&o.state, "state", "before", "Whether registers in text traces are from before or after each instruction (zog's text traces are after)")
+	flag.StringVar(&o.symbols, "symbols", "", "Name addresses from symbol `files`, comma separated. spectrum48k and cpm are built in.")
+	flag.Parse()
+	if flag.NArg() != 2 {
+		return nil, fmt.Errorf("Expected two traces to compare")
+	}
+	if o.state != "before" && o.state != "after" {
+		return nil, fmt.Errorf("State must be before or after, not [%s]", o.state)
+	}
+	o.aFileName = flag.Arg(0)
+	o.bFileName = flag.Arg(1)
+	return &o, nil
+}